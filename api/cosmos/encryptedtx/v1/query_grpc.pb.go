@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cosmos/encryptedtx/v1/query.proto
+
+package encryptedtxv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Query_Params_FullMethodName       = "/cosmos.encryptedtx.v1.Query/Params"
+	Query_ThresholdKey_FullMethodName = "/cosmos.encryptedtx.v1.Query/ThresholdKey"
+	Query_DKG_FullMethodName          = "/cosmos.encryptedtx.v1.Query/DKG"
+	Query_EncryptedTxs_FullMethodName = "/cosmos.encryptedtx.v1.Query/EncryptedTxs"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the encryptedtx gRPC querier service.
+type QueryClient interface {
+	// Params returns the encryptedtx parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ThresholdKey returns the key encrypted txs are encrypted with.
+	ThresholdKey(ctx context.Context, in *QueryThresholdKeyRequest, opts ...grpc.CallOption) (*QueryThresholdKeyResponse, error)
+	// DKG returns the last distributed key generation and its dealings.
+	DKG(ctx context.Context, in *QueryDKGRequest, opts ...grpc.CallOption) (*QueryDKGResponse, error)
+	// EncryptedTxs returns the encrypted txs pending decryption.
+	EncryptedTxs(ctx context.Context, in *QueryEncryptedTxsRequest, opts ...grpc.CallOption) (*QueryEncryptedTxsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ThresholdKey(ctx context.Context, in *QueryThresholdKeyRequest, opts ...grpc.CallOption) (*QueryThresholdKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryThresholdKeyResponse)
+	err := c.cc.Invoke(ctx, Query_ThresholdKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DKG(ctx context.Context, in *QueryDKGRequest, opts ...grpc.CallOption) (*QueryDKGResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDKGResponse)
+	err := c.cc.Invoke(ctx, Query_DKG_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptedTxs(ctx context.Context, in *QueryEncryptedTxsRequest, opts ...grpc.CallOption) (*QueryEncryptedTxsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEncryptedTxsResponse)
+	err := c.cc.Invoke(ctx, Query_EncryptedTxs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility.
+//
+// Query defines the encryptedtx gRPC querier service.
+type QueryServer interface {
+	// Params returns the encryptedtx parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ThresholdKey returns the key encrypted txs are encrypted with.
+	ThresholdKey(context.Context, *QueryThresholdKeyRequest) (*QueryThresholdKeyResponse, error)
+	// DKG returns the last distributed key generation and its dealings.
+	DKG(context.Context, *QueryDKGRequest) (*QueryDKGResponse, error)
+	// EncryptedTxs returns the encrypted txs pending decryption.
+	EncryptedTxs(context.Context, *QueryEncryptedTxsRequest) (*QueryEncryptedTxsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) ThresholdKey(context.Context, *QueryThresholdKeyRequest) (*QueryThresholdKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThresholdKey not implemented")
+}
+func (UnimplementedQueryServer) DKG(context.Context, *QueryDKGRequest) (*QueryDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKG not implemented")
+}
+func (UnimplementedQueryServer) EncryptedTxs(context.Context, *QueryEncryptedTxsRequest) (*QueryEncryptedTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxs not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call pancis, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ThresholdKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryThresholdKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ThresholdKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ThresholdKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ThresholdKey(ctx, req.(*QueryThresholdKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DKG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DKG_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DKG(ctx, req.(*QueryDKGRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EncryptedTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxs(ctx, req.(*QueryEncryptedTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.encryptedtx.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ThresholdKey",
+			Handler:    _Query_ThresholdKey_Handler,
+		},
+		{
+			MethodName: "DKG",
+			Handler:    _Query_DKG_Handler,
+		},
+		{
+			MethodName: "EncryptedTxs",
+			Handler:    _Query_EncryptedTxs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/encryptedtx/v1/query.proto",
+}
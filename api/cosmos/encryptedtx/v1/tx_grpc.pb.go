@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cosmos/encryptedtx/v1/tx.proto
+
+package encryptedtxv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Msg_SubmitEncryptedTx_FullMethodName = "/cosmos.encryptedtx.v1.Msg/SubmitEncryptedTx"
+	Msg_StartDKG_FullMethodName          = "/cosmos.encryptedtx.v1.Msg/StartDKG"
+	Msg_RegisterDKGKey_FullMethodName    = "/cosmos.encryptedtx.v1.Msg/RegisterDKGKey"
+	Msg_SubmitDealing_FullMethodName     = "/cosmos.encryptedtx.v1.Msg/SubmitDealing"
+	Msg_SubmitComplaint_FullMethodName   = "/cosmos.encryptedtx.v1.Msg/SubmitComplaint"
+	Msg_UpdateParams_FullMethodName      = "/cosmos.encryptedtx.v1.Msg/UpdateParams"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Msg defines the x/encryptedtx Msg service.
+type MsgClient interface {
+	// SubmitEncryptedTx orders an encrypted tx, it is decrypted and executed at
+	// the beginning of the block after the next one.
+	SubmitEncryptedTx(ctx context.Context, in *MsgSubmitEncryptedTx, opts ...grpc.CallOption) (*MsgSubmitEncryptedTxResponse, error)
+	// StartDKG defines a governance operation starting a distributed key
+	// generation of a new threshold key among the given validators.
+	StartDKG(ctx context.Context, in *MsgStartDKG, opts ...grpc.CallOption) (*MsgStartDKGResponse, error)
+	// RegisterDKGKey registers the key a participant of the distributed key
+	// generation receives its shares with.
+	RegisterDKGKey(ctx context.Context, in *MsgRegisterDKGKey, opts ...grpc.CallOption) (*MsgRegisterDKGKeyResponse, error)
+	// SubmitDealing submits the dealing of a participant of the distributed key
+	// generation.
+	SubmitDealing(ctx context.Context, in *MsgSubmitDealing, opts ...grpc.CallOption) (*MsgSubmitDealingResponse, error)
+	// SubmitComplaint submits the complaint of a participant of the distributed
+	// key generation which received an invalid share, disqualifying its dealer.
+	SubmitComplaint(ctx context.Context, in *MsgSubmitComplaint, opts ...grpc.CallOption) (*MsgSubmitComplaintResponse, error)
+	// UpdateParams defines a governance operation for updating the x/encryptedtx module
+	// parameters. The authority is defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitEncryptedTx(ctx context.Context, in *MsgSubmitEncryptedTx, opts ...grpc.CallOption) (*MsgSubmitEncryptedTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitEncryptedTxResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitEncryptedTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StartDKG(ctx context.Context, in *MsgStartDKG, opts ...grpc.CallOption) (*MsgStartDKGResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStartDKGResponse)
+	err := c.cc.Invoke(ctx, Msg_StartDKG_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterDKGKey(ctx context.Context, in *MsgRegisterDKGKey, opts ...grpc.CallOption) (*MsgRegisterDKGKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterDKGKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterDKGKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitDealing(ctx context.Context, in *MsgSubmitDealing, opts ...grpc.CallOption) (*MsgSubmitDealingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitDealingResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitDealing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitComplaint(ctx context.Context, in *MsgSubmitComplaint, opts ...grpc.CallOption) (*MsgSubmitComplaintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitComplaintResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitComplaint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility.
+//
+// Msg defines the x/encryptedtx Msg service.
+type MsgServer interface {
+	// SubmitEncryptedTx orders an encrypted tx, it is decrypted and executed at
+	// the beginning of the block after the next one.
+	SubmitEncryptedTx(context.Context, *MsgSubmitEncryptedTx) (*MsgSubmitEncryptedTxResponse, error)
+	// StartDKG defines a governance operation starting a distributed key
+	// generation of a new threshold key among the given validators.
+	StartDKG(context.Context, *MsgStartDKG) (*MsgStartDKGResponse, error)
+	// RegisterDKGKey registers the key a participant of the distributed key
+	// generation receives its shares with.
+	RegisterDKGKey(context.Context, *MsgRegisterDKGKey) (*MsgRegisterDKGKeyResponse, error)
+	// SubmitDealing submits the dealing of a participant of the distributed key
+	// generation.
+	SubmitDealing(context.Context, *MsgSubmitDealing) (*MsgSubmitDealingResponse, error)
+	// SubmitComplaint submits the complaint of a participant of the distributed
+	// key generation which received an invalid share, disqualifying its dealer.
+	SubmitComplaint(context.Context, *MsgSubmitComplaint) (*MsgSubmitComplaintResponse, error)
+	// UpdateParams defines a governance operation for updating the x/encryptedtx module
+	// parameters. The authority is defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMsgServer struct{}
+
+func (UnimplementedMsgServer) SubmitEncryptedTx(context.Context, *MsgSubmitEncryptedTx) (*MsgSubmitEncryptedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEncryptedTx not implemented")
+}
+func (UnimplementedMsgServer) StartDKG(context.Context, *MsgStartDKG) (*MsgStartDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDKG not implemented")
+}
+func (UnimplementedMsgServer) RegisterDKGKey(context.Context, *MsgRegisterDKGKey) (*MsgRegisterDKGKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDKGKey not implemented")
+}
+func (UnimplementedMsgServer) SubmitDealing(context.Context, *MsgSubmitDealing) (*MsgSubmitDealingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDealing not implemented")
+}
+func (UnimplementedMsgServer) SubmitComplaint(context.Context, *MsgSubmitComplaint) (*MsgSubmitComplaintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitComplaint not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+func (UnimplementedMsgServer) testEmbeddedByValue()             {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	// If the following call pancis, it indicates UnimplementedMsgServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_SubmitEncryptedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEncryptedTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEncryptedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitEncryptedTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEncryptedTx(ctx, req.(*MsgSubmitEncryptedTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StartDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartDKG)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StartDKG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StartDKG_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartDKG(ctx, req.(*MsgStartDKG))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterDKGKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDKGKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDKGKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterDKGKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDKGKey(ctx, req.(*MsgRegisterDKGKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDealing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDealing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDealing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitDealing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDealing(ctx, req.(*MsgSubmitDealing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitComplaint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitComplaint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitComplaint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitComplaint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitComplaint(ctx, req.(*MsgSubmitComplaint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.encryptedtx.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitEncryptedTx",
+			Handler:    _Msg_SubmitEncryptedTx_Handler,
+		},
+		{
+			MethodName: "StartDKG",
+			Handler:    _Msg_StartDKG_Handler,
+		},
+		{
+			MethodName: "RegisterDKGKey",
+			Handler:    _Msg_RegisterDKGKey_Handler,
+		},
+		{
+			MethodName: "SubmitDealing",
+			Handler:    _Msg_SubmitDealing_Handler,
+		},
+		{
+			MethodName: "SubmitComplaint",
+			Handler:    _Msg_SubmitComplaint_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/encryptedtx/v1/tx.proto",
+}
@@ -31,6 +31,8 @@ const (
 	Query_Class_FullMethodName                = "/cosmos.nft.v1beta1.Query/Class"
 	Query_ClassByQueryString_FullMethodName   = "/cosmos.nft.v1beta1.Query/ClassByQueryString"
 	Query_Classes_FullMethodName              = "/cosmos.nft.v1beta1.Query/Classes"
+	Query_Royalty_FullMethodName              = "/cosmos.nft.v1beta1.Query/Royalty"
+	Query_TransferHooks_FullMethodName        = "/cosmos.nft.v1beta1.Query/TransferHooks"
 )
 
 // QueryClient is the client API for Query service.
@@ -64,6 +66,11 @@ type QueryClient interface {
 	ClassByQueryString(ctx context.Context, in *QueryClassByQueryStringRequest, opts ...grpc.CallOption) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// Royalty queries the royalty info of an NFT class and, if a sale price is given, the royalty owed on the sale,
+	// same as royaltyInfo in EIP-2981
+	Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error)
+	// TransferHooks queries the names of the modules whose hooks are called on NFT transfers, in order
+	TransferHooks(ctx context.Context, in *QueryTransferHooksRequest, opts ...grpc.CallOption) (*QueryTransferHooksResponse, error)
 }
 
 type queryClient struct {
@@ -194,6 +201,26 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRoyaltyResponse)
+	err := c.cc.Invoke(ctx, Query_Royalty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferHooks(ctx context.Context, in *QueryTransferHooksRequest, opts ...grpc.CallOption) (*QueryTransferHooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferHooksResponse)
+	err := c.cc.Invoke(ctx, Query_TransferHooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -225,6 +252,11 @@ type QueryServer interface {
 	ClassByQueryString(context.Context, *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// Royalty queries the royalty info of an NFT class and, if a sale price is given, the royalty owed on the sale,
+	// same as royaltyInfo in EIP-2981
+	Royalty(context.Context, *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error)
+	// TransferHooks queries the names of the modules whose hooks are called on NFT transfers, in order
+	TransferHooks(context.Context, *QueryTransferHooksRequest) (*QueryTransferHooksResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -271,6 +303,12 @@ func (UnimplementedQueryServer) ClassByQueryString(context.Context, *QueryClassB
 func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (UnimplementedQueryServer) Royalty(context.Context, *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Royalty not implemented")
+}
+func (UnimplementedQueryServer) TransferHooks(context.Context, *QueryTransferHooksRequest) (*QueryTransferHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferHooks not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -508,6 +546,42 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Royalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Royalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Royalty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Royalty(ctx, req.(*QueryRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferHooks(ctx, req.(*QueryTransferHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -563,6 +637,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "Royalty",
+			Handler:    _Query_Royalty_Handler,
+		},
+		{
+			MethodName: "TransferHooks",
+			Handler:    _Query_TransferHooks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
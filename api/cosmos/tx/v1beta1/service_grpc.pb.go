@@ -19,15 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Service_Simulate_FullMethodName        = "/cosmos.tx.v1beta1.Service/Simulate"
-	Service_GetTx_FullMethodName           = "/cosmos.tx.v1beta1.Service/GetTx"
-	Service_BroadcastTx_FullMethodName     = "/cosmos.tx.v1beta1.Service/BroadcastTx"
-	Service_GetTxsEvent_FullMethodName     = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
-	Service_GetBlockWithTxs_FullMethodName = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
-	Service_TxDecode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxDecode"
-	Service_TxEncode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxEncode"
-	Service_TxEncodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
-	Service_TxDecodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_Simulate_FullMethodName          = "/cosmos.tx.v1beta1.Service/Simulate"
+	Service_SimulateWithTrace_FullMethodName = "/cosmos.tx.v1beta1.Service/SimulateWithTrace"
+	Service_GetTx_FullMethodName             = "/cosmos.tx.v1beta1.Service/GetTx"
+	Service_BroadcastTx_FullMethodName       = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+	Service_GetTxsEvent_FullMethodName       = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
+	Service_GetBlockWithTxs_FullMethodName   = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
+	Service_TxDecode_FullMethodName          = "/cosmos.tx.v1beta1.Service/TxDecode"
+	Service_TxEncode_FullMethodName          = "/cosmos.tx.v1beta1.Service/TxEncode"
+	Service_TxEncodeAmino_FullMethodName     = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
+	Service_TxDecodeAmino_FullMethodName     = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
 )
 
 // ServiceClient is the client API for Service service.
@@ -38,6 +39,9 @@ const (
 type ServiceClient interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// SimulateWithTrace simulates executing a transaction like Simulate,
+	// additionally returning the ordered store operations it performed.
+	SimulateWithTrace(ctx context.Context, in *SimulateWithTraceRequest, opts ...grpc.CallOption) (*SimulateWithTraceResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -74,6 +78,16 @@ func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts
 	return out, nil
 }
 
+func (c *serviceClient) SimulateWithTrace(ctx context.Context, in *SimulateWithTraceRequest, opts ...grpc.CallOption) (*SimulateWithTraceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateWithTraceResponse)
+	err := c.cc.Invoke(ctx, Service_SimulateWithTrace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTxResponse)
@@ -162,6 +176,9 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// SimulateWithTrace simulates executing a transaction like Simulate,
+	// additionally returning the ordered store operations it performed.
+	SimulateWithTrace(context.Context, *SimulateWithTraceRequest) (*SimulateWithTraceResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -191,6 +208,9 @@ type UnimplementedServiceServer struct{}
 func (UnimplementedServiceServer) Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
+func (UnimplementedServiceServer) SimulateWithTrace(context.Context, *SimulateWithTraceRequest) (*SimulateWithTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateWithTrace not implemented")
+}
 func (UnimplementedServiceServer) GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
@@ -254,6 +274,24 @@ func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SimulateWithTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateWithTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateWithTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SimulateWithTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateWithTrace(ctx, req.(*SimulateWithTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTxRequest)
 	if err := dec(in); err != nil {
@@ -409,6 +447,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Simulate",
 			Handler:    _Service_Simulate_Handler,
 		},
+		{
+			MethodName: "SimulateWithTrace",
+			Handler:    _Service_SimulateWithTrace_Handler,
+		},
 		{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
@@ -0,0 +1,159 @@
+package baseapp
+
+import (
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// LaneProposalHandler defines ABCI PrepareProposal and ProcessProposal handlers
+// building blocks out of the lanes of a mempool.LaneMempool. Each lane fills the
+// section of the block it is entitled to, in lane order, so that e.g. oracle
+// updates or governance txs cannot be crowded out by regular txs.
+type LaneProposalHandler struct {
+	mempool          *mempool.LaneMempool
+	txVerifier       ProposalTxVerifier
+	signerExtAdapter mempool.SignerExtractionAdapter
+}
+
+func NewLaneProposalHandler(mp *mempool.LaneMempool, txVerifier ProposalTxVerifier) *LaneProposalHandler {
+	return &LaneProposalHandler{
+		mempool:          mp,
+		txVerifier:       txVerifier,
+		signerExtAdapter: mempool.NewDefaultSignerExtractionAdapter(),
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler selecting the txs of
+// each lane in order. A lane selects txs from its mempool until it reaches its
+// share of RequestPrepareProposal.MaxTxBytes and of the block max gas, or the
+// remaining space of the block if lower. Txs are verified as in
+// DefaultProposalHandler, the invalid ones are removed from their lane.
+func (h *LaneProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+		var maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil { // nolint:staticcheck // ignore linting error
+			maxBlockGas = uint64(b.MaxGas)
+		}
+
+		var (
+			maxTxBytes             = uint64(req.MaxTxBytes)
+			totalTxBytes           uint64
+			totalTxGas             uint64
+			txs                    [][]byte
+			selectedTxsSignersSeqs = make(map[string]uint64)
+		)
+		for i, lane := range h.mempool.Lanes() {
+			laneMaxTxBytes := min(laneLimit(lane, maxTxBytes), maxTxBytes-totalTxBytes)
+			if laneMaxTxBytes == 0 {
+				continue
+			}
+
+			// a max gas of 0 means no limit, skip the lane instead
+			var laneMaxGas uint64
+			if maxBlockGas > 0 {
+				if laneMaxGas = min(laneLimit(lane, maxBlockGas), maxBlockGas-totalTxGas); laneMaxGas == 0 {
+					continue
+				}
+			}
+
+			// txs which would not be attributed to the lane by ProcessProposal are
+			// skipped
+			skip := func(tx sdk.Tx) bool {
+				laneIdx, ok := h.mempool.LaneIndex(tx)
+				return !ok || laneIdx != i
+			}
+
+			txSelector := &defaultTxSelector{}
+			iterator := lane.Mempool.Select(ctx, req.Txs)
+			err := selectProposalTxs(ctx, iterator, lane.Mempool, h.txVerifier, h.signerExtAdapter, txSelector,
+				laneMaxTxBytes, laneMaxGas, selectedTxsSignersSeqs, skip)
+			if err != nil {
+				return nil, err
+			}
+
+			totalTxBytes += txSelector.totalTxBytes
+			totalTxGas += txSelector.totalTxGas
+			txs = append(txs, txSelector.selectedTxs...)
+		}
+
+		return &abci.PrepareProposalResponse{Txs: txs}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler verifying the txs as
+// DefaultProposalHandler does. In addition, the proposal is rejected if:
+//
+// 1. A transaction matches none of the lanes.
+// 2. The transactions are not grouped by lane, in lane order.
+// 3. The transactions of a lane use more than the lane share of the block max
+// bytes or of the block max gas.
+//
+// The lane bytes limit is computed from the block max bytes since the max tx
+// bytes of the proposal are not known, it is therefore slightly more lenient
+// than the limit applied by PrepareProposalHandler.
+func (h *LaneProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		var maxBlockBytes, maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil { // nolint:staticcheck // ignore linting error
+			if b.MaxBytes > 0 {
+				maxBlockBytes = uint64(b.MaxBytes)
+			}
+			if b.MaxGas > 0 {
+				maxBlockGas = uint64(b.MaxGas)
+			}
+		}
+
+		var (
+			lanes                      = h.mempool.Lanes()
+			currentLane                int
+			laneTxBytes, laneTxGas     uint64
+			laneMaxTxBytes, laneMaxGas = laneLimit(lanes[0], maxBlockBytes), laneLimit(lanes[0], maxBlockGas)
+			totalTxGas                 uint64
+			reject                     = &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}
+		)
+		for _, txBytes := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			if err != nil {
+				return reject, nil
+			}
+
+			laneIdx, ok := h.mempool.LaneIndex(tx)
+			if !ok || laneIdx < currentLane {
+				return reject, nil
+			}
+			if laneIdx != currentLane {
+				currentLane = laneIdx
+				laneTxBytes, laneTxGas = 0, 0
+				laneMaxTxBytes, laneMaxGas = laneLimit(lanes[laneIdx], maxBlockBytes), laneLimit(lanes[laneIdx], maxBlockGas)
+			}
+
+			laneTxBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBytes}))
+			if maxBlockBytes > 0 && laneTxBytes > laneMaxTxBytes {
+				return reject, nil
+			}
+
+			if maxBlockGas > 0 {
+				if gasTx, ok := tx.(GasTx); ok {
+					laneTxGas += gasTx.GetGas()
+					totalTxGas += gasTx.GetGas()
+				}
+
+				if laneTxGas > laneMaxGas || totalTxGas > maxBlockGas {
+					return reject, nil
+				}
+			}
+		}
+
+		return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
+	}
+}
+
+// laneLimit returns the share of limit the lane is entitled to.
+func laneLimit(lane mempool.Lane, limit uint64) uint64 {
+	return lane.MaxBlockShare.MulInt(sdkmath.NewIntFromUint64(limit)).TruncateInt().Uint64()
+}
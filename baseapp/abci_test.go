@@ -28,6 +28,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
@@ -1058,6 +1059,67 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+type counterKeyDecoder struct{}
+
+func (counterKeyDecoder) DecodeKey(key []byte) (collection, decodedKey string, err error) {
+	return "counters", string(key), nil
+}
+
+func TestABCI_Query_SimulateTxWithTrace(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	opts := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+		bapp.SetTraceKeyDecoders(map[string]corestore.KeyDecoder{capKey1.Name(): counterKeyDecoder{}})
+	}
+	suite := NewBaseAppSuite(t, opts)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	gInfo, result, trace, err := suite.baseApp.SimulateWithTrace(txBytes)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	counterValue := func(i int64) []byte {
+		return binary.AppendVarint(nil, i)
+	}
+	expected := []corestore.TraceOperation{
+		{Type: corestore.TraceOperationGet, Key: anteKey},
+		{Type: corestore.TraceOperationSet, Key: anteKey, NewValue: counterValue(1)},
+		{Type: corestore.TraceOperationGet, Key: deliverKey},
+		{Type: corestore.TraceOperationSet, Key: deliverKey, NewValue: counterValue(1)},
+	}
+	require.Len(t, trace, len(expected))
+
+	var traceGas uint64
+	for i, op := range trace {
+		require.Equal(t, expected[i].Type, op.Type)
+		require.Equal(t, capKey1.Name(), op.StoreKey)
+		require.Equal(t, expected[i].Key, op.Key)
+		require.Equal(t, "counters", op.Collection)
+		require.Equal(t, string(expected[i].Key), op.DecodedKey)
+		require.Nil(t, op.OldValue)
+		require.Equal(t, expected[i].NewValue, op.NewValue)
+		require.NotZero(t, op.Gas)
+		traceGas += op.Gas
+	}
+	require.LessOrEqual(t, traceGas, gInfo.GasUsed)
+
+	// the trace does not alter the simulation result
+	simGasInfo, simResult, err := suite.baseApp.Simulate(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, simGasInfo, gInfo)
+	require.Equal(t, simResult.Events, result.Events)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
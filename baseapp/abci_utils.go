@@ -286,79 +286,109 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 		}
 
 		iterator := h.mempool.Select(ctx, req.Txs)
-		selectedTxsSignersSeqs := make(map[string]uint64)
-		var selectedTxsNums int
-		for iterator != nil {
-			memTx := iterator.Tx()
-			signerData, err := h.signerExtAdapter.GetSigners(memTx)
-			if err != nil {
-				return nil, err
-			}
+		err := selectProposalTxs(ctx, iterator, h.mempool, h.txVerifier, h.signerExtAdapter, h.txSelector,
+			uint64(req.MaxTxBytes), maxBlockGas, make(map[string]uint64), nil)
+		if err != nil {
+			return nil, err
+		}
 
-			// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
-			// so we add them and continue given that we don't need to check the sequence.
-			shouldAdd := true
-			txSignersSeqs := make(map[string]uint64)
-			for _, signer := range signerData {
-				seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
-				if !ok {
-					txSignersSeqs[signer.Signer.String()] = signer.Sequence
-					continue
-				}
+		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+	}
+}
 
-				// If we have seen this signer before in this block, we must make
-				// sure that the current sequence is seq+1; otherwise is invalid
-				// and we skip it.
-				if seq+1 != signer.Sequence {
-					shouldAdd = false
-					break
-				}
+// selectProposalTxs walks iterator and adds the valid txs to txSelector until it
+// is full or the iterator is exhausted. Txs failing verification are removed
+// from mp. selectedTxsSignersSeqs tracks the sequence of the signers of the txs
+// already selected for the proposal, so that a tx is skipped if it does not
+// follow the previous tx of one of its signers. Txs for which skip returns true
+// are ignored.
+func selectProposalTxs(
+	ctx sdk.Context,
+	iterator mempool.Iterator,
+	mp mempool.Mempool,
+	txVerifier ProposalTxVerifier,
+	signerExtAdapter mempool.SignerExtractionAdapter,
+	txSelector TxSelector,
+	maxTxBytes, maxBlockGas uint64,
+	selectedTxsSignersSeqs map[string]uint64,
+	skip func(sdk.Tx) bool,
+) error {
+	var selectedTxsNums int
+	for iterator != nil {
+		memTx := iterator.Tx()
+		if skip != nil && skip(memTx) {
+			iterator = iterator.Next()
+			continue
+		}
+
+		signerData, err := signerExtAdapter.GetSigners(memTx)
+		if err != nil {
+			return err
+		}
+
+		// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
+		// so we add them and continue given that we don't need to check the sequence.
+		shouldAdd := true
+		txSignersSeqs := make(map[string]uint64)
+		for _, signer := range signerData {
+			seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
+			if !ok {
 				txSignersSeqs[signer.Signer.String()] = signer.Sequence
-			}
-			if !shouldAdd {
-				iterator = iterator.Next()
 				continue
 			}
 
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
-			if err != nil {
-				err := h.mempool.Remove(memTx)
-				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-					return nil, err
-				}
-			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
-				if stop {
-					break
-				}
+			// If we have seen this signer before in this block, we must make
+			// sure that the current sequence is seq+1; otherwise is invalid
+			// and we skip it.
+			if seq+1 != signer.Sequence {
+				shouldAdd = false
+				break
+			}
+			txSignersSeqs[signer.Signer.String()] = signer.Sequence
+		}
+		if !shouldAdd {
+			iterator = iterator.Next()
+			continue
+		}
 
-				txsLen := len(h.txSelector.SelectedTxs(ctx))
-				for sender, seq := range txSignersSeqs {
-					// If txsLen != selectedTxsNums is true, it means that we've
-					// added a new tx to the selected txs, so we need to update
-					// the sequence of the sender.
-					if txsLen != selectedTxsNums {
-						selectedTxsSignersSeqs[sender] = seq
-					} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
-						// The transaction hasn't been added but it passed the
-						// verification, so we know that the sequence is correct.
-						// So we set this sender's sequence to seq-1, in order
-						// to avoid unnecessary calls to PrepareProposalVerifyTx.
-						selectedTxsSignersSeqs[sender] = seq - 1
-					}
-				}
-				selectedTxsNums = txsLen
+		// NOTE: Since transaction verification was already executed in CheckTx,
+		// which calls mempool.Insert, in theory everything in the pool should be
+		// valid. But some mempool implementations may insert invalid txs, so we
+		// check again.
+		txBz, err := txVerifier.PrepareProposalVerifyTx(memTx)
+		if err != nil {
+			err := mp.Remove(memTx)
+			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return err
+			}
+		} else {
+			stop := txSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
+			if stop {
+				break
 			}
 
-			iterator = iterator.Next()
+			txsLen := len(txSelector.SelectedTxs(ctx))
+			for sender, seq := range txSignersSeqs {
+				// If txsLen != selectedTxsNums is true, it means that we've
+				// added a new tx to the selected txs, so we need to update
+				// the sequence of the sender.
+				if txsLen != selectedTxsNums {
+					selectedTxsSignersSeqs[sender] = seq
+				} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
+					// The transaction hasn't been added but it passed the
+					// verification, so we know that the sequence is correct.
+					// So we set this sender's sequence to seq-1, in order
+					// to avoid unnecessary calls to PrepareProposalVerifyTx.
+					selectedTxsSignersSeqs[sender] = seq - 1
+				}
+			}
+			selectedTxsNums = txsLen
 		}
 
-		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+		iterator = iterator.Next()
 	}
+
+	return nil
 }
 
 // ProcessProposalHandler returns the default implementation for processing an
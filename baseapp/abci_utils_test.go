@@ -2,6 +2,8 @@ package baseapp_test
 
 import (
 	"bytes"
+	"errors"
+	"slices"
 	"sort"
 	"testing"
 
@@ -21,6 +23,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	authtx "cosmossdk.io/x/auth/tx"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -709,6 +712,202 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+// newTestLaneMempool returns a lane mempool with a "high" lane for the txs with
+// a value starting with "h", entitled to half of the block, and a "default"
+// lane for the txs with a value starting with "d".
+func newTestLaneMempool() *mempool.LaneMempool {
+	matchValuePrefix := func(prefix string) func(tx sdk.Tx) bool {
+		return func(tx sdk.Tx) bool {
+			msg, ok := tx.GetMsgs()[0].(*baseapptestutil.MsgKeyValue)
+			return ok && bytes.HasPrefix(msg.Value, []byte(prefix))
+		}
+	}
+
+	return mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:          "high",
+			Mempool:       mempool.DefaultPriorityMempool(),
+			Match:         matchValuePrefix("h"),
+			MaxBlockShare: sdkmath.LegacyNewDecWithPrec(5, 1),
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       mempool.DefaultPriorityMempool(),
+			Match:         matchValuePrefix("d"),
+			MaxBlockShare: sdkmath.LegacyOneDec(),
+		},
+	)
+}
+
+func (s *ABCIUtilsTestSuite) TestLaneProposalHandler_PrepareProposal() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	type testTx struct {
+		tx       sdk.Tx
+		priority int64
+		bz       []byte
+	}
+
+	testTxs := []testTx{
+		{tx: buildMsg(s.T(), txConfig, []byte(`d0`), [][]byte{[]byte("secret0")}, []uint64{1}), priority: 20},
+		{tx: buildMsg(s.T(), txConfig, []byte(`d1`), [][]byte{[]byte("secret1")}, []uint64{1}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`h2`), [][]byte{[]byte("secret2")}, []uint64{1}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`h3`), [][]byte{[]byte("secret3")}, []uint64{1}), priority: 30},
+		{tx: buildMsg(s.T(), txConfig, []byte(`h4`), [][]byte{[]byte("secret4")}, []uint64{1}), priority: 20},
+	}
+
+	for i := range testTxs {
+		bz, err := txConfig.TxEncoder()(testTxs[i].tx)
+		s.Require().NoError(err)
+		s.Require().Equal(194, int(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})))
+		testTxs[i].bz = bz
+	}
+
+	testCases := map[string]struct {
+		maxTxBytes  int64
+		invalidTxs  []int
+		expectedTxs []int
+	}{
+		"lanes are filled in order": {
+			maxTxBytes:  194 * 5,
+			expectedTxs: []int{3, 4, 0, 1},
+		},
+		"the last lane uses the space left by the first lanes": {
+			maxTxBytes:  194 * 3,
+			expectedTxs: []int{3, 0, 1},
+		},
+		"invalid txs are removed": {
+			maxTxBytes:  194 * 5,
+			invalidTxs:  []int{4, 0},
+			expectedTxs: []int{3, 2, 1},
+		},
+		"lane share too small for a tx": {
+			maxTxBytes:  194 + 96,
+			expectedTxs: []int{0},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctrl := gomock.NewController(s.T())
+			app := mock.NewMockProposalTxVerifier(ctrl)
+			mp := newTestLaneMempool()
+			ph := baseapp.NewLaneProposalHandler(mp, app)
+
+			for i, v := range testTxs {
+				if slices.Contains(tc.invalidTxs, i) {
+					app.EXPECT().PrepareProposalVerifyTx(v.tx).Return(nil, errors.New("invalid tx")).AnyTimes()
+				} else {
+					app.EXPECT().PrepareProposalVerifyTx(v.tx).Return(v.bz, nil).AnyTimes()
+				}
+				s.Require().NoError(mp.Insert(s.ctx.WithPriority(v.priority), v.tx))
+			}
+
+			resp, err := ph.PrepareProposalHandler()(s.ctx, &abci.PrepareProposalRequest{MaxTxBytes: tc.maxTxBytes})
+			s.Require().NoError(err)
+			respTxIndexes := []int{}
+			for _, tx := range resp.Txs {
+				for i, v := range testTxs {
+					if bytes.Equal(tx, v.bz) {
+						respTxIndexes = append(respTxIndexes, i)
+					}
+				}
+			}
+
+			s.Require().Equal(tc.expectedTxs, respTxIndexes)
+			s.Require().Equal(len(testTxs)-len(tc.invalidTxs), mp.CountTx())
+		})
+	}
+}
+
+func (s *ABCIUtilsTestSuite) TestLaneProposalHandler_ProcessProposal() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	testTxs := []sdk.Tx{
+		buildMsg(s.T(), txConfig, []byte(`h0`), [][]byte{[]byte("secret0")}, []uint64{1}),
+		buildMsg(s.T(), txConfig, []byte(`h1`), [][]byte{[]byte("secret1")}, []uint64{1}),
+		buildMsg(s.T(), txConfig, []byte(`h2`), [][]byte{[]byte("secret2")}, []uint64{1}),
+		buildMsg(s.T(), txConfig, []byte(`d3`), [][]byte{[]byte("secret3")}, []uint64{1}),
+		buildMsg(s.T(), txConfig, []byte(`x4`), [][]byte{[]byte("secret4")}, []uint64{1}),
+		buildMsg(s.T(), txConfig, []byte(`d5`), [][]byte{[]byte("secret5")}, []uint64{1}),
+	}
+	const invalidTx = 5
+
+	ctrl := gomock.NewController(s.T())
+	app := mock.NewMockProposalTxVerifier(ctrl)
+	ph := baseapp.NewLaneProposalHandler(newTestLaneMempool(), app)
+
+	txsBz := make([][]byte, len(testTxs))
+	for i, tx := range testTxs {
+		bz, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		s.Require().Equal(194, int(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})))
+		txsBz[i] = bz
+
+		if i == invalidTx {
+			app.EXPECT().ProcessProposalVerifyTx(bz).Return(nil, errors.New("invalid tx")).AnyTimes()
+		} else {
+			app.EXPECT().ProcessProposalVerifyTx(bz).Return(tx, nil).AnyTimes()
+		}
+	}
+
+	// the high lane is entitled to 2 txs
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{
+			MaxBytes: 194 * 4,
+		},
+	})
+
+	testCases := map[string]struct {
+		txs      []int
+		expected abci.ProcessProposalStatus
+	}{
+		"valid proposal": {
+			txs:      []int{0, 1, 3},
+			expected: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"empty lane": {
+			txs:      []int{3},
+			expected: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:      []int{0, 3, 1},
+			expected: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"lane share exceeded": {
+			txs:      []int{0, 1, 2},
+			expected: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"no matching lane": {
+			txs:      []int{0, 4},
+			expected: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"invalid tx": {
+			txs:      []int{0, invalidTx},
+			expected: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &abci.ProcessProposalRequest{}
+			for _, i := range tc.txs {
+				req.Txs = append(req.Txs, txsBz[i])
+			}
+
+			resp, err := ph.ProcessProposalHandler()(ctx, req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, resp.Status)
+		})
+	}
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
@@ -9,6 +9,7 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
@@ -408,3 +409,13 @@ func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 func (app *BaseApp) SetGRPCQueryRouter(grpcQueryRouter *GRPCQueryRouter) {
 	app.grpcQueryRouter = grpcQueryRouter
 }
+
+// SetTraceKeyDecoders sets the decoders, indexed by store key, used to decode the keys
+// of the store operations traced by SimulateWithTrace. A collections.Schema can be used as decoder.
+func (app *BaseApp) SetTraceKeyDecoders(decoders map[string]corestore.KeyDecoder) {
+	if app.sealed {
+		panic("SetTraceKeyDecoders() on sealed BaseApp")
+	}
+
+	app.traceKeyDecoders = decoders
+}
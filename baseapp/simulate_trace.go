@@ -0,0 +1,138 @@
+package baseapp
+
+import (
+	"bytes"
+
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SimulateWithTrace executes a tx in simulate mode like Simulate, additionally returning the
+// ordered list of store operations performed by the tx alongside the gas charged for each of them.
+// The trace is returned even if the tx fails, up to the operation which caused the failure.
+func (app *BaseApp) SimulateWithTrace(txBytes []byte) (sdk.GasInfo, *sdk.Result, []corestore.TraceOperation, error) {
+	tracer := &storeTracer{decoders: app.traceKeyDecoders}
+	gasInfo, result, _, err := app.runTx(execModeSimulate, txBytes, tracer)
+	return gasInfo, result, tracer.ops, err
+}
+
+var _ sdk.StoreTracer = (*storeTracer)(nil)
+
+// storeTracer is a sdk.StoreTracer collecting the traced store operations in order.
+type storeTracer struct {
+	decoders map[string]corestore.KeyDecoder
+	ops      []corestore.TraceOperation
+}
+
+// TraceKVStore implements sdk.StoreTracer.
+func (t *storeTracer) TraceKVStore(
+	key storetypes.StoreKey,
+	store, parent storetypes.KVStore,
+	gasMeter storetypes.GasMeter,
+) storetypes.KVStore {
+	return &tracingKVStore{
+		KVStore:  store,
+		parent:   parent,
+		storeKey: key.Name(),
+		gasMeter: gasMeter,
+		tracer:   t,
+	}
+}
+
+// tracingKVStore records the operations performed on a gas metered store.
+type tracingKVStore struct {
+	storetypes.KVStore
+	// parent is the store wrapped by the gas metered store, used to read
+	// the values overwritten by write operations without consuming gas.
+	parent   storetypes.KVStore
+	storeKey string
+	gasMeter storetypes.GasMeter
+	tracer   *storeTracer
+}
+
+// trace executes the operation and records it, alongside the gas it consumed.
+// The operation is recorded even if it panics, for example when running out of gas.
+func (s *tracingKVStore) trace(op corestore.TraceOperation, exec func(op *corestore.TraceOperation)) {
+	consumed := s.gasMeter.GasConsumed()
+	defer func() {
+		op.StoreKey = s.storeKey
+		op.Gas = s.gasMeter.GasConsumed() - consumed
+		corestore.DecodeTraceKey(&op, s.tracer.decoders)
+		s.tracer.ops = append(s.tracer.ops, op)
+	}()
+	exec(&op)
+}
+
+func (s *tracingKVStore) Get(key []byte) (value []byte) {
+	s.trace(corestore.TraceOperation{Type: corestore.TraceOperationGet, Key: bytes.Clone(key)}, func(op *corestore.TraceOperation) {
+		value = s.KVStore.Get(key)
+		op.OldValue = bytes.Clone(value)
+	})
+	return value
+}
+
+func (s *tracingKVStore) Has(key []byte) (has bool) {
+	s.trace(corestore.TraceOperation{Type: corestore.TraceOperationHas, Key: bytes.Clone(key)}, func(*corestore.TraceOperation) {
+		has = s.KVStore.Has(key)
+	})
+	return has
+}
+
+func (s *tracingKVStore) Set(key, value []byte) {
+	op := corestore.TraceOperation{
+		Type:     corestore.TraceOperationSet,
+		Key:      bytes.Clone(key),
+		OldValue: bytes.Clone(s.parent.Get(key)),
+		NewValue: bytes.Clone(value),
+	}
+	s.trace(op, func(*corestore.TraceOperation) { s.KVStore.Set(key, value) })
+}
+
+func (s *tracingKVStore) Delete(key []byte) {
+	op := corestore.TraceOperation{
+		Type:     corestore.TraceOperationDelete,
+		Key:      bytes.Clone(key),
+		OldValue: bytes.Clone(s.parent.Get(key)),
+	}
+	s.trace(op, func(*corestore.TraceOperation) { s.KVStore.Delete(key) })
+}
+
+func (s *tracingKVStore) Iterator(start, end []byte) (iter storetypes.Iterator) {
+	s.trace(corestore.TraceOperation{Type: corestore.TraceOperationIterate}, func(op *corestore.TraceOperation) {
+		iter = &tracingIterator{Iterator: s.KVStore.Iterator(start, end), store: s}
+		iter.(*tracingIterator).position(op)
+	})
+	return iter
+}
+
+func (s *tracingKVStore) ReverseIterator(start, end []byte) (iter storetypes.Iterator) {
+	s.trace(corestore.TraceOperation{Type: corestore.TraceOperationIterate}, func(op *corestore.TraceOperation) {
+		iter = &tracingIterator{Iterator: s.KVStore.ReverseIterator(start, end), store: s}
+		iter.(*tracingIterator).position(op)
+	})
+	return iter
+}
+
+// tracingIterator records the entries the iterator is positioned on. gaskv charges the
+// gas for an entry when the iterator is positioned on it and again when moving past it,
+// hence an operation is recorded on creation and on each call to Next.
+type tracingIterator struct {
+	storetypes.Iterator
+	store *tracingKVStore
+}
+
+func (it *tracingIterator) Next() {
+	op := corestore.TraceOperation{Type: corestore.TraceOperationIterate}
+	it.position(&op)
+	it.store.trace(op, func(*corestore.TraceOperation) { it.Iterator.Next() })
+}
+
+// position fills the operation with the entry the iterator is positioned on, if any.
+func (it *tracingIterator) position(op *corestore.TraceOperation) {
+	if it.Iterator.Valid() {
+		op.Key = bytes.Clone(it.Iterator.Key())
+		op.OldValue = bytes.Clone(it.Iterator.Value())
+	}
+}
@@ -24,6 +24,9 @@ func DefaultConfig() *Config {
 		Output:                "text",
 		Node:                  "tcp://localhost:26657",
 		BroadcastMode:         "sync",
+		Verify: VerifyConfig{
+			StateCommitment: "iavl",
+		},
 	}
 }
 
@@ -32,13 +35,14 @@ func DefaultConfig() *Config {
 type ClientConfig Config
 
 type Config struct {
-	ChainID               string     `mapstructure:"chain-id" json:"chain-id"`
-	KeyringBackend        string     `mapstructure:"keyring-backend" json:"keyring-backend"`
-	KeyringDefaultKeyName string     `mapstructure:"keyring-default-keyname" json:"keyring-default-keyname"`
-	Output                string     `mapstructure:"output" json:"output"`
-	Node                  string     `mapstructure:"node" json:"node"`
-	BroadcastMode         string     `mapstructure:"broadcast-mode" json:"broadcast-mode"`
-	GRPC                  GRPCConfig `mapstructure:",squash"`
+	ChainID               string       `mapstructure:"chain-id" json:"chain-id"`
+	KeyringBackend        string       `mapstructure:"keyring-backend" json:"keyring-backend"`
+	KeyringDefaultKeyName string       `mapstructure:"keyring-default-keyname" json:"keyring-default-keyname"`
+	Output                string       `mapstructure:"output" json:"output"`
+	Node                  string       `mapstructure:"node" json:"node"`
+	BroadcastMode         string       `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	GRPC                  GRPCConfig   `mapstructure:",squash"`
+	Verify                VerifyConfig `mapstructure:",squash"`
 }
 
 // GRPCConfig holds the gRPC client configuration.
@@ -47,6 +51,12 @@ type GRPCConfig struct {
 	Insecure bool   `mapstructure:"grpc-insecure"  json:"grpc-insecure"`
 }
 
+// VerifyConfig holds the configuration of the queries verified by a light client.
+type VerifyConfig struct {
+	StateCommitment string `mapstructure:"state-commitment" json:"state-commitment"`
+	LightWitnesses  string `mapstructure:"light-witnesses" json:"light-witnesses"`
+}
+
 // ReadFromClientConfig reads values from client.toml file and updates them in client.Context
 // It uses CreateClientConfig internally with no custom template and custom config.
 // Deprecated: use CreateClientConfig instead.
@@ -200,3 +200,22 @@ func TestGRPCConfig(t *testing.T) {
 		require.Equal(t, expectedGRPCConfig.Insecure, clientCtx.Viper.GetBool("grpc-insecure"))
 	})
 }
+
+func TestVerifyConfig(t *testing.T) {
+	expectedVerifyConfig := config.VerifyConfig{
+		StateCommitment: "smt",
+		LightWitnesses:  "http://localhost:26667,http://localhost:26677",
+	}
+
+	clientCfg := config.DefaultConfig()
+	require.Equal(t, "iavl", clientCfg.Verify.StateCommitment)
+	clientCfg.Verify = expectedVerifyConfig
+
+	clientCtx, cleanup, err := initClientContextWithTemplate(t, "", config.DefaultClientConfigTemplate, clientCfg)
+	defer cleanup()
+
+	require.NoError(t, err)
+
+	require.Equal(t, expectedVerifyConfig.StateCommitment, clientCtx.Viper.GetString("state-commitment"))
+	require.Equal(t, expectedVerifyConfig.LightWitnesses, clientCtx.Viper.GetString("light-witnesses"))
+}
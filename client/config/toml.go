@@ -36,6 +36,13 @@ grpc-address = "{{ .GRPC.Address }}"
 # Allow the gRPC client to connect over insecure channels.
 # It can be overwritten by the --grpc-insecure flag in each command.
 grpc-insecure = {{ .GRPC.Insecure }}
+
+# State commitment of the app (iavl|smt), whose proofs are checked by the queries verified with --verify.
+state-commitment = "{{ .Verify.StateCommitment }}"
+
+# Comma separated list of the CometBFT RPC endpoints witnessing the headers trusted by the light client
+# of the queries verified with --verify. The node is its own witness if the list is empty.
+light-witnesses = "{{ .Verify.LightWitnesses }}"
 `
 )
 
@@ -0,0 +1,49 @@
+package mempool
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	mempoolv1beta1 "cosmossdk.io/api/cosmos/base/mempool/v1beta1"
+)
+
+var ServiceAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
+	Service: mempoolv1beta1.Service_ServiceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod:      "PendingTxs",
+			Use:            "pending-txs [sender]",
+			Short:          "Query the txs of a sender pending in the node mempool",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+		},
+		{
+			RpcMethod:      "TxStatus",
+			Use:            "tx-status [hash]",
+			Short:          "Query the position and priority of a tx pending in the node mempool",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hash"}},
+		},
+		{
+			RpcMethod: "SubscribeEvents",
+			Skip:      true, // streams are not supported by autocli
+		},
+	},
+}
+
+// NewMempoolCommands is a fake `appmodule.Module` to be considered as a module
+// and be added in AutoCLI.
+func NewMempoolCommands() *mempoolModule {
+	return &mempoolModule{}
+}
+
+type mempoolModule struct{}
+
+func (m mempoolModule) IsOnePerModuleType() {}
+func (m mempoolModule) IsAppModule()        {}
+
+func (m mempoolModule) Name() string {
+	return "mempool"
+}
+
+func (m mempoolModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: ServiceAutoCLIDescriptor,
+	}
+}
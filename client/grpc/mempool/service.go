@@ -0,0 +1,173 @@
+package mempool
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// eventBufferSize is the number of mempool events buffered for each
+// subscriber, events are dropped once the buffer of a slow subscriber is full.
+const eventBufferSize = 256
+
+// RegisterMempoolService registers the mempool gRPC service on the provided gRPC router.
+func RegisterMempoolService(clientCtx client.Context, server gogogrpc.Server, mp sdkmempool.Mempool) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, mp))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool gRPC service's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	clientCtx client.Context
+	mempool   sdkmempool.Mempool
+}
+
+// NewQueryServer returns a mempool gRPC service inspecting mp. Its methods
+// return an Unimplemented error unless mp implements sdkmempool.InspectableMempool.
+func NewQueryServer(clientCtx client.Context, mp sdkmempool.Mempool) ServiceServer {
+	return queryServer{
+		clientCtx: clientCtx,
+		mempool:   mp,
+	}
+}
+
+func (s queryServer) PendingTxs(_ context.Context, req *PendingTxsRequest) (*PendingTxsResponse, error) {
+	if req == nil || req.Sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "sender cannot be empty")
+	}
+
+	mp, err := s.inspectableMempool()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := mp.Txs(req.Sender)
+	txs := make([]*PendingTx, len(infos))
+	for i, info := range infos {
+		if txs[i], err = s.pendingTx(info); err != nil {
+			return nil, err
+		}
+	}
+
+	return &PendingTxsResponse{Txs: txs}, nil
+}
+
+func (s queryServer) TxStatus(_ context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bz, err := hex.DecodeString(req.Hash)
+	if err != nil || len(bz) != sha256.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash: %s", req.Hash)
+	}
+	var hash [sha256.Size]byte
+	copy(hash[:], bz)
+
+	mp, err := s.inspectableMempool()
+	if err != nil {
+		return nil, err
+	}
+
+	info, ok := mp.TxByHash(hash)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "tx %s not found in mempool", req.Hash)
+	}
+
+	tx, err := s.pendingTx(info)
+	if err != nil {
+		return nil, err
+	}
+
+	position, selectable := mp.Position(info.Sender, info.Nonce)
+	return &TxStatusResponse{
+		Tx:         tx,
+		Selectable: selectable,
+		Position:   uint64(position),
+		Count:      uint64(mp.CountTx()),
+	}, nil
+}
+
+func (s queryServer) SubscribeEvents(req *SubscribeEventsRequest, stream Service_SubscribeEventsServer) error {
+	mp, err := s.inspectableMempool()
+	if err != nil {
+		return err
+	}
+
+	events, cancel := mp.Subscribe(eventBufferSize)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-events:
+			if req.Sender != "" && event.Sender != req.Sender {
+				continue
+			}
+
+			tx, err := s.pendingTx(event.TxInfo)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(&SubscribeEventsResponse{EventType: eventType(event.Type), Tx: tx}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s queryServer) inspectableMempool() (sdkmempool.InspectableMempool, error) {
+	mp, ok := s.mempool.(sdkmempool.InspectableMempool)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "mempool %T cannot be inspected", s.mempool)
+	}
+
+	return mp, nil
+}
+
+func (s queryServer) pendingTx(info sdkmempool.TxInfo) (*PendingTx, error) {
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(info.Tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode tx: %v", err)
+	}
+
+	return &PendingTx{
+		Hash:     fmt.Sprintf("%X", info.Tx.Hash()),
+		Sender:   info.Sender,
+		Nonce:    info.Nonce,
+		Priority: info.Priority,
+		TxBytes:  txBytes,
+	}, nil
+}
+
+func eventType(t sdkmempool.EventType) EventType {
+	switch t {
+	case sdkmempool.EventTypeInsert:
+		return EventType_EVENT_TYPE_INSERT
+	case sdkmempool.EventTypeRemove:
+		return EventType_EVENT_TYPE_REMOVE
+	case sdkmempool.EventTypeReplace:
+		return EventType_EVENT_TYPE_REPLACE
+	case sdkmempool.EventTypeEvict:
+		return EventType_EVENT_TYPE_EVICT
+	default:
+		return EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,190 @@
+package mempool_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type fixture struct {
+	clientCtx client.Context
+	mempool   *mempool.FeeMarketMempool
+	server    mempoolservice.ServiceServer
+}
+
+func newFixture() fixture {
+	clientCtx := client.Context{}.WithTxConfig(moduletestutil.MakeTestTxConfig(codectestutil.CodecOptions{}))
+	mp := mempool.NewFeeMarketMempool(mempool.DefaultFeeMarketMempoolConfig("stake"))
+
+	return fixture{
+		clientCtx: clientCtx,
+		mempool:   mp,
+		server:    mempoolservice.NewQueryServer(clientCtx, mp),
+	}
+}
+
+// newTx returns a tx of pubKey paying feePerGas stake for each of its 100 gas.
+func (f fixture) newTx(t *testing.T, pubKey cryptotypes.PubKey, nonce uint64, feePerGas int64) (sdk.Tx, string) {
+	t.Helper()
+	builder := f.clientCtx.TxConfig.NewTxBuilder()
+	builder.SetGasLimit(100)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 100*feePerGas)))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: nonce,
+	}))
+
+	tx := builder.GetTx()
+	txBytes, err := f.clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	return tx, fmt.Sprintf("%X", sha256.Sum256(txBytes))
+}
+
+func TestServiceServer_PendingTxs(t *testing.T) {
+	f := newFixture()
+	ctx := context.Background()
+	_, pkA, addrA := testdata.KeyTestPubAddr()
+	_, pkB, addrB := testdata.KeyTestPubAddr()
+
+	tx0, hash0 := f.newTx(t, pkA, 1, 10)
+	tx1, hash1 := f.newTx(t, pkA, 0, 5)
+	tx2, _ := f.newTx(t, pkB, 0, 20)
+	for _, tx := range []sdk.Tx{tx0, tx1, tx2} {
+		require.NoError(t, f.mempool.Insert(ctx, tx))
+	}
+
+	res, err := f.server.PendingTxs(ctx, &mempoolservice.PendingTxsRequest{Sender: addrA.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	require.Equal(t, hash1, res.Txs[0].Hash)
+	require.Equal(t, uint64(0), res.Txs[0].Nonce)
+	require.Equal(t, addrA.String(), res.Txs[0].Sender)
+	require.Equal(t, "5.000000000000000000", res.Txs[0].Priority)
+	require.Equal(t, hash0, res.Txs[1].Hash)
+
+	res, err = f.server.PendingTxs(ctx, &mempoolservice.PendingTxsRequest{Sender: addrB.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+
+	_, err = f.server.PendingTxs(ctx, &mempoolservice.PendingTxsRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceServer_TxStatus(t *testing.T) {
+	f := newFixture()
+	ctx := context.Background()
+	_, pkA, _ := testdata.KeyTestPubAddr()
+	_, pkB, _ := testdata.KeyTestPubAddr()
+
+	tx0, hash0 := f.newTx(t, pkA, 0, 10)
+	tx1, hash1 := f.newTx(t, pkA, 1, 30)
+	tx2, hash2 := f.newTx(t, pkB, 0, 20)
+	for _, tx := range []sdk.Tx{tx0, tx1, tx2} {
+		require.NoError(t, f.mempool.Insert(ctx, tx))
+	}
+
+	for hash, position := range map[string]uint64{hash2: 0, hash0: 1, hash1: 2} {
+		res, err := f.server.TxStatus(ctx, &mempoolservice.TxStatusRequest{Hash: hash})
+		require.NoError(t, err)
+		require.Equal(t, hash, res.Tx.Hash)
+		require.True(t, res.Selectable)
+		require.Equal(t, position, res.Position)
+		require.Equal(t, uint64(3), res.Count)
+	}
+
+	_, unknown := f.newTx(t, pkB, 1, 20)
+	_, err := f.server.TxStatus(ctx, &mempoolservice.TxStatusRequest{Hash: unknown})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = f.server.TxStatus(ctx, &mempoolservice.TxStatusRequest{Hash: "foo"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// hashes are case insensitive and removed txs are no longer found
+	_, err = f.server.TxStatus(ctx, &mempoolservice.TxStatusRequest{Hash: strings.ToLower(hash0)})
+	require.NoError(t, err)
+	require.NoError(t, f.mempool.Remove(tx0))
+	_, err = f.server.TxStatus(ctx, &mempoolservice.TxStatusRequest{Hash: hash0})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// mempools which cannot be inspected are not supported
+	server := mempoolservice.NewQueryServer(f.clientCtx, mempool.NoOpMempool{})
+	_, err = server.TxStatus(ctx, &mempoolservice.TxStatusRequest{Hash: hash0})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+type eventStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	cancel     context.CancelFunc
+	subscribed chan struct{}
+	once       sync.Once
+	events     []*mempoolservice.SubscribeEventsResponse
+	max        int
+}
+
+// Context is first called once the server subscribed to the mempool events.
+func (s *eventStream) Context() context.Context {
+	s.once.Do(func() { close(s.subscribed) })
+	return s.ctx
+}
+
+func (s *eventStream) Send(res *mempoolservice.SubscribeEventsResponse) error {
+	s.events = append(s.events, res)
+	if len(s.events) == s.max {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestServiceServer_SubscribeEvents(t *testing.T) {
+	f := newFixture()
+	ctx := context.Background()
+	_, pkA, addrA := testdata.KeyTestPubAddr()
+	_, pkB, _ := testdata.KeyTestPubAddr()
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream := &eventStream{ctx: streamCtx, cancel: cancel, subscribed: make(chan struct{}), max: 3}
+	done := make(chan error)
+	go func() {
+		done <- f.server.SubscribeEvents(&mempoolservice.SubscribeEventsRequest{Sender: addrA.String()}, stream)
+	}()
+	<-stream.subscribed
+
+	tx0, hash0 := f.newTx(t, pkA, 0, 10)
+	tx1, hash1 := f.newTx(t, pkA, 0, 20)
+	tx2, _ := f.newTx(t, pkB, 0, 20)
+	require.NoError(t, f.mempool.Insert(ctx, tx0))
+	require.NoError(t, f.mempool.Insert(ctx, tx2))
+	require.NoError(t, f.mempool.Insert(ctx, tx1))
+
+	// the server returns once the stream is canceled, only the events of the
+	// requested sender are sent
+	require.NoError(t, <-done)
+	require.Len(t, stream.events, 3)
+	require.Equal(t, mempoolservice.EventType_EVENT_TYPE_INSERT, stream.events[0].EventType)
+	require.Equal(t, hash0, stream.events[0].Tx.Hash)
+	require.Equal(t, mempoolservice.EventType_EVENT_TYPE_REPLACE, stream.events[1].EventType)
+	require.Equal(t, hash0, stream.events[1].Tx.Hash)
+	require.Equal(t, mempoolservice.EventType_EVENT_TYPE_INSERT, stream.events[2].EventType)
+	require.Equal(t, hash1, stream.events[2].Tx.Hash)
+}
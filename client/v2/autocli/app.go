@@ -7,6 +7,7 @@ import (
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/client/v2/verify"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 
@@ -36,6 +37,27 @@ type AppOptions struct {
 
 	// ClientCtx contains the necessary information needed to execute the commands.
 	ClientCtx client.Context
+
+	// StoreKeys maps the names of the modules to the keys of their stores, queried by
+	// the store query commands of the modules. The store key of a module defaults to
+	// its name.
+	StoreKeys map[string]string `optional:"true"`
+
+	// TrustedHeaders returns the headers trusted by a light client, such as the
+	// ones returned by verify.NewLightClientHeaders, against which the store query
+	// commands verify the values queried with --verify. It defaults to a light
+	// client of the node of the client context, which trusts the latest header of
+	// the node on first use and is witnessed by the light-witnesses of the client
+	// config.
+	TrustedHeaders func(*cobra.Command) (verify.TrustedHeaders, error) `optional:"true"`
+}
+
+// storeKey returns the key of the store of the module.
+func (appOptions AppOptions) storeKey(moduleName string) string {
+	if storeKey, ok := appOptions.StoreKeys[moduleName]; ok {
+		return storeKey
+	}
+	return moduleName
 }
 
 // EnhanceRootCommand enhances the provided root command with autocli AppOptions,
@@ -54,6 +76,11 @@ type AppOptions struct {
 //	rootCmd := initRootCmd()
 //	err = autoCliOpts.EnhanceRootCommand(rootCmd)
 func (appOptions AppOptions) EnhanceRootCommand(rootCmd *cobra.Command) error {
+	getTrustedHeaders := appOptions.TrustedHeaders
+	if getTrustedHeaders == nil {
+		getTrustedHeaders = defaultTrustedHeaders
+	}
+
 	builder := &Builder{
 		Builder: flag.Builder{
 			TypeResolver:          protoregistry.GlobalTypes,
@@ -65,6 +92,7 @@ func (appOptions AppOptions) EnhanceRootCommand(rootCmd *cobra.Command) error {
 		GetClientConn: func(cmd *cobra.Command) (grpc.ClientConnInterface, error) {
 			return client.GetClientQueryContext(cmd)
 		},
+		GetTrustedHeaders: getTrustedHeaders,
 		AddQueryConnFlags: sdkflags.AddQueryFlagsToCmd,
 		AddTxConnFlags:    sdkflags.AddTxFlagsToCmd,
 	}
@@ -5,6 +5,7 @@ import (
 	"google.golang.org/grpc"
 
 	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/client/v2/verify"
 )
 
 // Builder manages options for building CLI commands.
@@ -16,6 +17,11 @@ type Builder struct {
 	// from a given context.
 	GetClientConn func(*cobra.Command) (grpc.ClientConnInterface, error)
 
+	// GetTrustedHeaders specifies how the store query commands resolve the headers
+	// trusted by a light client, which the values queried with --verify are verified
+	// against. The --verify flag is unsupported if it is nil.
+	GetTrustedHeaders func(*cobra.Command) (verify.TrustedHeaders, error)
+
 	// AddQueryConnFlags and AddTxConnFlags are functions that add flags to query and transaction commands
 	AddQueryConnFlags func(*cobra.Command)
 	AddTxConnFlags    func(*cobra.Command)
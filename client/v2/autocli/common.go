@@ -157,7 +157,12 @@ func (b *Builder) enhanceCommandCommon(
 
 		switch cmdType {
 		case queryCmdType:
-			if err := enhanceQuery(b, moduleName, cmd, modOpts); err != nil {
+			// only the modules of the app have a store, unlike the services of the app
+			storeKey := ""
+			if _, ok := appOptions.Modules[moduleName]; ok {
+				storeKey = appOptions.storeKey(moduleName)
+			}
+			if err := enhanceQuery(b, moduleName, cmd, modOpts, storeKey); err != nil {
 				return err
 			}
 		case msgCmdType:
@@ -167,11 +172,17 @@ func (b *Builder) enhanceCommandCommon(
 		}
 	}
 
+	// the store query command does not override a module command of the same name
+	if cmdType == queryCmdType && findSubCommand(cmd, storeQueryCmdName) == nil {
+		cmd.AddCommand(b.BuildStoreQueryCommand())
+	}
+
 	return nil
 }
 
-// enhanceQuery enhances the provided query command with the autocli commands for a module.
-func enhanceQuery(builder *Builder, moduleName string, cmd *cobra.Command, modOpts *autocliv1.ModuleOptions) error {
+// enhanceQuery enhances the provided query command with the autocli commands for a module,
+// including the command querying its store if it has one.
+func enhanceQuery(builder *Builder, moduleName string, cmd *cobra.Command, modOpts *autocliv1.ModuleOptions, storeKey string) error {
 	if queryCmdDesc := modOpts.Query; queryCmdDesc != nil {
 		short := queryCmdDesc.Short
 		if short == "" {
@@ -182,6 +193,11 @@ func enhanceQuery(builder *Builder, moduleName string, cmd *cobra.Command, modOp
 			return err
 		}
 
+		// the store query command does not override a generated command of the same name
+		if storeKey != "" && findSubCommand(subCmd, storeQueryCmdName) == nil {
+			subCmd.AddCommand(builder.BuildModuleStoreQueryCommand(moduleName, storeKey))
+		}
+
 		cmd.AddCommand(subCmd)
 	}
 
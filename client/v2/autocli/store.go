@@ -0,0 +1,172 @@
+package autocli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/client/v2/internal/flags"
+	"cosmossdk.io/client/v2/verify"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	storeQueryCmdName = "store"
+
+	// stateCommitmentKey and lightWitnessesKey are the client config keys of the
+	// state commitment of the app and of the witnesses of the default light client.
+	stateCommitmentKey = "state-commitment"
+	lightWitnessesKey  = "light-witnesses"
+)
+
+// storeQueryResult is the output of the store query commands.
+type storeQueryResult struct {
+	Height   int64  `json:"height"`
+	Value    string `json:"value"`
+	Verified bool   `json:"verified"`
+}
+
+// BuildStoreQueryCommand builds the command querying the raw value of a key in a
+// store of the app. With the --verify flag, the proof of the value is verified
+// against the app hash of the headers trusted by a light client, so that the
+// queried node does not need to be trusted.
+func (b *Builder) BuildStoreQueryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   storeQueryCmdName + " [store-key] [hex-key]",
+		Short: "Query the raw value of a key in a store",
+		Long: `Query the raw value of a key in a store, returned hex encoded.
+With --verify, the proof of the value, or of its absence, is verified against the app hash
+of the headers trusted by a light client.`,
+		Example: fmt.Sprintf("%s %s bank 0201 --verify", "<appd> query", storeQueryCmdName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return b.runStoreQuery(cmd, args[0], args[1])
+		},
+	}
+
+	return b.addStoreQueryFlags(cmd)
+}
+
+// BuildModuleStoreQueryCommand builds the command querying the raw value of a key
+// in the store of a module, like the store query command.
+func (b *Builder) BuildModuleStoreQueryCommand(moduleName, storeKey string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   storeQueryCmdName + " [hex-key]",
+		Short: fmt.Sprintf("Query the raw value of a key in the store of the %s module", moduleName),
+		Long: fmt.Sprintf(`Query the raw value of a key in the %s store, returned hex encoded.
+With --verify, the proof of the value, or of its absence, is verified against the app hash
+of the headers trusted by a light client.`, storeKey),
+		Example: fmt.Sprintf("%s %s %s 0201 --verify", "<appd> query", moduleName, storeQueryCmdName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return b.runStoreQuery(cmd, storeKey, args[0])
+		},
+	}
+
+	return b.addStoreQueryFlags(cmd)
+}
+
+func (b *Builder) addStoreQueryFlags(cmd *cobra.Command) *cobra.Command {
+	if b.AddQueryConnFlags != nil {
+		b.AddQueryConnFlags(cmd)
+	}
+	cmd.Flags().Bool(flags.FlagVerify, false, "Verify the proof of the value against the headers trusted by a light client")
+	cmd.SilenceUsage = true
+
+	return cmd
+}
+
+// runStoreQuery queries the raw value of the hex encoded key in the store.
+func (b *Builder) runStoreQuery(cmd *cobra.Command, storeKey, hexKey string) error {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return fmt.Errorf("invalid hex key %s: %w", hexKey, err)
+	}
+
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	res := storeQueryResult{}
+	if res.Verified, _ = cmd.Flags().GetBool(flags.FlagVerify); res.Verified {
+		if b.GetTrustedHeaders == nil {
+			return errors.New("verifying queries is not supported, the app does not set a light client")
+		}
+		// the proofs are checked with the spec of the state commitment of the
+		// client config, never with the one chosen by the node
+		commitment := verify.CommitmentIAVL
+		if clientCtx.Viper != nil && clientCtx.Viper.GetString(stateCommitmentKey) != "" {
+			commitment = clientCtx.Viper.GetString(stateCommitmentKey)
+		}
+		spec, err := verify.ProofSpec(commitment)
+		if err != nil {
+			return err
+		}
+
+		headers, err := b.GetTrustedHeaders(cmd)
+		if err != nil {
+			return err
+		}
+		if closer, ok := headers.(io.Closer); ok {
+			defer closer.Close()
+		}
+
+		var value []byte
+		value, res.Height, err = verify.NewQuerier(clientCtx, headers, spec).Get(cmd.Context(), storeKey, key, clientCtx.Height)
+		if err != nil {
+			return err
+		}
+		res.Value = hex.EncodeToString(value)
+	} else {
+		abciRes, err := clientCtx.QueryABCI(abci.QueryRequest{
+			Path:   fmt.Sprintf("/store/%s/key", storeKey),
+			Data:   key,
+			Height: clientCtx.Height,
+		})
+		if err != nil {
+			return err
+		}
+		res.Height, res.Value = abciRes.Height, hex.EncodeToString(abciRes.Value)
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return b.outOrStdoutFormat(cmd, bz)
+}
+
+// defaultTrustedHeaders returns the headers trusted by a light client of the node
+// of the client context, witnessed by the nodes of the client config, which stores
+// the trusted headers in the light directory of the client home.
+func defaultTrustedHeaders(cmd *cobra.Command) (verify.TrustedHeaders, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	var witnesses []string
+	if clientCtx.Viper != nil {
+		for _, witness := range strings.Split(clientCtx.Viper.GetString(lightWitnessesKey), ",") {
+			if witness = strings.TrimSpace(witness); witness != "" {
+				witnesses = append(witnesses, witness)
+			}
+		}
+	}
+
+	return verify.NewHTTPLightClientHeaders(cmd.Context(), verify.LightClientConfig{
+		ChainID:   clientCtx.ChainID,
+		Primary:   clientCtx.NodeURI,
+		Witnesses: witnesses,
+		Dir:       filepath.Join(clientCtx.HomeDir, "light"),
+	})
+}
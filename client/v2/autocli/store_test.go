@@ -0,0 +1,195 @@
+package autocli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/client/v2/internal/flags"
+	"cosmossdk.io/client/v2/internal/testpb"
+	"cosmossdk.io/client/v2/verify"
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// testCometRPC is a node answering the ABCI queries with a fixed value.
+type testCometRPC struct {
+	client.CometRPC
+
+	lastPath string
+	lastData cmtbytes.HexBytes
+	lastOpts rpcclient.ABCIQueryOptions
+}
+
+func (t *testCometRPC) ABCIQueryWithOptions(_ context.Context, path string, data cmtbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	t.lastPath, t.lastData, t.lastOpts = path, data, opts
+	return &coretypes.ResultABCIQuery{Response: abci.QueryResponse{
+		Height: opts.Height,
+		Key:    data,
+		Value:  []byte("value"),
+	}}, nil
+}
+
+type testTrustedHeaders struct{}
+
+func (testTrustedHeaders) LatestHeight(context.Context) (int64, error) {
+	return 10, nil
+}
+
+func (testTrustedHeaders) TrustedHeader(_ context.Context, height int64) (*cmttypes.Header, error) {
+	return &cmttypes.Header{Height: height, AppHash: []byte("app hash")}, nil
+}
+
+// testClosingHeaders are trusted headers recording whether they are closed.
+type testClosingHeaders struct {
+	testTrustedHeaders
+	closed bool
+}
+
+func (h *testClosingHeaders) Close() error {
+	h.closed = true
+	return nil
+}
+
+func TestStoreQuery(t *testing.T) {
+	testFixture := initFixture(t)
+	node := &testCometRPC{}
+	testFixture.clientCtx = testFixture.clientCtx.WithClient(node)
+
+	buildStoreQueryCommand := func(_ string, f *fixture) (*cobra.Command, error) {
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &f.clientCtx)
+		cmd := topLevelCmd(ctx, "query", "Querying subcommands")
+		cmd.AddCommand(f.b.BuildStoreQueryCommand())
+		return cmd, nil
+	}
+
+	out, err := runCmd(testFixture, buildStoreQueryCommand, "store", "bank", "0102", "--height", "5", "--output", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out.String(), `"value":"76616c7565"`))
+	assert.Assert(t, strings.Contains(out.String(), `"verified":false`))
+	assert.Equal(t, node.lastPath, "/store/bank/key")
+	assert.DeepEqual(t, []byte(node.lastData), []byte{0x01, 0x02})
+	assert.Equal(t, node.lastOpts.Height, int64(5))
+	assert.Assert(t, !node.lastOpts.Prove)
+
+	_, err = runCmd(testFixture, buildStoreQueryCommand, "store", "bank", "not-hex")
+	assert.ErrorContains(t, err, "invalid hex key")
+
+	// verifying requires the app to set a light client
+	_, err = runCmd(testFixture, buildStoreQueryCommand, "store", "bank", "0102", "--verify")
+	assert.ErrorContains(t, err, "not supported")
+
+	// the value is not returned without a valid proof
+	testFixture.b.GetTrustedHeaders = func(*cobra.Command) (verify.TrustedHeaders, error) {
+		return testTrustedHeaders{}, nil
+	}
+	out, err = runCmd(testFixture, buildStoreQueryCommand, "store", "bank", "0102", "--verify")
+	assert.ErrorContains(t, err, "failed to verify")
+	assert.Assert(t, !strings.Contains(out.String(), "76616c7565"))
+	assert.Equal(t, node.lastOpts.Height, int64(9))
+	assert.Assert(t, node.lastOpts.Prove)
+
+	testFixture.b.GetTrustedHeaders = func(*cobra.Command) (verify.TrustedHeaders, error) {
+		return nil, errors.New("light client failure")
+	}
+	_, err = runCmd(testFixture, buildStoreQueryCommand, "store", "bank", "0102", "--verify")
+	assert.ErrorContains(t, err, "light client failure")
+
+	// the proofs are checked with the state commitment of the client config
+	headers := &testClosingHeaders{}
+	testFixture.b.GetTrustedHeaders = func(*cobra.Command) (verify.TrustedHeaders, error) {
+		return headers, nil
+	}
+	testFixture.clientCtx.Viper.Set(stateCommitmentKey, "unknown")
+	_, err = runCmd(testFixture, buildStoreQueryCommand, "store", "bank", "0102", "--verify")
+	assert.ErrorContains(t, err, "unsupported state commitment")
+
+	testFixture.clientCtx.Viper.Set(stateCommitmentKey, verify.CommitmentSMT)
+	_, err = runCmd(testFixture, buildStoreQueryCommand, "store", "bank", "0102", "--verify")
+	assert.ErrorContains(t, err, "failed to verify")
+	assert.Assert(t, headers.closed)
+}
+
+func TestModuleStoreQuery(t *testing.T) {
+	testFixture := initFixture(t)
+	node := &testCometRPC{}
+	testFixture.clientCtx = testFixture.clientCtx.WithClient(node)
+
+	buildModuleStoreQueryCommand := func(_ string, f *fixture) (*cobra.Command, error) {
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &f.clientCtx)
+		cmd := topLevelCmd(ctx, "auth", "Querying commands for the auth module")
+		cmd.AddCommand(f.b.BuildModuleStoreQueryCommand("auth", "acc"))
+		return cmd, nil
+	}
+
+	out, err := runCmd(testFixture, buildModuleStoreQueryCommand, "store", "0102", "--output", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out.String(), `"value":"76616c7565"`))
+	assert.Equal(t, node.lastPath, "/store/acc/key")
+	assert.DeepEqual(t, []byte(node.lastData), []byte{0x01, 0x02})
+
+	testFixture.b.GetTrustedHeaders = func(*cobra.Command) (verify.TrustedHeaders, error) {
+		return testTrustedHeaders{}, nil
+	}
+	_, err = runCmd(testFixture, buildModuleStoreQueryCommand, "store", "0102", "--verify")
+	assert.ErrorContains(t, err, "failed to verify")
+	assert.Equal(t, node.lastPath, "/store/acc/key")
+	assert.Assert(t, node.lastOpts.Prove)
+}
+
+func TestEnhanceCommandStoreQuery(t *testing.T) {
+	b := &Builder{}
+
+	cmd := &cobra.Command{Use: "query"}
+	assert.NilError(t, b.enhanceCommandCommon(cmd, queryCmdType, AppOptions{}, map[string]*cobra.Command{}))
+	assert.Assert(t, findSubCommand(cmd, storeQueryCmdName) != nil)
+
+	// an existing store command is not overridden
+	custom := &cobra.Command{Use: storeQueryCmdName}
+	cmd = &cobra.Command{Use: "query"}
+	cmd.AddCommand(custom)
+	assert.NilError(t, b.enhanceCommandCommon(cmd, queryCmdType, AppOptions{}, map[string]*cobra.Command{}))
+	assert.Equal(t, findSubCommand(cmd, storeQueryCmdName), custom)
+
+	cmd = &cobra.Command{Use: "tx"}
+	assert.NilError(t, b.enhanceCommandCommon(cmd, msgCmdType, AppOptions{}, map[string]*cobra.Command{}))
+	assert.Assert(t, findSubCommand(cmd, storeQueryCmdName) == nil)
+}
+
+func TestEnhanceCommandModuleStoreQuery(t *testing.T) {
+	testFixture := initFixture(t)
+	queryDesc := &autocliv1.ServiceCommandDescriptor{Service: testpb.Query_ServiceDesc.ServiceName}
+
+	cmd := &cobra.Command{Use: "query"}
+	appOptions := AppOptions{
+		Modules: map[string]appmodule.AppModule{"auth": nil, "bank": nil},
+		ModuleOptions: map[string]*autocliv1.ModuleOptions{
+			"auth": {Query: queryDesc},
+			"bank": {Query: queryDesc},
+			// the services of the app have no store
+			"node": {Query: queryDesc},
+		},
+		StoreKeys: map[string]string{"auth": "acc"},
+	}
+	assert.NilError(t, testFixture.b.enhanceCommandCommon(cmd, queryCmdType, appOptions, map[string]*cobra.Command{}))
+
+	authStoreCmd := findSubCommand(findSubCommand(cmd, "auth"), storeQueryCmdName)
+	assert.Assert(t, authStoreCmd != nil)
+	assert.Assert(t, authStoreCmd.Flags().Lookup(flags.FlagVerify) != nil)
+	assert.Assert(t, strings.Contains(authStoreCmd.Long, "acc store"))
+	bankStoreCmd := findSubCommand(findSubCommand(cmd, "bank"), storeQueryCmdName)
+	assert.Assert(t, bankStoreCmd != nil)
+	assert.Assert(t, strings.Contains(bankStoreCmd.Long, "bank store"))
+	assert.Assert(t, findSubCommand(findSubCommand(cmd, "node"), storeQueryCmdName) == nil)
+}
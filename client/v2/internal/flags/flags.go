@@ -17,6 +17,9 @@ const (
 	// FlagNoProposal is the flag convert a gov proposal command into a normal command.
 	// This is used to allow user of chains with custom authority to not use gov submit proposals for usual proposal commands.
 	FlagNoProposal = "no-proposal"
+
+	// FlagVerify is the flag to verify the proof of a queried value against the headers trusted by a light client.
+	FlagVerify = "verify"
 )
 
 // List of supported output formats
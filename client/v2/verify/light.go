@@ -0,0 +1,148 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/light"
+	lighthttp "github.com/cometbft/cometbft/light/provider/http"
+	lightdb "github.com/cometbft/cometbft/light/store/db"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// DefaultTrustingPeriod is the default trusting period of the light clients built
+// by NewHTTPLightClientHeaders, which must be lower than the unbonding period of
+// the chain.
+const DefaultTrustingPeriod = 168 * time.Hour
+
+var _ TrustedHeaders = lightClientHeaders{}
+
+// lightClientHeaders provides the headers verified by a CometBFT light client.
+type lightClientHeaders struct {
+	client *light.Client
+	// db is the database of the trusted headers, if opened by the light client.
+	db dbm.DB
+}
+
+// NewLightClientHeaders returns the TrustedHeaders verified by the CometBFT light client.
+func NewLightClientHeaders(client *light.Client) TrustedHeaders {
+	return lightClientHeaders{client: client}
+}
+
+// LightClientConfig is the configuration of the light client built by
+// NewHTTPLightClientHeaders.
+type LightClientConfig struct {
+	// ChainID is the ID of the chain of the headers.
+	ChainID string
+	// Primary is the CometBFT RPC address of the node serving the headers.
+	Primary string
+	// Witnesses are the CometBFT RPC addresses of the nodes cross-checking the
+	// headers of the primary, which is its own witness if there are none.
+	Witnesses []string
+	// Dir is the directory of the database storing the trusted headers.
+	Dir string
+	// TrustingPeriod defaults to DefaultTrustingPeriod if it is 0.
+	TrustingPeriod time.Duration
+}
+
+// NewHTTPLightClientHeaders returns the TrustedHeaders verified by a CometBFT light
+// client fetching the headers over HTTP. The root of trust is the latest header
+// stored in the directory of the configuration or, when none is stored, the latest
+// commit of the primary, which is trusted on first use and then stored. The
+// returned headers must be closed once done.
+func NewHTTPLightClientHeaders(ctx context.Context, cfg LightClientConfig) (_ TrustedHeaders, err error) {
+	if cfg.ChainID == "" || cfg.Primary == "" {
+		return nil, errors.New("the light client requires a chain ID and a primary node")
+	}
+	if cfg.TrustingPeriod == 0 {
+		cfg.TrustingPeriod = DefaultTrustingPeriod
+	}
+	witnesses := cfg.Witnesses
+	if len(witnesses) == 0 {
+		witnesses = []string{cfg.Primary}
+	}
+
+	db, err := dbm.NewGoLevelDB("light-client", cfg.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the light client database: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = db.Close()
+		}
+	}()
+
+	store := lightdb.New(db, cfg.ChainID)
+	lastHeight, err := store.LastLightBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	var client *light.Client
+	if lastHeight > 0 {
+		client, err = light.NewHTTPClientFromTrustedStore(cfg.ChainID, cfg.TrustingPeriod, cfg.Primary, witnesses, store)
+	} else {
+		var trustOptions light.TrustOptions
+		if trustOptions, err = primaryTrustOptions(ctx, cfg); err != nil {
+			return nil, err
+		}
+		client, err = light.NewHTTPClient(ctx, cfg.ChainID, trustOptions, cfg.Primary, witnesses, store)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the light client: %w", err)
+	}
+
+	return lightClientHeaders{client: client, db: db}, nil
+}
+
+// primaryTrustOptions returns the options trusting the latest header of the primary.
+func primaryTrustOptions(ctx context.Context, cfg LightClientConfig) (light.TrustOptions, error) {
+	primary, err := lighthttp.New(cfg.ChainID, cfg.Primary)
+	if err != nil {
+		return light.TrustOptions{}, err
+	}
+	lb, err := primary.LightBlock(ctx, 0)
+	if err != nil {
+		return light.TrustOptions{}, fmt.Errorf("failed to get the latest header of the primary: %w", err)
+	}
+
+	return light.TrustOptions{
+		Period: cfg.TrustingPeriod,
+		Height: lb.Height,
+		Hash:   lb.Hash(),
+	}, nil
+}
+
+// LatestHeight advances the light client to the latest header of its primary
+// and returns its height.
+func (h lightClientHeaders) LatestHeight(ctx context.Context) (int64, error) {
+	lb, err := h.client.Update(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if lb != nil {
+		return lb.Height, nil
+	}
+
+	return h.client.LastTrustedHeight()
+}
+
+func (h lightClientHeaders) TrustedHeader(ctx context.Context, height int64) (*cmttypes.Header, error) {
+	lb, err := h.client.VerifyLightBlockAtHeight(ctx, height, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return lb.Header, nil
+}
+
+// Close closes the database of the trusted headers, if opened by the light client.
+func (h lightClientHeaders) Close() error {
+	if h.db == nil {
+		return nil
+	}
+	return h.db.Close()
+}
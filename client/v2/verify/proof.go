@@ -0,0 +1,93 @@
+package verify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	ics23 "github.com/cosmos/ics23/go"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	// CommitmentIAVL is the state commitment of the apps storing their state in IAVL trees.
+	CommitmentIAVL = "iavl"
+	// CommitmentSMT is the state commitment of the apps storing their state in sparse merkle trees.
+	CommitmentSMT = "smt"
+)
+
+// ProofSpec returns the ICS-23 proof spec of the proofs of the keys in the stores
+// of an app with the given state commitment.
+func ProofSpec(commitment string) (*ics23.ProofSpec, error) {
+	switch commitment {
+	case CommitmentIAVL:
+		return ics23.IavlSpec, nil
+	case CommitmentSMT:
+		return ics23.SmtSpec, nil
+	default:
+		return nil, fmt.Errorf("unsupported state commitment %q, expected %s or %s", commitment, CommitmentIAVL, CommitmentSMT)
+	}
+}
+
+// VerifyProof verifies the proof of the value of the key in the store against the
+// app hash, an empty value being proved absent. The proof is the chain of the
+// commitment proof of the key in the store, checked with the given spec of the
+// state commitment of the app, and the simple merkle proof of the store in the
+// commit info, as returned by the "/store/<store-key>/key" ABCI queries.
+func VerifyProof(appHash []byte, spec *ics23.ProofSpec, storeKey string, key, value []byte, proofOps *cmtcrypto.ProofOps) error {
+	if proofOps == nil || len(proofOps.Ops) != 2 {
+		return errors.New("expected the proof of the key in the store and the proof of the store in the commit")
+	}
+
+	// the spec of a proof is never taken from the operation type set by the node,
+	// which would let it choose how its proof is verified
+	storeOp, err := commitmentOp(proofOps.Ops[0], spec)
+	if err != nil {
+		return err
+	}
+	if proofOps.Ops[1].Type != storetypes.ProofOpSimpleMerkleCommitment {
+		return fmt.Errorf("expected the proof of the store to be of type %s, got %s", storetypes.ProofOpSimpleMerkleCommitment, proofOps.Ops[1].Type)
+	}
+	commitOp, err := commitmentOp(proofOps.Ops[1], ics23.TendermintSpec)
+	if err != nil {
+		return err
+	}
+
+	// the key of each operation is the one it proves the value of
+	keys := [][]byte{key, []byte(storeKey)}
+	var args [][]byte
+	if len(value) > 0 {
+		args = [][]byte{value}
+	}
+	for i, op := range []storetypes.CommitmentOp{storeOp, commitOp} {
+		if !bytes.Equal(op.GetKey(), keys[i]) {
+			return fmt.Errorf("proof operation %d proves key %X, expected %X", i, op.GetKey(), keys[i])
+		}
+		if args, err = op.Run(args); err != nil {
+			return fmt.Errorf("failed to verify proof operation %d: %w", i, err)
+		}
+	}
+
+	if !bytes.Equal(args[0], appHash) {
+		return fmt.Errorf("proof root %X does not match the app hash %X", args[0], appHash)
+	}
+	return nil
+}
+
+// commitmentOp decodes the commitment operation of the ABCI proof operation,
+// verified with the given proof spec.
+func commitmentOp(proofOp cmtcrypto.ProofOp, spec *ics23.ProofSpec) (storetypes.CommitmentOp, error) {
+	commitmentProof := &ics23.CommitmentProof{}
+	if err := commitmentProof.Unmarshal(proofOp.Data); err != nil {
+		return storetypes.CommitmentOp{}, fmt.Errorf("failed to decode %s proof: %w", proofOp.Type, err)
+	}
+
+	return storetypes.CommitmentOp{
+		Type:  proofOp.Type,
+		Spec:  spec,
+		Key:   proofOp.Key,
+		Proof: commitmentProof,
+	}, nil
+}
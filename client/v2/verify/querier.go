@@ -0,0 +1,91 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// ABCIQuerier sends ABCI queries to a node, such as the client.Context.
+type ABCIQuerier interface {
+	QueryABCI(req abci.QueryRequest) (abci.QueryResponse, error)
+}
+
+// TrustedHeaders provides the headers verified by a light client.
+type TrustedHeaders interface {
+	// LatestHeight returns the height of the latest trusted header.
+	LatestHeight(ctx context.Context) (int64, error)
+	// TrustedHeader returns the header at the given height once verified.
+	TrustedHeader(ctx context.Context, height int64) (*cmttypes.Header, error)
+}
+
+// Querier queries the stores of a node, which is not trusted, verifying the
+// proofs of the returned values against the app hashes of the trusted headers.
+type Querier struct {
+	querier ABCIQuerier
+	headers TrustedHeaders
+	spec    *ics23.ProofSpec
+}
+
+// NewQuerier returns a new Querier sending the queries with the given querier and
+// verifying them against the given trusted headers, with the proof spec of the
+// state commitment of the app as returned by ProofSpec.
+func NewQuerier(querier ABCIQuerier, headers TrustedHeaders, spec *ics23.ProofSpec) *Querier {
+	return &Querier{
+		querier: querier,
+		headers: headers,
+		spec:    spec,
+	}
+}
+
+// Get returns the verified value of the key in the store at the given height,
+// which is nil if the key is proved absent, and the height of the value. The
+// height defaults to the latest one committed by a trusted header if it is 0.
+func (q *Querier) Get(ctx context.Context, storeKey string, key []byte, height int64) ([]byte, int64, error) {
+	if len(key) == 0 {
+		return nil, 0, errors.New("key cannot be empty")
+	}
+
+	// the app hash resulting from the block at a height is committed by the header
+	// of the next one
+	if height == 0 {
+		latest, err := q.headers.LatestHeight(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get the latest trusted height: %w", err)
+		}
+		height = latest - 1
+	}
+	if height <= 1 {
+		return nil, 0, fmt.Errorf("cannot verify a query at height %d", height)
+	}
+
+	res, err := q.querier.QueryABCI(abci.QueryRequest{
+		Path:   fmt.Sprintf("/store/%s/key", storeKey),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if res.Height != height {
+		return nil, 0, fmt.Errorf("queried height %d, got the value at height %d", height, res.Height)
+	}
+
+	header, err := q.headers.TrustedHeader(ctx, height+1)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get the trusted header at height %d: %w", height+1, err)
+	}
+	if err := VerifyProof(header.AppHash, q.spec, storeKey, key, res.Value, res.ProofOps); err != nil {
+		return nil, 0, fmt.Errorf("failed to verify the value of key %X in store %s at height %d: %w", key, storeKey, height, err)
+	}
+
+	if len(res.Value) == 0 {
+		return nil, height, nil
+	}
+	return res.Value, height, nil
+}
@@ -0,0 +1,180 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var storeKeys = []string{"acc", "bank"}
+
+// node is an untrusted node serving the proofs of a multistore of IAVL stores,
+// whose app hashes are committed by the headers of the local stand-in of a light
+// client.
+type node struct {
+	store   *rootmulti.Store
+	headers map[int64]*cmttypes.Header
+	latest  int64
+
+	// tamper modifies the responses of the node
+	tamper func(res *abci.QueryResponse)
+}
+
+func newNode(t *testing.T, versions int) *node {
+	t.Helper()
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := make([]*storetypes.KVStoreKey, 0, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		key := storetypes.NewKVStoreKey(storeKey)
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		keys = append(keys, key)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	n := &node{store: ms, headers: make(map[int64]*cmttypes.Header)}
+	for v := 1; v <= versions; v++ {
+		for _, key := range keys {
+			ms.GetKVStore(key).Set([]byte(fmt.Sprintf("key-%d", v)), []byte(fmt.Sprintf("value-%s-%d", key.Name(), v)))
+		}
+		commitID := ms.Commit()
+
+		// the app hash of a version is committed by the next header
+		n.headers[int64(v+1)] = &cmttypes.Header{Height: int64(v + 1), AppHash: commitID.Hash}
+		n.latest = int64(v + 1)
+	}
+
+	return n
+}
+
+func (n *node) QueryABCI(req abci.QueryRequest) (abci.QueryResponse, error) {
+	storeRes, err := n.store.Query(&storetypes.RequestQuery{
+		Path:   req.Path[len("/store"):],
+		Data:   req.Data,
+		Height: req.Height,
+		Prove:  req.Prove,
+	})
+	if err != nil {
+		return abci.QueryResponse{}, err
+	}
+
+	res := abci.QueryResponse{Height: storeRes.Height, Key: storeRes.Key, Value: storeRes.Value, ProofOps: storeRes.ProofOps}
+	if n.tamper != nil {
+		n.tamper(&res)
+	}
+	return res, nil
+}
+
+func (n *node) LatestHeight(context.Context) (int64, error) {
+	return n.latest, nil
+}
+
+func (n *node) TrustedHeader(_ context.Context, height int64) (*cmttypes.Header, error) {
+	header, ok := n.headers[height]
+	if !ok {
+		return nil, errors.New("header not found")
+	}
+	return header, nil
+}
+
+func TestQuerier(t *testing.T) {
+	n := newNode(t, 5)
+	q := NewQuerier(n, n, ics23.IavlSpec)
+	ctx := context.Background()
+
+	value, height, err := q.Get(ctx, "bank", []byte("key-2"), 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+	require.Equal(t, []byte("value-bank-2"), value)
+
+	// the absence of a key is verified
+	value, _, err = q.Get(ctx, "bank", []byte("key-4"), 3)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// the latest height committed by a trusted header is queried by default
+	value, height, err = q.Get(ctx, "acc", []byte("key-5"), 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), height)
+	require.Equal(t, []byte("value-acc-5"), value)
+
+	// the value of a height is not verified until the next header is trusted
+	delete(n.headers, 6)
+	_, _, err = q.Get(ctx, "acc", []byte("key-5"), 5)
+	require.ErrorContains(t, err, "trusted header")
+
+	_, _, err = q.Get(ctx, "acc", []byte("key-1"), 1)
+	require.Error(t, err)
+}
+
+func TestQuerier_Tampered(t *testing.T) {
+	testCases := map[string]func(res *abci.QueryResponse){
+		"value": func(res *abci.QueryResponse) {
+			res.Value = []byte("forged")
+		},
+		"removed value": func(res *abci.QueryResponse) {
+			res.Value = nil
+		},
+		"height": func(res *abci.QueryResponse) {
+			res.Height++
+		},
+		"proof of another store": func(res *abci.QueryResponse) {
+			res.ProofOps.Ops[1].Key = []byte("acc")
+		},
+		"missing store proof": func(res *abci.QueryResponse) {
+			res.ProofOps.Ops = res.ProofOps.Ops[:1]
+		},
+		"store proof of another type": func(res *abci.QueryResponse) {
+			res.ProofOps.Ops[1].Type = storetypes.ProofOpIAVLCommitment
+		},
+		"corrupted proof": func(res *abci.QueryResponse) {
+			res.ProofOps.Ops[0].Data = res.ProofOps.Ops[0].Data[1:]
+		},
+	}
+
+	for name, tamper := range testCases {
+		t.Run(name, func(t *testing.T) {
+			n := newNode(t, 3)
+			n.tamper = tamper
+			_, _, err := NewQuerier(n, n, ics23.IavlSpec).Get(context.Background(), "bank", []byte("key-2"), 2)
+			require.Error(t, err)
+		})
+	}
+
+	// the proof of another version does not match the trusted app hash
+	n := newNode(t, 3)
+	n.headers[3] = n.headers[4]
+	_, _, err := NewQuerier(n, n, ics23.IavlSpec).Get(context.Background(), "bank", []byte("key-2"), 2)
+	require.ErrorContains(t, err, "app hash")
+}
+
+func TestQuerier_ProofSpec(t *testing.T) {
+	n := newNode(t, 3)
+
+	// the IAVL proofs of the node are not verified with the spec of another state commitment
+	spec, err := ProofSpec(CommitmentSMT)
+	require.NoError(t, err)
+	_, _, err = NewQuerier(n, n, spec).Get(context.Background(), "bank", []byte("key-2"), 2)
+	require.ErrorContains(t, err, "failed to verify")
+
+	spec, err = ProofSpec(CommitmentIAVL)
+	require.NoError(t, err)
+	value, _, err := NewQuerier(n, n, spec).Get(context.Background(), "bank", []byte("key-2"), 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-bank-2"), value)
+
+	_, err = ProofSpec("unknown")
+	require.Error(t, err)
+}
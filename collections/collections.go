@@ -3,6 +3,7 @@ package collections
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"math"
 
@@ -89,6 +90,10 @@ type Collection interface {
 	// ValueCodec returns the codec used to encode/decode values of the collection.
 	ValueCodec() codec.UntypedValueCodec
 
+	// stringifyKey decodes the provided key, without the collection prefix, and
+	// returns its human-readable representation.
+	stringifyKey(key []byte) (string, error)
+
 	genesisHandler
 }
 
@@ -146,6 +151,17 @@ func (c collectionImpl[K, V]) GetName() string { return c.m.name }
 
 func (c collectionImpl[K, V]) GetPrefix() []byte { return NewPrefix(c.m.prefix) }
 
+func (c collectionImpl[K, V]) stringifyKey(key []byte) (string, error) {
+	read, k, err := c.m.kc.Decode(key)
+	if err != nil {
+		return "", err
+	}
+	if read != len(key) {
+		return "", fmt.Errorf("%w: key decoder read %d bytes out of %d", ErrEncoding, read, len(key))
+	}
+	return c.m.kc.Stringify(k), nil
+}
+
 func (c collectionImpl[K, V]) validateGenesis(r io.Reader) error { return c.m.validateGenesis(r) }
 
 func (c collectionImpl[K, V]) importGenesis(ctx context.Context, r io.Reader) error {
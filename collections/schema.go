@@ -292,6 +292,22 @@ func (s Schema) getCollection(name string) (Collection, error) {
 	return coll, nil
 }
 
+// DecodeKey returns the name of the collection owning the provided store key,
+// alongside the human-readable representation of the key within the collection.
+func (s Schema) DecodeKey(key []byte) (collection, decodedKey string, err error) {
+	for prefix, coll := range s.collectionsByPrefix {
+		if !strings.HasPrefix(string(key), prefix) {
+			continue
+		}
+		decodedKey, err = coll.stringifyKey(key[len(prefix):])
+		if err != nil {
+			return "", "", fmt.Errorf("failed to decode key of collection %s: %w", coll.GetName(), err)
+		}
+		return coll.GetName(), decodedKey, nil
+	}
+	return "", "", fmt.Errorf("no collection found for key %X", key)
+}
+
 func (s Schema) ListCollections() []Collection {
 	colls := make([]Collection, len(s.collectionsOrdered))
 	for i, name := range s.collectionsOrdered {
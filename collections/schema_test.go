@@ -70,3 +70,35 @@ func TestSchemaBuilderCantBeUsedAfterBuild(t *testing.T) {
 		NewMap(schemaBuilder, NewPrefix(2), "def", Uint64Key, Uint64Value)
 	})
 }
+
+func TestSchemaDecodeKey(t *testing.T) {
+	sk, _ := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	NewMap(schemaBuilder, NewPrefix(1), "balances", PairKeyCodec(StringKey, StringKey), Uint64Value)
+	NewItem(schemaBuilder, NewPrefix(2), "params", Uint64Value)
+	NewMap(schemaBuilder, NewPrefix(3), "counts", Uint64Key, Uint64Value)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	key, err := EncodeKeyWithPrefix(NewPrefix(1), PairKeyCodec(StringKey, StringKey), Join("alice", "stake"))
+	require.NoError(t, err)
+	collection, decodedKey, err := schema.DecodeKey(key)
+	require.NoError(t, err)
+	require.Equal(t, "balances", collection)
+	require.Equal(t, `("alice", "stake")`, decodedKey)
+
+	collection, _, err = schema.DecodeKey([]byte{2})
+	require.NoError(t, err)
+	require.Equal(t, "params", collection)
+
+	collection, decodedKey, err = schema.DecodeKey([]byte{3, 0, 0, 0, 0, 0, 0, 0, 7})
+	require.NoError(t, err)
+	require.Equal(t, "counts", collection)
+	require.Equal(t, "7", decodedKey)
+
+	_, _, err = schema.DecodeKey([]byte{4, 1})
+	require.ErrorContains(t, err, "no collection found")
+
+	_, _, err = schema.DecodeKey([]byte{3, 0, 0, 0, 0, 0, 0, 0, 7, 1})
+	require.ErrorContains(t, err, "failed to decode key of collection counts")
+}
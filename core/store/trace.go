@@ -0,0 +1,55 @@
+package store
+
+// TraceOperationType is the type of a traced store operation.
+type TraceOperationType string
+
+const (
+	TraceOperationGet     TraceOperationType = "get"
+	TraceOperationHas     TraceOperationType = "has"
+	TraceOperationSet     TraceOperationType = "set"
+	TraceOperationDelete  TraceOperationType = "delete"
+	TraceOperationIterate TraceOperationType = "iterate"
+)
+
+// TraceOperation is a store operation recorded while tracing the execution of a transaction.
+type TraceOperation struct {
+	// Type is the type of the operation.
+	Type TraceOperationType `json:"type"`
+	// StoreKey is the key of the accessed store, or the actor in server/v2.
+	StoreKey string `json:"store_key"`
+	// Key is the raw accessed key. For iterations it is the key the iterator was positioned on.
+	Key []byte `json:"key"`
+	// Collection is the name of the collection owning the key, if the key could be decoded.
+	Collection string `json:"collection,omitempty"`
+	// DecodedKey is the human readable representation of the key, if the key could be decoded.
+	DecodedKey string `json:"decoded_key,omitempty"`
+	// OldValue is the value stored at the key before the operation. For reads it is the value read.
+	OldValue []byte `json:"old_value,omitempty"`
+	// NewValue is the value stored at the key after a set operation.
+	NewValue []byte `json:"new_value,omitempty"`
+	// Gas is the gas charged for the operation.
+	Gas uint64 `json:"gas"`
+}
+
+// KeyDecoder decodes the raw keys of a store in a human readable form.
+// It is implemented by collections.Schema.
+type KeyDecoder interface {
+	// DecodeKey returns the name of the collection owning the key and the human
+	// readable representation of the key within the collection.
+	DecodeKey(key []byte) (collection, decodedKey string, err error)
+}
+
+// DecodeTraceKey fills the decoded key fields of the operation using the provided decoders,
+// indexed by store key. Operations on stores without a decoder, or whose key cannot be
+// decoded, are left unchanged.
+func DecodeTraceKey(op *TraceOperation, decoders map[string]KeyDecoder) {
+	decoder, ok := decoders[op.StoreKey]
+	if !ok {
+		return
+	}
+	collection, decodedKey, err := decoder.DecodeKey(op.Key)
+	if err != nil {
+		return
+	}
+	op.Collection, op.DecodedKey = collection, decodedKey
+}
@@ -0,0 +1,23 @@
+package transaction
+
+// StateAccess declares the state of an actor accessed by a transaction.
+type StateAccess struct {
+	// Actor is the actor owning the accessed state, e.g. the store key of a module.
+	Actor []byte
+	// Prefixes are the key prefixes accessed within the state of the actor.
+	// If empty the whole state of the actor is accessed.
+	Prefixes [][]byte
+	// ReadOnly reports whether the state is only read, and not written.
+	ReadOnly bool
+}
+
+// TxWithAccessList is implemented by transactions which can declare ahead of
+// execution the state their messages access. Transactions whose access lists
+// do not conflict can be executed in parallel, and accessing undeclared state
+// makes the message execution fail.
+type TxWithAccessList interface {
+	Tx
+	// GetAccessList returns the state accessed by the transaction. The boolean
+	// reports whether the transaction declared an access list at all.
+	GetAccessList() ([]StateAccess, bool)
+}
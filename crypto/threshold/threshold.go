@@ -0,0 +1,138 @@
+// Package threshold implements threshold encryption on the BLS12-381 curve,
+// with keys generated by a distributed key generation (DKG).
+//
+// Each of the n participants of a DKG deals a random polynomial of degree
+// threshold-1: it publishes Feldman commitments to its coefficients in G2 and
+// the evaluation of the polynomial at the index of every participant, encrypted
+// to the DKG key the participant registered. Participants which received an
+// invalid share publish a complaint revealing the key the share was encrypted
+// with, which anyone can check with a pairing to disqualify the dealer. The
+// public key is the sum of the constant commitments of the qualified dealers,
+// the key share of a participant the sum of the shares it received from them,
+// so that no party ever knows the decryption key.
+//
+// DKG keys are crypto/keys/bls12_381 keys, whose public key in G1 the shares
+// are encrypted to. Secret scalars, i.e. the coefficients of the polynomials,
+// the key shares and the ephemeral keys, are only handled with the constant
+// time scalar arithmetic of blst.
+//
+// Plaintexts are encrypted with AES-GCM, with a fresh random nonce, under a key
+// derived from e(rG1, PK). Ciphertexts carry W = r H(U, label, ...) in G2,
+// binding them to a label, e.g. the address of the sender, so that a ciphertext
+// cannot be resubmitted under another label to get it decrypted. Key holders
+// decrypt ciphertexts with decryption shares s_i U, which are verified with a
+// pairing against the public verification key s_i G2 of their holder.
+//
+// The implementation requires the bls12381 build tag, like the
+// crypto/keys/bls12_381 keys, otherwise Available returns false and every
+// function returns an error.
+package threshold
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+)
+
+const (
+	// G1PointSize is the size, in bytes, of a compressed G1 point.
+	G1PointSize = 48
+	// G2PointSize is the size, in bytes, of a compressed G2 point.
+	G2PointSize = 96
+	// ScalarSize is the size, in bytes, of a scalar.
+	ScalarSize = 32
+	// CiphertextOverhead is the number of bytes a ciphertext is longer than its
+	// plaintext.
+	CiphertextOverhead = G1PointSize + G2PointSize + nonceSize + tagSize
+	// DecryptionShareSize is the size, in bytes, of an encoded DecryptionShare.
+	DecryptionShareSize = 4 + 2*G1PointSize
+
+	nonceSize = 12
+	tagSize   = 16
+
+	domainCiphertext = "COSMOS-SDK-THRESHOLD-CIPHERTEXT_BLS12381G2_XMD:SHA-256_SSWU_RO_"
+	domainKey        = "cosmos-sdk/threshold/key"
+	domainDealing    = "cosmos-sdk/threshold/dealing"
+)
+
+var (
+	ErrInvalidCiphertext      = errors.New("invalid ciphertext")
+	ErrInvalidDecryptionShare = errors.New("invalid decryption share")
+	ErrNotEnoughShares        = errors.New("not enough decryption shares")
+	ErrInvalidDealing         = errors.New("invalid dealing")
+	ErrInvalidComplaint       = errors.New("invalid complaint")
+
+	errBuildTag = errors.New("threshold encryption requires the bls12381 build tag")
+)
+
+// DKGKey is the key a participant of a DKG receives its key shares with. It
+// must be kept private.
+type DKGKey struct {
+	PrivKey bls12_381.PrivKey
+}
+
+// Dealing is the contribution of a dealer to a DKG.
+type Dealing struct {
+	// Commitments are the commitments, in G2, to the coefficients of the
+	// polynomial of the dealer. There is one commitment per coefficient, i.e.
+	// threshold commitments.
+	Commitments [][]byte
+	// EphemeralG1 and EphemeralG2 are the ephemeral keys, with the same discrete
+	// logarithm, the shares are encrypted with.
+	EphemeralG1 []byte
+	EphemeralG2 []byte
+	// Shares are the encrypted evaluations of the polynomial, by index.
+	Shares map[uint32][]byte
+}
+
+// KeyShare is the share of the decryption key of a key holder. Index is the
+// point, starting at 1, at which the secret sharing polynomial was evaluated to
+// obtain Secret.
+type KeyShare struct {
+	Index  uint32
+	Secret []byte
+}
+
+// DecryptionShare is the share of a key holder to decrypt a ciphertext.
+type DecryptionShare struct {
+	// Index is the index of the key share used to compute the decryption share.
+	Index uint32
+	// Ephemeral is the ephemeral public key, in G1, of the decrypted ciphertext.
+	Ephemeral []byte
+	// Point is the ephemeral public key multiplied by the key share.
+	Point []byte
+}
+
+// Ephemeral returns the ephemeral public key of ciphertext, which identifies
+// the ciphertext in decryption shares.
+func Ephemeral(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < CiphertextOverhead {
+		return nil, fmt.Errorf("%w: too short", ErrInvalidCiphertext)
+	}
+
+	return ciphertext[:G1PointSize], nil
+}
+
+// ParseDecryptionShare decodes a decryption share encoded with Bytes.
+func ParseDecryptionShare(bz []byte) (DecryptionShare, error) {
+	if len(bz) != DecryptionShareSize {
+		return DecryptionShare{}, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidDecryptionShare, DecryptionShareSize, len(bz))
+	}
+
+	return DecryptionShare{
+		Index:     binary.BigEndian.Uint32(bz),
+		Ephemeral: bytes.Clone(bz[4 : 4+G1PointSize]),
+		Point:     bytes.Clone(bz[4+G1PointSize:]),
+	}, nil
+}
+
+// Bytes encodes the decryption share.
+func (ds DecryptionShare) Bytes() []byte {
+	bz := make([]byte, 0, DecryptionShareSize)
+	bz = binary.BigEndian.AppendUint32(bz, ds.Index)
+	bz = append(bz, ds.Ephemeral...)
+	return append(bz, ds.Point...)
+}
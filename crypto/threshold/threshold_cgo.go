@@ -0,0 +1,787 @@
+//go:build bls12381
+
+package threshold
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	blst "github.com/supranational/blst/bindings/go"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+)
+
+// Available reports whether threshold encryption is available, i.e. whether
+// the package was built with the bls12381 build tag.
+func Available() bool {
+	return true
+}
+
+// GenerateDKGKey generates the key a participant of a DKG receives its key
+// shares with.
+func GenerateDKGKey(rand io.Reader) (DKGKey, error) {
+	ikm := make([]byte, ScalarSize)
+	defer clear(ikm)
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		return DKGKey{}, err
+	}
+
+	sk := blst.KeyGen(ikm)
+	defer sk.Zeroize()
+	privKey, err := bls12_381.NewPrivateKeyFromBytes(sk.Serialize())
+	if err != nil {
+		return DKGKey{}, err
+	}
+
+	return DKGKey{PrivKey: privKey}, nil
+}
+
+// PublicKey returns the public key, in G1, the shares of the participant are
+// encrypted to.
+func (k DKGKey) PublicKey() ([]byte, error) {
+	pubKey := k.PrivKey.PubKey()
+	if pubKey == nil {
+		return nil, errors.New("invalid DKG key")
+	}
+
+	return pubKey.Bytes(), nil
+}
+
+// secret returns the secret scalar of the DKG key, which must be zeroized once
+// used.
+func (k DKGKey) secret() (*blst.Scalar, error) {
+	x, err := decodeScalar(k.PrivKey.Key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DKG key: %w", err)
+	}
+
+	return x, nil
+}
+
+// VerifyDKGPublicKey verifies that publicKey is a valid DKG public key.
+func VerifyDKGPublicKey(publicKey []byte) error {
+	_, err := decodeG1(publicKey)
+	return err
+}
+
+// NewDealing deals a random polynomial of degree threshold-1 to the
+// participants with the given DKG public keys, indexed by their key share
+// index.
+func NewDealing(rand io.Reader, threshold int, encryptionKeys map[uint32][]byte) (Dealing, error) {
+	if threshold < 1 || threshold > len(encryptionKeys) {
+		return Dealing{}, fmt.Errorf("threshold must be in [1, %d], got %d", len(encryptionKeys), threshold)
+	}
+
+	// f(x) = coefficients[0] + coefficients[1] x + ... where f(0) is the secret
+	coefficients := make([]*blst.Scalar, threshold)
+	defer zeroize(coefficients...)
+	commitments := make([][]byte, threshold)
+	for i := range coefficients {
+		c, err := randomScalar(rand)
+		if err != nil {
+			return Dealing{}, err
+		}
+		coefficients[i] = c
+		commitments[i] = blst.P2Generator().Mult(c).Compress()
+	}
+
+	rho, err := randomScalar(rand)
+	if err != nil {
+		return Dealing{}, err
+	}
+	defer rho.Zeroize()
+	dealing := Dealing{
+		Commitments: commitments,
+		EphemeralG1: blst.P1Generator().Mult(rho).Compress(),
+		EphemeralG2: blst.P2Generator().Mult(rho).Compress(),
+		Shares:      make(map[uint32][]byte, len(encryptionKeys)),
+	}
+
+	for index, encryptionKey := range encryptionKeys {
+		if index == 0 {
+			return Dealing{}, errors.New("key share index cannot be 0")
+		}
+
+		pk, err := decodeG1(encryptionKey)
+		if err != nil {
+			return Dealing{}, fmt.Errorf("invalid encryption key %d: %w", index, err)
+		}
+
+		var p blst.P1
+		p.FromAffine(pk)
+		sharedKey := p.Mult(rho).Compress()
+
+		share := evaluate(coefficients, index)
+		share.AddAssign(shareMask(dealing.EphemeralG1, sharedKey, index))
+		dealing.Shares[index] = share.Serialize()
+		share.Zeroize()
+	}
+
+	return dealing, nil
+}
+
+// Verify verifies that the dealing is well formed, for the given threshold and
+// with one share for each of the given indexes. The shares themselves can only
+// be verified by their recipient, which must publish a complaint if its share
+// is invalid.
+func (d Dealing) Verify(threshold int, indexes []uint32) error {
+	if threshold < 1 || len(d.Commitments) != threshold {
+		return fmt.Errorf("%w: expected %d commitments, got %d", ErrInvalidDealing, threshold, len(d.Commitments))
+	}
+	for i, commitment := range d.Commitments {
+		if _, err := decodeG2(commitment); err != nil {
+			return fmt.Errorf("%w: commitment %d: %w", ErrInvalidDealing, i, err)
+		}
+	}
+
+	if len(d.Shares) != len(indexes) {
+		return fmt.Errorf("%w: expected %d shares, got %d", ErrInvalidDealing, len(indexes), len(d.Shares))
+	}
+	for _, index := range indexes {
+		share, ok := d.Shares[index]
+		if !ok {
+			return fmt.Errorf("%w: missing share %d", ErrInvalidDealing, index)
+		}
+		if _, err := decodeScalar(share); err != nil {
+			return fmt.Errorf("%w: share %d: %w", ErrInvalidDealing, index, err)
+		}
+	}
+
+	r1, err := decodeG1(d.EphemeralG1)
+	if err != nil {
+		return fmt.Errorf("%w: ephemeral key: %w", ErrInvalidDealing, err)
+	}
+	r2, err := decodeG2(d.EphemeralG2)
+	if err != nil {
+		return fmt.Errorf("%w: ephemeral key: %w", ErrInvalidDealing, err)
+	}
+
+	// e(R1, G2) == e(G1, R2), so that complaints can be verified against R2
+	if !pairingsEqual(r1, g2Affine(), g1Affine(), r2) {
+		return fmt.Errorf("%w: ephemeral keys do not match", ErrInvalidDealing)
+	}
+
+	return nil
+}
+
+// SharedKey returns the key the share of the participant holding key was
+// encrypted with. Publishing it in a complaint only reveals this share.
+func (d Dealing) SharedKey(key DKGKey) ([]byte, error) {
+	x, err := key.secret()
+	if err != nil {
+		return nil, err
+	}
+	defer x.Zeroize()
+	r1, err := decodeG1(d.EphemeralG1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: ephemeral key: %w", ErrInvalidDealing, err)
+	}
+
+	var p blst.P1
+	p.FromAffine(r1)
+	return p.Mult(x).Compress(), nil
+}
+
+// Share decrypts the share of index, held by the participant holding key, and
+// verifies it against the commitments of the dealing. It returns an error
+// wrapping ErrInvalidDealing if the share is invalid, in which case the
+// participant should publish a complaint.
+func (d Dealing) Share(key DKGKey, index uint32) ([]byte, error) {
+	sharedKey, err := d.SharedKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := d.decryptShare(index, sharedKey)
+	if err != nil {
+		return nil, err
+	}
+	defer share.Zeroize()
+
+	return share.Serialize(), nil
+}
+
+// VerifyComplaint verifies the complaint of the participant with the given
+// index and DKG public key against the dealing: it returns nil if sharedKey is
+// the key the share of the participant was encrypted with and the share is
+// invalid, in which case the dealer must be disqualified.
+func (d Dealing) VerifyComplaint(index uint32, encryptionKey, sharedKey []byte) error {
+	pk, err := decodeG1(encryptionKey)
+	if err != nil {
+		return fmt.Errorf("%w: encryption key: %w", ErrInvalidComplaint, err)
+	}
+	k, err := decodeG1(sharedKey)
+	if err != nil {
+		return fmt.Errorf("%w: shared key: %w", ErrInvalidComplaint, err)
+	}
+	r2, err := decodeG2(d.EphemeralG2)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDealing, err)
+	}
+
+	// e(K, G2) == e(PK, R2) proves K = rho x G1 without revealing x
+	if !pairingsEqual(k, g2Affine(), pk, r2) {
+		return fmt.Errorf("%w: shared key does not match the encryption key", ErrInvalidComplaint)
+	}
+
+	if _, err := d.decryptShare(index, sharedKey); !errors.Is(err, ErrInvalidDealing) {
+		return fmt.Errorf("%w: share %d is valid", ErrInvalidComplaint, index)
+	}
+
+	return nil
+}
+
+// decryptShare decrypts the share of index with sharedKey and verifies it
+// against the commitments. The share must be zeroized once used.
+func (d Dealing) decryptShare(index uint32, sharedKey []byte) (*blst.Scalar, error) {
+	encrypted, ok := d.Shares[index]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing share %d", ErrInvalidDealing, index)
+	}
+	share, err := decodeScalar(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("%w: share %d: %w", ErrInvalidDealing, index, err)
+	}
+
+	share.SubAssign(shareMask(d.EphemeralG1, sharedKey, index))
+
+	expected, err := evaluateCommitments(d.Commitments, index)
+	if err != nil {
+		share.Zeroize()
+		return nil, err
+	}
+	if !bytes.Equal(blst.P2Generator().Mult(share).Compress(), expected.Compress()) {
+		share.Zeroize()
+		return nil, fmt.Errorf("%w: share %d does not match the commitments", ErrInvalidDealing, index)
+	}
+
+	return share, nil
+}
+
+// AggregateDealings returns the public key and the verification keys of the
+// participants with the given indexes of the threshold key generated by the
+// dealings of the qualified dealers, which must have been verified.
+func AggregateDealings(dealings []Dealing, threshold int, indexes []uint32) ([]byte, map[uint32][]byte, error) {
+	if len(dealings) == 0 {
+		return nil, nil, errors.New("no dealings")
+	}
+
+	// the commitments of the sum of the polynomials are the sums of the
+	// commitments
+	commitments := make([]blst.P2, threshold)
+	for _, dealing := range dealings {
+		if len(dealing.Commitments) != threshold {
+			return nil, nil, fmt.Errorf("%w: expected %d commitments, got %d", ErrInvalidDealing, threshold, len(dealing.Commitments))
+		}
+
+		for i, commitment := range dealing.Commitments {
+			c, err := decodeG2(commitment)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: %w", ErrInvalidDealing, err)
+			}
+			commitments[i].AddAssign(c)
+		}
+	}
+
+	encoded := make([][]byte, threshold)
+	for i := range commitments {
+		encoded[i] = commitments[i].Compress()
+	}
+	if isInfinity(encoded[0]) {
+		return nil, nil, errors.New("public key is the point at infinity")
+	}
+
+	verificationKeys := make(map[uint32][]byte, len(indexes))
+	for _, index := range indexes {
+		vk, err := evaluateCommitments(encoded, index)
+		if err != nil {
+			return nil, nil, err
+		}
+		verificationKeys[index] = vk.Compress()
+	}
+
+	return encoded[0], verificationKeys, nil
+}
+
+// NewKeyShare returns the key share of index, held by the participant holding
+// key, of the threshold key generated by the dealings of the qualified dealers.
+func NewKeyShare(key DKGKey, index uint32, dealings []Dealing) (KeyShare, error) {
+	var secret blst.Scalar
+	defer secret.Zeroize()
+	for i, dealing := range dealings {
+		sharedKey, err := dealing.SharedKey(key)
+		if err != nil {
+			return KeyShare{}, fmt.Errorf("dealing %d: %w", i, err)
+		}
+		share, err := dealing.decryptShare(index, sharedKey)
+		if err != nil {
+			return KeyShare{}, fmt.Errorf("dealing %d: %w", i, err)
+		}
+
+		secret.AddAssign(share)
+		share.Zeroize()
+	}
+
+	return KeyShare{Index: index, Secret: secret.Serialize()}, nil
+}
+
+// VerificationKey returns the public verification key of the key share.
+func (s KeyShare) VerificationKey() ([]byte, error) {
+	secret, err := decodeScalar(s.Secret)
+	if err != nil {
+		return nil, err
+	}
+	defer secret.Zeroize()
+
+	return blst.P2Generator().Mult(secret).Compress(), nil
+}
+
+// VerifyVerificationKeys verifies that the verification keys, indexed by key
+// share index, are the verification keys of key shares of publicKey any
+// threshold of which can decrypt.
+func VerifyVerificationKeys(publicKey []byte, threshold int, verificationKeys map[uint32][]byte) error {
+	if threshold < 1 || threshold > len(verificationKeys) {
+		return fmt.Errorf("threshold must be in [1, %d], got %d", len(verificationKeys), threshold)
+	}
+
+	if _, err := decodeG2(publicKey); err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+
+	indexes := make([]uint32, 0, len(verificationKeys))
+	for index := range verificationKeys {
+		if index == 0 {
+			return errors.New("key share index cannot be 0")
+		}
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+
+	points := make([]*blst.P2Affine, len(indexes))
+	for i, index := range indexes {
+		p, err := decodeG2(verificationKeys[index])
+		if err != nil {
+			return fmt.Errorf("invalid verification key %d: %w", index, err)
+		}
+		points[i] = p
+	}
+
+	// the first threshold verification keys define the polynomial, the public
+	// key and the other verification keys must lie on it
+	basis, basisPoints := indexes[:threshold], points[:threshold]
+	if p := interpolateG2(basis, basisPoints, 0); !bytes.Equal(p.Compress(), publicKey) {
+		return errors.New("verification keys do not match the public key")
+	}
+	for i := threshold; i < len(indexes); i++ {
+		if p := interpolateG2(basis, basisPoints, indexes[i]); !bytes.Equal(p.Compress(), verificationKeys[indexes[i]]) {
+			return fmt.Errorf("verification key %d does not match the threshold", indexes[i])
+		}
+	}
+
+	return nil
+}
+
+// Encrypt encrypts plaintext for publicKey. The ciphertext can only be decrypted
+// with the same label.
+func Encrypt(rand io.Reader, publicKey, label, plaintext []byte) ([]byte, error) {
+	pk, err := decodeG2(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	r, err := randomScalar(rand)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Zeroize()
+
+	nonce := make([]byte, nonceSize)
+	if _, err := io.ReadFull(rand, nonce); err != nil {
+		return nil, err
+	}
+
+	u := blst.P1Generator().Mult(r).ToAffine()
+	ephemeral := u.Compress()
+	aead, err := newAEAD(ephemeral, pairing(u, pk))
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext := make([]byte, G1PointSize+G2PointSize, len(plaintext)+CiphertextOverhead)
+	copy(ciphertext, ephemeral)
+	ciphertext = append(ciphertext, nonce...)
+	ciphertext = aead.Seal(ciphertext, nonce, plaintext, concat(ephemeral, label))
+
+	// W = r H(U, nonce, box, label) binds the ciphertext to its label, only the
+	// holder of r can compute it
+	h := hashToG2(ephemeral, ciphertext[G1PointSize+G2PointSize:], label)
+	copy(ciphertext[G1PointSize:], h.Mult(r).Compress())
+
+	return ciphertext, nil
+}
+
+// VerifyCiphertext verifies that ciphertext is well formed and bound to label.
+func VerifyCiphertext(ciphertext, label []byte) error {
+	_, err := parseCiphertext(ciphertext, label)
+	return err
+}
+
+// NewDecryptionShare computes the decryption share of the given key share for
+// ciphertext. It fails if ciphertext is not bound to label.
+func NewDecryptionShare(share KeyShare, ciphertext, label []byte) (DecryptionShare, error) {
+	u, err := parseCiphertext(ciphertext, label)
+	if err != nil {
+		return DecryptionShare{}, err
+	}
+
+	secret, err := decodeScalar(share.Secret)
+	if err != nil {
+		return DecryptionShare{}, err
+	}
+	defer secret.Zeroize()
+
+	var p blst.P1
+	p.FromAffine(u)
+	return DecryptionShare{
+		Index:     share.Index,
+		Ephemeral: bytes.Clone(ciphertext[:G1PointSize]),
+		Point:     p.Mult(secret).Compress(),
+	}, nil
+}
+
+// Verify verifies that the decryption share was computed with the key share
+// matching verificationKey.
+func (ds DecryptionShare) Verify(verificationKey []byte) error {
+	vk, err := decodeG2(verificationKey)
+	if err != nil {
+		return fmt.Errorf("invalid verification key: %w", err)
+	}
+	u, err := decodeG1(ds.Ephemeral)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDecryptionShare, err)
+	}
+	d, err := decodeG1(ds.Point)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDecryptionShare, err)
+	}
+
+	// e(D, G2) == e(U, VK)
+	if !pairingsEqual(d, g2Affine(), u, vk) {
+		return ErrInvalidDecryptionShare
+	}
+
+	return nil
+}
+
+// Decrypt decrypts ciphertext with the decryption shares of threshold distinct
+// key holders. The shares must have been verified beforehand, the first
+// threshold shares with distinct indexes are used.
+func Decrypt(ciphertext, label []byte, shares []DecryptionShare, threshold int) ([]byte, error) {
+	if threshold < 1 {
+		return nil, fmt.Errorf("invalid threshold %d", threshold)
+	}
+
+	if _, err := parseCiphertext(ciphertext, label); err != nil {
+		return nil, err
+	}
+	ephemeral := ciphertext[:G1PointSize]
+
+	var (
+		indexes = make([]uint32, 0, threshold)
+		points  = make([]*blst.P1Affine, 0, threshold)
+		seen    = make(map[uint32]struct{}, threshold)
+	)
+	for _, share := range shares {
+		if len(indexes) == threshold {
+			break
+		}
+		if _, ok := seen[share.Index]; ok || share.Index == 0 {
+			continue
+		}
+		if !bytes.Equal(share.Ephemeral, ephemeral) {
+			return nil, fmt.Errorf("%w: share %d is for another ciphertext", ErrInvalidDecryptionShare, share.Index)
+		}
+
+		point, err := decodeG1(share.Point)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidDecryptionShare, err)
+		}
+
+		seen[share.Index] = struct{}{}
+		indexes = append(indexes, share.Index)
+		points = append(points, point)
+	}
+	if len(indexes) < threshold {
+		return nil, fmt.Errorf("%w: got %d, expected %d", ErrNotEnoughShares, len(indexes), threshold)
+	}
+
+	// interpolate the shares in the exponent to recover s U, then
+	// e(s U, G2) == e(U, PK)
+	su := interpolateG1(indexes, points).ToAffine()
+	aead, err := newAEAD(ephemeral, pairing(su, g2Affine()))
+	if err != nil {
+		return nil, err
+	}
+
+	box := ciphertext[G1PointSize+G2PointSize:]
+	plaintext, err := aead.Open(nil, box[:nonceSize], box[nonceSize:], concat(ephemeral, label))
+	if err != nil {
+		return nil, fmt.Errorf("%w: decryption failed", ErrInvalidCiphertext)
+	}
+
+	return plaintext, nil
+}
+
+// parseCiphertext verifies ciphertext is bound to label and returns its
+// ephemeral public key.
+func parseCiphertext(ciphertext, label []byte) (*blst.P1Affine, error) {
+	if len(ciphertext) < CiphertextOverhead {
+		return nil, fmt.Errorf("%w: too short", ErrInvalidCiphertext)
+	}
+
+	ephemeral := ciphertext[:G1PointSize]
+	u, err := decodeG1(ephemeral)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCiphertext, err)
+	}
+	w, err := decodeG2(ciphertext[G1PointSize : G1PointSize+G2PointSize])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCiphertext, err)
+	}
+
+	// e(U, H) == e(G1, W)
+	h := hashToG2(ephemeral, ciphertext[G1PointSize+G2PointSize:], label).ToAffine()
+	if !pairingsEqual(u, h, g1Affine(), w) {
+		return nil, fmt.Errorf("%w: invalid proof or label", ErrInvalidCiphertext)
+	}
+
+	return u, nil
+}
+
+// newAEAD returns the AES-256-GCM cipher keyed with the hash of the ephemeral
+// public key and the shared pairing value.
+func newAEAD(ephemeral []byte, shared *blst.Fp12) (cipher.AEAD, error) {
+	key := sha256.Sum256(concat([]byte(domainKey), ephemeral, shared.ToBendian()))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// shareMask derives the scalar a share is masked with from the ephemeral key
+// of the dealing and the shared key of the participant.
+func shareMask(ephemeral, sharedKey []byte, index uint32) *blst.Scalar {
+	// HashTo leaves the mask at zero when the hash reduces to zero
+	var mask blst.Scalar
+	mask.HashTo(concat(ephemeral, sharedKey, binary.BigEndian.AppendUint32(nil, index)), []byte(domainDealing))
+	return &mask
+}
+
+func hashToG2(ephemeral, box, label []byte) *blst.P2 {
+	var msg []byte
+	for _, part := range [][]byte{ephemeral, box, label} {
+		// length prefix the parts to avoid ambiguities
+		msg = binary.BigEndian.AppendUint64(msg, uint64(len(part)))
+		msg = append(msg, part...)
+	}
+
+	return blst.HashToG2(msg, []byte(domainCiphertext))
+}
+
+// pairing returns e(p, q).
+func pairing(p *blst.P1Affine, q *blst.P2Affine) *blst.Fp12 {
+	gt := blst.Fp12MillerLoop(q, p)
+	gt.FinalExp()
+	return gt
+}
+
+// pairingsEqual returns whether e(p1, q1) == e(p2, q2).
+func pairingsEqual(p1 *blst.P1Affine, q1 *blst.P2Affine, p2 *blst.P1Affine, q2 *blst.P2Affine) bool {
+	return blst.Fp12FinalVerify(blst.Fp12MillerLoop(q1, p1), blst.Fp12MillerLoop(q2, p2))
+}
+
+// evaluate returns the value at x of the polynomial with the given
+// coefficients.
+func evaluate(coefficients []*blst.Scalar, x uint32) *blst.Scalar {
+	xi := scalarFromUint32(x)
+	y := new(blst.Scalar)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		// blst reports zero results, which are still valid, as failures
+		y.MulAssign(xi)
+		y.AddAssign(coefficients[i])
+	}
+
+	return y
+}
+
+// evaluateCommitments returns the value at x of the polynomial, evaluated in
+// the exponent, with the given commitments to its coefficients.
+func evaluateCommitments(commitments [][]byte, x uint32) (*blst.P2, error) {
+	xi := binary.LittleEndian.AppendUint32(nil, x)
+	var y blst.P2
+	for i := len(commitments) - 1; i >= 0; i-- {
+		c, err := decodeG2(commitments[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: commitment %d: %w", ErrInvalidDealing, i, err)
+		}
+
+		y.MultAssign(xi, 32)
+		y.AddAssign(c)
+	}
+
+	return &y, nil
+}
+
+// lagrangeCoefficient returns the Lagrange coefficient at x of indexes[i].
+func lagrangeCoefficient(indexes []uint32, i int, x uint32) *blst.Scalar {
+	num, den := scalarFromUint32(1), scalarFromUint32(1)
+	xi, xs := scalarFromUint32(indexes[i]), scalarFromUint32(x)
+	for j, index := range indexes {
+		if j == i {
+			continue
+		}
+
+		xj := scalarFromUint32(index)
+		d, _ := xj.Sub(xs)
+		num.MulAssign(d)
+		d, _ = xj.Sub(xi)
+		den.MulAssign(d)
+	}
+
+	num.MulAssign(den.Inverse())
+	return num
+}
+
+// interpolateG1 returns the value at 0 of the polynomial, evaluated in the
+// exponent, passing through points at indexes.
+func interpolateG1(indexes []uint32, points []*blst.P1Affine) *blst.P1 {
+	var result blst.P1
+	for i, point := range points {
+		var p blst.P1
+		p.FromAffine(point)
+		result.AddAssign(p.Mult(lagrangeCoefficient(indexes, i, 0)))
+	}
+
+	return &result
+}
+
+// interpolateG2 returns the value at x of the polynomial, evaluated in the
+// exponent, passing through points at indexes.
+func interpolateG2(indexes []uint32, points []*blst.P2Affine, x uint32) *blst.P2 {
+	var result blst.P2
+	for i, point := range points {
+		var p blst.P2
+		p.FromAffine(point)
+		result.AddAssign(p.Mult(lagrangeCoefficient(indexes, i, x)))
+	}
+
+	return &result
+}
+
+// randomScalar returns a random non-zero scalar, which must be zeroized once
+// used.
+func randomScalar(rand io.Reader) (*blst.Scalar, error) {
+	// reduce 512 bits to make the bias negligible
+	bz := make([]byte, 64)
+	defer clear(bz)
+	for {
+		if _, err := io.ReadFull(rand, bz); err != nil {
+			return nil, err
+		}
+
+		// FromBEndian fails on zero scalars
+		if s := new(blst.Scalar).FromBEndian(bz); s != nil {
+			return s, nil
+		}
+	}
+}
+
+// decodeScalar decodes a big endian non-zero scalar lower than the group
+// order.
+func decodeScalar(bz []byte) (*blst.Scalar, error) {
+	if len(bz) != ScalarSize {
+		return nil, fmt.Errorf("invalid scalar size %d", len(bz))
+	}
+
+	s := new(blst.Scalar).Deserialize(bz)
+	if s == nil {
+		return nil, errors.New("scalar is zero or overflows the group order")
+	}
+
+	return s, nil
+}
+
+// scalarFromUint32 returns the scalar of x.
+func scalarFromUint32(x uint32) *blst.Scalar {
+	var s blst.Scalar
+	// FromBEndian fails on zero scalars but still sets s
+	s.FromBEndian(binary.BigEndian.AppendUint32(make([]byte, ScalarSize-4), x))
+	return &s
+}
+
+// zeroize zeroizes the secret scalars.
+func zeroize(scalars ...*blst.Scalar) {
+	for _, s := range scalars {
+		if s != nil {
+			s.Zeroize()
+		}
+	}
+}
+
+func decodeG1(bz []byte) (*blst.P1Affine, error) {
+	if len(bz) != G1PointSize {
+		return nil, fmt.Errorf("invalid G1 point size %d", len(bz))
+	}
+	if isInfinity(bz) {
+		return nil, errors.New("point at infinity")
+	}
+
+	p := new(blst.P1Affine).Uncompress(bz)
+	if p == nil || !p.InG1() {
+		return nil, errors.New("invalid G1 point")
+	}
+
+	return p, nil
+}
+
+func decodeG2(bz []byte) (*blst.P2Affine, error) {
+	if len(bz) != G2PointSize {
+		return nil, fmt.Errorf("invalid G2 point size %d", len(bz))
+	}
+	if isInfinity(bz) {
+		return nil, errors.New("point at infinity")
+	}
+
+	p := new(blst.P2Affine).Uncompress(bz)
+	if p == nil || !p.InG2() {
+		return nil, errors.New("invalid G2 point")
+	}
+
+	return p, nil
+}
+
+// isInfinity returns whether the infinity flag of a compressed point is set.
+func isInfinity(bz []byte) bool {
+	return len(bz) > 0 && bz[0]&0x40 != 0
+}
+
+func g1Affine() *blst.P1Affine {
+	return blst.P1Generator().ToAffine()
+}
+
+func g2Affine() *blst.P2Affine {
+	return blst.P2Generator().ToAffine()
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
@@ -0,0 +1,112 @@
+//go:build !bls12381
+
+package threshold
+
+import "io"
+
+// Available reports whether threshold encryption is available, i.e. whether
+// the package was built with the bls12381 build tag.
+func Available() bool {
+	return false
+}
+
+// GenerateDKGKey generates the key a participant of a DKG receives its key
+// shares with.
+func GenerateDKGKey(rand io.Reader) (DKGKey, error) {
+	return DKGKey{}, errBuildTag
+}
+
+// PublicKey returns the public key, in G1, the shares of the participant are
+// encrypted to.
+func (k DKGKey) PublicKey() ([]byte, error) {
+	return nil, errBuildTag
+}
+
+// VerifyDKGPublicKey verifies that publicKey is a valid DKG public key.
+func VerifyDKGPublicKey(publicKey []byte) error {
+	return errBuildTag
+}
+
+// NewDealing deals a random polynomial of degree threshold-1 to the
+// participants with the given DKG public keys, indexed by their key share
+// index.
+func NewDealing(rand io.Reader, threshold int, encryptionKeys map[uint32][]byte) (Dealing, error) {
+	return Dealing{}, errBuildTag
+}
+
+// Verify verifies that the dealing is well formed, for the given threshold and
+// with one share for each of the given indexes.
+func (d Dealing) Verify(threshold int, indexes []uint32) error {
+	return errBuildTag
+}
+
+// SharedKey returns the key the share of the participant holding key was
+// encrypted with.
+func (d Dealing) SharedKey(key DKGKey) ([]byte, error) {
+	return nil, errBuildTag
+}
+
+// Share decrypts the share of index, held by the participant holding key, and
+// verifies it against the commitments of the dealing.
+func (d Dealing) Share(key DKGKey, index uint32) ([]byte, error) {
+	return nil, errBuildTag
+}
+
+// VerifyComplaint verifies the complaint of the participant with the given
+// index and DKG public key against the dealing.
+func (d Dealing) VerifyComplaint(index uint32, encryptionKey, sharedKey []byte) error {
+	return errBuildTag
+}
+
+// AggregateDealings returns the public key and the verification keys of the
+// threshold key generated by the dealings of the qualified dealers.
+func AggregateDealings(dealings []Dealing, threshold int, indexes []uint32) ([]byte, map[uint32][]byte, error) {
+	return nil, nil, errBuildTag
+}
+
+// NewKeyShare returns the key share of index, held by the participant holding
+// key, of the threshold key generated by the dealings of the qualified dealers.
+func NewKeyShare(key DKGKey, index uint32, dealings []Dealing) (KeyShare, error) {
+	return KeyShare{}, errBuildTag
+}
+
+// VerificationKey returns the public verification key of the key share.
+func (s KeyShare) VerificationKey() ([]byte, error) {
+	return nil, errBuildTag
+}
+
+// VerifyVerificationKeys verifies that the verification keys are the
+// verification keys of key shares of publicKey any threshold of which can
+// decrypt.
+func VerifyVerificationKeys(publicKey []byte, threshold int, verificationKeys map[uint32][]byte) error {
+	return errBuildTag
+}
+
+// Encrypt encrypts plaintext for publicKey. The ciphertext can only be decrypted
+// with the same label.
+func Encrypt(rand io.Reader, publicKey, label, plaintext []byte) ([]byte, error) {
+	return nil, errBuildTag
+}
+
+// VerifyCiphertext verifies that ciphertext is well formed and bound to label.
+func VerifyCiphertext(ciphertext, label []byte) error {
+	return errBuildTag
+}
+
+// NewDecryptionShare computes the decryption share of the given key share for
+// ciphertext.
+func NewDecryptionShare(share KeyShare, ciphertext, label []byte) (DecryptionShare, error) {
+	return DecryptionShare{}, errBuildTag
+}
+
+// Verify verifies that the decryption share was computed with the key share
+// matching verificationKey.
+func (ds DecryptionShare) Verify(verificationKey []byte) error {
+	return errBuildTag
+}
+
+// Decrypt decrypts ciphertext with the decryption shares of threshold distinct
+// key holders.
+func Decrypt(ciphertext, label []byte, shares []DecryptionShare, threshold int) ([]byte, error) {
+	return nil, errBuildTag
+}
@@ -0,0 +1,229 @@
+//go:build bls12381
+
+package threshold_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+// runDKG runs a DKG among n participants, all of which are honest, and returns
+// the public key and the key shares of the participants.
+func runDKG(t *testing.T, thresholdValue, n int) ([]byte, []threshold.KeyShare) {
+	t.Helper()
+
+	keys := make([]threshold.DKGKey, n)
+	encryptionKeys := make(map[uint32][]byte, n)
+	indexes := make([]uint32, n)
+	for i := range keys {
+		var err error
+		keys[i], err = threshold.GenerateDKGKey(rand.Reader)
+		require.NoError(t, err)
+
+		indexes[i] = uint32(i + 1)
+		encryptionKeys[indexes[i]], err = keys[i].PublicKey()
+		require.NoError(t, err)
+	}
+
+	dealings := make([]threshold.Dealing, n)
+	for i := range dealings {
+		var err error
+		dealings[i], err = threshold.NewDealing(rand.Reader, thresholdValue, encryptionKeys)
+		require.NoError(t, err)
+		require.NoError(t, dealings[i].Verify(thresholdValue, indexes))
+	}
+
+	publicKey, verificationKeys, err := threshold.AggregateDealings(dealings, thresholdValue, indexes)
+	require.NoError(t, err)
+	require.Len(t, publicKey, threshold.G2PointSize)
+	require.NoError(t, threshold.VerifyVerificationKeys(publicKey, thresholdValue, verificationKeys))
+
+	keyShares := make([]threshold.KeyShare, n)
+	for i, key := range keys {
+		keyShares[i], err = threshold.NewKeyShare(key, indexes[i], dealings)
+		require.NoError(t, err)
+
+		vk, err := keyShares[i].VerificationKey()
+		require.NoError(t, err)
+		require.Equal(t, verificationKeys[indexes[i]], vk)
+	}
+
+	return publicKey, keyShares
+}
+
+func TestThresholdEncryption(t *testing.T) {
+	publicKey, keyShares := runDKG(t, 3, 5)
+
+	plaintext := []byte("swap 100atom for osmo")
+	label := []byte("cosmos1sender")
+	ciphertext, err := threshold.Encrypt(rand.Reader, publicKey, label, plaintext)
+	require.NoError(t, err)
+	require.Len(t, ciphertext, len(plaintext)+threshold.CiphertextOverhead)
+	require.NoError(t, threshold.VerifyCiphertext(ciphertext, label))
+
+	// every ciphertext uses a fresh nonce and ephemeral key
+	other, err := threshold.Encrypt(rand.Reader, publicKey, label, plaintext)
+	require.NoError(t, err)
+	require.NotEqual(t, ciphertext, other)
+
+	shares := make([]threshold.DecryptionShare, len(keyShares))
+	for i, keyShare := range keyShares {
+		shares[i], err = threshold.NewDecryptionShare(keyShare, ciphertext, label)
+		require.NoError(t, err)
+
+		vk, err := keyShare.VerificationKey()
+		require.NoError(t, err)
+		require.NoError(t, shares[i].Verify(vk))
+
+		decoded, err := threshold.ParseDecryptionShare(shares[i].Bytes())
+		require.NoError(t, err)
+		require.Equal(t, shares[i], decoded)
+	}
+
+	// any threshold of distinct shares decrypts
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {3, 3, 1, 0}} {
+		var selected []threshold.DecryptionShare
+		for _, i := range subset {
+			selected = append(selected, shares[i])
+		}
+
+		decrypted, err := threshold.Decrypt(ciphertext, label, selected, 3)
+		require.NoError(t, err)
+		require.Equal(t, plaintext, decrypted)
+	}
+
+	_, err = threshold.Decrypt(ciphertext, label, shares[:2], 3)
+	require.ErrorIs(t, err, threshold.ErrNotEnoughShares)
+	_, err = threshold.Decrypt(ciphertext, label, []threshold.DecryptionShare{shares[0], shares[0], shares[1]}, 3)
+	require.ErrorIs(t, err, threshold.ErrNotEnoughShares)
+}
+
+func TestThresholdEncryption_Label(t *testing.T) {
+	publicKey, keyShares := runDKG(t, 1, 1)
+
+	ciphertext, err := threshold.Encrypt(rand.Reader, publicKey, []byte("alice"), []byte("tx"))
+	require.NoError(t, err)
+
+	// a ciphertext resubmitted under another label is not decrypted
+	require.ErrorIs(t, threshold.VerifyCiphertext(ciphertext, []byte("mallory")), threshold.ErrInvalidCiphertext)
+	_, err = threshold.NewDecryptionShare(keyShares[0], ciphertext, []byte("mallory"))
+	require.ErrorIs(t, err, threshold.ErrInvalidCiphertext)
+
+	// tampered ciphertexts are rejected
+	tampered := append([]byte{}, ciphertext...)
+	tampered[len(tampered)-1] ^= 1
+	require.ErrorIs(t, threshold.VerifyCiphertext(tampered, []byte("alice")), threshold.ErrInvalidCiphertext)
+	require.ErrorIs(t, threshold.VerifyCiphertext(ciphertext[:threshold.CiphertextOverhead-1], []byte("alice")), threshold.ErrInvalidCiphertext)
+}
+
+func TestDecryptionShare_Verify(t *testing.T) {
+	publicKey, keyShares := runDKG(t, 2, 3)
+
+	ciphertext, err := threshold.Encrypt(rand.Reader, publicKey, nil, []byte("tx"))
+	require.NoError(t, err)
+
+	share, err := threshold.NewDecryptionShare(keyShares[0], ciphertext, nil)
+	require.NoError(t, err)
+
+	// the share does not match the verification key of another key holder
+	vk, err := keyShares[1].VerificationKey()
+	require.NoError(t, err)
+	require.ErrorIs(t, share.Verify(vk), threshold.ErrInvalidDecryptionShare)
+
+	// a share computed with another key share is rejected
+	other, err := threshold.NewDecryptionShare(keyShares[1], ciphertext, nil)
+	require.NoError(t, err)
+	share.Point = other.Point
+	vk, err = keyShares[0].VerificationKey()
+	require.NoError(t, err)
+	require.ErrorIs(t, share.Verify(vk), threshold.ErrInvalidDecryptionShare)
+
+	_, err = threshold.ParseDecryptionShare(share.Bytes()[1:])
+	require.ErrorIs(t, err, threshold.ErrInvalidDecryptionShare)
+}
+
+func TestDealing_Complaint(t *testing.T) {
+	keys := make([]threshold.DKGKey, 3)
+	encryptionKeys := make(map[uint32][]byte, len(keys))
+	for i := range keys {
+		var err error
+		keys[i], err = threshold.GenerateDKGKey(rand.Reader)
+		require.NoError(t, err)
+		encryptionKeys[uint32(i+1)], err = keys[i].PublicKey()
+		require.NoError(t, err)
+	}
+	indexes := []uint32{1, 2, 3}
+
+	dealing, err := threshold.NewDealing(rand.Reader, 2, encryptionKeys)
+	require.NoError(t, err)
+
+	// complaints against valid shares are rejected
+	sharedKey, err := dealing.SharedKey(keys[0])
+	require.NoError(t, err)
+	require.ErrorIs(t, dealing.VerifyComplaint(1, encryptionKeys[1], sharedKey), threshold.ErrInvalidComplaint)
+
+	// the dealer corrupts the share of the second participant
+	dealing.Shares[2] = dealing.Shares[1]
+	require.NoError(t, dealing.Verify(2, indexes))
+	_, err = dealing.Share(keys[1], 2)
+	require.ErrorIs(t, err, threshold.ErrInvalidDealing)
+
+	sharedKey, err = dealing.SharedKey(keys[1])
+	require.NoError(t, err)
+	require.NoError(t, dealing.VerifyComplaint(2, encryptionKeys[2], sharedKey))
+
+	// a complaint must reveal the key the share was encrypted with
+	otherKey, err := dealing.SharedKey(keys[2])
+	require.NoError(t, err)
+	require.ErrorIs(t, dealing.VerifyComplaint(2, encryptionKeys[2], otherKey), threshold.ErrInvalidComplaint)
+
+	// malformed dealings are rejected
+	require.ErrorIs(t, dealing.Verify(3, indexes), threshold.ErrInvalidDealing)
+	require.ErrorIs(t, dealing.Verify(2, []uint32{1, 2}), threshold.ErrInvalidDealing)
+	other, err := threshold.NewDealing(rand.Reader, 2, encryptionKeys)
+	require.NoError(t, err)
+	dealing.EphemeralG2 = other.EphemeralG2
+	require.ErrorIs(t, dealing.Verify(2, indexes), threshold.ErrInvalidDealing)
+}
+
+func TestNewDealing_InvalidThreshold(t *testing.T) {
+	key, err := threshold.GenerateDKGKey(rand.Reader)
+	require.NoError(t, err)
+	pk, err := key.PublicKey()
+	require.NoError(t, err)
+
+	_, err = threshold.NewDealing(rand.Reader, 0, map[uint32][]byte{1: pk})
+	require.Error(t, err)
+	_, err = threshold.NewDealing(rand.Reader, 2, map[uint32][]byte{1: pk})
+	require.Error(t, err)
+	_, err = threshold.NewDealing(rand.Reader, 1, map[uint32][]byte{0: pk})
+	require.Error(t, err)
+}
+
+func TestVerifyVerificationKeys(t *testing.T) {
+	publicKey, keyShares := runDKG(t, 2, 4)
+
+	verificationKeys := make(map[uint32][]byte)
+	for _, keyShare := range keyShares {
+		var err error
+		verificationKeys[keyShare.Index], err = keyShare.VerificationKey()
+		require.NoError(t, err)
+	}
+	require.NoError(t, threshold.VerifyVerificationKeys(publicKey, 2, verificationKeys))
+
+	// fewer key shares than the threshold cannot decrypt
+	require.Error(t, threshold.VerifyVerificationKeys(publicKey, 1, verificationKeys))
+	require.Error(t, threshold.VerifyVerificationKeys(publicKey, 5, verificationKeys))
+
+	otherKey, otherShares := runDKG(t, 2, 4)
+	require.Error(t, threshold.VerifyVerificationKeys(otherKey, 2, verificationKeys))
+
+	var err error
+	verificationKeys[4], err = otherShares[3].VerificationKey()
+	require.NoError(t, err)
+	require.Error(t, threshold.VerifyVerificationKeys(publicKey, 2, verificationKeys))
+}
@@ -17,17 +17,41 @@ CREATE TABLE IF NOT EXISTS tx
     id             BIGSERIAL PRIMARY KEY,
     block_number   BIGINT NOT NULL REFERENCES block (number),
     index_in_block BIGINT NOT NULL,
-    data           JSONB  NOT NULL
+    data           JSONB  NULL,
+    bytes          BYTEA  NULL,
+    UNIQUE (block_number, index_in_block)
 );
 
 CREATE TABLE IF NOT EXISTS event
 (
     id           BIGSERIAL PRIMARY KEY,
-    block_number BIGINT NOT NULL REFERENCES block (number),
-    tx_id        BIGINT NULL REFERENCES tx (id),
-    msg_index    BIGINT NULL,
-    event_index  BIGINT NULL,
-    type         TEXT   NOT NULL,
-    data         JSONB  NOT NULL
+    block_number BIGINT  NOT NULL REFERENCES block (number),
+    tx_index     INTEGER NOT NULL,
+    tx_id        BIGINT  NULL REFERENCES tx (id),
+    msg_index    BIGINT  NULL,
+    event_index  BIGINT  NULL,
+    type         TEXT    NOT NULL,
+    data         JSONB   NULL
 );
+
+CREATE INDEX IF NOT EXISTS event_block_number_idx ON event (block_number);
+CREATE INDEX IF NOT EXISTS event_tx_id_idx ON event (tx_id);
+CREATE INDEX IF NOT EXISTS event_type_idx ON event (type);
+
+CREATE TABLE IF NOT EXISTS event_attribute
+(
+    event_id      BIGINT  NOT NULL REFERENCES event (id),
+    idx           INTEGER NOT NULL,
+    key           TEXT    NOT NULL,
+    value         TEXT    NOT NULL,
+    value_numeric NUMERIC NULL,
+    value_bool    BOOLEAN NULL,
+    value_json    JSONB   NULL,
+    PRIMARY KEY (event_id, idx)
+);
+
+CREATE INDEX IF NOT EXISTS event_attribute_key_value_idx ON event_attribute (key, value);
+CREATE INDEX IF NOT EXISTS event_attribute_key_value_numeric_idx ON event_attribute (key, value_numeric) WHERE value_numeric IS NOT NULL;
+
+GRANT SELECT ON TABLE block, tx, event, event_attribute TO PUBLIC;
 `
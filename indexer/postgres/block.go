@@ -0,0 +1,253 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"cosmossdk.io/schema/appdata"
+)
+
+// blockIndexer writes blocks, transactions and events to the base block, tx, event and event_attribute tables.
+type blockIndexer struct {
+	options Options
+
+	// height is the height of the current block.
+	height uint64
+
+	// blockWritten is true if a row for the current block has been written.
+	blockWritten bool
+
+	// txIDs maps the index of transactions in the current block to their tx table ids.
+	txIDs map[int32]int64
+
+	// lastTxID and lastEventID are the ids of the tx and event most recently written in the current block.
+	// Object updates are linked to them.
+	lastTxID, lastEventID *int64
+}
+
+// newBlockIndexer creates a new blockIndexer.
+func newBlockIndexer(options Options) *blockIndexer {
+	return &blockIndexer{
+		options: options,
+		txIDs:   map[int32]int64{},
+	}
+}
+
+// startBlock writes the block row with its header.
+func (b *blockIndexer) startBlock(ctx context.Context, conn DBConn, data appdata.StartBlockData) error {
+	b.height = data.Height
+	b.blockWritten = false
+	b.txIDs = map[int32]int64{}
+	b.lastTxID, b.lastEventID = nil, nil
+
+	var header interface{}
+	if data.HeaderJSON != nil {
+		headerJSON, err := data.HeaderJSON()
+		if err != nil {
+			return err
+		}
+		header = string(headerJSON)
+	}
+
+	sqlStr := "INSERT INTO block (number, header) VALUES ($1, $2) ON CONFLICT (number) DO UPDATE SET header = EXCLUDED.header;"
+	err := b.exec(ctx, conn, "Insert block", sqlStr, int64(b.height), header)
+	if err != nil {
+		return err
+	}
+
+	b.blockWritten = true
+	return nil
+}
+
+// ensureBlock writes a row without a header for the current block if one hasn't been written yet, so that
+// rows referencing the block can be inserted even if StartBlock wasn't called, for instance at genesis.
+func (b *blockIndexer) ensureBlock(ctx context.Context, conn DBConn) error {
+	if b.blockWritten {
+		return nil
+	}
+
+	sqlStr := "INSERT INTO block (number) VALUES ($1) ON CONFLICT (number) DO NOTHING;"
+	err := b.exec(ctx, conn, "Insert block", sqlStr, int64(b.height))
+	if err != nil {
+		return err
+	}
+
+	b.blockWritten = true
+	return nil
+}
+
+// onTx writes the transaction row and records its id so that events can reference it.
+func (b *blockIndexer) onTx(ctx context.Context, conn DBConn, data appdata.TxData) error {
+	err := b.ensureBlock(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	var txJSON, txBytes interface{}
+	if data.JSON != nil {
+		bz, err := data.JSON()
+		if err != nil {
+			return err
+		}
+		txJSON = string(bz)
+	}
+	if data.Bytes != nil {
+		bz, err := data.Bytes()
+		if err != nil {
+			return err
+		}
+		txBytes = bz
+	}
+
+	sqlStr := "INSERT INTO tx (block_number, index_in_block, data, bytes) VALUES ($1, $2, $3, $4) RETURNING id;"
+	params := []interface{}{int64(b.height), data.TxIndex, txJSON, txBytes}
+	if b.options.Logger != nil {
+		b.options.Logger("Insert tx", sqlStr, params...)
+	}
+
+	var id int64
+	err = conn.QueryRowContext(ctx, sqlStr, params...).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	b.txIDs[data.TxIndex] = id
+	b.lastTxID, b.lastEventID = &id, nil
+	return nil
+}
+
+// updateSource returns the source which object updates are currently linked to: the current block and the
+// most recently written tx and event in it. Events which are not associated with a transaction, such as
+// begin and end block events, unlink subsequent updates from any tx.
+func (b *blockIndexer) updateSource() UpdateSource {
+	return UpdateSource{
+		Height:  b.height,
+		TxID:    b.lastTxID,
+		EventID: b.lastEventID,
+	}
+}
+
+// onEvent writes the event rows and their attributes.
+func (b *blockIndexer) onEvent(ctx context.Context, conn DBConn, data appdata.EventData) error {
+	err := b.ensureBlock(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range data.Events {
+		err = b.insertEvent(ctx, conn, event)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertEvent writes a single event row and its attributes.
+func (b *blockIndexer) insertEvent(ctx context.Context, conn DBConn, event appdata.Event) error {
+	var txID *int64
+	if event.TxIndex >= 0 {
+		id, ok := b.txIDs[event.TxIndex]
+		if ok {
+			txID = &id
+		}
+	}
+
+	var eventJSON interface{}
+	if event.Data != nil {
+		bz, err := event.Data()
+		if err != nil {
+			return err
+		}
+		eventJSON = string(bz)
+	}
+
+	sqlStr := `INSERT INTO event (block_number, tx_index, tx_id, msg_index, event_index, type, data)
+VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id;`
+	params := []interface{}{int64(b.height), event.TxIndex, nullableID(txID), event.MsgIndex, event.EventIndex, event.Type, eventJSON}
+	if b.options.Logger != nil {
+		b.options.Logger("Insert event", sqlStr, params...)
+	}
+
+	var id int64
+	err := conn.QueryRowContext(ctx, sqlStr, params...).Scan(&id)
+	if err != nil {
+		return err
+	}
+	b.lastTxID, b.lastEventID = txID, &id
+
+	if event.Attributes == nil {
+		return nil
+	}
+
+	attrs, err := event.Attributes()
+	if err != nil {
+		return err
+	}
+
+	for i, attr := range attrs {
+		numericValue, boolValue, jsonValue := typedAttributeValues(attr.Value)
+		err = b.exec(ctx, conn, "Insert event attribute",
+			`INSERT INTO event_attribute (event_id, idx, key, value, value_numeric, value_bool, value_json)
+VALUES ($1, $2, $3, $4, $5, $6, $7);`,
+			id, i, attr.Key, attr.Value, numericValue, boolValue, jsonValue)
+		if err != nil {
+			return fmt.Errorf("failed to insert attribute %q of event %s: %v", attr.Key, event.Type, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+
+	return nil
+}
+
+// numericAttributeRegex matches attribute values which can be stored in a NUMERIC column.
+var numericAttributeRegex = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// typedAttributeValues returns the values of the typed value_numeric, value_bool and value_json columns
+// for an event attribute value, each of which is nil if the value can't be represented in that column.
+// JSON string values, as emitted by typed events, are unquoted before detecting numeric and boolean values.
+func typedAttributeValues(value string) (numericValue, boolValue, jsonValue interface{}) {
+	str := value
+	if json.Valid([]byte(value)) {
+		trimmed := strings.TrimSpace(value)
+		switch trimmed[0] {
+		case '{', '[':
+			return nil, nil, trimmed
+		case '"':
+			err := json.Unmarshal([]byte(trimmed), &str)
+			if err != nil {
+				return nil, nil, nil
+			}
+		}
+	}
+
+	switch {
+	case numericAttributeRegex.MatchString(str):
+		return str, nil, nil
+	case str == "true":
+		return nil, true, nil
+	case str == "false":
+		return nil, false, nil
+	default:
+		return nil, nil, nil
+	}
+}
+
+// nullableID returns the id or nil if it is not set.
+func nullableID(id *int64) interface{} {
+	if id == nil {
+		return nil
+	}
+	return *id
+}
+
+// exec logs and executes the SQL statement.
+func (b *blockIndexer) exec(ctx context.Context, conn DBConn, msg, sqlStr string, params ...interface{}) error {
+	if b.options.Logger != nil {
+		b.options.Logger(msg, sqlStr, params...)
+	}
+	_, err := conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import "testing"
+
+func TestTypedAttributeValues(t *testing.T) {
+	tests := []struct {
+		value                              string
+		numericValue, boolValue, jsonValue interface{}
+	}{
+		{value: "100", numericValue: "100"},
+		{value: "-1.5", numericValue: "-1.5"},
+		{value: `"100"`, numericValue: "100"},
+		{value: "100stake"},
+		{value: "true", boolValue: true},
+		{value: `"false"`, boolValue: false},
+		{value: `{"denom":"stake"}`, jsonValue: `{"denom":"stake"}`},
+		{value: ` ["a"] `, jsonValue: `["a"]`},
+		{value: "cosmos1abc"},
+		{value: ""},
+	}
+
+	for _, tt := range tests {
+		numericValue, boolValue, jsonValue := typedAttributeValues(tt.value)
+		if numericValue != tt.numericValue || boolValue != tt.boolValue || jsonValue != tt.jsonValue {
+			t.Errorf("typedAttributeValues(%q) = (%v, %v, %v), want (%v, %v, %v)", tt.value,
+				numericValue, boolValue, jsonValue, tt.numericValue, tt.boolValue, tt.jsonValue)
+		}
+	}
+}
@@ -60,6 +60,20 @@ func (tm *ObjectIndexer) CreateTableSql(writer io.Writer) error {
 		}
 	}
 
+	// link each row to the tx and event in which it was last written
+	_, err = fmt.Fprintf(writer, "_tx_id BIGINT NULL REFERENCES tx (id),\n\t_event_id BIGINT NULL REFERENCES event (id),\n\t")
+	if err != nil {
+		return err
+	}
+
+	// add the block height range columns in historical mode
+	if tm.options.Historical {
+		_, err = fmt.Fprintf(writer, "_valid_from BIGINT NOT NULL REFERENCES block (number),\n\t_valid_to BIGINT NULL REFERENCES block (number),\n\t")
+		if err != nil {
+			return err
+		}
+	}
+
 	var pKeys []string
 	if !isSingleton {
 		for _, field := range tm.typ.KeyFields {
@@ -74,7 +88,12 @@ func (tm *ObjectIndexer) CreateTableSql(writer io.Writer) error {
 		pKeys = []string{"_id"}
 	}
 
-	_, err = fmt.Fprintf(writer, "PRIMARY KEY (%s)", strings.Join(pKeys, ", "))
+	// in historical mode each key has one row per version, so the version must be part of the primary key
+	if tm.options.Historical {
+		_, err = fmt.Fprintf(writer, "PRIMARY KEY (%s)", strings.Join(append(pKeys, "_valid_from"), ", "))
+	} else {
+		_, err = fmt.Fprintf(writer, "PRIMARY KEY (%s)", strings.Join(pKeys, ", "))
+	}
 	if err != nil {
 		return err
 	}
@@ -84,6 +103,16 @@ func (tm *ObjectIndexer) CreateTableSql(writer io.Writer) error {
 		return err
 	}
 
+	// in historical mode, we add a partial unique index which both guarantees that there is at most one
+	// current version of each object and speeds up current state lookups
+	if tm.options.Historical {
+		_, err = fmt.Fprintf(writer, "CREATE UNIQUE INDEX IF NOT EXISTS %q ON %q (%s) WHERE _valid_to IS NULL;\n",
+			tm.currentIndexName(), tm.TableName(), strings.Join(pKeys, ", "))
+		if err != nil {
+			return err
+		}
+	}
+
 	// we GRANT SELECT on the table to PUBLIC so that the table is automatically available
 	// for querying using off-the-shelf tools like pg_graphql, Postgrest, Postgraphile, etc.
 	// without any login permissions
@@ -35,6 +35,8 @@ func ExampleObjectIndexer_CreateTableSql_allKinds() {
 	//	"address" TEXT NOT NULL,
 	//	"enum" "test_my_enum" NOT NULL,
 	//	"json" JSONB NOT NULL,
+	//	_tx_id BIGINT NULL REFERENCES tx (id),
+	//	_event_id BIGINT NULL REFERENCES event (id),
 	//	PRIMARY KEY ("id", "ts_nanos")
 	// );
 	// GRANT SELECT ON TABLE "test_all_kinds" TO PUBLIC;
@@ -48,6 +50,8 @@ func ExampleObjectIndexer_CreateTableSql_singleton() {
 	//	"foo" TEXT NOT NULL,
 	//	"bar" INTEGER NULL,
 	//	"an_enum" "test_my_enum" NOT NULL,
+	//	_tx_id BIGINT NULL REFERENCES tx (id),
+	//	_event_id BIGINT NULL REFERENCES event (id),
 	//	PRIMARY KEY (_id)
 	// );
 	// GRANT SELECT ON TABLE "test_singleton" TO PUBLIC;
@@ -61,6 +65,8 @@ func ExampleObjectIndexer_CreateTableSql_vote() {
 	// 	"address" TEXT NOT NULL,
 	// 	"vote" "test_vote_type" NOT NULL,
 	// 	_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	// 	_tx_id BIGINT NULL REFERENCES tx (id),
+	// 	_event_id BIGINT NULL REFERENCES event (id),
 	// 	PRIMARY KEY ("proposal", "address")
 	// );
 	// GRANT SELECT ON TABLE "test_vote" TO PUBLIC;
@@ -73,20 +79,60 @@ func ExampleObjectIndexer_CreateTableSql_vote_no_retain_delete() {
 	// 	"proposal" BIGINT NOT NULL,
 	//	"address" TEXT NOT NULL,
 	//	"vote" "test_vote_type" NOT NULL,
+	//	_tx_id BIGINT NULL REFERENCES tx (id),
+	//	_event_id BIGINT NULL REFERENCES event (id),
 	//	PRIMARY KEY ("proposal", "address")
 	// );
 	// GRANT SELECT ON TABLE "test_vote" TO PUBLIC;
 }
 
+func ExampleObjectIndexer_CreateTableSql_vote_historical() {
+	exampleCreateTableOpts(testdata.VoteObject, Options{Historical: true})
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" BIGINT NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"vote" "test_vote_type" NOT NULL,
+	// 	_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	// 	_tx_id BIGINT NULL REFERENCES tx (id),
+	// 	_event_id BIGINT NULL REFERENCES event (id),
+	// 	_valid_from BIGINT NOT NULL REFERENCES block (number),
+	// 	_valid_to BIGINT NULL REFERENCES block (number),
+	// 	PRIMARY KEY ("proposal", "address", _valid_from)
+	// );
+	// CREATE UNIQUE INDEX IF NOT EXISTS "test_vote_current" ON "test_vote" ("proposal", "address") WHERE _valid_to IS NULL;
+	// GRANT SELECT ON TABLE "test_vote" TO PUBLIC;
+}
+
+func ExampleObjectIndexer_CreateTableSql_singleton_historical() {
+	exampleCreateTableOpts(testdata.SingletonObject, Options{Historical: true})
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_singleton" (
+	// 	_id INTEGER NOT NULL CHECK (_id = 1),
+	// 	"foo" TEXT NOT NULL,
+	// 	"bar" INTEGER NULL,
+	// 	"an_enum" "test_my_enum" NOT NULL,
+	// 	_tx_id BIGINT NULL REFERENCES tx (id),
+	// 	_event_id BIGINT NULL REFERENCES event (id),
+	// 	_valid_from BIGINT NOT NULL REFERENCES block (number),
+	// 	_valid_to BIGINT NULL REFERENCES block (number),
+	// 	PRIMARY KEY (_id, _valid_from)
+	// );
+	// CREATE UNIQUE INDEX IF NOT EXISTS "test_singleton_current" ON "test_singleton" (_id) WHERE _valid_to IS NULL;
+	// GRANT SELECT ON TABLE "test_singleton" TO PUBLIC;
+}
+
 func exampleCreateTable(objectType schema.ObjectType) {
 	exampleCreateTableOpt(objectType, false)
 }
 
 func exampleCreateTableOpt(objectType schema.ObjectType, noRetainDelete bool) {
-	tm := NewObjectIndexer("test", objectType, Options{
-		Logger:                 func(msg, sql string, params ...interface{}) {},
-		DisableRetainDeletions: noRetainDelete,
-	})
+	exampleCreateTableOpts(objectType, Options{DisableRetainDeletions: noRetainDelete})
+}
+
+func exampleCreateTableOpts(objectType schema.ObjectType, options Options) {
+	options.Logger = func(msg, sql string, params ...interface{}) {}
+	tm := NewObjectIndexer("test", objectType, options)
 	err := tm.CreateTableSql(os.Stdout)
 	if err != nil {
 		panic(err)
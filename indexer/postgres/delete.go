@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Delete deletes the row with the provided key or flags it as deleted if deletions are retained, in which
+// case the row is linked to the tx and event of the update source.
+func (tm *ObjectIndexer) Delete(ctx context.Context, conn DBConn, src UpdateSource, key interface{}) error {
+	if tm.options.Historical {
+		return tm.deleteHistorical(ctx, conn, src, key)
+	}
+
+	buf := new(strings.Builder)
+	params, err := tm.DeleteSql(buf, src, key)
+	if err != nil {
+		return err
+	}
+
+	return tm.exec(ctx, conn, "Delete", buf.String(), params...)
+}
+
+// DeleteSql generates a DELETE statement, or an UPDATE statement setting _deleted if deletions are retained,
+// for the provided key and returns the params to pass to it.
+func (tm *ObjectIndexer) DeleteSql(w io.Writer, src UpdateSource, key interface{}) ([]interface{}, error) {
+	var err error
+	var params []interface{}
+	if tm.retainDeletions() {
+		params = src.sourceParams()
+		_, err = fmt.Fprintf(w, "UPDATE %q SET _deleted = TRUE, _tx_id = $1, _event_id = $2", tm.TableName())
+	} else {
+		_, err = fmt.Fprintf(w, "DELETE FROM %q", tm.TableName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keyParams, err := tm.whereSqlAndParams(w, key, len(params))
+	if err != nil {
+		return nil, err
+	}
+	params = append(params, keyParams...)
+
+	_, err = fmt.Fprintf(w, ";")
+	return params, err
+}
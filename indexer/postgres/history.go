@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// In historical mode, each row in an object table is a version of an object which is valid for the half-open
+// block height range [_valid_from, _valid_to). The current version of an object has a NULL _valid_to.
+// The state of an object at height H can be queried with:
+//
+//	WHERE _valid_from <= H AND (_valid_to IS NULL OR _valid_to > H)
+//
+// Multiple updates to the same object within a block are collapsed into a single version so that
+// there are never empty height ranges.
+
+// insertUpdateHistorical closes the current version of the object and writes a new version starting at the
+// source height.
+func (tm *ObjectIndexer) insertUpdateHistorical(ctx context.Context, conn DBConn, src UpdateSource, key, value interface{}) error {
+	err := tm.closeVersion(ctx, conn, src.Height, key)
+	if err != nil {
+		return err
+	}
+
+	// copy the previous version forward so that fields omitted in partial value updates are retained
+	err = tm.copyVersion(ctx, conn, src, key, false)
+	if err != nil {
+		return err
+	}
+
+	return tm.insertOrUpdate(ctx, conn, src, key, value)
+}
+
+// deleteHistorical closes the current version of the object at the source height. If deletions are retained,
+// a new version flagged as deleted is written with the last values of the object.
+func (tm *ObjectIndexer) deleteHistorical(ctx context.Context, conn DBConn, src UpdateSource, key interface{}) error {
+	err := tm.closeVersion(ctx, conn, src.Height, key)
+	if err != nil {
+		return err
+	}
+
+	// remove any version written earlier in the same block
+	buf := new(strings.Builder)
+	_, err = fmt.Fprintf(buf, "DELETE FROM %q", tm.TableName())
+	if err != nil {
+		return err
+	}
+	params, err := tm.versionWhereSqlAndParams(buf, src.Height, key, "_valid_from = $1")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(buf, ";")
+	if err != nil {
+		return err
+	}
+	err = tm.exec(ctx, conn, "Delete version", buf.String(), params...)
+	if err != nil {
+		return err
+	}
+
+	if !tm.retainDeletions() {
+		return nil
+	}
+
+	return tm.copyVersion(ctx, conn, src, key, true)
+}
+
+// closeVersion sets _valid_to to height for the current version of the object if it was written
+// before height.
+func (tm *ObjectIndexer) closeVersion(ctx context.Context, conn DBConn, height uint64, key interface{}) error {
+	buf := new(strings.Builder)
+	_, err := fmt.Fprintf(buf, "UPDATE %q SET _valid_to = $1", tm.TableName())
+	if err != nil {
+		return err
+	}
+
+	params, err := tm.versionWhereSqlAndParams(buf, height, key, "_valid_to IS NULL AND _valid_from < $1")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(buf, ";")
+	if err != nil {
+		return err
+	}
+
+	return tm.exec(ctx, conn, "Close version", buf.String(), params...)
+}
+
+// copyVersion copies the version of the object which was closed at the source height to a new version starting
+// at that height and linked to the tx and event of the source. If a version starting at the source height
+// already exists, nothing is copied.
+func (tm *ObjectIndexer) copyVersion(ctx context.Context, conn DBConn, src UpdateSource, key interface{}, deleted bool) error {
+	keyCols, err := tm.keyColumnNames()
+	if err != nil {
+		return err
+	}
+
+	valueCols, err := tm.columnNames(tm.typ.ValueFields)
+	if err != nil {
+		return err
+	}
+
+	var cols []string
+	cols = append(cols, keyCols...)
+	cols = append(cols, valueCols...)
+	selectCols := append([]string{}, cols...)
+	if tm.retainDeletions() {
+		cols = append(cols, "_deleted")
+		if deleted {
+			selectCols = append(selectCols, "TRUE")
+		} else {
+			selectCols = append(selectCols, "FALSE")
+		}
+	}
+	cols = append(cols, "_valid_from")
+	selectCols = append(selectCols, "$1::BIGINT")
+
+	where := new(strings.Builder)
+	params, err := tm.versionWhereSqlAndParams(where, src.Height, key, "_valid_to = $1")
+	if err != nil {
+		return err
+	}
+
+	// the tx and event params are bound after the key params
+	params = append(params, src.sourceParams()...)
+	cols = append(cols, "_tx_id", "_event_id")
+	selectCols = append(selectCols, fmt.Sprintf("$%d::BIGINT", len(params)-1), fmt.Sprintf("$%d::BIGINT", len(params)))
+
+	buf := new(strings.Builder)
+	_, err = fmt.Fprintf(buf, "INSERT INTO %q (%s) SELECT %s FROM %q%s ON CONFLICT DO NOTHING;",
+		tm.TableName(), strings.Join(cols, ", "), strings.Join(selectCols, ", "), tm.TableName(), where.String())
+	if err != nil {
+		return err
+	}
+
+	return tm.exec(ctx, conn, "Copy version", buf.String(), params...)
+}
+
+// versionWhereSqlAndParams writes a WHERE clause matching the key columns and the provided version condition
+// to the writer without a terminating semicolon. The height is always bound to the first param.
+func (tm *ObjectIndexer) versionWhereSqlAndParams(w io.Writer, height uint64, key interface{}, versionCond string) ([]interface{}, error) {
+	keyParams, err := tm.whereSqlAndParams(w, key, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(w, " AND %s", versionCond)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]interface{}{int64(height)}, keyParams...), nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/schema/addressutil"
 	"cosmossdk.io/schema/appdata"
 )
 
@@ -18,6 +19,14 @@ type Config struct {
 
 	// DisableRetainDeletions disables the retain deletions functionality even if it is set in an object type schema.
 	DisableRetainDeletions bool `json:"disable_retain_deletions"`
+
+	// Historical enables historical (versioned) mode where every object update is written as a new row
+	// with the block height range in which it was valid, so that state can be queried at any height.
+	Historical bool `json:"historical"`
+
+	// AddressCodec is the address codec used to convert address fields to strings. It defaults to
+	// addressutil.HexAddressCodec.
+	AddressCodec addressutil.AddressCodec `json:"-"`
 }
 
 type SqlLogger = func(msg, sql string, params ...interface{})
@@ -48,12 +57,21 @@ func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (appdata
 		return appdata.Listener{}, err
 	}
 
+	addressCodec := config.AddressCodec
+	if addressCodec == nil {
+		addressCodec = addressutil.HexAddressCodec{}
+	}
+
 	moduleIndexers := map[string]*ModuleIndexer{}
 	opts := Options{
 		DisableRetainDeletions: config.DisableRetainDeletions,
+		Historical:             config.Historical,
+		AddressCodec:           addressCodec,
 		Logger:                 logger,
 	}
 
+	blocks := newBlockIndexer(opts)
+
 	return appdata.Listener{
 		InitializeModuleData: func(data appdata.ModuleInitializationData) error {
 			moduleName := data.ModuleName
@@ -68,6 +86,37 @@ func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (appdata
 
 			return mm.InitializeSchema(ctx, tx)
 		},
+		StartBlock: func(data appdata.StartBlockData) error {
+			return blocks.startBlock(ctx, tx, data)
+		},
+		OnTx: func(data appdata.TxData) error {
+			return blocks.onTx(ctx, tx, data)
+		},
+		OnEvent: func(data appdata.EventData) error {
+			return blocks.onEvent(ctx, tx, data)
+		},
+		OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+			mm, ok := moduleIndexers[data.ModuleName]
+			if !ok {
+				return fmt.Errorf("module %s not initialized", data.ModuleName)
+			}
+
+			// historical object versions reference the block they were written in
+			if opts.Historical {
+				err := blocks.ensureBlock(ctx, tx)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, update := range data.Updates {
+				err := mm.ApplyUpdate(ctx, tx, blocks.updateSource(), update)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
 		Commit: func(data appdata.CommitData) (completionCallback func() error, err error) {
 			err = tx.Commit()
 			if err != nil {
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// UpdateSource identifies the block, transaction and event in which an object update occurred.
+type UpdateSource struct {
+	// Height is the block height at which the update occurred. It is only used in historical mode.
+	Height uint64
+
+	// TxID is the id of the row in the tx table which the update is linked to, or nil if there is none.
+	TxID *int64
+
+	// EventID is the id of the row in the event table which the update is linked to, or nil if there is none.
+	EventID *int64
+}
+
+// sourceParams returns the params for the _tx_id and _event_id columns.
+func (s UpdateSource) sourceParams() []interface{} {
+	return []interface{}{nullableID(s.TxID), nullableID(s.EventID)}
+}
+
+// InsertUpdate inserts or updates the row with the provided key and value and links it to the tx and event
+// of the update source.
+func (tm *ObjectIndexer) InsertUpdate(ctx context.Context, conn DBConn, src UpdateSource, key, value interface{}) error {
+	if tm.options.Historical {
+		return tm.insertUpdateHistorical(ctx, conn, src, key, value)
+	}
+
+	return tm.insertOrUpdate(ctx, conn, src, key, value)
+}
+
+// insertOrUpdate writes the row with the provided key and value. NOT NULL constraints are checked before
+// ON CONFLICT clauses, so partial value updates are first attempted with an UPDATE statement and only
+// inserted if no existing row was updated.
+func (tm *ObjectIndexer) insertOrUpdate(ctx context.Context, conn DBConn, src UpdateSource, key, value interface{}) error {
+	if _, ok := value.(schema.ValueUpdates); ok {
+		buf := new(strings.Builder)
+		params, err := tm.UpdateSql(buf, src, key, value)
+		if err != nil {
+			return err
+		}
+
+		if tm.options.Logger != nil {
+			tm.options.Logger(fmt.Sprintf("Update %s", tm.TableName()), buf.String(), params...)
+		}
+		res, err := conn.ExecContext(ctx, buf.String(), params...)
+		if err != nil {
+			return err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return nil
+		}
+	}
+
+	buf := new(strings.Builder)
+	params, err := tm.InsertUpdateSql(buf, src, key, value)
+	if err != nil {
+		return err
+	}
+
+	return tm.exec(ctx, conn, "Insert or update", buf.String(), params...)
+}
+
+// UpdateSql generates an UPDATE statement for the provided key and value and returns the params to pass to it.
+// In historical mode, the statement updates the version of the object starting at the source height.
+func (tm *ObjectIndexer) UpdateSql(w io.Writer, src UpdateSource, key, value interface{}) ([]interface{}, error) {
+	params, valueCols, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+	valueCols = append(valueCols, "_tx_id", "_event_id")
+	params = append(params, src.sourceParams()...)
+
+	sets := make([]string, 0, len(valueCols)+1)
+	for i, col := range valueCols {
+		sets = append(sets, fmt.Sprintf("%s = $%d", col, i+1))
+	}
+	if tm.retainDeletions() {
+		sets = append(sets, "_deleted = FALSE")
+	}
+
+	_, err = fmt.Fprintf(w, "UPDATE %q SET %s", tm.TableName(), strings.Join(sets, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	keyParams, err := tm.whereSqlAndParams(w, key, len(params))
+	if err != nil {
+		return nil, err
+	}
+	params = append(params, keyParams...)
+
+	if tm.options.Historical {
+		params = append(params, int64(src.Height))
+		_, err = fmt.Fprintf(w, " AND _valid_from = $%d", len(params))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return params, err
+}
+
+// InsertUpdateSql generates an INSERT ... ON CONFLICT statement for the provided key and value and returns the
+// params to pass to it. In historical mode, the statement writes the version of the object starting at the
+// source height.
+func (tm *ObjectIndexer) InsertUpdateSql(w io.Writer, src UpdateSource, key, value interface{}) ([]interface{}, error) {
+	keyParams, keyCols, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	valueParams, valueCols, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+	valueCols = append(valueCols, "_tx_id", "_event_id")
+	valueParams = append(valueParams, src.sourceParams()...)
+
+	var cols, conflictCols []string
+	var params []interface{}
+	cols = append(cols, keyCols...)
+	cols = append(cols, valueCols...)
+	params = append(params, keyParams...)
+	params = append(params, valueParams...)
+	conflictCols = append(conflictCols, keyCols...)
+	if tm.options.Historical {
+		cols = append(cols, "_valid_from")
+		params = append(params, int64(src.Height))
+		conflictCols = append(conflictCols, "_valid_from")
+	}
+
+	placeholders := make([]string, len(cols))
+	for i := range cols {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+
+	_, err = fmt.Fprintf(w, "INSERT INTO %q (%s) VALUES (%s) ON CONFLICT (%s)",
+		tm.TableName(), strings.Join(cols, ", "), strings.Join(placeholders, ", "), strings.Join(conflictCols, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]string, 0, len(valueCols)+1)
+	for _, col := range valueCols {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", col, col))
+	}
+	if tm.retainDeletions() {
+		sets = append(sets, "_deleted = FALSE")
+	}
+
+	_, err = fmt.Fprintf(w, " DO UPDATE SET %s;", strings.Join(sets, ", "))
+	return params, err
+}
+
+// exec logs and executes the SQL statement.
+func (tm *ObjectIndexer) exec(ctx context.Context, conn DBConn, msg, sqlStr string, params ...interface{}) error {
+	if tm.options.Logger != nil {
+		tm.options.Logger(fmt.Sprintf("%s %s", msg, tm.TableName()), sqlStr, params...)
+	}
+	_, err := conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
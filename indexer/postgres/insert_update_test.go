@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+var (
+	exampleTxID    int64 = 3
+	exampleEventID int64 = 7
+	exampleSource        = UpdateSource{Height: 10, TxID: &exampleTxID, EventID: &exampleEventID}
+)
+
+func ExampleObjectIndexer_InsertUpdateSql_vote() {
+	exampleInsertUpdate(testdata.VoteObject, Options{}, []interface{}{int64(1), []byte{0x1}}, "yes")
+	// Output:
+	// INSERT INTO "test_vote" ("proposal", "address", "vote", _tx_id, _event_id) VALUES ($1, $2, $3, $4, $5) ON CONFLICT ("proposal", "address") DO UPDATE SET "vote" = EXCLUDED."vote", _tx_id = EXCLUDED._tx_id, _event_id = EXCLUDED._event_id, _deleted = FALSE;
+	// [1 0x01 yes 3 7]
+}
+
+func ExampleObjectIndexer_InsertUpdateSql_vote_historical() {
+	exampleInsertUpdate(testdata.VoteObject, Options{Historical: true}, []interface{}{int64(1), []byte{0x1}}, "yes")
+	// Output:
+	// INSERT INTO "test_vote" ("proposal", "address", "vote", _tx_id, _event_id, _valid_from) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT ("proposal", "address", _valid_from) DO UPDATE SET "vote" = EXCLUDED."vote", _tx_id = EXCLUDED._tx_id, _event_id = EXCLUDED._event_id, _deleted = FALSE;
+	// [1 0x01 yes 3 7 10]
+}
+
+func ExampleObjectIndexer_InsertUpdateSql_singleton_partial() {
+	exampleInsertUpdate(testdata.SingletonObject, Options{}, nil, schema.MapValueUpdates{"foo": "abc"})
+	// Output:
+	// INSERT INTO "test_singleton" (_id, "foo", _tx_id, _event_id) VALUES ($1, $2, $3, $4) ON CONFLICT (_id) DO UPDATE SET "foo" = EXCLUDED."foo", _tx_id = EXCLUDED._tx_id, _event_id = EXCLUDED._event_id;
+	// [1 abc 3 7]
+}
+
+func ExampleObjectIndexer_DeleteSql_vote() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{AddressCodec: addressutil.HexAddressCodec{}})
+	params, err := tm.DeleteSql(os.Stdout, exampleSource, []interface{}{int64(1), []byte{0x1}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// UPDATE "test_vote" SET _deleted = TRUE, _tx_id = $1, _event_id = $2 WHERE "proposal" = $3 AND "address" = $4;
+	// [3 7 1 0x01]
+}
+
+func exampleInsertUpdate(objectType schema.ObjectType, options Options, key, value interface{}) {
+	options.AddressCodec = addressutil.HexAddressCodec{}
+	tm := NewObjectIndexer("test", objectType, options)
+	params, err := tm.InsertUpdateSql(os.Stdout, exampleSource, key, value)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+}
+
+func ExampleObjectIndexer_UpdateSql_singleton_partial_historical() {
+	tm := NewObjectIndexer("test", testdata.SingletonObject, Options{Historical: true})
+	params, err := tm.UpdateSql(os.Stdout, UpdateSource{Height: 10}, nil, schema.MapValueUpdates{"bar": int32(3), "foo": "abc"})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// UPDATE "test_singleton" SET "bar" = $1, "foo" = $2, _tx_id = $3, _event_id = $4 WHERE _id = $5 AND _valid_from = $6;
+	// [3 abc <nil> <nil> 1 10]
+}
@@ -57,3 +57,17 @@ func (m *ModuleIndexer) InitializeSchema(ctx context.Context, conn DBConn) error
 func (m *ModuleIndexer) ObjectIndexers() map[string]*ObjectIndexer {
 	return m.tables
 }
+
+// ApplyUpdate applies the object update from the given source to the object's table.
+func (m *ModuleIndexer) ApplyUpdate(ctx context.Context, conn DBConn, src UpdateSource, update schema.ObjectUpdate) error {
+	tm, ok := m.tables[update.TypeName]
+	if !ok {
+		return fmt.Errorf("object type %s not found in schema for module %s", update.TypeName, m.moduleName)
+	}
+
+	if update.Delete {
+		return tm.Delete(ctx, conn, src, update.Key)
+	}
+
+	return tm.InsertUpdate(ctx, conn, src, update.Key, update.Value)
+}
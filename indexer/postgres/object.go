@@ -42,3 +42,40 @@ func NewObjectIndexer(moduleName string, typ schema.ObjectType, options Options)
 func (tm *ObjectIndexer) TableName() string {
 	return fmt.Sprintf("%s_%s", tm.moduleName, tm.typ.Name)
 }
+
+// currentIndexName returns the name of the index on the current versions of objects in historical mode.
+func (tm *ObjectIndexer) currentIndexName() string {
+	return fmt.Sprintf("%s_current", tm.TableName())
+}
+
+// isSingleton returns true if the object type has no key fields.
+func (tm *ObjectIndexer) isSingleton() bool {
+	return len(tm.typ.KeyFields) == 0
+}
+
+// retainDeletions returns true if deleted rows are retained and flagged with the _deleted column.
+func (tm *ObjectIndexer) retainDeletions() bool {
+	return !tm.options.DisableRetainDeletions && tm.typ.RetainDeletions
+}
+
+// keyColumnNames returns the quoted names of the primary key columns, excluding any version column.
+func (tm *ObjectIndexer) keyColumnNames() ([]string, error) {
+	if tm.isSingleton() {
+		return []string{"_id"}, nil
+	}
+
+	return tm.columnNames(tm.typ.KeyFields)
+}
+
+// columnNames returns the quoted updatable column names for the fields.
+func (tm *ObjectIndexer) columnNames(fields []schema.Field) ([]string, error) {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		name, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
@@ -1,10 +1,20 @@
 package postgres
 
+import "cosmossdk.io/schema/addressutil"
+
 // Options are the options for module and object indexers.
 type Options struct {
 	// DisableRetainDeletions disables retain deletions functionality even on object types that have it set.
 	DisableRetainDeletions bool
 
+	// Historical enables historical (versioned) mode. In this mode, every object update is written as a new
+	// row tagged with the block height range in which it was valid instead of being updated in place.
+	Historical bool
+
+	// AddressCodec is the address codec used to convert AddressKind fields to strings. It must be non-nil
+	// if any object type has an AddressKind field.
+	AddressCodec addressutil.AddressCodec
+
 	// Logger is the logger for the indexer to use.
 	Logger SqlLogger
 }
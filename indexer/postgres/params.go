@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// bindKeyParams binds the key to the key columns.
+func (tm *ObjectIndexer) bindKeyParams(key interface{}) ([]interface{}, []string, error) {
+	n := len(tm.typ.KeyFields)
+	if n == 0 {
+		// singleton, set _id = 1
+		return []interface{}{1}, []string{"_id"}, nil
+	} else if n == 1 {
+		return tm.bindParams(tm.typ.KeyFields, []interface{}{key})
+	} else {
+		key, ok := key.([]interface{})
+		if !ok {
+			return nil, nil, errors.New("expected key to be a slice")
+		}
+
+		return tm.bindParams(tm.typ.KeyFields, key)
+	}
+}
+
+// bindValueParams binds the value to the value columns. If the value is a schema.ValueUpdates instance,
+// only the updated columns are returned.
+func (tm *ObjectIndexer) bindValueParams(value interface{}) (params []interface{}, valueCols []string, err error) {
+	n := len(tm.typ.ValueFields)
+	if n == 0 {
+		return nil, nil, nil
+	} else if valueUpdates, ok := value.(schema.ValueUpdates); ok {
+		var e error
+		var fields []schema.Field
+		var values []interface{}
+		err := valueUpdates.Iterate(func(name string, value interface{}) bool {
+			field, ok := tm.valueFields[name]
+			if !ok {
+				e = fmt.Errorf("unknown column %q", name)
+				return false
+			}
+			fields = append(fields, field)
+			values = append(values, value)
+			return true
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if e != nil {
+			return nil, nil, e
+		}
+
+		return tm.bindParams(fields, values)
+	} else if n == 1 {
+		return tm.bindParams(tm.typ.ValueFields, []interface{}{value})
+	} else {
+		values, ok := value.([]interface{})
+		if !ok {
+			return nil, nil, errors.New("expected values to be a slice")
+		}
+
+		return tm.bindParams(tm.typ.ValueFields, values)
+	}
+}
+
+// bindParams binds the values to the fields, returning the params and the quoted column names.
+func (tm *ObjectIndexer) bindParams(fields []schema.Field, values []interface{}) ([]interface{}, []string, error) {
+	if len(values) != len(fields) {
+		return nil, nil, fmt.Errorf("expected %d values, got %d", len(fields), len(values))
+	}
+
+	names := make([]string, 0, len(fields))
+	params := make([]interface{}, 0, len(fields))
+	for i, field := range fields {
+		param, err := tm.bindParam(field, values[i])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		name, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		names = append(names, name)
+		params = append(params, param)
+	}
+	return params, names, nil
+}
+
+// bindParam converts the value of the field to a value that can be passed as a SQL parameter.
+func (tm *ObjectIndexer) bindParam(field schema.Field, value interface{}) (param interface{}, err error) {
+	param = value
+	if value == nil {
+		if !field.Nullable {
+			return nil, fmt.Errorf("expected non-null value for field %q", field.Name)
+		}
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.TimeKind:
+		t, ok := value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Time value for field %q, got %T", field.Name, value)
+		}
+		param = t.UnixNano()
+	case schema.DurationKind:
+		d, ok := value.(time.Duration)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Duration value for field %q, got %T", field.Name, value)
+		}
+		param = int64(d)
+	case schema.AddressKind:
+		bz, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("expected []byte value for field %q, got %T", field.Name, value)
+		}
+		if tm.options.AddressCodec == nil {
+			return nil, fmt.Errorf("missing address codec for field %q", field.Name)
+		}
+		param, err = tm.options.AddressCodec.BytesToString(bz)
+	case schema.JSONKind:
+		// pass JSON as text so that drivers don't treat it as binary data
+		raw, ok := value.(json.RawMessage)
+		if !ok {
+			return nil, fmt.Errorf("expected json.RawMessage value for field %q, got %T", field.Name, value)
+		}
+		param = string(raw)
+	case schema.Uint64Kind:
+		// database/sql drivers don't accept uint64 values with the high bit set, so we pass them as strings
+		u, ok := value.(uint64)
+		if !ok {
+			return nil, fmt.Errorf("expected uint64 value for field %q, got %T", field.Name, value)
+		}
+		param = strconv.FormatUint(u, 10)
+	default:
+	}
+	return
+}
@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+)
+
+func TestBlockTxEvents(t *testing.T) {
+	connectionUrl := createTestDB(t)
+	ctx := context.Background()
+
+	listener, err := postgres.StartIndexer(ctx, nil, postgres.Config{
+		DatabaseURL: connectionUrl,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     testdata.ExampleSchema,
+	}))
+	commit(t, listener)
+
+	toJSON := func(s string) appdata.ToJSON {
+		return func() (json.RawMessage, error) {
+			return json.RawMessage(s), nil
+		}
+	}
+
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{
+		Height:     1,
+		HeaderJSON: toJSON(`{"chain_id":"test"}`),
+	}))
+	require.NoError(t, listener.OnTx(appdata.TxData{
+		TxIndex: 0,
+		Bytes: func() ([]byte, error) {
+			return []byte{0x1, 0x2}, nil
+		},
+		JSON: toJSON(`{"memo":"hello"}`),
+	}))
+	require.NoError(t, listener.OnEvent(appdata.EventData{
+		Events: []appdata.Event{
+			{
+				TxIndex: -1,
+				Type:    "begin_block",
+			},
+			{
+				TxIndex:    0,
+				MsgIndex:   0,
+				EventIndex: 1,
+				Type:       "transfer",
+				Attributes: func() ([]appdata.EventAttribute, error) {
+					return []appdata.EventAttribute{
+						{Key: "recipient", Value: "foo"},
+						{Key: "amount", Value: "10stake"},
+						{Key: "count", Value: `"42"`},
+						{Key: "success", Value: "true"},
+						{Key: "coin", Value: `{"denom":"stake","amount":"10"}`},
+					}, nil
+				},
+			},
+		},
+	}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates: []schema.ObjectUpdate{
+			{TypeName: "vote", Key: []interface{}{int64(1), []byte{0x1}}, Value: "yes"},
+		},
+	}))
+	commit(t, listener)
+
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	var chainID string
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT header->>'chain_id' FROM block WHERE number = 1`).Scan(&chainID))
+	require.Equal(t, "test", chainID)
+
+	var memo string
+	var txBytes []byte
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT data->>'memo', bytes FROM tx WHERE block_number = 1 AND index_in_block = 0`).Scan(&memo, &txBytes))
+	require.Equal(t, "hello", memo)
+	require.Equal(t, []byte{0x1, 0x2}, txBytes)
+
+	// begin block events are not linked to a transaction
+	var numUnlinked int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM event WHERE tx_id IS NULL`).Scan(&numUnlinked))
+	require.Equal(t, 1, numUnlinked)
+
+	var recipient string
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT a.value FROM event e
+		JOIN tx ON tx.id = e.tx_id
+		JOIN event_attribute a ON a.event_id = e.id
+		WHERE e.type = 'transfer' AND tx.index_in_block = 0 AND a.key = 'recipient'`).Scan(&recipient))
+	require.Equal(t, "foo", recipient)
+
+	// attribute values are also stored in the typed column matching their format
+	var count int64
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT value_numeric FROM event_attribute WHERE key = 'count'`).Scan(&count))
+	require.Equal(t, int64(42), count)
+
+	var success bool
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT value_bool FROM event_attribute WHERE key = 'success'`).Scan(&success))
+	require.True(t, success)
+
+	var denom string
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT value_json->>'denom' FROM event_attribute WHERE key = 'coin'`).Scan(&denom))
+	require.Equal(t, "stake", denom)
+
+	var numUntyped int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM event_attribute
+		WHERE value_numeric IS NULL AND value_bool IS NULL AND value_json IS NULL`).Scan(&numUntyped))
+	require.Equal(t, 2, numUntyped)
+
+	// object updates are linked to the tx and event most recently written before them
+	var eventType string
+	var txIndex int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT tx.index_in_block, e.type FROM "test_vote" v
+		JOIN tx ON tx.id = v._tx_id
+		JOIN event e ON e.id = v._event_id
+		WHERE v."proposal" = 1`).Scan(&txIndex, &eventType))
+	require.Equal(t, 0, txIndex)
+	require.Equal(t, "transfer", eventType)
+}
+
+func TestBlockSchema(t *testing.T) {
+	connectionUrl := createTestDB(t)
+	ctx := context.Background()
+
+	listener, err := postgres.StartIndexer(ctx, nil, postgres.Config{
+		DatabaseURL: connectionUrl,
+		Historical:  true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     testdata.ExampleSchema,
+	}))
+	commit(t, listener)
+
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	columnType := func(table, column string) string {
+		var dataType string
+		require.NoError(t, db.QueryRowContext(ctx, `SELECT data_type FROM information_schema.columns
+			WHERE table_name = $1 AND column_name = $2`, table, column).Scan(&dataType))
+		return dataType
+	}
+	require.Equal(t, "text", columnType("event_attribute", "value"))
+	require.Equal(t, "numeric", columnType("event_attribute", "value_numeric"))
+	require.Equal(t, "boolean", columnType("event_attribute", "value_bool"))
+	require.Equal(t, "jsonb", columnType("event_attribute", "value_json"))
+
+	// foreignKey returns the table and column referenced by a column, or empty strings if there is no
+	// foreign key on it
+	foreignKey := func(table, column string) (string, string) {
+		var refTable, refColumn string
+		err := db.QueryRowContext(ctx, `SELECT ccu.table_name, ccu.column_name
+			FROM information_schema.table_constraints tc
+			JOIN information_schema.key_column_usage kcu ON kcu.constraint_name = tc.constraint_name
+			JOIN information_schema.constraint_column_usage ccu ON ccu.constraint_name = tc.constraint_name
+			WHERE tc.constraint_type = 'FOREIGN KEY' AND tc.table_name = $1 AND kcu.column_name = $2`,
+			table, column).Scan(&refTable, &refColumn)
+		if err == sql.ErrNoRows {
+			return "", ""
+		}
+		require.NoError(t, err)
+		return refTable, refColumn
+	}
+
+	for _, fk := range []struct {
+		table, column, refTable, refColumn string
+	}{
+		{"tx", "block_number", "block", "number"},
+		{"event", "block_number", "block", "number"},
+		{"event", "tx_id", "tx", "id"},
+		{"event_attribute", "event_id", "event", "id"},
+		{"test_vote", "_tx_id", "tx", "id"},
+		{"test_vote", "_event_id", "event", "id"},
+		{"test_vote", "_valid_from", "block", "number"},
+		{"test_singleton", "_tx_id", "tx", "id"},
+		{"test_singleton", "_event_id", "event", "id"},
+	} {
+		refTable, refColumn := foreignKey(fk.table, fk.column)
+		require.Equal(t, fk.refTable, refTable, "%s.%s", fk.table, fk.column)
+		require.Equal(t, fk.refColumn, refColumn, "%s.%s", fk.table, fk.column)
+	}
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+)
+
+func TestHistorical(t *testing.T) {
+	connectionUrl := createTestDB(t)
+	ctx := context.Background()
+
+	listener, err := postgres.StartIndexer(ctx, nil, postgres.Config{
+		DatabaseURL: connectionUrl,
+		Historical:  true,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     testdata.ExampleSchema,
+	}))
+	commit(t, listener)
+
+	voteKey := []interface{}{int64(1), []byte{0x1}}
+	block := func(height uint64, updates ...schema.ObjectUpdate) {
+		require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: height}))
+		require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+			ModuleName: "test",
+			Updates:    updates,
+		}))
+		commit(t, listener)
+	}
+
+	block(1, schema.ObjectUpdate{TypeName: "vote", Key: voteKey, Value: "yes"})
+	// multiple updates in one block are collapsed into one version
+	block(2,
+		schema.ObjectUpdate{TypeName: "vote", Key: voteKey, Value: "abstain"},
+		schema.ObjectUpdate{TypeName: "vote", Key: voteKey, Value: "no"},
+	)
+	block(4, schema.ObjectUpdate{TypeName: "vote", Key: voteKey, Delete: true})
+
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	voteAt := func(height int64) (vote string, deleted, found bool) {
+		row := db.QueryRowContext(ctx, `SELECT "vote", _deleted FROM "test_vote"
+			WHERE "proposal" = 1 AND "address" = '0x01'
+			AND _valid_from <= $1 AND (_valid_to IS NULL OR _valid_to > $1)`, height)
+		err := row.Scan(&vote, &deleted)
+		if err == sql.ErrNoRows {
+			return "", false, false
+		}
+		require.NoError(t, err)
+		return vote, deleted, true
+	}
+
+	_, _, found := voteAt(0)
+	require.False(t, found)
+
+	vote, deleted, found := voteAt(1)
+	require.True(t, found)
+	require.False(t, deleted)
+	require.Equal(t, "yes", vote)
+
+	for _, height := range []int64{2, 3} {
+		vote, deleted, found = voteAt(height)
+		require.True(t, found)
+		require.False(t, deleted)
+		require.Equal(t, "no", vote)
+	}
+
+	// the vote object retains deletions so the last value is kept with the deleted flag set
+	vote, deleted, found = voteAt(4)
+	require.True(t, found)
+	require.True(t, deleted)
+	require.Equal(t, "no", vote)
+
+	var numVersions int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM "test_vote"`).Scan(&numVersions))
+	require.Equal(t, 3, numVersions)
+}
+
+func commit(t *testing.T, listener appdata.Listener) {
+	t.Helper()
+	cb, err := listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	if cb != nil {
+		require.NoError(t, cb())
+	}
+}
@@ -20,15 +20,19 @@ import (
 
 func TestInitSchema(t *testing.T) {
 	t.Run("default", func(t *testing.T) {
-		testInitSchema(t, false, "init_schema.txt")
+		testInitSchema(t, postgres.Config{}, "init_schema.txt")
 	})
 
 	t.Run("retain deletions disabled", func(t *testing.T) {
-		testInitSchema(t, true, "init_schema_no_retain_delete.txt")
+		testInitSchema(t, postgres.Config{DisableRetainDeletions: true}, "init_schema_no_retain_delete.txt")
+	})
+
+	t.Run("historical", func(t *testing.T) {
+		testInitSchema(t, postgres.Config{Historical: true}, "init_schema_historical.txt")
 	})
 }
 
-func testInitSchema(t *testing.T, disableRetainDeletions bool, goldenFileName string) {
+func testInitSchema(t *testing.T, config postgres.Config, goldenFileName string) {
 	t.Helper()
 	connectionUrl := createTestDB(t)
 
@@ -45,10 +49,8 @@ func testInitSchema(t *testing.T, disableRetainDeletions bool, goldenFileName st
 		_, err = fmt.Fprintln(buf)
 		require.NoError(t, err)
 	}
-	listener, err := postgres.StartIndexer(context.Background(), logger, postgres.Config{
-		DatabaseURL:            connectionUrl,
-		DisableRetainDeletions: disableRetainDeletions,
-	})
+	config.DatabaseURL = connectionUrl
+	listener, err := postgres.StartIndexer(context.Background(), logger, config)
 	require.NoError(t, err)
 
 	require.NotNil(t, listener.InitializeModuleData)
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"fmt"
+	"io"
+)
+
+// whereSqlAndParams writes a WHERE clause matching the key columns to the writer and returns the key params.
+// Param placeholders are numbered starting after paramOffset.
+func (tm *ObjectIndexer) whereSqlAndParams(writer io.Writer, key interface{}, paramOffset int) ([]interface{}, error) {
+	params, cols, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(writer, " WHERE ")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, col := range cols {
+		if i > 0 {
+			_, err = fmt.Fprintf(writer, " AND ")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = fmt.Fprintf(writer, "%s = $%d", col, paramOffset+i+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params, nil
+}
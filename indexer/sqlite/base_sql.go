@@ -0,0 +1,10 @@
+package sqlite
+
+// BaseSQL is the base SQL that is always included in the schema.
+const BaseSQL = `
+CREATE TABLE IF NOT EXISTS block
+(
+    number INTEGER NOT NULL PRIMARY KEY,
+    header TEXT    NULL CHECK (header IS NULL OR json_valid(header))
+);
+`
@@ -0,0 +1,142 @@
+package sqlite
+
+import (
+	"fmt"
+	"io"
+
+	"cosmossdk.io/schema"
+)
+
+// createColumnDefinition writes a column definition within a CREATE TABLE statement for the field.
+func (tm *ObjectIndexer) createColumnDefinition(writer io.Writer, field schema.Field) error {
+	_, err := fmt.Fprintf(writer, "%q ", field.Name)
+	if err != nil {
+		return err
+	}
+
+	simple := simpleColumnType(field.Kind)
+	if simple != "" {
+		_, err = fmt.Fprintf(writer, "%s", simple)
+		if err != nil {
+			return err
+		}
+
+		return writeNullability(writer, field.Nullable)
+	} else {
+		switch field.Kind {
+		case schema.EnumKind:
+			// SQLite has no enum types so we constrain the values of a TEXT column instead
+			_, err = fmt.Fprintf(writer, "TEXT")
+			if err != nil {
+				return err
+			}
+
+			err = writeNullabilityInline(writer, field.Nullable)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(writer, " CHECK (%q IN (%s)),\n\t", field.Name, enumValuesSql(field.EnumType))
+			return err
+		case schema.TimeKind:
+			// for time fields, we generate two columns:
+			// - one with nanoseconds precision for lossless storage, suffixed with _nanos
+			// - one as a TEXT ISO 8601 timestamp (millisecond precision) for ease of use, that is GENERATED
+			nanosColName := fmt.Sprintf("%s_nanos", field.Name)
+			_, err = fmt.Fprintf(writer, "TEXT GENERATED ALWAYS AS (strftime('%%Y-%%m-%%dT%%H:%%M:%%fZ', %q / 1000000000.0, 'unixepoch')) VIRTUAL,\n\t", nanosColName)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(writer, `%q INTEGER`, nanosColName)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unexpected kind: %v, this should have been handled earlier", field.Kind)
+		}
+
+		return writeNullability(writer, field.Nullable)
+	}
+}
+
+// writeNullability writes column nullability.
+func writeNullability(writer io.Writer, nullable bool) error {
+	err := writeNullabilityInline(writer, nullable)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, ",\n\t")
+	return err
+}
+
+// writeNullabilityInline writes column nullability without terminating the column definition.
+func writeNullabilityInline(writer io.Writer, nullable bool) error {
+	if nullable {
+		_, err := fmt.Fprintf(writer, " NULL")
+		return err
+	} else {
+		_, err := fmt.Fprintf(writer, " NOT NULL")
+		return err
+	}
+}
+
+// simpleColumnType returns the SQLite column type for the kind for simple types.
+// Numeric kinds which can't be losslessly represented as 64-bit signed integers or doubles
+// are stored as TEXT.
+func simpleColumnType(kind schema.Kind) string {
+	//nolint:goconst // adding constants for these sqlite type names would impede readability
+	switch kind {
+	case schema.StringKind:
+		return "TEXT"
+	case schema.BoolKind:
+		return "BOOLEAN"
+	case schema.BytesKind:
+		return "BLOB"
+	case schema.Int8Kind:
+		return "INTEGER"
+	case schema.Int16Kind:
+		return "INTEGER"
+	case schema.Int32Kind:
+		return "INTEGER"
+	case schema.Int64Kind:
+		return "INTEGER"
+	case schema.Uint8Kind:
+		return "INTEGER"
+	case schema.Uint16Kind:
+		return "INTEGER"
+	case schema.Uint32Kind:
+		return "INTEGER"
+	case schema.Uint64Kind:
+		return "TEXT"
+	case schema.IntegerStringKind:
+		return "TEXT"
+	case schema.DecimalStringKind:
+		return "TEXT"
+	case schema.Float32Kind:
+		return "REAL"
+	case schema.Float64Kind:
+		return "REAL"
+	case schema.JSONKind:
+		return "TEXT"
+	case schema.DurationKind:
+		return "INTEGER"
+	case schema.AddressKind:
+		return "TEXT"
+	default:
+		return ""
+	}
+}
+
+// updatableColumnName is the name of the insertable/updatable column name for the field.
+// This is the field name in most cases, except for time columns which are stored as nanos
+// and then converted to timestamp generated columns.
+func (tm *ObjectIndexer) updatableColumnName(field schema.Field) (name string, err error) {
+	name = field.Name
+	if field.Kind == schema.TimeKind {
+		name = fmt.Sprintf("%s_nanos", name)
+	}
+	name = fmt.Sprintf("%q", name)
+	return
+}
@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// CreateTable creates the table for the object type.
+func (tm *ObjectIndexer) CreateTable(ctx context.Context, conn DBConn) error {
+	buf := new(strings.Builder)
+	err := tm.CreateTableSql(buf)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger(fmt.Sprintf("Creating table %s", tm.TableName()), sqlStr)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr)
+	return err
+}
+
+// CreateTableSql generates a CREATE TABLE statement for the object type.
+func (tm *ObjectIndexer) CreateTableSql(writer io.Writer) error {
+	_, err := fmt.Fprintf(writer, "CREATE TABLE IF NOT EXISTS %q (\n\t", tm.TableName())
+	if err != nil {
+		return err
+	}
+	if len(tm.typ.KeyFields) == 0 {
+		_, err = fmt.Fprintf(writer, "_id INTEGER NOT NULL CHECK (_id = 1),\n\t")
+		if err != nil {
+			return err
+		}
+	} else {
+		for _, field := range tm.typ.KeyFields {
+			err = tm.createColumnDefinition(writer, field)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, field := range tm.typ.ValueFields {
+		err = tm.createColumnDefinition(writer, field)
+		if err != nil {
+			return err
+		}
+	}
+
+	// add _deleted column when we have RetainDeletions set and enabled
+	if tm.retainDeletions() {
+		_, err = fmt.Fprintf(writer, "_deleted BOOLEAN NOT NULL DEFAULT FALSE,\n\t")
+		if err != nil {
+			return err
+		}
+	}
+
+	pKeys, err := tm.keyColumnNames()
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "PRIMARY KEY (%s)", strings.Join(pKeys, ", "))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "\n);")
+	return err
+}
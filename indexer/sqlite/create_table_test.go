@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"os"
+
+	"cosmossdk.io/indexer/sqlite/internal/testdata"
+	"cosmossdk.io/schema"
+)
+
+func ExampleObjectIndexer_CreateTableSql_allKinds() {
+	exampleCreateTable(testdata.AllKindsObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_all_kinds" (
+	// 	"id" INTEGER NOT NULL,
+	// 	"ts" TEXT GENERATED ALWAYS AS (strftime('%Y-%m-%dT%H:%M:%fZ', "ts_nanos" / 1000000000.0, 'unixepoch')) VIRTUAL,
+	// 	"ts_nanos" INTEGER NOT NULL,
+	// 	"string" TEXT NOT NULL,
+	// 	"bytes" BLOB NOT NULL,
+	// 	"int8" INTEGER NOT NULL,
+	// 	"uint8" INTEGER NOT NULL,
+	// 	"int16" INTEGER NOT NULL,
+	// 	"uint16" INTEGER NOT NULL,
+	// 	"int32" INTEGER NOT NULL,
+	// 	"uint32" INTEGER NOT NULL,
+	// 	"int64" INTEGER NOT NULL,
+	// 	"uint64" TEXT NOT NULL,
+	// 	"integer" TEXT NOT NULL,
+	// 	"decimal" TEXT NOT NULL,
+	// 	"bool" BOOLEAN NOT NULL,
+	// 	"time" TEXT GENERATED ALWAYS AS (strftime('%Y-%m-%dT%H:%M:%fZ', "time_nanos" / 1000000000.0, 'unixepoch')) VIRTUAL,
+	// 	"time_nanos" INTEGER NOT NULL,
+	// 	"duration" INTEGER NOT NULL,
+	// 	"float32" REAL NOT NULL,
+	// 	"float64" REAL NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"enum" TEXT NOT NULL CHECK ("enum" IN ('a', 'b', 'c')),
+	// 	"json" TEXT NOT NULL,
+	// 	PRIMARY KEY ("id", "ts_nanos")
+	// );
+}
+
+func ExampleObjectIndexer_CreateTableSql_singleton() {
+	exampleCreateTable(testdata.SingletonObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_singleton" (
+	// 	_id INTEGER NOT NULL CHECK (_id = 1),
+	// 	"foo" TEXT NOT NULL,
+	// 	"bar" INTEGER NULL,
+	// 	"an_enum" TEXT NOT NULL CHECK ("an_enum" IN ('a', 'b', 'c')),
+	// 	PRIMARY KEY (_id)
+	// );
+}
+
+func ExampleObjectIndexer_CreateTableSql_vote() {
+	exampleCreateTable(testdata.VoteObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" INTEGER NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"vote" TEXT NOT NULL CHECK ("vote" IN ('yes', 'no', 'abstain')),
+	// 	_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+	// 	PRIMARY KEY ("proposal", "address")
+	// );
+}
+
+func ExampleObjectIndexer_CreateTableSql_vote_no_retain_delete() {
+	exampleCreateTableOpt(testdata.VoteObject, true)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" INTEGER NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"vote" TEXT NOT NULL CHECK ("vote" IN ('yes', 'no', 'abstain')),
+	// 	PRIMARY KEY ("proposal", "address")
+	// );
+}
+
+func exampleCreateTable(objectType schema.ObjectType) {
+	exampleCreateTableOpt(objectType, false)
+}
+
+func exampleCreateTableOpt(objectType schema.ObjectType, noRetainDelete bool) {
+	tm := NewObjectIndexer("test", objectType, Options{
+		Logger:                 func(msg, sql string, params ...interface{}) {},
+		DisableRetainDeletions: noRetainDelete,
+	})
+	err := tm.CreateTableSql(os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+}
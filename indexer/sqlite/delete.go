@@ -0,0 +1,41 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Delete deletes the row with the provided key or flags it as deleted if deletions are retained.
+func (tm *ObjectIndexer) Delete(ctx context.Context, conn DBConn, key interface{}) error {
+	buf := new(strings.Builder)
+	params, err := tm.DeleteSql(buf, key)
+	if err != nil {
+		return err
+	}
+
+	return tm.exec(ctx, conn, "Delete", buf.String(), params...)
+}
+
+// DeleteSql generates a DELETE statement, or an UPDATE statement setting _deleted if deletions are retained,
+// for the provided key and returns the params to pass to it.
+func (tm *ObjectIndexer) DeleteSql(w io.Writer, key interface{}) ([]interface{}, error) {
+	var err error
+	if tm.retainDeletions() {
+		_, err = fmt.Fprintf(w, "UPDATE %q SET _deleted = TRUE", tm.TableName())
+	} else {
+		_, err = fmt.Fprintf(w, "DELETE FROM %q", tm.TableName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := tm.whereSqlAndParams(w, key)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return params, err
+}
@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// CreateEnumType creates a table listing the values of the enum type. SQLite has no enum types, so columns
+// of enum types are TEXT columns constrained to the enum values and these tables exist for introspection.
+func (m *ModuleIndexer) CreateEnumType(ctx context.Context, conn DBConn, enum schema.EnumType) error {
+	buf := new(strings.Builder)
+	err := CreateEnumTypeSql(buf, m.moduleName, enum)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if m.options.Logger != nil {
+		m.options.Logger("Creating enum type", sqlStr)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr)
+	return err
+}
+
+// CreateEnumTypeSql generates the CREATE TABLE and INSERT statements for the enum definition.
+func CreateEnumTypeSql(writer io.Writer, moduleName string, enum schema.EnumType) error {
+	typeName := enumTypeName(moduleName, enum)
+	_, err := fmt.Fprintf(writer, "CREATE TABLE IF NOT EXISTS %q (value TEXT NOT NULL PRIMARY KEY);\n", typeName)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "INSERT OR IGNORE INTO %q (value) VALUES ", typeName)
+	if err != nil {
+		return err
+	}
+
+	for i, value := range enum.Values {
+		if i > 0 {
+			_, err = fmt.Fprintf(writer, ", ")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = fmt.Fprintf(writer, "('%s')", value)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(writer, ";")
+	return err
+}
+
+// enumValuesSql returns the comma separated quoted values of the enum type.
+func enumValuesSql(enum schema.EnumType) string {
+	values := make([]string, len(enum.Values))
+	for i, value := range enum.Values {
+		values[i] = fmt.Sprintf("'%s'", value)
+	}
+	return strings.Join(values, ", ")
+}
+
+// enumTypeName returns the name of the enum type scoped to the module.
+func enumTypeName(moduleName string, enum schema.EnumType) string {
+	return fmt.Sprintf("%s_%s", moduleName, enum.Name)
+}
@@ -0,0 +1,17 @@
+package sqlite
+
+import (
+	"os"
+
+	"cosmossdk.io/indexer/sqlite/internal/testdata"
+)
+
+func ExampleCreateEnumTypeSql() {
+	err := CreateEnumTypeSql(os.Stdout, "test", testdata.MyEnum)
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_my_enum" (value TEXT NOT NULL PRIMARY KEY);
+	// INSERT OR IGNORE INTO "test_my_enum" (value) VALUES ('a'), ('b'), ('c');
+}
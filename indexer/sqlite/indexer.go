@@ -0,0 +1,182 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+)
+
+type Config struct {
+	// DatabaseURL is the SQLite data source name to use to connect to the database, usually a file path.
+	DatabaseURL string `json:"database_url"`
+
+	// DatabaseDriver is the SQLite database/sql driver to use. This defaults to "sqlite".
+	DatabaseDriver string `json:"database_driver"`
+
+	// DisableRetainDeletions disables the retain deletions functionality even if it is set in an object type schema.
+	DisableRetainDeletions bool `json:"disable_retain_deletions"`
+
+	// AddressCodec is the address codec used to convert address fields to and from strings. It defaults to
+	// addressutil.HexAddressCodec.
+	AddressCodec addressutil.AddressCodec `json:"-"`
+}
+
+type SqlLogger = func(msg, sql string, params ...interface{})
+
+func init() {
+	indexer.Register("sqlite", initIndexer)
+}
+
+// initIndexer is the indexer.InitFunc for the SQLite indexer.
+func initIndexer(params indexer.InitParams) (indexer.InitResult, error) {
+	bz, err := json.Marshal(params.Config.Config)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	var config Config
+	err = json.Unmarshal(bz, &config)
+	if err != nil {
+		return indexer.InitResult{}, fmt.Errorf("invalid sqlite indexer config: %v", err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+	config.AddressCodec = params.AddressCodec
+
+	var logger SqlLogger
+	if params.Logger != nil {
+		logger = func(msg, sql string, sqlParams ...interface{}) {
+			params.Logger.Debug(msg, "sql", sql, "params", sqlParams)
+		}
+	}
+
+	ctx := params.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return StartIndexer(ctx, logger, config)
+}
+
+// StartIndexer opens the database, creates the base schema and returns the indexer's listener and a view
+// of the indexed data.
+func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (indexer.InitResult, error) {
+	if config.DatabaseURL == "" {
+		return indexer.InitResult{}, errors.New("missing database URL")
+	}
+
+	driver := config.DatabaseDriver
+	if driver == "" {
+		driver = "sqlite"
+	}
+
+	db, err := sql.Open(driver, config.DatabaseURL)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	// commit base schema
+	_, err = tx.Exec(BaseSQL)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	addressCodec := config.AddressCodec
+	if addressCodec == nil {
+		addressCodec = addressutil.HexAddressCodec{}
+	}
+
+	idx := &indexerImpl{
+		ctx:     ctx,
+		db:      db,
+		tx:      tx,
+		modules: map[string]*ModuleIndexer{},
+		opts: Options{
+			DisableRetainDeletions: config.DisableRetainDeletions,
+			AddressCodec:           addressCodec,
+			Logger:                 logger,
+		},
+	}
+
+	return indexer.InitResult{
+		Listener: idx.listener(),
+		View:     idx,
+	}, nil
+}
+
+// indexerImpl holds the state of a running SQLite indexer.
+type indexerImpl struct {
+	ctx     context.Context
+	db      *sql.DB
+	tx      *sql.Tx
+	modules map[string]*ModuleIndexer
+	opts    Options
+}
+
+func (i *indexerImpl) listener() appdata.Listener {
+	return appdata.Listener{
+		InitializeModuleData: func(data appdata.ModuleInitializationData) error {
+			moduleName := data.ModuleName
+			modSchema := data.Schema
+			_, ok := i.modules[moduleName]
+			if ok {
+				return fmt.Errorf("module %s already initialized", moduleName)
+			}
+
+			mm := NewModuleIndexer(moduleName, modSchema, i.opts)
+			i.modules[moduleName] = mm
+
+			return mm.InitializeSchema(i.ctx, i.tx)
+		},
+		StartBlock: func(data appdata.StartBlockData) error {
+			var header interface{}
+			if data.HeaderJSON != nil {
+				headerJSON, err := data.HeaderJSON()
+				if err != nil {
+					return err
+				}
+				header = string(headerJSON)
+			}
+
+			sqlStr := "INSERT INTO block (number, header) VALUES (?, ?) ON CONFLICT (number) DO UPDATE SET header = excluded.header;"
+			params := []interface{}{int64(data.Height), header}
+			if i.opts.Logger != nil {
+				i.opts.Logger("Insert block", sqlStr, params...)
+			}
+			_, err := i.tx.ExecContext(i.ctx, sqlStr, params...)
+			return err
+		},
+		OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+			mm, ok := i.modules[data.ModuleName]
+			if !ok {
+				return fmt.Errorf("module %s not initialized", data.ModuleName)
+			}
+
+			for _, update := range data.Updates {
+				err := mm.ApplyUpdate(i.ctx, i.tx, update)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Commit: func(data appdata.CommitData) (completionCallback func() error, err error) {
+			err = i.tx.Commit()
+			if err != nil {
+				return nil, err
+			}
+
+			i.tx, err = i.db.BeginTx(i.ctx, nil)
+			return nil, err
+		},
+	}
+}
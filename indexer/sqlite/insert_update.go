@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// InsertUpdate inserts or updates the row with the provided key and value.
+func (tm *ObjectIndexer) InsertUpdate(ctx context.Context, conn DBConn, key, value interface{}) error {
+	// NOT NULL constraints are checked before ON CONFLICT clauses, so partial value updates
+	// need to update existing rows with an UPDATE statement
+	if _, ok := value.(schema.ValueUpdates); ok {
+		buf := new(strings.Builder)
+		params, err := tm.UpdateSql(buf, key, value)
+		if err != nil {
+			return err
+		}
+
+		if tm.options.Logger != nil {
+			tm.options.Logger(fmt.Sprintf("Update %s", tm.TableName()), buf.String(), params...)
+		}
+		res, err := conn.ExecContext(ctx, buf.String(), params...)
+		if err != nil {
+			return err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return nil
+		}
+	}
+
+	buf := new(strings.Builder)
+	params, err := tm.InsertUpdateSql(buf, key, value)
+	if err != nil {
+		return err
+	}
+
+	return tm.exec(ctx, conn, "Insert or update", buf.String(), params...)
+}
+
+// UpdateSql generates an UPDATE statement for the provided key and value and returns the params to pass to it.
+func (tm *ObjectIndexer) UpdateSql(w io.Writer, key, value interface{}) ([]interface{}, error) {
+	params, valueCols, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]string, 0, len(valueCols)+1)
+	for _, col := range valueCols {
+		sets = append(sets, fmt.Sprintf("%s = ?", col))
+	}
+	if tm.retainDeletions() {
+		sets = append(sets, "_deleted = FALSE")
+	}
+	if len(sets) == 0 {
+		// there is nothing to update, so we only check if the row exists
+		keyCols, err := tm.keyColumnNames()
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, fmt.Sprintf("%s = %s", keyCols[0], keyCols[0]))
+	}
+
+	_, err = fmt.Fprintf(w, "UPDATE %q SET %s", tm.TableName(), strings.Join(sets, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	keyParams, err := tm.whereSqlAndParams(w, key)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return append(params, keyParams...), err
+}
+
+// InsertUpdateSql generates an INSERT ... ON CONFLICT statement for the provided key and value and returns the
+// params to pass to it.
+func (tm *ObjectIndexer) InsertUpdateSql(w io.Writer, key, value interface{}) ([]interface{}, error) {
+	keyParams, keyCols, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	valueParams, valueCols, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var cols []string
+	var params []interface{}
+	cols = append(cols, keyCols...)
+	cols = append(cols, valueCols...)
+	params = append(params, keyParams...)
+	params = append(params, valueParams...)
+
+	placeholders := make([]string, len(cols))
+	for i := range cols {
+		placeholders[i] = "?"
+	}
+
+	_, err = fmt.Fprintf(w, "INSERT INTO %q (%s) VALUES (%s) ON CONFLICT (%s)",
+		tm.TableName(), strings.Join(cols, ", "), strings.Join(placeholders, ", "), strings.Join(keyCols, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]string, 0, len(valueCols)+1)
+	for _, col := range valueCols {
+		sets = append(sets, fmt.Sprintf("%s = excluded.%s", col, col))
+	}
+	if tm.retainDeletions() {
+		sets = append(sets, "_deleted = FALSE")
+	}
+
+	if len(sets) == 0 {
+		_, err = fmt.Fprintf(w, " DO NOTHING;")
+	} else {
+		_, err = fmt.Fprintf(w, " DO UPDATE SET %s;", strings.Join(sets, ", "))
+	}
+	return params, err
+}
+
+// exec logs and executes the SQL statement.
+func (tm *ObjectIndexer) exec(ctx context.Context, conn DBConn, msg, sqlStr string, params ...interface{}) error {
+	if tm.options.Logger != nil {
+		tm.options.Logger(fmt.Sprintf("%s %s", msg, tm.TableName()), sqlStr, params...)
+	}
+	_, err := conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
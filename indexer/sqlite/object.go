@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"fmt"
+
+	"cosmossdk.io/schema"
+)
+
+// ObjectIndexer is a helper struct that generates SQL for a given object type.
+type ObjectIndexer struct {
+	moduleName  string
+	typ         schema.ObjectType
+	valueFields map[string]schema.Field
+	allFields   map[string]schema.Field
+	options     Options
+}
+
+// NewObjectIndexer creates a new ObjectIndexer for the given object type.
+func NewObjectIndexer(moduleName string, typ schema.ObjectType, options Options) *ObjectIndexer {
+	allFields := make(map[string]schema.Field)
+	valueFields := make(map[string]schema.Field)
+
+	for _, field := range typ.KeyFields {
+		allFields[field.Name] = field
+	}
+
+	for _, field := range typ.ValueFields {
+		valueFields[field.Name] = field
+		allFields[field.Name] = field
+	}
+
+	return &ObjectIndexer{
+		moduleName:  moduleName,
+		typ:         typ,
+		allFields:   allFields,
+		valueFields: valueFields,
+		options:     options,
+	}
+}
+
+// TableName returns the name of the table for the object type scoped to its module.
+func (tm *ObjectIndexer) TableName() string {
+	return fmt.Sprintf("%s_%s", tm.moduleName, tm.typ.Name)
+}
+
+// retainDeletions returns true if deleted rows are retained and flagged with the _deleted column.
+func (tm *ObjectIndexer) retainDeletions() bool {
+	return !tm.options.DisableRetainDeletions && tm.typ.RetainDeletions
+}
+
+// keyColumnNames returns the quoted names of the primary key columns.
+func (tm *ObjectIndexer) keyColumnNames() ([]string, error) {
+	if len(tm.typ.KeyFields) == 0 {
+		return []string{"_id"}, nil
+	}
+
+	return tm.columnNames(tm.typ.KeyFields)
+}
+
+// columnNames returns the quoted updatable column names for the fields.
+func (tm *ObjectIndexer) columnNames(fields []schema.Field) ([]string, error) {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		name, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
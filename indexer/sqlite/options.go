@@ -0,0 +1,16 @@
+package sqlite
+
+import "cosmossdk.io/schema/addressutil"
+
+// Options are the options for module and object indexers.
+type Options struct {
+	// DisableRetainDeletions disables retain deletions functionality even on object types that have it set.
+	DisableRetainDeletions bool
+
+	// AddressCodec is the address codec used to convert AddressKind fields to and from strings. It must be
+	// non-nil if any object type has an AddressKind field.
+	AddressCodec addressutil.AddressCodec
+
+	// Logger is the logger for the indexer to use.
+	Logger SqlLogger
+}
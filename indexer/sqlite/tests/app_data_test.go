@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/sqlite"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/indexer"
+	schematesting "cosmossdk.io/schema/testing"
+	"cosmossdk.io/schema/testing/appdatasim"
+	"cosmossdk.io/schema/testing/statesim"
+)
+
+func TestAppDataSim(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		testAppDataSim(t, false)
+	})
+
+	t.Run("retain deletions disabled", func(t *testing.T) {
+		testAppDataSim(t, true)
+	})
+}
+
+func testAppDataSim(t *testing.T, disableRetainDeletions bool) {
+	t.Helper()
+	res, err := sqlite.StartIndexer(context.Background(), nil, sqlite.Config{
+		DatabaseURL:            createTestDB(t),
+		DisableRetainDeletions: disableRetainDeletions,
+		AddressCodec:           addressutil.HexAddressCodec{},
+	})
+	require.NoError(t, err)
+
+	sim, err := appdatasim.NewSimulator(appdatasim.Options{
+		AppSchema: schematesting.ExampleAppSchema,
+		Listener:  res.Listener,
+		StateSimOptions: statesim.Options{
+			CanRetainDeletions: !disableRetainDeletions,
+		},
+	})
+	require.NoError(t, err)
+
+	blockDataGen := sim.BlockDataGenN(50, 100)
+	for i := 0; i < 10; i++ {
+		data := blockDataGen.Example(i + 1)
+		require.NoError(t, sim.ProcessBlockData(data))
+		require.Empty(t, appdatasim.DiffAppData(sim, res.View))
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	// the sqlite indexer registers itself on import so registering it again panics
+	require.Panics(t, func() {
+		indexer.Register("sqlite", func(params indexer.InitParams) (indexer.InitResult, error) {
+			return indexer.InitResult{}, nil
+		})
+	})
+}
@@ -0,0 +1,41 @@
+module cosmossdk.io/indexer/sqlite/testing
+
+go 1.23
+
+require (
+	cosmossdk.io/indexer/sqlite v0.0.0-00010101000000-000000000000
+	cosmossdk.io/schema v0.1.1
+	cosmossdk.io/schema/testing v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.9.0
+	gotest.tools/v3 v3.5.1
+	modernc.org/sqlite v1.29.5
+)
+
+require (
+	github.com/cockroachdb/apd/v3 v3.2.1 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/dustin/go-humanize v1.0.1 // indirect
+	github.com/google/go-cmp v0.5.9 // indirect
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/hashicorp/golang-lru/v2 v2.0.7 // indirect
+	github.com/mattn/go-isatty v0.0.16 // indirect
+	github.com/ncruces/go-strftime v0.1.9 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/remyoudompheng/bigfft v0.0.0-20230129092748-24d4a6f8daec // indirect
+	github.com/tidwall/btree v1.7.0 // indirect
+	golang.org/x/sys v0.16.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+	modernc.org/gc/v3 v3.0.0-20240107210532-573471604cb6 // indirect
+	modernc.org/libc v1.41.0 // indirect
+	modernc.org/mathutil v1.6.0 // indirect
+	modernc.org/memory v1.7.2 // indirect
+	modernc.org/strutil v1.2.0 // indirect
+	modernc.org/token v1.1.0 // indirect
+	pgregory.net/rapid v1.1.0 // indirect
+)
+
+replace cosmossdk.io/indexer/sqlite => ../.
+
+replace cosmossdk.io/schema => ../../../schema
+
+replace cosmossdk.io/schema/testing => ../../../schema/testing
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+	_ "modernc.org/sqlite" // this is where we get our sqlite database driver from
+
+	"cosmossdk.io/indexer/sqlite"
+	"cosmossdk.io/indexer/sqlite/internal/testdata"
+	"cosmossdk.io/schema/appdata"
+)
+
+func TestInitSchema(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		testInitSchema(t, false, "init_schema.txt")
+	})
+
+	t.Run("retain deletions disabled", func(t *testing.T) {
+		testInitSchema(t, true, "init_schema_no_retain_delete.txt")
+	})
+}
+
+func testInitSchema(t *testing.T, disableRetainDeletions bool, goldenFileName string) {
+	t.Helper()
+
+	buf := &strings.Builder{}
+	logger := func(msg, sql string, params ...interface{}) {
+		_, err := fmt.Fprintln(buf, msg)
+		require.NoError(t, err)
+		_, err = fmt.Fprintln(buf, sql)
+		require.NoError(t, err)
+		if len(params) != 0 {
+			_, err = fmt.Fprintln(buf, "Params:", params)
+			require.NoError(t, err)
+		}
+		_, err = fmt.Fprintln(buf)
+		require.NoError(t, err)
+	}
+	res, err := sqlite.StartIndexer(context.Background(), logger, sqlite.Config{
+		DatabaseURL:            createTestDB(t),
+		DisableRetainDeletions: disableRetainDeletions,
+	})
+	require.NoError(t, err)
+	listener := res.Listener
+
+	require.NotNil(t, listener.InitializeModuleData)
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     testdata.ExampleSchema,
+	}))
+
+	require.NotNil(t, listener.Commit)
+	cb, err := listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	if cb != nil {
+		require.NoError(t, cb())
+	}
+
+	golden.Assert(t, buf.String(), goldenFileName)
+}
+
+func createTestDB(t *testing.T) (databaseURL string) {
+	t.Helper()
+	return filepath.Join(t.TempDir(), "indexer.db")
+}
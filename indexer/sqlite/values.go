@@ -0,0 +1,135 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// readValue converts a column value read from the database back to the value type expected for the field's kind.
+// It is the inverse of bindParam.
+func (tm *ObjectIndexer) readValue(field schema.Field, value interface{}) (interface{}, error) {
+	if value == nil {
+		if !field.Nullable {
+			return nil, fmt.Errorf("unexpected null value for field %q", field.Name)
+		}
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.StringKind, schema.EnumKind, schema.IntegerStringKind, schema.DecimalStringKind:
+		return readString(value)
+	case schema.BytesKind:
+		switch value := value.(type) {
+		case []byte:
+			return append([]byte{}, value...), nil
+		case string:
+			return []byte(value), nil
+		default:
+			return nil, fmt.Errorf("unexpected type %T for bytes", value)
+		}
+	case schema.BoolKind:
+		return readBool(value)
+	case schema.Int8Kind:
+		i, err := readInt64(value)
+		return int8(i), err
+	case schema.Int16Kind:
+		i, err := readInt64(value)
+		return int16(i), err
+	case schema.Int32Kind:
+		i, err := readInt64(value)
+		return int32(i), err
+	case schema.Int64Kind:
+		return readInt64(value)
+	case schema.Uint8Kind:
+		i, err := readInt64(value)
+		return uint8(i), err
+	case schema.Uint16Kind:
+		i, err := readInt64(value)
+		return uint16(i), err
+	case schema.Uint32Kind:
+		i, err := readInt64(value)
+		return uint32(i), err
+	case schema.Uint64Kind:
+		s, err := readString(value)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.ParseUint(s, 10, 64)
+	case schema.Float32Kind:
+		f, err := readFloat64(value)
+		return float32(f), err
+	case schema.Float64Kind:
+		return readFloat64(value)
+	case schema.TimeKind:
+		nanos, err := readInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		return time.Unix(0, nanos), nil
+	case schema.DurationKind:
+		nanos, err := readInt64(value)
+		return time.Duration(nanos), err
+	case schema.AddressKind:
+		s, err := readString(value)
+		if err != nil {
+			return nil, err
+		}
+		if tm.options.AddressCodec == nil {
+			return nil, fmt.Errorf("missing address codec for field %q", field.Name)
+		}
+		return tm.options.AddressCodec.StringToBytes(s)
+	case schema.JSONKind:
+		s, err := readString(value)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(s), nil
+	default:
+		return nil, fmt.Errorf("unexpected kind: %v", field.Kind)
+	}
+}
+
+func readString(value interface{}) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case []byte:
+		return string(value), nil
+	default:
+		return "", fmt.Errorf("unexpected type %T for string", value)
+	}
+}
+
+func readInt64(value interface{}) (int64, error) {
+	switch value := value.(type) {
+	case int64:
+		return value, nil
+	case bool:
+		if value {
+			return 1, nil
+		}
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T for integer", value)
+	}
+}
+
+func readFloat64(value interface{}) (float64, error) {
+	switch value := value.(type) {
+	case float64:
+		return value, nil
+	case int64:
+		return float64(value), nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T for real", value)
+	}
+}
+
+func readBool(value interface{}) (bool, error) {
+	i, err := readInt64(value)
+	return i != 0, err
+}
@@ -0,0 +1,310 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/view"
+)
+
+// BlockNum implements view.AppData.
+func (i *indexerImpl) BlockNum() (uint64, error) {
+	var blockNum int64
+	err := i.tx.QueryRowContext(i.ctx, "SELECT COALESCE(MAX(number), 0) FROM block").Scan(&blockNum)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(blockNum), nil
+}
+
+// AppState implements view.AppData.
+func (i *indexerImpl) AppState() view.AppState {
+	return i
+}
+
+// GetModule implements view.AppState.
+func (i *indexerImpl) GetModule(moduleName string) (view.ModuleState, error) {
+	mm, ok := i.modules[moduleName]
+	if !ok {
+		return nil, nil
+	}
+	return &moduleView{mm, i}, nil
+}
+
+// Modules implements view.AppState.
+func (i *indexerImpl) Modules(f func(modState view.ModuleState, err error) bool) {
+	names := make([]string, 0, len(i.modules))
+	for name := range i.modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !f(&moduleView{i.modules[name], i}, nil) {
+			return
+		}
+	}
+}
+
+// NumModules implements view.AppState.
+func (i *indexerImpl) NumModules() (int, error) {
+	return len(i.modules), nil
+}
+
+// moduleView implements view.ModuleState over the indexed tables of a module.
+type moduleView struct {
+	*ModuleIndexer
+	idx *indexerImpl
+}
+
+// ModuleName implements view.ModuleState.
+func (m *moduleView) ModuleName() string {
+	return m.moduleName
+}
+
+// ModuleSchema implements view.ModuleState.
+func (m *moduleView) ModuleSchema() schema.ModuleSchema {
+	return m.schema
+}
+
+// GetObjectCollection implements view.ModuleState.
+func (m *moduleView) GetObjectCollection(objectType string) (view.ObjectCollection, error) {
+	tm, ok := m.tables[objectType]
+	if !ok {
+		return nil, nil
+	}
+	return &objectView{tm, m.idx}, nil
+}
+
+// ObjectCollections implements view.ModuleState.
+func (m *moduleView) ObjectCollections(f func(value view.ObjectCollection, err error) bool) {
+	m.schema.ObjectTypes(func(typ schema.ObjectType) bool {
+		tm, ok := m.tables[typ.Name]
+		if !ok {
+			return f(nil, fmt.Errorf("missing table for object type %s", typ.Name))
+		}
+		return f(&objectView{tm, m.idx}, nil)
+	})
+}
+
+// NumObjectCollections implements view.ModuleState.
+func (m *moduleView) NumObjectCollections() (int, error) {
+	return len(m.tables), nil
+}
+
+// objectView implements view.ObjectCollection over the table of an object type.
+type objectView struct {
+	*ObjectIndexer
+	idx *indexerImpl
+}
+
+// ObjectType implements view.ObjectCollection.
+func (o *objectView) ObjectType() schema.ObjectType {
+	return o.typ
+}
+
+// GetObject implements view.ObjectCollection.
+func (o *objectView) GetObject(key interface{}) (update schema.ObjectUpdate, found bool, err error) {
+	return o.ObjectIndexer.GetObject(o.idx.ctx, o.idx.tx, key)
+}
+
+// AllState implements view.ObjectCollection.
+func (o *objectView) AllState(f func(schema.ObjectUpdate, error) bool) {
+	o.ObjectIndexer.AllState(o.idx.ctx, o.idx.tx, f)
+}
+
+// Len implements view.ObjectCollection.
+func (o *objectView) Len() (int, error) {
+	return o.ObjectIndexer.Len(o.idx.ctx, o.idx.tx)
+}
+
+// GetObject reads the object with the provided key. Deletions that are retained are returned as
+// ObjectUpdate's with Delete set to true.
+func (tm *ObjectIndexer) GetObject(ctx context.Context, conn DBConn, key interface{}) (update schema.ObjectUpdate, found bool, err error) {
+	valueCols, err := tm.selectValueColumnNames()
+	if err != nil {
+		return schema.ObjectUpdate{}, false, err
+	}
+
+	buf := new(strings.Builder)
+	_, err = fmt.Fprintf(buf, "SELECT %s FROM %q", strings.Join(valueCols, ", "), tm.TableName())
+	if err != nil {
+		return schema.ObjectUpdate{}, false, err
+	}
+
+	params, err := tm.whereSqlAndParams(buf, key)
+	if err != nil {
+		return schema.ObjectUpdate{}, false, err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger(fmt.Sprintf("Select %s", tm.TableName()), sqlStr, params...)
+	}
+
+	dest := scanDest(len(valueCols))
+	err = conn.QueryRowContext(ctx, sqlStr, params...).Scan(dest...)
+	if err == sql.ErrNoRows {
+		return schema.ObjectUpdate{}, false, nil
+	}
+	if err != nil {
+		return schema.ObjectUpdate{}, false, err
+	}
+
+	update, err = tm.readObject(key, dest)
+	if err != nil {
+		return schema.ObjectUpdate{}, false, err
+	}
+
+	return update, true, nil
+}
+
+// AllState iterates over all the rows in the object's table.
+func (tm *ObjectIndexer) AllState(ctx context.Context, conn DBConn, f func(schema.ObjectUpdate, error) bool) {
+	keyCols, err := tm.keyColumnNames()
+	if err != nil {
+		f(schema.ObjectUpdate{}, err)
+		return
+	}
+
+	valueCols, err := tm.selectValueColumnNames()
+	if err != nil {
+		f(schema.ObjectUpdate{}, err)
+		return
+	}
+
+	cols := append(append([]string{}, keyCols...), valueCols...)
+	sqlStr := fmt.Sprintf("SELECT %s FROM %q;", strings.Join(cols, ", "), tm.TableName())
+	if tm.options.Logger != nil {
+		tm.options.Logger(fmt.Sprintf("Select %s", tm.TableName()), sqlStr)
+	}
+
+	rows, err := conn.QueryContext(ctx, sqlStr)
+	if err != nil {
+		f(schema.ObjectUpdate{}, err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		dest := scanDest(len(cols))
+		err = rows.Scan(dest...)
+		if err != nil {
+			f(schema.ObjectUpdate{}, err)
+			return
+		}
+
+		key, err := tm.readKey(dest[:len(keyCols)])
+		if err != nil {
+			f(schema.ObjectUpdate{}, err)
+			return
+		}
+
+		update, err := tm.readObject(key, dest[len(keyCols):])
+		if !f(update, err) {
+			return
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		f(schema.ObjectUpdate{}, err)
+	}
+}
+
+// Len returns the number of rows in the object's table.
+func (tm *ObjectIndexer) Len(ctx context.Context, conn DBConn) (int, error) {
+	var n int
+	err := conn.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %q;", tm.TableName())).Scan(&n)
+	return n, err
+}
+
+// selectValueColumnNames returns the names of the value columns to select, including the _deleted column
+// if deletions are retained. At least one column is always returned so that the existence of
+// rows without value columns can be checked.
+func (tm *ObjectIndexer) selectValueColumnNames() ([]string, error) {
+	cols, err := tm.columnNames(tm.typ.ValueFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if tm.retainDeletions() {
+		cols = append(cols, "_deleted")
+	} else {
+		cols = append(cols, "FALSE")
+	}
+	return cols, nil
+}
+
+// readKey converts the scanned key columns to a key conforming to the object type's key fields.
+func (tm *ObjectIndexer) readKey(dest []interface{}) (interface{}, error) {
+	values, err := tm.readValues(tm.typ.KeyFields, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(tm.typ.KeyFields) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	default:
+		return values, nil
+	}
+}
+
+// readObject converts the scanned value columns, followed by the deleted flag, to an object update.
+func (tm *ObjectIndexer) readObject(key interface{}, dest []interface{}) (schema.ObjectUpdate, error) {
+	n := len(tm.typ.ValueFields)
+	values, err := tm.readValues(tm.typ.ValueFields, dest[:n])
+	if err != nil {
+		return schema.ObjectUpdate{}, err
+	}
+
+	deleted, err := readBool(*(dest[n].(*interface{})))
+	if err != nil {
+		return schema.ObjectUpdate{}, err
+	}
+
+	update := schema.ObjectUpdate{
+		TypeName: tm.typ.Name,
+		Key:      key,
+		Delete:   deleted,
+	}
+
+	switch n {
+	case 0:
+	case 1:
+		update.Value = values[0]
+	default:
+		update.Value = values
+	}
+
+	return update, nil
+}
+
+// readValues converts the scanned columns to values for the fields.
+func (tm *ObjectIndexer) readValues(fields []schema.Field, dest []interface{}) ([]interface{}, error) {
+	values := make([]interface{}, len(fields))
+	for j, field := range fields {
+		value, err := tm.readValue(field, *(dest[j].(*interface{})))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read field %q: %v", field.Name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+		values[j] = value
+	}
+	return values, nil
+}
+
+// scanDest returns n scan destinations which accept any column type.
+func scanDest(n int) []interface{} {
+	dest := make([]interface{}, n)
+	for j := range dest {
+		dest[j] = new(interface{})
+	}
+	return dest
+}
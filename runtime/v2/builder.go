@@ -32,6 +32,9 @@ type AppBuilder[T transaction.Tx] struct {
 	branch      func(state store.ReaderMap) store.WriterMap
 	txValidator func(ctx context.Context, tx T) error
 	postTxExec  func(ctx context.Context, tx T, success bool) error
+	stfOptions  []stf.Option
+
+	traceKeyDecoders map[string]store.KeyDecoder
 }
 
 // DefaultGenesis returns a default genesis from the registered AppModule's.
@@ -118,6 +121,7 @@ func (a *AppBuilder[T]) Build(opts ...AppBuilderOption[T]) (*App[T], error) {
 		valUpdate,
 		a.postTxExec,
 		a.branch,
+		a.stfOptions...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create STF: %w", err)
@@ -160,6 +164,7 @@ func (a *AppBuilder[T]) Build(opts ...AppBuilderOption[T]) (*App[T], error) {
 		ValidateTxGasLimit: a.app.config.GasConfig.ValidateTxGasLimit,
 		QueryGasLimit:      a.app.config.GasConfig.QueryGasLimit,
 		SimulationGasLimit: a.app.config.GasConfig.SimulationGasLimit,
+		TraceKeyDecoders:   a.traceKeyDecoders,
 		InitGenesis: func(ctx context.Context, src io.Reader, txHandler func(json.RawMessage) error) error {
 			// this implementation assumes that the state is a JSON object
 			bz, err := io.ReadAll(src)
@@ -230,3 +235,19 @@ func AppBuilderWithPostTxExec[T transaction.Tx](
 		a.postTxExec = postTxExec
 	}
 }
+
+// AppBuilderWithSTFOptions sets the options used to configure the state transition function,
+// such as enabling the parallel execution of txs.
+func AppBuilderWithSTFOptions[T transaction.Tx](opts ...stf.Option) AppBuilderOption[T] {
+	return func(a *AppBuilder[T]) {
+		a.stfOptions = append(a.stfOptions, opts...)
+	}
+}
+
+// AppBuilderWithTraceKeyDecoders sets the decoders, indexed by store key, used to decode the keys
+// of the store operations traced during simulation. A collections.Schema can be used as decoder.
+func AppBuilderWithTraceKeyDecoders[T transaction.Tx](decoders map[string]store.KeyDecoder) AppBuilderOption[T] {
+	return func(a *AppBuilder[T]) {
+		a.traceKeyDecoders = decoders
+	}
+}
@@ -0,0 +1,47 @@
+package graphql
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:       false,
+		Address:      "localhost:8090",
+		DefaultLimit: 100,
+		MaxLimit:     1000,
+		IndexerPath:  "",
+	}
+}
+
+// Config defines configuration for the GraphQL server.
+type Config struct {
+	// Enable defines if the GraphQL server should be enabled.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the GraphQL server should be enabled."`
+
+	// Address defines the GraphQL server address to bind to.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the GraphQL server address to bind to."`
+
+	// DefaultLimit defines the number of objects returned by list queries when no limit is specified.
+	DefaultLimit int `mapstructure:"default-limit" toml:"default-limit" comment:"DefaultLimit defines the number of objects returned by list queries when no limit is specified."`
+
+	// MaxLimit defines the maximum number of objects that can be returned by a single list query.
+	MaxLimit int `mapstructure:"max-limit" toml:"max-limit" comment:"MaxLimit defines the maximum number of objects that can be returned by a single list query."`
+
+	// IndexerPath defines the path of the SQLite database into which module state is indexed when the server
+	// serves its own index. It defaults to data/graphql.db in the node home directory.
+	IndexerPath string `mapstructure:"indexer-path" toml:"indexer-path" comment:"IndexerPath defines the path of the SQLite database into which module state is indexed when the server serves its own index. It defaults to data/graphql.db in the node home directory."`
+}
+
+// CfgOption is a function that allows to overwrite the default server configuration.
+type CfgOption func(*Config)
+
+// OverwriteDefaultConfig overwrites the default config with the new config.
+func OverwriteDefaultConfig(newCfg *Config) CfgOption {
+	return func(cfg *Config) {
+		*cfg = *newCfg
+	}
+}
+
+// Enable the GraphQL server by default (default disabled).
+func Enable() CfgOption {
+	return func(cfg *Config) {
+		cfg.Enable = true
+	}
+}
@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+)
+
+// maxRequestBodySize is the maximum size in bytes of a GraphQL request body.
+const maxRequestBodySize = 1 << 20
+
+// request is a GraphQL request as sent over HTTP.
+type request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// NewHandler returns an http.Handler which executes GraphQL queries against the schema.
+// Queries can be sent either as a JSON body in a POST request or using the query,
+// variables and operationName URL parameters in a GET request.
+func NewHandler(gqlSchema graphql.Schema) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		switch r.Method {
+		case http.MethodGet:
+			params := r.URL.Query()
+			req.Query = params.Get("query")
+			req.OperationName = params.Get("operationName")
+			if vars := params.Get("variables"); vars != "" {
+				if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+					http.Error(w, "invalid variables: "+err.Error(), http.StatusBadRequest)
+					return
+				}
+			}
+		case http.MethodPost:
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize)).Decode(&req); err != nil {
+				http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if req.Query == "" {
+			http.Error(w, "query is required", http.StatusBadRequest)
+			return
+		}
+
+		res := graphql.Do(graphql.Params{
+			Schema:         gqlSchema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			OperationName:  req.OperationName,
+			Context:        r.Context(),
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
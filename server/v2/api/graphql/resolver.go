@@ -0,0 +1,205 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/view"
+)
+
+// page is the result of a list query.
+type page struct {
+	Items []map[string]interface{} `json:"items"`
+	Total int                      `json:"total"`
+}
+
+// objectResolver resolves queries for a single object type.
+type objectResolver struct {
+	objectType schema.ObjectType
+	opts       SchemaOptions
+}
+
+// collection returns the object collection from the module state which is the source of the field.
+func (r *objectResolver) collection(p graphql.ResolveParams) (view.ObjectCollection, error) {
+	modState, ok := p.Source.(view.ModuleState)
+	if !ok {
+		return nil, fmt.Errorf("unexpected source %T", p.Source)
+	}
+
+	coll, err := modState.GetObjectCollection(r.objectType.Name)
+	if err != nil {
+		return nil, err
+	}
+	if coll == nil {
+		return nil, fmt.Errorf("object collection %s not found in module %s", r.objectType.Name, modState.ModuleName())
+	}
+	return coll, nil
+}
+
+// resolveSingleton resolves the object of a singleton object type, or nil if it has not been set.
+func (r *objectResolver) resolveSingleton(p graphql.ResolveParams) (interface{}, error) {
+	coll, err := r.collection(p)
+	if err != nil {
+		return nil, err
+	}
+
+	update, found, err := coll.GetObject(nil)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return objectToMap(r.objectType, update, r.opts.AddressCodec)
+}
+
+// resolvePage resolves a page of the objects which match the query's filter. Objects are returned
+// in the order of the underlying collection, which is expected to be stable between requests.
+// Filters on every key field are resolved with a single lookup. Otherwise the collection is iterated
+// until the page is full, and only iterated to the end if the total number of matches is selected
+// and can't be taken from the collection's length.
+func (r *objectResolver) resolvePage(p graphql.ResolveParams) (interface{}, error) {
+	coll, err := r.collection(p)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := r.opts.DefaultLimit
+	if l, ok := p.Args["limit"].(int); ok {
+		if l < 0 || l > r.opts.MaxLimit {
+			return nil, fmt.Errorf("limit must be between 0 and %d, got %d", r.opts.MaxLimit, l)
+		}
+		limit = l
+	}
+
+	offset, _ := p.Args["offset"].(int)
+	if offset < 0 {
+		return nil, fmt.Errorf("offset must not be negative, got %d", offset)
+	}
+
+	includeDeleted, _ := p.Args["includeDeleted"].(bool)
+	where, _ := p.Args["where"].(map[string]interface{})
+
+	res := &page{Items: []map[string]interface{}{}}
+	matched := 0
+	add := func(update schema.ObjectUpdate) error {
+		if update.Delete && !includeDeleted {
+			return nil
+		}
+
+		obj, err := objectToMap(r.objectType, update, r.opts.AddressCodec)
+		if err != nil {
+			return err
+		}
+
+		if !matches(obj, where) {
+			return nil
+		}
+
+		if matched >= offset && len(res.Items) < limit {
+			res.Items = append(res.Items, obj)
+		}
+		matched++
+		return nil
+	}
+
+	key, ok, err := r.keyFromFilter(where)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		update, found, err := coll.GetObject(key)
+		if err != nil || !found {
+			return res, err
+		}
+		err = add(update)
+		res.Total = matched
+		return res, err
+	}
+
+	// without a filter, the total is the length of the collection unless deleted objects must be skipped
+	total := -1
+	countAll := selectsField(p, "total")
+	if countAll && len(where) == 0 && (!r.objectType.RetainDeletions || includeDeleted) {
+		total, err = coll.Len()
+		if err != nil {
+			return nil, err
+		}
+		countAll = false
+	}
+
+	coll.AllState(func(update schema.ObjectUpdate, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+
+		err = add(update)
+		if err != nil {
+			return false
+		}
+		return countAll || len(res.Items) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res.Total = matched
+	if total >= 0 {
+		res.Total = total
+	}
+	return res, nil
+}
+
+// keyFromFilter returns the object key if the filter has a value for every key field.
+func (r *objectResolver) keyFromFilter(where map[string]interface{}) (interface{}, bool, error) {
+	keyFields := r.objectType.KeyFields
+	values := make([]interface{}, len(keyFields))
+	for i, field := range keyFields {
+		value, ok := where[field.Name]
+		if !ok || value == nil {
+			return nil, false, nil
+		}
+
+		var err error
+		values[i], err = decodeValue(field, value, r.opts.AddressCodec)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if len(values) == 1 {
+		return values[0], true, nil
+	}
+	return values, true, nil
+}
+
+// selectsField returns true if the field with the given name may be selected from the result of the
+// field being resolved. Fragments are assumed to select it.
+func selectsField(p graphql.ResolveParams, name string) bool {
+	for _, fieldAST := range p.Info.FieldASTs {
+		if fieldAST.SelectionSet == nil {
+			continue
+		}
+		for _, selection := range fieldAST.SelectionSet.Selections {
+			field, ok := selection.(*ast.Field)
+			if !ok || field.Name == nil || field.Name.Value == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matches returns true if every field in the filter is equal to the field of the object.
+// Both sides are in their GraphQL representation so that, for example, 64-bit integers
+// are compared as strings.
+func matches(obj, where map[string]interface{}) bool {
+	for name, want := range where {
+		if fmt.Sprint(obj[name]) != fmt.Sprint(want) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,260 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/view"
+)
+
+// SchemaOptions are the options for generating a GraphQL schema.
+type SchemaOptions struct {
+	// AddressCodec is used to convert address fields to strings. It defaults to addressutil.HexAddressCodec.
+	AddressCodec addressutil.AddressCodec
+
+	// DefaultLimit is the number of objects returned by list queries when no limit is specified.
+	DefaultLimit int
+
+	// MaxLimit is the maximum number of objects that can be returned by a single list query.
+	MaxLimit int
+}
+
+// NewSchema generates a GraphQL schema from the module schemas of the modules in the app state.
+// Each module is exposed as a field of the root query type and each object type as a field of the
+// module's type:
+//
+//	query {
+//	  bank {
+//	    balance(where: {address: "cosmos1..."}, limit: 10, offset: 0) {
+//	      items { address denom amount }
+//	      total
+//	    }
+//	  }
+//	}
+//
+// Singleton object types are exposed as a single nullable object rather than a page of objects.
+// Queries are resolved against the app state at request time.
+func NewSchema(appState view.AppState, opts SchemaOptions) (graphql.Schema, error) {
+	if appState == nil {
+		return graphql.Schema{}, errors.New("app state is required")
+	}
+
+	if opts.AddressCodec == nil {
+		opts.AddressCodec = addressutil.HexAddressCodec{}
+	}
+	if opts.MaxLimit <= 0 {
+		opts.MaxLimit = DefaultConfig().MaxLimit
+	}
+	if opts.DefaultLimit <= 0 || opts.DefaultLimit > opts.MaxLimit {
+		opts.DefaultLimit = opts.MaxLimit
+	}
+
+	b := &schemaBuilder{
+		appState: appState,
+		opts:     opts,
+	}
+
+	rootFields := graphql.Fields{}
+	var err error
+	appState.Modules(func(modState view.ModuleState, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+
+		var field *graphql.Field
+		field, err = b.moduleField(modState.ModuleName(), modState.ModuleSchema())
+		if err != nil {
+			err = fmt.Errorf("failed to generate GraphQL schema for module %s: %w", modState.ModuleName(), err)
+			return false
+		}
+		if field != nil {
+			rootFields[modState.ModuleName()] = field
+		}
+		return true
+	})
+	if err != nil {
+		return graphql.Schema{}, err
+	}
+
+	if len(rootFields) == 0 {
+		return graphql.Schema{}, errors.New("no modules with object types found in app state")
+	}
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: rootFields,
+		}),
+	})
+}
+
+// schemaBuilder holds the state used while generating a GraphQL schema.
+type schemaBuilder struct {
+	appState view.AppState
+	opts     SchemaOptions
+}
+
+// moduleField generates the root query field for a module. It returns nil if the module has no object types.
+func (b *schemaBuilder) moduleField(moduleName string, modSchema schema.ModuleSchema) (*graphql.Field, error) {
+	enums := map[string]*graphql.Enum{}
+	modSchema.EnumTypes(func(enumType schema.EnumType) bool {
+		values := graphql.EnumValueConfigMap{}
+		for _, value := range enumType.Values {
+			values[value] = &graphql.EnumValueConfig{Value: value}
+		}
+		enums[enumType.Name] = graphql.NewEnum(graphql.EnumConfig{
+			Name:   fmt.Sprintf("%s_%s", moduleName, enumType.Name),
+			Values: values,
+		})
+		return true
+	})
+
+	fields := graphql.Fields{}
+	var err error
+	modSchema.ObjectTypes(func(objectType schema.ObjectType) bool {
+		var field *graphql.Field
+		field, err = b.objectTypeField(moduleName, objectType, enums)
+		if err != nil {
+			err = fmt.Errorf("object type %s: %w", objectType.Name, err)
+			return false
+		}
+		fields[objectType.Name] = field
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+			Name:   fmt.Sprintf("%s__Module", moduleName),
+			Fields: fields,
+		})),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			modState, err := b.appState.GetModule(moduleName)
+			if err != nil {
+				return nil, err
+			}
+			if modState == nil {
+				return nil, fmt.Errorf("module %s not found", moduleName)
+			}
+			return modState, nil
+		},
+	}, nil
+}
+
+// objectTypeField generates the module field for an object type.
+func (b *schemaBuilder) objectTypeField(moduleName string, objectType schema.ObjectType, enums map[string]*graphql.Enum) (*graphql.Field, error) {
+	typeName := fmt.Sprintf("%s_%s", moduleName, objectType.Name)
+
+	objectFields := graphql.Fields{}
+	filterFields := graphql.InputObjectConfigFieldMap{}
+	for _, field := range append(append([]schema.Field{}, objectType.KeyFields...), objectType.ValueFields...) {
+		typ, err := outputType(field, enums)
+		if err != nil {
+			return nil, err
+		}
+
+		objectFields[field.Name] = &graphql.Field{Type: typ}
+		filterFields[field.Name] = &graphql.InputObjectFieldConfig{Type: graphql.GetNullable(typ).(graphql.Input)}
+	}
+
+	if objectType.RetainDeletions {
+		objectFields[deletedFieldName] = &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)}
+	}
+
+	gqlObjectType := graphql.NewObject(graphql.ObjectConfig{
+		Name:   typeName,
+		Fields: objectFields,
+	})
+
+	resolver := &objectResolver{
+		objectType: objectType,
+		opts:       b.opts,
+	}
+
+	if len(objectType.KeyFields) == 0 {
+		return &graphql.Field{
+			Type:    gqlObjectType,
+			Resolve: resolver.resolveSingleton,
+		}, nil
+	}
+
+	args := graphql.FieldConfigArgument{
+		"where": &graphql.ArgumentConfig{
+			Type: graphql.NewInputObject(graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%s__Filter", typeName),
+				Fields: filterFields,
+			}),
+			Description: "Filters objects by equality on any of the object's fields.",
+		},
+		"limit": &graphql.ArgumentConfig{
+			Type:        graphql.Int,
+			Description: fmt.Sprintf("The maximum number of objects to return, at most %d.", b.opts.MaxLimit),
+		},
+		"offset": &graphql.ArgumentConfig{
+			Type:         graphql.Int,
+			DefaultValue: 0,
+			Description:  "The number of matching objects to skip.",
+		},
+	}
+	if objectType.RetainDeletions {
+		args["includeDeleted"] = &graphql.ArgumentConfig{
+			Type:         graphql.Boolean,
+			DefaultValue: false,
+			Description:  "Whether to include objects that were deleted but retained in state.",
+		}
+	}
+
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%s__Page", typeName),
+			Fields: graphql.Fields{
+				"items": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(gqlObjectType)))},
+				"total": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			},
+		})),
+		Args:    args,
+		Resolve: resolver.resolvePage,
+	}, nil
+}
+
+// outputType returns the GraphQL type of the field.
+func outputType(field schema.Field, enums map[string]*graphql.Enum) (graphql.Output, error) {
+	var typ graphql.Output
+	switch field.Kind {
+	case schema.StringKind, schema.IntegerStringKind, schema.DecimalStringKind, schema.BytesKind,
+		schema.AddressKind, schema.JSONKind, schema.TimeKind, schema.DurationKind,
+		// 64-bit and unsigned 32-bit integers don't fit in GraphQL's signed 32-bit Int type
+		schema.Uint32Kind, schema.Int64Kind, schema.Uint64Kind:
+		typ = graphql.String
+	case schema.BoolKind:
+		typ = graphql.Boolean
+	case schema.Int8Kind, schema.Int16Kind, schema.Int32Kind, schema.Uint8Kind, schema.Uint16Kind:
+		typ = graphql.Int
+	case schema.Float32Kind, schema.Float64Kind:
+		typ = graphql.Float
+	case schema.EnumKind:
+		enum, ok := enums[field.EnumType.Name]
+		if !ok {
+			return nil, fmt.Errorf("enum type %s not found", field.EnumType.Name)
+		}
+		typ = enum
+	default:
+		return nil, fmt.Errorf("unsupported kind %s for field %s", field.Kind, field.Name)
+	}
+
+	if !field.Nullable {
+		typ = graphql.NewNonNull(typ)
+	}
+	return typ, nil
+}
@@ -0,0 +1,300 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/view"
+)
+
+var testModuleSchema = schema.ModuleSchema{}
+
+func init() {
+	var err error
+	testModuleSchema, err = schema.NewModuleSchema([]schema.ObjectType{
+		{
+			Name:      "Params",
+			KeyFields: []schema.Field{},
+			ValueFields: []schema.Field{
+				{Name: "max_supply", Kind: schema.Uint64Kind},
+			},
+		},
+		{
+			Name: "Balance",
+			KeyFields: []schema.Field{
+				{Name: "address", Kind: schema.AddressKind},
+				{Name: "denom", Kind: schema.StringKind},
+			},
+			ValueFields: []schema.Field{
+				{Name: "amount", Kind: schema.Int64Kind},
+				{Name: "status", Kind: schema.EnumKind, EnumType: schema.EnumType{Name: "status", Values: []string{"active", "frozen"}}},
+			},
+			RetainDeletions: true,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	appState := testAppState{
+		"bank": &testModuleState{
+			name:   "bank",
+			schema: testModuleSchema,
+			objects: map[string][]schema.ObjectUpdate{
+				"Params": {
+					{TypeName: "Params", Value: uint64(1000000)},
+				},
+				"Balance": {
+					{TypeName: "Balance", Key: []interface{}{[]byte{0x01}, "atom"}, Value: []interface{}{int64(10), "active"}},
+					{TypeName: "Balance", Key: []interface{}{[]byte{0x01}, "btc"}, Value: []interface{}{int64(20), "frozen"}},
+					{TypeName: "Balance", Key: []interface{}{[]byte{0x02}, "atom"}, Value: []interface{}{int64(30), "active"}},
+					{TypeName: "Balance", Key: []interface{}{[]byte{0x03}, "atom"}, Value: []interface{}{int64(40), "active"}, Delete: true},
+				},
+			},
+		},
+		"empty": &testModuleState{name: "empty"},
+	}
+
+	gqlSchema, err := NewSchema(appState, SchemaOptions{DefaultLimit: 2, MaxLimit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "singleton",
+			query:    `{ bank { Params { max_supply } } }`,
+			expected: `{"bank":{"Params":{"max_supply":"1000000"}}}`,
+		},
+		{
+			name:     "default limit",
+			query:    `{ bank { Balance { items { address denom amount status } total } } }`,
+			expected: `{"bank":{"Balance":{"items":[{"address":"0x01","amount":"10","denom":"atom","status":"active"},{"address":"0x01","amount":"20","denom":"btc","status":"frozen"}],"total":3}}}`,
+		},
+		{
+			name:     "offset",
+			query:    `{ bank { Balance(offset: 2) { items { denom amount } total } } }`,
+			expected: `{"bank":{"Balance":{"items":[{"amount":"30","denom":"atom"}],"total":3}}}`,
+		},
+		{
+			name:     "filter",
+			query:    `{ bank { Balance(where: {denom: "atom", status: active}) { items { address } total } } }`,
+			expected: `{"bank":{"Balance":{"items":[{"address":"0x01"},{"address":"0x02"}],"total":2}}}`,
+		},
+		{
+			name:     "include deleted",
+			query:    `{ bank { Balance(where: {address: "0x03"}, includeDeleted: true) { items { amount _deleted } total } } }`,
+			expected: `{"bank":{"Balance":{"items":[{"_deleted":true,"amount":"40"}],"total":1}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := graphql.Do(graphql.Params{Schema: gqlSchema, RequestString: tt.query})
+			if res.HasErrors() {
+				t.Fatalf("unexpected errors: %v", res.Errors)
+			}
+			bz, err := json.Marshal(res.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(bz) != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, bz)
+			}
+		})
+	}
+
+	res := graphql.Do(graphql.Params{Schema: gqlSchema, RequestString: `{ bank { Balance(limit: 11) { total } } }`})
+	if !res.HasErrors() {
+		t.Fatal("expected error for limit above max limit")
+	}
+
+	res = graphql.Do(graphql.Params{Schema: gqlSchema, RequestString: `{ empty { Foo { total } } }`})
+	if !res.HasErrors() {
+		t.Fatal("expected error for module without object types")
+	}
+}
+
+func TestQueryScans(t *testing.T) {
+	bank := &testModuleState{
+		name:   "bank",
+		schema: testModuleSchema,
+		objects: map[string][]schema.ObjectUpdate{
+			"Balance": {
+				{TypeName: "Balance", Key: []interface{}{[]byte{0x01}, "atom"}, Value: []interface{}{int64(10), "active"}},
+				{TypeName: "Balance", Key: []interface{}{[]byte{0x02}, "atom"}, Value: []interface{}{int64(20), "active"}, Delete: true},
+				{TypeName: "Balance", Key: []interface{}{[]byte{0x03}, "atom"}, Value: []interface{}{int64(30), "active"}},
+				{TypeName: "Balance", Key: []interface{}{[]byte{0x04}, "atom"}, Value: []interface{}{int64(40), "active"}},
+			},
+		},
+	}
+
+	gqlSchema, err := NewSchema(testAppState{"bank": bank}, SchemaOptions{DefaultLimit: 1, MaxLimit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+		scanned  int
+	}{
+		{
+			name:     "key lookup",
+			query:    `{ bank { Balance(where: {address: "0x03", denom: "atom"}) { items { amount } total } } }`,
+			expected: `{"bank":{"Balance":{"items":[{"amount":"30"}],"total":1}}}`,
+			scanned:  0,
+		},
+		{
+			name:     "key lookup of deleted object",
+			query:    `{ bank { Balance(where: {address: "0x02", denom: "atom"}) { items { amount } total } } }`,
+			expected: `{"bank":{"Balance":{"items":[],"total":0}}}`,
+			scanned:  0,
+		},
+		{
+			name:     "key lookup with value filter",
+			query:    `{ bank { Balance(where: {address: "0x03", denom: "atom", amount: "40"}) { total } } }`,
+			expected: `{"bank":{"Balance":{"total":0}}}`,
+			scanned:  0,
+		},
+		{
+			name:     "items only",
+			query:    `{ bank { Balance(offset: 1) { items { amount } } } }`,
+			expected: `{"bank":{"Balance":{"items":[{"amount":"30"}]}}}`,
+			scanned:  3,
+		},
+		{
+			name:     "total from length",
+			query:    `{ bank { Balance(includeDeleted: true) { items { amount } total } } }`,
+			expected: `{"bank":{"Balance":{"items":[{"amount":"10"}],"total":4}}}`,
+			scanned:  1,
+		},
+		{
+			name:     "total skipping deleted objects",
+			query:    `{ bank { Balance { items { amount } total } } }`,
+			expected: `{"bank":{"Balance":{"items":[{"amount":"10"}],"total":3}}}`,
+			scanned:  4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bank.scanned = 0
+			res := graphql.Do(graphql.Params{Schema: gqlSchema, RequestString: tt.query})
+			if res.HasErrors() {
+				t.Fatalf("unexpected errors: %v", res.Errors)
+			}
+			bz, err := json.Marshal(res.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(bz) != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, bz)
+			}
+			if bank.scanned != tt.scanned {
+				t.Fatalf("expected %d objects to be scanned, got %d", tt.scanned, bank.scanned)
+			}
+		})
+	}
+}
+
+type testAppState map[string]*testModuleState
+
+func (a testAppState) GetModule(moduleName string) (view.ModuleState, error) {
+	mod, ok := a[moduleName]
+	if !ok {
+		return nil, nil
+	}
+	return mod, nil
+}
+
+func (a testAppState) Modules(f func(modState view.ModuleState, err error) bool) {
+	for _, mod := range a {
+		if !f(mod, nil) {
+			return
+		}
+	}
+}
+
+func (a testAppState) NumModules() (int, error) {
+	return len(a), nil
+}
+
+type testModuleState struct {
+	name    string
+	schema  schema.ModuleSchema
+	objects map[string][]schema.ObjectUpdate
+
+	// scanned counts the objects returned by AllState
+	scanned int
+}
+
+func (m *testModuleState) ModuleName() string {
+	return m.name
+}
+
+func (m *testModuleState) ModuleSchema() schema.ModuleSchema {
+	return m.schema
+}
+
+func (m *testModuleState) GetObjectCollection(objectType string) (view.ObjectCollection, error) {
+	typ, ok := m.schema.LookupType(objectType)
+	if !ok {
+		return nil, nil
+	}
+	return &testObjectCollection{objectType: typ.(schema.ObjectType), objects: m.objects[objectType], scanned: &m.scanned}, nil
+}
+
+func (m *testModuleState) ObjectCollections(f func(value view.ObjectCollection, err error) bool) {
+	m.schema.ObjectTypes(func(objectType schema.ObjectType) bool {
+		coll, err := m.GetObjectCollection(objectType.Name)
+		return f(coll, err)
+	})
+}
+
+func (m *testModuleState) NumObjectCollections() (int, error) {
+	return len(m.objects), nil
+}
+
+type testObjectCollection struct {
+	objectType schema.ObjectType
+	objects    []schema.ObjectUpdate
+	scanned    *int
+}
+
+func (c *testObjectCollection) ObjectType() schema.ObjectType {
+	return c.objectType
+}
+
+func (c *testObjectCollection) GetObject(key interface{}) (schema.ObjectUpdate, bool, error) {
+	for _, obj := range c.objects {
+		if fmt.Sprint(obj.Key) == fmt.Sprint(key) {
+			return obj, true, nil
+		}
+	}
+	return schema.ObjectUpdate{}, false, nil
+}
+
+func (c *testObjectCollection) AllState(f func(schema.ObjectUpdate, error) bool) {
+	for _, obj := range c.objects {
+		*c.scanned++
+		if !f(obj, nil) {
+			return
+		}
+	}
+}
+
+func (c *testObjectCollection) Len() (int, error) {
+	return len(c.objects), nil
+}
@@ -0,0 +1,244 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/indexer/sqlite"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+	"cosmossdk.io/schema/view"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+var _ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
+
+const ServerName = "graphql"
+
+// Server is a read-only GraphQL server whose schema is generated from the module schemas of the app state.
+type Server[T transaction.Tx] struct {
+	logger     log.Logger
+	config     *Config
+	cfgOptions []CfgOption
+
+	appState     view.AppState
+	addressCodec addressutil.AddressCodec
+
+	// resolver and listener are only set when the server indexes module state itself
+	resolver decoding.DecoderResolver
+	listener appdata.Listener
+
+	httpSrv *http.Server
+}
+
+// New creates a new GraphQL server serving queries against the provided app state, which is
+// usually the view of an indexer. If the address codec is nil, addresses are rendered as hex strings.
+func New[T transaction.Tx](appState view.AppState, addressCodec addressutil.AddressCodec, cfgOptions ...CfgOption) *Server[T] {
+	return &Server[T]{
+		appState:     appState,
+		addressCodec: addressCodec,
+		cfgOptions:   cfgOptions,
+	}
+}
+
+// NewIndexed creates a new GraphQL server which indexes the state of the modules known to the resolver
+// into a SQLite database and serves queries against it. The app data passed to the listener returned by
+// Listener is indexed, so it must be registered with the consensus server. Only state written after the
+// indexer was first started is indexed. The database/sql driver for SQLite, registered as "sqlite", must be
+// imported by the app.
+func NewIndexed[T transaction.Tx](resolver decoding.DecoderResolver, addressCodec addressutil.AddressCodec, cfgOptions ...CfgOption) *Server[T] {
+	return &Server[T]{
+		resolver:     resolver,
+		addressCodec: addressCodec,
+		cfgOptions:   cfgOptions,
+	}
+}
+
+// Listener returns the listener indexing app data when the server was created with NewIndexed.
+// App data is dropped until the server is initialized, or if it is disabled.
+func (s *Server[T]) Listener() appdata.Listener {
+	if s.resolver == nil {
+		return appdata.Listener{}
+	}
+
+	return appdata.Listener{
+		StartBlock: func(data appdata.StartBlockData) error {
+			if s.listener.StartBlock == nil {
+				return nil
+			}
+			return s.listener.StartBlock(data)
+		},
+		OnKVPair: func(data appdata.KVPairData) error {
+			if s.listener.OnKVPair == nil {
+				return nil
+			}
+			return s.listener.OnKVPair(data)
+		},
+		Commit: func(data appdata.CommitData) (func() error, error) {
+			if s.listener.Commit == nil {
+				return nil, nil
+			}
+			return s.listener.Commit(data)
+		},
+	}
+}
+
+// Init generates the GraphQL schema and configures the HTTP server.
+// Note, the caller is responsible for starting the server.
+func (s *Server[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger log.Logger) error {
+	cfg := s.Config().(*Config)
+	if v != nil {
+		if err := serverv2.UnmarshalSubConfig(v, s.Name(), &cfg); err != nil {
+			return fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+
+	s.config = cfg
+	s.logger = logger.With(log.ModuleKey, s.Name())
+
+	if !cfg.Enable {
+		return nil
+	}
+
+	if s.resolver != nil {
+		path := cfg.IndexerPath
+		if path == "" && v != nil {
+			path = filepath.Join(v.GetString(serverv2.FlagHome), "data", "graphql.db")
+		}
+		if err := s.startIndexer(path); err != nil {
+			return fmt.Errorf("failed to start GraphQL indexer: %w", err)
+		}
+	}
+
+	gqlSchema, err := NewSchema(s.appState, SchemaOptions{
+		AddressCodec: s.addressCodec,
+		DefaultLimit: cfg.DefaultLimit,
+		MaxLimit:     cfg.MaxLimit,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate GraphQL schema: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", NewHandler(gqlSchema))
+	s.httpSrv = &http.Server{
+		Addr:    cfg.Address,
+		Handler: mux,
+	}
+
+	return nil
+}
+
+func (s *Server[T]) StartCmdFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet(s.Name(), pflag.ExitOnError)
+	flags.String(FlagAddress, "localhost:8090", "Listen address")
+	return flags
+}
+
+func (s *Server[T]) Name() string {
+	return ServerName
+}
+
+func (s *Server[T]) Config() any {
+	if s.config == nil || s.config == (&Config{}) {
+		cfg := DefaultConfig()
+		// overwrite the default config with the provided options
+		for _, opt := range s.cfgOptions {
+			opt(cfg)
+		}
+
+		return cfg
+	}
+
+	return s.config
+}
+
+func (s *Server[T]) Start(ctx context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on address %s: %w", s.config.Address, err)
+	}
+
+	s.logger.Info("starting GraphQL server...", "address", s.config.Address)
+	if err := s.httpSrv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.logger.Error("failed to start GraphQL server", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server[T]) Stop(ctx context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	s.logger.Info("stopping GraphQL server...", "address", s.config.Address)
+	return s.httpSrv.Shutdown(ctx)
+}
+
+// startIndexer starts the SQLite indexer in the database at path and serves queries against its view.
+func (s *Server[T]) startIndexer(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	res, err := sqlite.StartIndexer(context.Background(), nil, sqlite.Config{
+		DatabaseURL:  path,
+		AddressCodec: s.addressCodec,
+	})
+	if err != nil {
+		return err
+	}
+
+	// modules are initialized up front so that all of them can be queried, and are then skipped when
+	// they are initialized again by the decoding middleware as their state is first written
+	target := res.Listener
+	initModule := target.InitializeModuleData
+	initialized := map[string]bool{}
+	target.InitializeModuleData = func(data appdata.ModuleInitializationData) error {
+		if initialized[data.ModuleName] {
+			return nil
+		}
+		initialized[data.ModuleName] = true
+		return initModule(data)
+	}
+
+	err = s.resolver.IterateAll(func(moduleName string, cdc schema.ModuleCodec) error {
+		return target.InitializeModuleData(appdata.ModuleInitializationData{
+			ModuleName: moduleName,
+			Schema:     cdc.Schema,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err = target.Commit(appdata.CommitData{}); err != nil {
+		return err
+	}
+
+	s.listener, err = decoding.Middleware(target, s.resolver, decoding.MiddlewareOptions{})
+	if err != nil {
+		return err
+	}
+
+	s.appState = res.View.AppState()
+	return nil
+}
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+)
+
+// testBankModule decodes balances stored under the address byte followed by the denom,
+// with the amount as a decimal string value.
+type testBankModule struct{}
+
+func (testBankModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: testModuleSchema,
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			key := []interface{}{update.Key[:1], string(update.Key[1:])}
+			if update.Remove {
+				return []schema.ObjectUpdate{{TypeName: "Balance", Key: key, Delete: true}}, nil
+			}
+
+			amount, err := strconv.ParseInt(string(update.Value), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return []schema.ObjectUpdate{{TypeName: "Balance", Key: key, Value: []interface{}{amount, "active"}}}, nil
+		},
+	}, nil
+}
+
+func TestIndexedServer(t *testing.T) {
+	resolver := decoding.ModuleSetDecoderResolver(map[string]interface{}{"bank": testBankModule{}})
+	srv := NewIndexed[transaction.Tx](resolver, nil, func(cfg *Config) {
+		cfg.Enable = true
+		cfg.IndexerPath = filepath.Join(t.TempDir(), "graphql.db")
+	})
+	if err := srv.Init(nil, nil, log.NewNopLogger()); err != nil {
+		t.Fatal(err)
+	}
+
+	listener := srv.Listener()
+	block := func(height uint64, changes ...schema.KVPairUpdate) {
+		if err := listener.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+			t.Fatal(err)
+		}
+		err := listener.OnKVPair(appdata.KVPairData{Updates: []appdata.ActorKVPairUpdate{
+			{Actor: []byte("bank"), StateChanges: changes},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := listener.Commit(appdata.CommitData{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	block(1,
+		schema.KVPairUpdate{Key: []byte("\x01atom"), Value: []byte("10")},
+		schema.KVPairUpdate{Key: []byte("\x02atom"), Value: []byte("20")},
+	)
+	block(2, schema.KVPairUpdate{Key: []byte("\x01atom"), Value: []byte("15")})
+
+	query := func(q string) string {
+		rec := httptest.NewRecorder()
+		srv.httpSrv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?query="+url.QueryEscape(q), nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+		}
+		return strings.TrimSpace(rec.Body.String())
+	}
+
+	expected := `{"data":{"bank":{"Balance":{"items":[{"address":"0x01","amount":"15"},{"address":"0x02","amount":"20"}],"total":2}}}}`
+	if res := query(`{ bank { Balance { items { address amount } total } } }`); res != expected {
+		t.Fatalf("expected %s, got %s", expected, res)
+	}
+
+	// the singleton is queryable although it was never written
+	expected = `{"data":{"bank":{"Params":null}}}`
+	if res := query(`{ bank { Params { max_supply } } }`); res != expected {
+		t.Fatalf("expected %s, got %s", expected, res)
+	}
+}
@@ -0,0 +1,223 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+// deletedFieldName is the name of the field which indicates whether an object has been deleted
+// for object types which retain deletions.
+const deletedFieldName = "_deleted"
+
+// objectToMap converts an object update into a map of GraphQL field values.
+func objectToMap(objectType schema.ObjectType, update schema.ObjectUpdate, addressCodec addressutil.AddressCodec) (map[string]interface{}, error) {
+	res := make(map[string]interface{}, len(objectType.KeyFields)+len(objectType.ValueFields)+1)
+
+	err := fieldsToMap(res, objectType.KeyFields, update.Key, addressCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	if valueUpdates, ok := update.Value.(schema.ValueUpdates); ok {
+		// only the updated fields are known, the rest are left unset
+		fields := make(map[string]schema.Field, len(objectType.ValueFields))
+		for _, field := range objectType.ValueFields {
+			fields[field.Name] = field
+		}
+		err = valueUpdates.Iterate(func(fieldName string, value interface{}) bool {
+			field, ok := fields[fieldName]
+			if !ok {
+				err = fmt.Errorf("unknown field %s", fieldName)
+				return false
+			}
+			res[fieldName], err = encodeValue(field, value, addressCodec)
+			return err == nil
+		})
+	} else {
+		err = fieldsToMap(res, objectType.ValueFields, update.Value, addressCodec)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if objectType.RetainDeletions {
+		res[deletedFieldName] = update.Delete
+	}
+
+	return res, nil
+}
+
+// fieldsToMap adds the encoded values of the fields to the map. As in the rest of schema, a single
+// field is represented by its value directly and multiple fields by a slice of values.
+func fieldsToMap(res map[string]interface{}, fields []schema.Field, value interface{}, addressCodec addressutil.AddressCodec) error {
+	switch len(fields) {
+	case 0:
+		return nil
+	case 1:
+		v, err := encodeValue(fields[0], value, addressCodec)
+		if err != nil {
+			return err
+		}
+		res[fields[0].Name] = v
+		return nil
+	default:
+		values, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected slice of values, got %T", value)
+		}
+		if len(values) != len(fields) {
+			return fmt.Errorf("expected %d values, got %d", len(fields), len(values))
+		}
+		for i, field := range fields {
+			v, err := encodeValue(field, values[i], addressCodec)
+			if err != nil {
+				return err
+			}
+			res[field.Name] = v
+		}
+		return nil
+	}
+}
+
+// encodeValue converts a value of the field into its GraphQL representation.
+func encodeValue(field schema.Field, value interface{}, addressCodec addressutil.AddressCodec) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.Int8Kind:
+		return int(value.(int8)), nil
+	case schema.Int16Kind:
+		return int(value.(int16)), nil
+	case schema.Int32Kind:
+		return int(value.(int32)), nil
+	case schema.Uint8Kind:
+		return int(value.(uint8)), nil
+	case schema.Uint16Kind:
+		return int(value.(uint16)), nil
+	case schema.Uint32Kind:
+		return strconv.FormatUint(uint64(value.(uint32)), 10), nil
+	case schema.Int64Kind:
+		return strconv.FormatInt(value.(int64), 10), nil
+	case schema.Uint64Kind:
+		return strconv.FormatUint(value.(uint64), 10), nil
+	case schema.Float32Kind:
+		return float64(value.(float32)), nil
+	case schema.BytesKind:
+		return base64.StdEncoding.EncodeToString(value.([]byte)), nil
+	case schema.TimeKind:
+		return value.(time.Time).UTC().Format(time.RFC3339Nano), nil
+	case schema.DurationKind:
+		return value.(time.Duration).String(), nil
+	case schema.AddressKind:
+		return addressCodec.BytesToString(value.([]byte))
+	case schema.JSONKind:
+		return string(value.(json.RawMessage)), nil
+	default:
+		return value, nil
+	}
+}
+
+// decodeValue converts the GraphQL representation of a value of the field, as produced by encodeValue,
+// back into the value of the field.
+func decodeValue(field schema.Field, value interface{}, addressCodec addressutil.AddressCodec) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	var (
+		res interface{}
+		err error
+	)
+	switch field.Kind {
+	case schema.Int8Kind, schema.Int16Kind, schema.Int32Kind, schema.Uint8Kind, schema.Uint16Kind:
+		n, ok := value.(int)
+		if !ok {
+			return nil, fmt.Errorf("expected int for field %s, got %T", field.Name, value)
+		}
+		res, err = decodeInt(field.Kind, n)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for field %s: %w", field.Name, err)
+		}
+	case schema.Float32Kind:
+		f, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("expected float for field %s, got %T", field.Name, value)
+		}
+		res = float32(f)
+	case schema.Uint32Kind, schema.Int64Kind, schema.Uint64Kind, schema.BytesKind, schema.TimeKind,
+		schema.DurationKind, schema.AddressKind, schema.JSONKind:
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string for field %s, got %T", field.Name, value)
+		}
+		res, err = decodeString(field.Kind, str, addressCodec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for field %s: %w", field.Name, err)
+		}
+	default:
+		res = value
+	}
+
+	// check the format of strings and enum values
+	if field.Kind != schema.JSONKind {
+		err = field.ValidateValue(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// decodeInt converts an int into a value of the integer kind which is represented as an Int in GraphQL.
+func decodeInt(kind schema.Kind, n int) (interface{}, error) {
+	var res interface{}
+	var inRange bool
+	switch kind {
+	case schema.Int8Kind:
+		res, inRange = int8(n), n >= math.MinInt8 && n <= math.MaxInt8
+	case schema.Int16Kind:
+		res, inRange = int16(n), n >= math.MinInt16 && n <= math.MaxInt16
+	case schema.Int32Kind:
+		res, inRange = int32(n), n >= math.MinInt32 && n <= math.MaxInt32
+	case schema.Uint8Kind:
+		res, inRange = uint8(n), n >= 0 && n <= math.MaxUint8
+	default:
+		res, inRange = uint16(n), n >= 0 && n <= math.MaxUint16
+	}
+	if !inRange {
+		return nil, fmt.Errorf("%d is out of range for %s", n, kind)
+	}
+	return res, nil
+}
+
+// decodeString decodes a value of the kind which is represented as a string in GraphQL.
+func decodeString(kind schema.Kind, str string, addressCodec addressutil.AddressCodec) (interface{}, error) {
+	switch kind {
+	case schema.Uint32Kind:
+		n, err := strconv.ParseUint(str, 10, 32)
+		return uint32(n), err
+	case schema.Int64Kind:
+		return strconv.ParseInt(str, 10, 64)
+	case schema.Uint64Kind:
+		return strconv.ParseUint(str, 10, 64)
+	case schema.BytesKind:
+		return base64.StdEncoding.DecodeString(str)
+	case schema.TimeKind:
+		return time.Parse(time.RFC3339Nano, str)
+	case schema.DurationKind:
+		return time.ParseDuration(str)
+	case schema.AddressKind:
+		return addressCodec.StringToBytes(str)
+	default:
+		return json.RawMessage(str), nil
+	}
+}
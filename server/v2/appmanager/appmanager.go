@@ -34,6 +34,9 @@ type AppManager[T transaction.Tx] struct {
 	exportGenesis ExportGenesis
 
 	stf StateTransitionFunction[T]
+
+	// traceKeyDecoders are used to decode the keys of the store operations traced during simulation.
+	traceKeyDecoders map[string]corestore.KeyDecoder
 }
 
 // InitGenesis initializes the genesis state of the application.
@@ -135,6 +138,30 @@ func (a AppManager[T]) DeliverBlock(
 	return blockResponse, newState, nil
 }
 
+// ReplayBlock executes an already committed block on top of the state at the height
+// preceding it. The resulting state is not committed, which allows comparing the
+// outcome of the execution with the one committed by the chain.
+func (a AppManager[T]) ReplayBlock(
+	ctx context.Context,
+	block *appmanager.BlockRequest[T],
+) (*appmanager.BlockResponse, corestore.WriterMap, error) {
+	if block.Height == 0 {
+		return nil, nil, errors.New("cannot replay block at height 0")
+	}
+
+	state, err := a.db.StateAt(block.Height - 1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get state at height %d: %w", block.Height-1, err)
+	}
+
+	blockResponse, newState, err := a.stf.DeliverBlock(ctx, block, state)
+	if err != nil {
+		return nil, nil, fmt.Errorf("block replay failed: %w", err)
+	}
+
+	return blockResponse, newState, nil
+}
+
 // ValidateTx will validate the tx against the latest storage state. This means that
 // only the stateful validation will be run, not the execution portion of the tx.
 // If full execution is needed, Simulate must be used.
@@ -156,6 +183,20 @@ func (a AppManager[T]) Simulate(ctx context.Context, tx T) (appmanager.TxResult,
 	return result, cs, nil
 }
 
+// SimulateWithTrace runs validation and execution flow of a Tx like Simulate, additionally returning
+// the ordered list of store operations performed by the Tx alongside the gas charged for each of them.
+func (a AppManager[T]) SimulateWithTrace(
+	ctx context.Context,
+	tx T,
+) (appmanager.TxResult, corestore.WriterMap, []corestore.TraceOperation, error) {
+	_, state, err := a.db.StateLatest()
+	if err != nil {
+		return appmanager.TxResult{}, nil, nil, err
+	}
+	result, cs, trace := a.stf.SimulateWithTrace(ctx, state, a.config.SimulationGasLimit, tx, a.traceKeyDecoders)
+	return result, cs, trace, nil
+}
+
 // Query queries the application at the provided version.
 // CONTRACT: Version must always be provided, if 0, get latest
 func (a AppManager[T]) Query(ctx context.Context, version uint64, request transaction.Msg) (transaction.Msg, error) {
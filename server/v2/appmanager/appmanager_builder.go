@@ -1,6 +1,7 @@
 package appmanager
 
 import (
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
 )
 
@@ -21,6 +22,10 @@ type Builder[T transaction.Tx] struct {
 	// ExportGenesis is a function that exports the application state to a genesis file.
 	// It takes a context and a version number for the genesis file.
 	ExportGenesis ExportGenesis
+
+	// TraceKeyDecoders are the decoders, indexed by store key, used to decode the keys
+	// of the store operations traced by SimulateWithTrace. They are optional.
+	TraceKeyDecoders map[string]store.KeyDecoder
 }
 
 // Build creates a new instance of AppManager with the provided configuration and returns it.
@@ -36,5 +41,7 @@ func (b Builder[T]) Build() (*AppManager[T], error) {
 		initGenesis:   b.InitGenesis,
 		exportGenesis: b.ExportGenesis,
 		stf:           b.STF,
+
+		traceKeyDecoders: b.TraceKeyDecoders,
 	}, nil
 }
@@ -33,6 +33,16 @@ type StateTransitionFunction[T transaction.Tx] interface {
 		tx T,
 	) (appmanager.TxResult, store.WriterMap)
 
+	// SimulateWithTrace executes a transaction in simulation mode, additionally returning
+	// the ordered list of store operations it performed.
+	SimulateWithTrace(
+		ctx context.Context,
+		state store.ReaderMap,
+		gasLimit uint64,
+		tx T,
+		decoders map[string]store.KeyDecoder,
+	) (appmanager.TxResult, store.WriterMap, []store.TraceOperation)
+
 	// Query executes a query on the application.
 	Query(
 		ctx context.Context,
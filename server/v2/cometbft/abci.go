@@ -11,6 +11,7 @@ import (
 	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	gogoproto "github.com/cosmos/gogoproto/proto"
 
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	coreappmgr "cosmossdk.io/core/app"
 	"cosmossdk.io/core/comet"
 	corecontext "cosmossdk.io/core/context"
@@ -19,6 +20,7 @@ import (
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/server/v2/appmanager"
 	"cosmossdk.io/server/v2/cometbft/client/grpc/cmtservice"
 	"cosmossdk.io/server/v2/cometbft/handlers"
@@ -40,6 +42,7 @@ type Consensus[T transaction.Tx] struct {
 	txCodec            transaction.Codec[T]
 	store              types.Store
 	streaming          streaming.Manager
+	listener           appdata.Listener
 	snapshotManager    *snapshots.Manager
 	mempool            mempool.Mempool[T]
 
@@ -188,6 +191,15 @@ func (c *Consensus[T]) Info(ctx context.Context, _ *abciproto.InfoRequest) (*abc
 // Query implements types.Application.
 // It is called by cometbft to query application state.
 func (c *Consensus[T]) Query(ctx context.Context, req *abciproto.QueryRequest) (resp *abciproto.QueryResponse, err error) {
+	// simulations with trace are served by the consensus, as they are not module queries
+	if req.Path == txv1beta1.Service_SimulateWithTrace_FullMethodName {
+		resp, err = c.handleSimulateWithTrace(ctx, req)
+		if err != nil {
+			return QueryResult(err, c.cfg.AppTomlConfig.Trace), nil
+		}
+		return resp, nil
+	}
+
 	// check if it's a gRPC method
 	makeGRPCRequest, isGRPC := c.grpcMethodsMap[req.Path]
 	if isGRPC {
@@ -487,6 +499,12 @@ func (c *Consensus[T]) FinalizeBlock(
 		return nil, err
 	}
 
+	// index the block with the app data listener, if any
+	err = c.listenAppData(req.Height, req.Txs, resp, stateChanges)
+	if err != nil {
+		return nil, fmt.Errorf("app data listener failed: %w", err)
+	}
+
 	// remove txs from the mempool
 	err = c.mempool.Remove(decodedTxs)
 	if err != nil {
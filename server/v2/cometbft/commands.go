@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/node"
@@ -14,6 +15,8 @@ import (
 	pvm "github.com/cometbft/cometbft/privval"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cometbft/cometbft/rpc/client/local"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
 	cmtversion "github.com/cometbft/cometbft/version"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -404,6 +407,93 @@ func (s *CometBFTServer[T]) BootstrapStateCmd() *cobra.Command {
 	return cmd
 }
 
+// ReplayBlockCmd returns a command re-executing a committed block with the current binary
+// and comparing the resulting state with the committed one.
+func (s *CometBFTServer[T]) ReplayBlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-block <height>",
+		Short: "Re-execute a committed block on top of the state at the previous height and diff the results",
+		Long: `Re-execute the txs of a block read from the CometBFT block store on top of the application state
+at the previous height, and compare the resulting state changes and AppHash with the committed ones.
+The replayed state is never committed. The node must be stopped while running this command.
+
+The application state at the previous height must still be available and match the AppHash of
+the replayed block.`,
+		Example: "<appd> comet replay-block 1000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			cfg := client.GetConfigFromCmd(cmd)
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := cmtstore.NewBlockStore(blockStoreDB)
+			defer blockStore.Close()
+
+			stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+			defer stateStore.Close()
+
+			block, _ := blockStore.LoadBlock(height)
+			if block == nil {
+				return fmt.Errorf("block %d not found in the block store (base %d, height %d)", height, blockStore.Base(), blockStore.Height())
+			}
+
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+			var lastCommit abci.CommitInfo
+			if height > state.InitialHeight {
+				lastValSet, err := stateStore.LoadValidators(height - 1)
+				if err != nil {
+					return fmt.Errorf("failed to load validators at height %d: %w", height-1, err)
+				}
+				lastCommit = sm.BuildLastCommitInfo(block, lastValSet, state.InitialHeight)
+			}
+
+			// the AppHash resulting from a block is committed in the header of the next one.
+			var committedAppHash []byte
+			if next := blockStore.LoadBlockMeta(height + 1); next != nil {
+				committedAppHash = next.Header.AppHash
+			}
+
+			res, err := s.Consensus.ReplayBlock(cmd.Context(), block, lastCommit, committedAppHash)
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, txRes := range res.TxResults {
+				if txRes.Error != nil {
+					failed++
+				}
+			}
+			cmd.Printf("replayed block %d: %d txs (%d failed), %d state changes\n", res.Height, len(res.TxResults), failed, res.StateChanges)
+			for _, diff := range res.Diffs {
+				cmd.Printf("state diff: store %s, key %X: committed %X, replayed %X\n", diff.StoreKey, diff.Key, diff.Committed, diff.Replayed)
+			}
+			cmd.Printf("committed app hash: %X\n", res.CommittedAppHash)
+			cmd.Printf("replayed app hash: %X\n", res.ReplayedAppHash)
+
+			if len(res.Diffs) > 0 || (res.CommittedAppHash != nil && !res.AppHashMatches()) {
+				return fmt.Errorf("replayed block %d diverges from the committed one", height)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 func printOutput(cmd *cobra.Command, out []byte) error {
 	// Get flags output
 	outFlag, err := cmd.Flags().GetString(FlagOutput)
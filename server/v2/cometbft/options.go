@@ -2,6 +2,7 @@ package cometbft
 
 import (
 	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/server/v2/cometbft/handlers"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 	"cosmossdk.io/server/v2/cometbft/types"
@@ -20,6 +21,10 @@ type ServerOptions[T transaction.Tx] struct {
 
 	AddrPeerFilter types.PeerFilter // filter peers by address and port
 	IdPeerFilter   types.PeerFilter // filter peers by node ID
+
+	// Listener receives the blocks, transactions, events and state changes of every finalized block,
+	// for instance to index them. It is optional.
+	Listener appdata.Listener
 }
 
 // DefaultServerOptions returns the default server options.
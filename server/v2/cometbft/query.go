@@ -6,7 +6,10 @@ import (
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	crypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	"google.golang.org/protobuf/proto"
 
+	v1beta1 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/server/v2/cometbft/types"
 	cometerrors "cosmossdk.io/server/v2/cometbft/types/errors"
@@ -84,6 +87,54 @@ func (c *Consensus[T]) handlerQueryApp(ctx context.Context, path []string, req *
 	return nil, errorsmod.Wrapf(cometerrors.ErrUnknownRequest, "unknown query: %s", path)
 }
 
+// handleSimulateWithTrace handles the SimulateWithTrace method of the tx gRPC service.
+// It simulates the tx and returns its result alongside the store operations it performed.
+// A failing tx is not an error of the query: the error is returned in the response,
+// with the store operations performed until the failure.
+func (c *Consensus[T]) handleSimulateWithTrace(ctx context.Context, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+	simReq := &txv1beta1.SimulateWithTraceRequest{}
+	if err := proto.Unmarshal(req.Data, simReq); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decode request")
+	}
+
+	tx, err := c.txCodec.Decode(simReq.TxBytes)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decode tx")
+	}
+
+	txResult, _, ops, err := c.app.SimulateWithTrace(ctx, tx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to simulate tx")
+	}
+
+	res := &txv1beta1.SimulateWithTraceResponse{
+		GasInfo: &v1beta1.GasInfo{
+			GasWanted: txResult.GasWanted,
+			GasUsed:   txResult.GasUsed,
+		},
+		Operations: intoStoreOperations(ops),
+	}
+	if txResult.Error != nil {
+		res.Error = txResult.Error.Error()
+	} else {
+		res.Result, err = intoSimulationResult(txResult, c.indexedEvents)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to convert txResult")
+		}
+	}
+
+	bz, err := proto.Marshal(res)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal response")
+	}
+
+	return &abci.QueryResponse{
+		Codespace: cometerrors.RootCodespace,
+		Value:     bz,
+		Height:    req.Height,
+	}, nil
+}
+
 func (c *Consensus[T]) handleQueryStore(path []string, _ types.Store, req *abci.QueryRequest) (*abci.QueryResponse, error) {
 	req.Path = "/" + strings.Join(path[1:], "/")
 	if req.Height <= 1 && req.Prove {
@@ -109,21 +160,18 @@ func (c *Consensus[T]) handleQueryStore(path []string, _ types.Store, req *abci.
 	}
 
 	if req.Prove {
+		res.ProofOps = &crypto.ProofOps{Ops: make([]crypto.ProofOp, 0, len(qRes.ProofOps))}
 		for _, proof := range qRes.ProofOps {
 			bz, err := proof.Proof.Marshal()
 			if err != nil {
 				return nil, errorsmod.Wrap(err, "failed to marshal proof")
 			}
 
-			res.ProofOps = &crypto.ProofOps{
-				Ops: []crypto.ProofOp{
-					{
-						Type: proof.Type,
-						Key:  proof.Key,
-						Data: bz,
-					},
-				},
-			}
+			res.ProofOps.Ops = append(res.ProofOps.Ops, crypto.ProofOp{
+				Type: proof.Type,
+				Key:  proof.Key,
+				Data: bz,
+			})
 		}
 	}
 
@@ -0,0 +1,183 @@
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	coreappmgr "cosmossdk.io/core/app"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2"
+)
+
+// ReplayResult is the outcome of the replay of a committed block.
+type ReplayResult struct {
+	// Height is the height of the replayed block.
+	Height uint64
+	// TxResults are the results of the replayed txs.
+	TxResults []coreappmgr.TxResult
+	// CommittedAppHash is the AppHash committed by the chain for the block, if known.
+	CommittedAppHash []byte
+	// ReplayedAppHash is the AppHash resulting from the replay.
+	ReplayedAppHash []byte
+	// StateChanges is the number of state changes produced by the replay.
+	StateChanges int
+	// Diffs are the state changes of the replay which differ from the committed state.
+	Diffs []StateDiff
+}
+
+// StateDiff is a key whose value resulting from the replay differs from the committed one.
+type StateDiff struct {
+	StoreKey  []byte
+	Key       []byte
+	Committed []byte
+	Replayed  []byte
+}
+
+// AppHashMatches reports if the replayed AppHash matches the committed one.
+// It returns false if any of the two is unknown.
+func (r *ReplayResult) AppHashMatches() bool {
+	return r.CommittedAppHash != nil && r.ReplayedAppHash != nil &&
+		bytes.Equal(r.CommittedAppHash, r.ReplayedAppHash)
+}
+
+// ReplayBlock re-executes the provided committed block on top of the state at the previous height
+// and compares the resulting state changes with the state committed at the block height.
+// The state is never committed: the replayed AppHash is computed on a branch of the state
+// commitment at the previous height, which must match the AppHash of the block.
+//
+// Only the keys written by the replay are compared with the committed state, divergences on keys
+// written exclusively by the committed block are reported through the AppHash only.
+func (c *Consensus[T]) ReplayBlock(
+	ctx context.Context,
+	block *cmttypes.Block,
+	lastCommit abci.CommitInfo,
+	committedAppHash []byte,
+) (*ReplayResult, error) {
+	if block.Height <= 1 {
+		return nil, fmt.Errorf("invalid block height %d, the block must follow a committed one", block.Height)
+	}
+	height := uint64(block.Height)
+
+	// the replay starts from the state committed at the previous height, which must be the
+	// state the block was executed on.
+	sc := c.store.GetStateCommitment()
+	brancher, ok := sc.(storev2.Brancher)
+	if !ok {
+		return nil, errors.New("the state commitment cannot be branched")
+	}
+	prevCommitInfo, err := sc.GetCommitInfo(height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit info at height %d: %w", height-1, err)
+	}
+	if prevCommitInfo == nil {
+		return nil, fmt.Errorf("no state committed at height %d", height-1)
+	}
+	if prevHash := prevCommitInfo.Hash(); !bytes.Equal(prevHash, block.AppHash) {
+		return nil, fmt.Errorf("state committed at height %d does not match the block: app hash %X, block app hash %X", height-1, prevHash, block.AppHash)
+	}
+	ss := c.store.GetStateStorage()
+	ssVersion, err := ss.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if ssVersion < height-1 {
+		return nil, fmt.Errorf("state storage is at height %d, below the starting height %d", ssVersion, height-1)
+	}
+
+	rawTxs := make([][]byte, len(block.Txs))
+	for i, tx := range block.Txs {
+		rawTxs[i] = tx
+	}
+	decodedTxs, err := decodeTxs(rawTxs, c.txCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	blockReq := &coreappmgr.BlockRequest[T]{
+		Height:  height,
+		Time:    block.Time,
+		Hash:    block.Hash(),
+		AppHash: block.AppHash,
+		ChainId: block.ChainID,
+		Txs:     decodedTxs,
+	}
+
+	ciCtx := contextWithCometInfo(ctx, comet.Info{
+		Evidence:        toCoreEvidence(block.Evidence.Evidence.ToABCI()),
+		ValidatorsHash:  block.NextValidatorsHash,
+		ProposerAddress: block.ProposerAddress,
+		LastCommit:      toCoreCommitInfo(lastCommit),
+	})
+
+	resp, newState, err := c.app.ReplayBlock(ciCtx, blockReq)
+	if err != nil {
+		return nil, err
+	}
+
+	stateChanges, err := newState.GetStateChanges()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReplayResult{
+		Height:           height,
+		TxResults:        resp.TxResults,
+		CommittedAppHash: committedAppHash,
+	}
+
+	// compare the replayed state changes with the state committed at the block height, if available.
+	for _, changes := range stateChanges {
+		result.StateChanges += len(changes.StateChanges)
+		if ssVersion < height {
+			continue
+		}
+		for _, kv := range changes.StateChanges {
+			committed, err := ss.Get(changes.Actor, height, kv.Key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get committed value of key %X in store %s: %w", kv.Key, changes.Actor, err)
+			}
+			replayed := kv.Value
+			if kv.Remove {
+				replayed = nil
+			}
+			if !bytes.Equal(committed, replayed) {
+				result.Diffs = append(result.Diffs, StateDiff{
+					StoreKey:  changes.Actor,
+					Key:       kv.Key,
+					Committed: committed,
+					Replayed:  replayed,
+				})
+			}
+		}
+	}
+
+	if result.CommittedAppHash == nil {
+		commitInfo, err := sc.GetCommitInfo(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commit info at height %d: %w", height, err)
+		}
+		if commitInfo != nil {
+			result.CommittedAppHash = commitInfo.Hash()
+		}
+	}
+
+	branch, err := brancher.Branch(height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to branch the state commitment at height %d: %w", height-1, err)
+	}
+	if err := branch.WriteChangeset(&store.Changeset{Changes: stateChanges}); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to write the replayed changeset: %w", err), branch.Close())
+	}
+	result.ReplayedAppHash = branch.WorkingCommitInfo(height).Hash()
+	if err := branch.Close(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -110,6 +110,7 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger l
 	consensus.extendVote = s.serverOptions.ExtendVoteHandler
 	consensus.addrPeerFilter = s.serverOptions.AddrPeerFilter
 	consensus.idPeerFilter = s.serverOptions.IdPeerFilter
+	consensus.listener = s.serverOptions.Listener
 
 	ss := store.GetStateStorage().(snapshots.StorageSnapshotter)
 	sc := store.GetStateCommitment().(snapshots.CommitSnapshotter)
@@ -240,6 +241,7 @@ func (s *CometBFTServer[T]) CLICommands() serverv2.CLIConfig {
 			s.VersionCmd(),
 			cmtcmd.ResetAllCmd,
 			cmtcmd.ResetStateCmd,
+			s.ReplayBlockCmd(),
 		},
 		Queries: []*cobra.Command{
 			s.QueryBlockCmd(),
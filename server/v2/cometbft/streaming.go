@@ -6,6 +6,8 @@ import (
 	coreappmgr "cosmossdk.io/core/app"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/server/v2/streaming"
 )
 
@@ -75,3 +77,81 @@ func intoStreamingKVPairs(stateChanges []store.StateChanges) []*streaming.StoreK
 	}
 	return streamKvPairs
 }
+
+// listenAppData writes the block, its transactions, events and state changes to the app data listener
+// and commits them. Pre and begin block events are passed with a tx index of -1 and end block events
+// with a tx index of -2.
+func (c *Consensus[T]) listenAppData(
+	height int64,
+	txs [][]byte,
+	resp *coreappmgr.BlockResponse,
+	stateChanges []store.StateChanges,
+) error {
+	listener := c.listener
+	if listener.StartBlock != nil {
+		if err := listener.StartBlock(appdata.StartBlockData{Height: uint64(height)}); err != nil {
+			return err
+		}
+	}
+
+	if listener.OnTx != nil {
+		for i, tx := range txs {
+			if err := listener.OnTx(appdata.TxData{
+				TxIndex: int32(i),
+				Bytes:   func() ([]byte, error) { return tx, nil },
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if listener.OnEvent != nil {
+		var events []appdata.Event
+		appendEvents := func(txIndex int32, evts []event.Event) {
+			for i, evt := range evts {
+				attrs := make([]appdata.EventAttribute, len(evt.Attributes))
+				for j, attr := range evt.Attributes {
+					attrs[j] = appdata.EventAttribute{Key: attr.Key, Value: attr.Value}
+				}
+				events = append(events, appdata.Event{
+					TxIndex:    txIndex,
+					EventIndex: int32(i),
+					Type:       evt.Type,
+					Attributes: func() ([]appdata.EventAttribute, error) { return attrs, nil },
+				})
+			}
+		}
+		appendEvents(-1, append(append([]event.Event{}, resp.PreBlockEvents...), resp.BeginBlockEvents...))
+		for i, txResult := range resp.TxResults {
+			appendEvents(int32(i), txResult.Events)
+		}
+		appendEvents(-2, resp.EndBlockEvents)
+
+		if err := listener.OnEvent(appdata.EventData{Events: events}); err != nil {
+			return err
+		}
+	}
+
+	if listener.OnKVPair != nil {
+		updates := make([]appdata.ActorKVPairUpdate, len(stateChanges))
+		for i, changes := range stateChanges {
+			kvs := make([]schema.KVPairUpdate, len(changes.StateChanges))
+			for j, kv := range changes.StateChanges {
+				kvs[j] = schema.KVPairUpdate{Key: kv.Key, Value: kv.Value, Remove: kv.Remove}
+			}
+			updates[i] = appdata.ActorKVPairUpdate{Actor: changes.Actor, StateChanges: kvs}
+		}
+		if err := listener.OnKVPair(appdata.KVPairData{Updates: updates}); err != nil {
+			return err
+		}
+	}
+
+	if listener.Commit == nil {
+		return nil
+	}
+	completionCallback, err := listener.Commit(appdata.CommitData{})
+	if err != nil || completionCallback == nil {
+		return err
+	}
+	return completionCallback()
+}
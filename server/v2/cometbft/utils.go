@@ -17,10 +17,12 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	v1beta1 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	appmanager "cosmossdk.io/core/app"
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
 	consensus "cosmossdk.io/x/consensus/types"
@@ -147,6 +149,24 @@ func intoABCIEvents(events []event.Event, indexSet map[string]struct{}) []abci.E
 }
 
 func intoABCISimulationResponse(txRes appmanager.TxResult, indexSet map[string]struct{}) ([]byte, error) {
+	result, err := intoSimulationResult(txRes, indexSet)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1beta1.SimulationResponse{
+		GasInfo: &v1beta1.GasInfo{
+			GasWanted: txRes.GasWanted,
+			GasUsed:   txRes.GasUsed,
+		},
+		Result: result,
+	}
+
+	return protojson.Marshal(res)
+}
+
+// intoSimulationResult returns the result of a simulated tx, with its events and message responses.
+func intoSimulationResult(txRes appmanager.TxResult, indexSet map[string]struct{}) (*v1beta1.Result, error) {
 	indexAll := len(indexSet) == 0
 	abciEvents := make([]*abciv1.Event, len(txRes.Events))
 	for i, e := range txRes.Events {
@@ -175,20 +195,33 @@ func intoABCISimulationResponse(txRes appmanager.TxResult, indexSet map[string]s
 		msgResponses[i] = &anypb.Any{TypeUrl: anyMsg.TypeUrl, Value: anyMsg.Value}
 	}
 
-	res := &v1beta1.SimulationResponse{
-		GasInfo: &v1beta1.GasInfo{
-			GasWanted: txRes.GasWanted,
-			GasUsed:   txRes.GasUsed,
-		},
-		Result: &v1beta1.Result{
-			Data:         []byte{},
-			Log:          txRes.Error.Error(),
-			Events:       abciEvents,
-			MsgResponses: msgResponses,
-		},
+	result := &v1beta1.Result{
+		Data:         []byte{},
+		Events:       abciEvents,
+		MsgResponses: msgResponses,
+	}
+	if txRes.Error != nil {
+		result.Log = txRes.Error.Error()
 	}
+	return result, nil
+}
 
-	return protojson.Marshal(res)
+// intoStoreOperations returns the protobuf representation of the store operations traced by a simulation.
+func intoStoreOperations(ops []store.TraceOperation) []*txv1beta1.StoreOperation {
+	operations := make([]*txv1beta1.StoreOperation, len(ops))
+	for i, op := range ops {
+		operations[i] = &txv1beta1.StoreOperation{
+			OperationType: string(op.Type),
+			StoreKey:      op.StoreKey,
+			Key:           op.Key,
+			Collection:    op.Collection,
+			DecodedKey:    op.DecodedKey,
+			OldValue:      op.OldValue,
+			NewValue:      op.NewValue,
+			Gas:           op.Gas,
+		}
+	}
+	return operations
 }
 
 // ToSDKEvidence takes comet evidence and returns sdk evidence
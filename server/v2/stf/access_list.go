@@ -0,0 +1,219 @@
+package stf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+)
+
+// ErrUndeclaredStateAccess is returned when a tx accesses state which is not declared in its access list.
+var ErrUndeclaredStateAccess = errors.New("state access not declared in the tx access list")
+
+// accessListContextKey is the context key under which the access list of the executing tx is stored.
+type accessListContextKey struct{}
+
+// accessList is the state the messages of a tx declared to access, grouped by actor.
+type accessList struct {
+	// reads are the prefixes which are only read.
+	reads map[string][][]byte
+	// writes are the prefixes which are read and written.
+	writes map[string][][]byte
+}
+
+func newAccessList() accessList {
+	return accessList{reads: map[string][][]byte{}, writes: map[string][][]byte{}}
+}
+
+// txAccessList returns the access list declared by the tx, if any.
+func txAccessList[T transaction.Tx](tx T) (accessList, bool) {
+	withAccessList, ok := any(tx).(transaction.TxWithAccessList)
+	if !ok {
+		return accessList{}, false
+	}
+	accesses, ok := withAccessList.GetAccessList()
+	if !ok {
+		return accessList{}, false
+	}
+
+	list := newAccessList()
+	for _, access := range accesses {
+		prefixes := list.writes
+		if access.ReadOnly {
+			prefixes = list.reads
+		}
+		actor := string(access.Actor)
+		if len(access.Prefixes) == 0 {
+			// the empty prefix grants access to the whole state of the actor.
+			prefixes[actor] = append(prefixes[actor], []byte{})
+			continue
+		}
+		prefixes[actor] = append(prefixes[actor], access.Prefixes...)
+	}
+	return list, true
+}
+
+// withAccessList returns a context which enforces the access list declared by the tx, if any.
+func withAccessList[T transaction.Tx](ctx context.Context, tx T) context.Context {
+	list, ok := txAccessList(tx)
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, accessListContextKey{}, list)
+}
+
+// conflicts reports if the two access lists declare any common state which is
+// written by at least one of them. Reading the same state is not a conflict.
+func (a accessList) conflicts(b accessList) bool {
+	return prefixesOverlap(a.writes, b.writes) ||
+		prefixesOverlap(a.writes, b.reads) ||
+		prefixesOverlap(a.reads, b.writes)
+}
+
+// prefixesOverlap reports if any prefix of a overlaps with a prefix of b of the same actor.
+func prefixesOverlap(a, b map[string][][]byte) bool {
+	for actor, prefixes := range a {
+		for _, p := range prefixes {
+			for _, other := range b[actor] {
+				if bytes.HasPrefix(p, other) || bytes.HasPrefix(other, p) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// merge adds the state declared by b to the access list.
+func (a accessList) merge(b accessList) {
+	for actor, prefixes := range b.reads {
+		a.reads[actor] = append(a.reads[actor], prefixes...)
+	}
+	for actor, prefixes := range b.writes {
+		a.writes[actor] = append(a.writes[actor], prefixes...)
+	}
+}
+
+// allowsKey reports if the key of the provided actor state was declared,
+// as written if write is true.
+func (a accessList) allowsKey(actor string, key []byte, write bool) bool {
+	if hasKeyPrefix(a.writes[actor], key) {
+		return true
+	}
+	return !write && hasKeyPrefix(a.reads[actor], key)
+}
+
+// allowsRange reports if the [start, end) domain of the provided actor state was declared.
+func (a accessList) allowsRange(actor string, start, end []byte) bool {
+	return coversRange(a.writes[actor], start, end) || coversRange(a.reads[actor], start, end)
+}
+
+func hasKeyPrefix(prefixes [][]byte, key []byte) bool {
+	for _, p := range prefixes {
+		if bytes.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func coversRange(prefixes [][]byte, start, end []byte) bool {
+	for _, p := range prefixes {
+		if len(p) == 0 {
+			return true
+		}
+		if start == nil || !bytes.HasPrefix(start, p) {
+			continue
+		}
+		pEnd := prefixEnd(p)
+		if pEnd == nil || (end != nil && bytes.Compare(end, pEnd) <= 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixEnd returns the first key which does not have the provided prefix,
+// or nil if no such key exists.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+var _ store.KVStore = accessListStore{}
+
+// accessListStore is a store.KVStore which fails when accessing state not
+// declared in the access list of the executing tx, or writing read only state.
+type accessListStore struct {
+	store.KVStore
+	actor string
+	list  accessList
+}
+
+func (s accessListStore) checkKey(key []byte, write bool) error {
+	if !s.list.allowsKey(s.actor, key, write) {
+		if write {
+			return fmt.Errorf("%w: write to store %s, key %X", ErrUndeclaredStateAccess, s.actor, key)
+		}
+		return fmt.Errorf("%w: store %s, key %X", ErrUndeclaredStateAccess, s.actor, key)
+	}
+	return nil
+}
+
+func (s accessListStore) checkRange(start, end []byte) error {
+	if !s.list.allowsRange(s.actor, start, end) {
+		return fmt.Errorf("%w: store %s, range [%X, %X)", ErrUndeclaredStateAccess, s.actor, start, end)
+	}
+	return nil
+}
+
+func (s accessListStore) Get(key []byte) ([]byte, error) {
+	if err := s.checkKey(key, false); err != nil {
+		return nil, err
+	}
+	return s.KVStore.Get(key)
+}
+
+func (s accessListStore) Has(key []byte) (bool, error) {
+	if err := s.checkKey(key, false); err != nil {
+		return false, err
+	}
+	return s.KVStore.Has(key)
+}
+
+func (s accessListStore) Set(key, value []byte) error {
+	if err := s.checkKey(key, true); err != nil {
+		return err
+	}
+	return s.KVStore.Set(key, value)
+}
+
+func (s accessListStore) Delete(key []byte) error {
+	if err := s.checkKey(key, true); err != nil {
+		return err
+	}
+	return s.KVStore.Delete(key)
+}
+
+func (s accessListStore) Iterator(start, end []byte) (store.Iterator, error) {
+	if err := s.checkRange(start, end); err != nil {
+		return nil, err
+	}
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s accessListStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	if err := s.checkRange(start, end); err != nil {
+		return nil, err
+	}
+	return s.KVStore.ReverseIterator(start, end)
+}
@@ -0,0 +1,73 @@
+package stf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessList(t *testing.T) {
+	list := accessList{
+		reads: map[string][][]byte{
+			"bank":    {[]byte("params")},
+			"staking": {[]byte{}},
+		},
+		writes: map[string][][]byte{
+			"bank": {[]byte("balances/alice"), []byte{0xff, 0xff}},
+			"auth": {[]byte{}},
+		},
+	}
+
+	t.Run("keys", func(t *testing.T) {
+		require.True(t, list.allowsKey("bank", []byte("balances/alice"), true))
+		require.True(t, list.allowsKey("bank", []byte("balances/alice/stake"), false))
+		require.False(t, list.allowsKey("bank", []byte("balances/bob"), false))
+		require.True(t, list.allowsKey("auth", []byte("anything"), true))
+		require.True(t, list.allowsKey("bank", []byte("params"), false))
+		require.False(t, list.allowsKey("bank", []byte("params"), true))
+		require.True(t, list.allowsKey("staking", []byte("balances/alice"), false))
+		require.False(t, list.allowsKey("staking", []byte("balances/alice"), true))
+		require.False(t, list.allowsKey("gov", []byte("balances/alice"), false))
+	})
+
+	t.Run("ranges", func(t *testing.T) {
+		require.True(t, list.allowsRange("bank", []byte("balances/alice"), []byte("balances/alicf")))
+		require.True(t, list.allowsRange("bank", []byte("balances/alice/a"), []byte("balances/alice/b")))
+		require.False(t, list.allowsRange("bank", []byte("balances/alice"), []byte("balances/bob")))
+		require.False(t, list.allowsRange("bank", []byte("balances/alice"), nil))
+		require.False(t, list.allowsRange("bank", nil, []byte("balances/alicf")))
+		require.True(t, list.allowsRange("bank", []byte{0xff, 0xff, 0x01}, nil))
+		require.True(t, list.allowsRange("bank", []byte("params"), []byte("paramt")))
+		require.True(t, list.allowsRange("auth", nil, nil))
+		require.True(t, list.allowsRange("staking", nil, nil))
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		writes := func(actor string, prefix []byte) accessList {
+			l := newAccessList()
+			l.writes[actor] = [][]byte{prefix}
+			return l
+		}
+		reads := func(actor string, prefix []byte) accessList {
+			l := newAccessList()
+			l.reads[actor] = [][]byte{prefix}
+			return l
+		}
+		require.True(t, list.conflicts(writes("bank", []byte("balances/"))))
+		require.True(t, list.conflicts(writes("bank", []byte("balances/alice/stake"))))
+		require.True(t, list.conflicts(writes("auth", []byte("accounts/bob"))))
+		require.True(t, list.conflicts(reads("auth", []byte("accounts/bob"))))
+		require.True(t, list.conflicts(writes("bank", []byte("params/send"))))
+		require.True(t, list.conflicts(writes("staking", []byte{})))
+		require.False(t, list.conflicts(writes("bank", []byte("balances/bob"))))
+		require.False(t, list.conflicts(reads("bank", []byte("params/send"))))
+		require.False(t, list.conflicts(reads("staking", []byte{})))
+		require.False(t, list.conflicts(writes("gov", []byte{})))
+	})
+}
+
+func TestPrefixEnd(t *testing.T) {
+	require.Equal(t, []byte("b"), prefixEnd([]byte("a")))
+	require.Equal(t, []byte{0x01, 0x03}, prefixEnd([]byte{0x01, 0x02, 0xff}))
+	require.Nil(t, prefixEnd([]byte{0xff, 0xff}))
+}
@@ -0,0 +1,39 @@
+package stf
+
+// Config defines the optional execution settings of the STF.
+type Config struct {
+	// ParallelExecutionWorkers is the number of workers used to optimistically
+	// execute the txs of a block concurrently. Values lower than 2 keep the
+	// default sequential execution.
+	ParallelExecutionWorkers int `mapstructure:"parallel-execution-workers"`
+}
+
+// DefaultConfig returns the default STF configuration, which executes txs sequentially.
+func DefaultConfig() Config {
+	return Config{
+		ParallelExecutionWorkers: 0,
+	}
+}
+
+// Option is a function that customizes the STF configuration.
+type Option func(*Config)
+
+// WithConfig replaces the STF configuration with the provided one.
+func WithConfig(cfg Config) Option {
+	return func(c *Config) {
+		*c = cfg
+	}
+}
+
+// WithParallelExecution enables optimistic parallel execution of the txs of a block
+// using the provided number of workers.
+func WithParallelExecution(workers int) Option {
+	return func(c *Config) {
+		c.ParallelExecutionWorkers = workers
+	}
+}
+
+// parallelExecutionEnabled reports if the txs of a block should be executed in parallel.
+func (c Config) parallelExecutionEnabled() bool {
+	return c.ParallelExecutionWorkers > 1
+}
@@ -25,6 +25,10 @@ func (s storeService) OpenKVStore(ctx context.Context) store.KVStore {
 	if err != nil {
 		panic(err)
 	}
+	// if the executing tx declared an access list, then it can only access the declared state.
+	if list, ok := ctx.Value(accessListContextKey{}).(accessList); ok {
+		return accessListStore{KVStore: state, actor: string(s.actor), list: list}
+	}
 	return state
 }
 
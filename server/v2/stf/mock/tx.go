@@ -12,12 +12,14 @@ import (
 	"cosmossdk.io/core/transaction"
 )
 
-var _ transaction.Tx = Tx{}
+var _ transaction.TxWithAccessList = Tx{}
 
 type Tx struct {
 	Sender   []byte
 	Msg      transaction.Msg
 	GasLimit uint64
+	// AccessList is the optional state accessed by the tx, it is not encoded.
+	AccessList []transaction.StateAccess
 }
 
 func (t Tx) Hash() [32]byte {
@@ -42,6 +44,10 @@ func (t Tx) GetGasLimit() (uint64, error) {
 	return t.GasLimit, nil
 }
 
+func (t Tx) GetAccessList() ([]transaction.StateAccess, bool) {
+	return t.AccessList, t.AccessList != nil
+}
+
 type encodedTx struct {
 	Sender   []byte         `json:"sender"`
 	Msg      *gogoproto.Any `json:"message"`
@@ -0,0 +1,563 @@
+package stf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	appmanager "cosmossdk.io/core/app"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+)
+
+// speculativeTx holds the outcome of the optimistic execution of a tx.
+type speculativeTx struct {
+	gasLimit    uint64
+	gasLimitErr error
+
+	// validation holds the reads and writes of the tx validation.
+	validation       speculativeStep
+	validateGas      uint64
+	validationEvents []event.Event
+	validationErr    error
+
+	// exec holds the reads and writes of the message execution, it is
+	// only run if the validation succeeded.
+	exec       speculativeStep
+	execResp   []transaction.Msg
+	execGas    uint64
+	execEvents []event.Event
+	execErr    error
+}
+
+// speculativeStep holds the reads and writes of a step of the optimistic execution of a tx.
+type speculativeStep struct {
+	changes []store.StateChanges
+	reads   *readSet
+	// err is set if the step cannot be committed, in which case it is re-executed.
+	err error
+}
+
+// valid reports if the step observed the same values it would observe in the provided state.
+func (st speculativeStep) valid(state store.ReaderMap) bool {
+	return st.err == nil && st.reads != nil && st.reads.validate(state)
+}
+
+// deliverTxsParallel executes the txs of a block following a Block-STM style approach.
+//
+// The txs are split in waves, using their access lists to keep txs declaring conflicting
+// state in different waves. Access lists only cover the state accessed by the messages,
+// the state accessed by the tx validation (e.g. fees and sequences) is not declared, so
+// the txs of a wave are first validated one after the other on a branch of the provided
+// state. Then the messages of the validated txs are executed concurrently, each tx on its
+// own branch of the validated state. Both steps record every read that reaches the state
+// (their read set) and collect their writes (their write set).
+//
+// Finally the txs are committed in block order: if the values observed by a step are still
+// the same in the state which contains the writes of all the previous txs, then the
+// speculative execution is equivalent to the sequential one and its write set is applied.
+// Otherwise the tx is re-executed on the up-to-date state. This makes the results and the
+// resulting state identical to the ones of sequential execution.
+func (s STF[T]) deliverTxsParallel(
+	ctx context.Context,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]appmanager.TxResult, error) {
+	txResults := make([]appmanager.TxResult, len(txs))
+	for start := 0; start < len(txs); {
+		end := nextWave(txs, start)
+		// a wave made of a single tx gains nothing from speculation.
+		if end-start == 1 {
+			if err := isCtxCancelled(ctx); err != nil {
+				return nil, err
+			}
+			txResults[start] = s.deliverTx(ctx, state, txs[start], transaction.ExecModeFinalize, hi)
+			start = end
+			continue
+		}
+
+		speculative, err := s.executeSpeculatively(ctx, state, txs[start:end], hi)
+		if err != nil {
+			return nil, err
+		}
+		for i, spec := range speculative {
+			// check if we need to return early or continue delivering txs
+			if err := isCtxCancelled(ctx); err != nil {
+				return nil, err
+			}
+			txResults[start+i], err = s.commitSpeculativeTx(ctx, state, txs[start+i], spec, hi)
+			if err != nil {
+				return nil, err
+			}
+		}
+		start = end
+	}
+	return txResults, nil
+}
+
+// nextWave returns the end of the wave of txs starting at the provided index.
+// A wave ends before the first tx whose access list conflicts with the access
+// lists of the txs already in the wave. Txs which do not declare an access list
+// are always added to the current wave, relying on the validation of their reads.
+func nextWave[T transaction.Tx](txs []T, start int) int {
+	declared := newAccessList()
+	end := start
+	for ; end < len(txs); end++ {
+		list, ok := txAccessList(txs[end])
+		if !ok {
+			continue
+		}
+		if end > start && declared.conflicts(list) {
+			break
+		}
+		declared.merge(list)
+	}
+	return end
+}
+
+// executeSpeculatively executes all the provided txs on top of the provided state.
+// The txs are validated sequentially, then their messages are executed concurrently.
+// The state is not modified, the txs write to branches of it.
+func (s STF[T]) executeSpeculatively(
+	ctx context.Context,
+	state store.ReaderMap,
+	txs []T,
+	hi header.Info,
+) ([]*speculativeTx, error) {
+	speculative := make([]*speculativeTx, len(txs))
+	validationState := s.branchFn(state)
+	for i, tx := range txs {
+		if err := isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+		speculative[i] = s.validateSpeculativeTx(ctx, validationState, tx)
+	}
+
+	// the validated state is shared across workers, so access to it is serialized.
+	snapshot := lockedReaderMap{mu: &sync.Mutex{}, state: validationState}
+
+	workers := min(s.config.ParallelExecutionWorkers, len(txs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				s.executeSpeculativeMsgs(ctx, snapshot, txs[i], speculative[i], hi)
+			}
+		}()
+	}
+
+	for i, spec := range speculative {
+		// stop scheduling txs if the execution was cancelled, the commit
+		// step will report the error.
+		if isCtxCancelled(ctx) != nil {
+			break
+		}
+		if spec.gasLimitErr != nil || spec.validationErr != nil {
+			continue
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return speculative, nil
+}
+
+// validateSpeculativeTx validates a single tx on a branch of the provided state, tracking its reads.
+// If the validation succeeds its writes are applied to the provided state.
+func (s STF[T]) validateSpeculativeTx(ctx context.Context, state store.WriterMap, tx T) (spec *speculativeTx) {
+	spec = &speculativeTx{}
+	spec.gasLimit, spec.gasLimitErr = tx.GetGasLimit()
+	if spec.gasLimitErr != nil {
+		return spec
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			spec.validation = speculativeStep{err: fmt.Errorf("panic during tx validation: %s", r)}
+		}
+	}()
+
+	reads := &readSet{}
+	txState := s.branchFn(trackingReaderMap{state: state, reads: reads})
+	spec.validateGas, spec.validationEvents, spec.validationErr = s.validateTx(ctx, txState, spec.gasLimit, tx, transaction.ExecModeFinalize)
+	changes, err := txState.GetStateChanges()
+	if err == nil && spec.validationErr == nil {
+		err = state.ApplyStateChanges(changes)
+	}
+	spec.validation = speculativeStep{changes: changes, reads: reads, err: err}
+	return spec
+}
+
+// executeSpeculativeMsgs executes the messages of a validated tx on a branch of the provided
+// state, tracking its reads.
+func (s STF[T]) executeSpeculativeMsgs(
+	ctx context.Context,
+	state store.ReaderMap,
+	tx T,
+	spec *speculativeTx,
+	hi header.Info,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			spec.exec = speculativeStep{err: fmt.Errorf("panic during transaction execution: %s", r)}
+		}
+	}()
+
+	reads := &readSet{}
+	txState := s.branchFn(trackingReaderMap{state: state, reads: reads})
+	spec.execResp, spec.execGas, spec.execEvents, spec.execErr = s.execTx(
+		ctx, txState, spec.gasLimit, spec.validateGas, tx, transaction.ExecModeFinalize, hi,
+	)
+	changes, err := txState.GetStateChanges()
+	spec.exec = speculativeStep{changes: changes, reads: reads, err: err}
+}
+
+// commitSpeculativeTx applies the outcome of the speculative execution of a tx to the state and
+// returns its result. The txs whose steps observed values since modified by the previous txs,
+// or failed to complete, are re-executed on top of their writes.
+func (s STF[T]) commitSpeculativeTx(
+	ctx context.Context,
+	state store.WriterMap,
+	tx T,
+	spec *speculativeTx,
+	hi header.Info,
+) (appmanager.TxResult, error) {
+	if spec.gasLimitErr != nil {
+		return appmanager.TxResult{Error: spec.gasLimitErr}, nil
+	}
+	if !spec.validation.valid(state) {
+		return s.deliverTx(ctx, state, tx, transaction.ExecModeFinalize, hi), nil
+	}
+	if spec.validationErr != nil {
+		return appmanager.TxResult{Error: spec.validationErr}, nil
+	}
+	// the validation is applied to a branch of the state, so that the tx can be delivered anew if
+	// its message execution failed to complete, e.g. because it panicked, or observed values since
+	// modified. The re-execution goes through the sequential path, which recovers from panics.
+	txState := s.branchFn(state)
+	if err := txState.ApplyStateChanges(spec.validation.changes); err != nil {
+		return appmanager.TxResult{}, err
+	}
+	if !spec.exec.valid(txState) {
+		return s.deliverTx(ctx, state, tx, transaction.ExecModeFinalize, hi), nil
+	}
+	if err := txState.ApplyStateChanges(spec.exec.changes); err != nil {
+		return appmanager.TxResult{}, err
+	}
+	if err := applyStateChanges(state, txState); err != nil {
+		return appmanager.TxResult{}, err
+	}
+	return appmanager.TxResult{
+		Events:    append(spec.validationEvents, spec.execEvents...),
+		GasUsed:   spec.execGas + spec.validateGas,
+		GasWanted: spec.gasLimit,
+		Resp:      spec.execResp,
+		Error:     spec.execErr,
+	}, nil
+}
+
+// readKind defines the kind of read operation recorded in a read set.
+type readKind uint8
+
+const (
+	readKindGet readKind = iota
+	readKindHas
+	readKindIterator
+	readKindReverseIterator
+)
+
+// read is a single read operation performed by a tx, alongside with what it observed.
+type read struct {
+	kind  readKind
+	actor []byte
+	// key is the key read by get and has operations.
+	key []byte
+	// value is the value observed by get operations.
+	value []byte
+	// found reports if the key existed at the time of the read.
+	found bool
+	// start and end are the domain of iterators.
+	start, end []byte
+	// entries are the key value pairs the iterator was positioned on, in order.
+	entries []store.KVPair
+	// exhausted reports if the iterator was observed as no longer valid.
+	exhausted bool
+}
+
+// readSet contains all the reads performed by a tx on the state it was executed on.
+// It is only accessed by the goroutine executing the tx.
+type readSet struct {
+	reads []*read
+	// failed reports if any read returned an error, in which case the
+	// speculative execution cannot be validated.
+	failed bool
+}
+
+func (rs *readSet) add(r *read) {
+	rs.reads = append(rs.reads, r)
+}
+
+// validate reports if all the reads in the set observe the same values in the provided state.
+func (rs *readSet) validate(state store.ReaderMap) bool {
+	if rs.failed {
+		return false
+	}
+	for _, r := range rs.reads {
+		reader, err := state.GetReader(r.actor)
+		if err != nil {
+			return false
+		}
+		if !r.validate(reader) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *read) validate(reader store.Reader) bool {
+	switch r.kind {
+	case readKindGet:
+		value, err := reader.Get(r.key)
+		return err == nil && (value != nil) == r.found && bytes.Equal(value, r.value)
+	case readKindHas:
+		has, err := reader.Has(r.key)
+		return err == nil && has == r.found
+	case readKindIterator, readKindReverseIterator:
+		var (
+			iter store.Iterator
+			err  error
+		)
+		if r.kind == readKindIterator {
+			iter, err = reader.Iterator(r.start, r.end)
+		} else {
+			iter, err = reader.ReverseIterator(r.start, r.end)
+		}
+		if err != nil {
+			return false
+		}
+		defer iter.Close()
+
+		for _, entry := range r.entries {
+			if !iter.Valid() || !bytes.Equal(iter.Key(), entry.Key) || !bytes.Equal(iter.Value(), entry.Value) {
+				return false
+			}
+			iter.Next()
+		}
+		if r.exhausted && iter.Valid() {
+			return false
+		}
+		return iter.Error() == nil
+	default:
+		return false
+	}
+}
+
+var (
+	_ store.ReaderMap = trackingReaderMap{}
+	_ store.Reader    = trackingReader{}
+	_ store.Iterator  = (*trackingIterator)(nil)
+)
+
+// trackingReaderMap is a store.ReaderMap which records all the reads in a read set.
+type trackingReaderMap struct {
+	state store.ReaderMap
+	reads *readSet
+}
+
+func (t trackingReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	reader, err := t.state.GetReader(actor)
+	if err != nil {
+		t.reads.failed = true
+		return nil, err
+	}
+	return trackingReader{actor: bytes.Clone(actor), state: reader, reads: t.reads}, nil
+}
+
+// trackingReader is a store.Reader which records all the reads in a read set.
+type trackingReader struct {
+	actor []byte
+	state store.Reader
+	reads *readSet
+}
+
+func (t trackingReader) Has(key []byte) (bool, error) {
+	has, err := t.state.Has(key)
+	if err != nil {
+		t.reads.failed = true
+		return false, err
+	}
+	t.reads.add(&read{kind: readKindHas, actor: t.actor, key: bytes.Clone(key), found: has})
+	return has, nil
+}
+
+func (t trackingReader) Get(key []byte) ([]byte, error) {
+	value, err := t.state.Get(key)
+	if err != nil {
+		t.reads.failed = true
+		return nil, err
+	}
+	t.reads.add(&read{kind: readKindGet, actor: t.actor, key: bytes.Clone(key), value: bytes.Clone(value), found: value != nil})
+	return value, nil
+}
+
+func (t trackingReader) Iterator(start, end []byte) (store.Iterator, error) {
+	iter, err := t.state.Iterator(start, end)
+	if err != nil {
+		t.reads.failed = true
+		return nil, err
+	}
+	return t.track(iter, readKindIterator, start, end), nil
+}
+
+func (t trackingReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	iter, err := t.state.ReverseIterator(start, end)
+	if err != nil {
+		t.reads.failed = true
+		return nil, err
+	}
+	return t.track(iter, readKindReverseIterator, start, end), nil
+}
+
+func (t trackingReader) track(iter store.Iterator, kind readKind, start, end []byte) store.Iterator {
+	r := &read{kind: kind, actor: t.actor, start: bytes.Clone(start), end: bytes.Clone(end)}
+	t.reads.add(r)
+	tracked := &trackingIterator{Iterator: iter, read: r}
+	tracked.observe()
+	return tracked
+}
+
+// trackingIterator records every entry the underlying iterator is positioned on.
+type trackingIterator struct {
+	store.Iterator
+	read *read
+}
+
+func (t *trackingIterator) Next() {
+	t.Iterator.Next()
+	t.observe()
+}
+
+func (t *trackingIterator) observe() {
+	if !t.Iterator.Valid() {
+		t.read.exhausted = true
+		return
+	}
+	t.read.entries = append(t.read.entries, store.KVPair{
+		Key:   bytes.Clone(t.Iterator.Key()),
+		Value: bytes.Clone(t.Iterator.Value()),
+	})
+}
+
+var (
+	_ store.ReaderMap = lockedReaderMap{}
+	_ store.Reader    = lockedReader{}
+	_ store.Iterator  = lockedIterator{}
+)
+
+// lockedReaderMap serializes the access to a store.ReaderMap which is not safe for concurrent use.
+type lockedReaderMap struct {
+	mu    *sync.Mutex
+	state store.ReaderMap
+}
+
+func (l lockedReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	reader, err := l.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	return lockedReader{mu: l.mu, state: reader}, nil
+}
+
+type lockedReader struct {
+	mu    *sync.Mutex
+	state store.Reader
+}
+
+func (l lockedReader) Has(key []byte) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.state.Has(key)
+}
+
+func (l lockedReader) Get(key []byte) ([]byte, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.state.Get(key)
+}
+
+func (l lockedReader) Iterator(start, end []byte) (store.Iterator, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	iter, err := l.state.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return lockedIterator{mu: l.mu, iter: iter}, nil
+}
+
+func (l lockedReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	iter, err := l.state.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return lockedIterator{mu: l.mu, iter: iter}, nil
+}
+
+type lockedIterator struct {
+	mu   *sync.Mutex
+	iter store.Iterator
+}
+
+func (l lockedIterator) Domain() (start, end []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.iter.Domain()
+}
+
+func (l lockedIterator) Valid() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.iter.Valid()
+}
+
+func (l lockedIterator) Next() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.iter.Next()
+}
+
+func (l lockedIterator) Key() []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.iter.Key()
+}
+
+func (l lockedIterator) Value() []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.iter.Value()
+}
+
+func (l lockedIterator) Error() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.iter.Error()
+}
+
+func (l lockedIterator) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.iter.Close()
+}
@@ -0,0 +1,414 @@
+package stf
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	appmanager "cosmossdk.io/core/app"
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/stf/branch"
+	"cosmossdk.io/server/v2/stf/gas"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+var (
+	bankActor = []byte("bank")
+	authActor = []byte("auth")
+)
+
+const initialBalance = 10
+
+var feeCollector = []byte("fee-collector")
+
+// newBankSTF returns an STF which mints initialBalance to the provided accounts at begin block,
+// increments the sender sequence and the fees collected during tx validation and transfers one
+// token from the sender to the recipient defined by the message. Messages holding a string panic
+// after writing to the sender balance.
+func newBankSTF(tb testing.TB, accounts [][]byte, workers int) *STF[mock.Tx] {
+	tb.Helper()
+	s := &STF[mock.Tx]{
+		doPreBlock: func(ctx context.Context, txs []mock.Tx) error { return nil },
+		doBeginBlock: func(ctx context.Context) error {
+			for _, acc := range accounts {
+				if err := setUint64(ctx, bankActor, acc, initialBalance); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		doEndBlock:        func(ctx context.Context) error { return nil },
+		doValidatorUpdate: func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error) { return nil, nil },
+		doTxValidation: func(ctx context.Context, tx mock.Tx) error {
+			seq, err := getUint64(ctx, authActor, tx.Sender)
+			if err != nil {
+				return err
+			}
+			if err := setUint64(ctx, authActor, tx.Sender, seq+1); err != nil {
+				return err
+			}
+			fees, err := getUint64(ctx, bankActor, feeCollector)
+			if err != nil {
+				return err
+			}
+			return setUint64(ctx, bankActor, feeCollector, fees+1)
+		},
+		postTxExec:          func(ctx context.Context, tx mock.Tx, success bool) error { return nil },
+		branchFn:            branch.DefaultNewWriterMap,
+		makeGasMeter:        gas.DefaultGasMeter,
+		makeGasMeteredState: gas.DefaultWrapWithGasMeter,
+		config:              Config{ParallelExecutionWorkers: workers},
+		logger:              nopLogger{},
+	}
+
+	msgRouterBuilder := NewMsgRouterBuilder()
+	require.NoError(tb, msgRouterBuilder.RegisterHandler(
+		msgTypeURL(&gogotypes.BytesValue{}),
+		func(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+			sender := ctx.(*executionContext).sender
+			recipient := msg.(*gogotypes.BytesValue).Value
+			senderBalance, err := getUint64(ctx, bankActor, sender)
+			if err != nil {
+				return nil, err
+			}
+			if senderBalance == 0 {
+				return nil, errors.New("insufficient funds")
+			}
+			recipientBalance, err := getUint64(ctx, bankActor, recipient)
+			if err != nil {
+				return nil, err
+			}
+			if err := setUint64(ctx, bankActor, sender, senderBalance-1); err != nil {
+				return nil, err
+			}
+			if err := setUint64(ctx, bankActor, recipient, recipientBalance+1); err != nil {
+				return nil, err
+			}
+			return &gogotypes.UInt64Value{Value: senderBalance - 1}, nil
+		},
+	))
+	require.NoError(tb, msgRouterBuilder.RegisterHandler(
+		msgTypeURL(&gogotypes.StringValue{}),
+		func(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+			if err := setUint64(ctx, bankActor, ctx.(*executionContext).sender, 0); err != nil {
+				return nil, err
+			}
+			panic(msg.(*gogotypes.StringValue).Value)
+		},
+	))
+	msgRouter, err := msgRouterBuilder.Build()
+	require.NoError(tb, err)
+	s.msgRouter = msgRouter
+	return s
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Impl() any            { return nil }
+
+func getUint64(ctx context.Context, actor, key []byte) (uint64, error) {
+	bz, err := NewKVStoreService(actor).OpenKVStore(ctx).Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func setUint64(ctx context.Context, actor, key []byte, v uint64) error {
+	return NewKVStoreService(actor).OpenKVStore(ctx).Set(key, binary.BigEndian.AppendUint64(nil, v))
+}
+
+func bankAccounts(n int) [][]byte {
+	accounts := make([][]byte, n)
+	for i := range accounts {
+		accounts[i] = []byte(fmt.Sprintf("account-%d", i))
+	}
+	return accounts
+}
+
+func bankSend(from, to []byte) mock.Tx {
+	return mock.Tx{
+		Sender:   from,
+		Msg:      &gogotypes.BytesValue{Value: to},
+		GasLimit: 100_000,
+	}
+}
+
+// withBankAccessList declares the state accessed by the message of a bank send to the
+// provided recipient, the state accessed by the tx validation is not declared.
+func withBankAccessList(tx mock.Tx, to []byte) mock.Tx {
+	tx.AccessList = []transaction.StateAccess{
+		{Actor: bankActor, Prefixes: [][]byte{tx.Sender, to}},
+	}
+	return tx
+}
+
+func bankBlock(txs []mock.Tx) *appmanager.BlockRequest[mock.Tx] {
+	sum := sha256.Sum256([]byte("bank-block"))
+	return &appmanager.BlockRequest[mock.Tx]{
+		Height:  uint64(1),
+		Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+		AppHash: sum[:],
+		Hash:    sum[:],
+		Txs:     txs,
+	}
+}
+
+// sortedStateChanges returns the state changes of the provided state sorted by actor.
+func sortedStateChanges(t *testing.T, state store.WriterMap) []store.StateChanges {
+	t.Helper()
+	changes, err := state.GetStateChanges()
+	require.NoError(t, err)
+	slices.SortFunc(changes, func(a, b store.StateChanges) int {
+		return bytes.Compare(a.Actor, b.Actor)
+	})
+	return changes
+}
+
+func TestDeliverBlockParallel(t *testing.T) {
+	accounts := bankAccounts(8)
+
+	testCases := map[string][]mock.Tx{
+		"independent sends": func() []mock.Tx {
+			txs := make([]mock.Tx, 0, len(accounts)/2)
+			for i := 0; i < len(accounts); i += 2 {
+				txs = append(txs, bankSend(accounts[i], accounts[i+1]))
+			}
+			return txs
+		}(),
+		"chained sends": func() []mock.Tx {
+			txs := make([]mock.Tx, 0, len(accounts)-1)
+			for i := 0; i < len(accounts)-1; i++ {
+				txs = append(txs, bankSend(accounts[i], accounts[i+1]))
+			}
+			return txs
+		}(),
+		"same sender until insufficient funds": func() []mock.Tx {
+			txs := make([]mock.Tx, 0, initialBalance+5)
+			for i := 0; i < initialBalance+5; i++ {
+				txs = append(txs, bankSend(accounts[0], accounts[1+i%(len(accounts)-1)]))
+			}
+			return txs
+		}(),
+		"mixed": func() []mock.Tx {
+			txs := make([]mock.Tx, 0, 64)
+			for i := 0; i < 64; i++ {
+				txs = append(txs, bankSend(accounts[(i*3)%len(accounts)], accounts[(i*5+1)%len(accounts)]))
+			}
+			return txs
+		}(),
+		"invalid tx": {
+			bankSend(accounts[0], accounts[1]),
+			{Sender: accounts[2], GasLimit: 100_000},
+			bankSend(accounts[1], accounts[0]),
+		},
+	}
+
+	for name, txs := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sequential := newBankSTF(t, accounts, 0)
+			parallel := newBankSTF(t, accounts, 4)
+
+			wantResult, wantState, err := sequential.DeliverBlock(context.Background(), bankBlock(txs), mock.DB())
+			require.NoError(t, err)
+			gotResult, gotState, err := parallel.DeliverBlock(context.Background(), bankBlock(txs), mock.DB())
+			require.NoError(t, err)
+
+			require.Equal(t, wantResult, gotResult)
+			require.Equal(t, sortedStateChanges(t, wantState), sortedStateChanges(t, gotState))
+		})
+	}
+}
+
+func TestDeliverBlockParallelPanic(t *testing.T) {
+	accounts := bankAccounts(4)
+	panicking := mock.Tx{Sender: accounts[2], Msg: &gogotypes.StringValue{Value: "handler panic"}, GasLimit: 100_000}
+	txs := []mock.Tx{
+		bankSend(accounts[0], accounts[1]),
+		panicking,
+		bankSend(accounts[2], accounts[3]),
+		panicking,
+	}
+
+	sequential := newBankSTF(t, accounts, 0)
+	parallel := newBankSTF(t, accounts, 4)
+
+	wantResult, wantState, err := sequential.DeliverBlock(context.Background(), bankBlock(txs), mock.DB())
+	require.NoError(t, err)
+	gotResult, gotState, err := parallel.DeliverBlock(context.Background(), bankBlock(txs), mock.DB())
+	require.NoError(t, err)
+
+	require.Equal(t, wantResult, gotResult)
+	require.Equal(t, sortedStateChanges(t, wantState), sortedStateChanges(t, gotState))
+	for i, res := range gotResult.TxResults {
+		if txs[i].Msg == panicking.Msg {
+			require.ErrorContains(t, res.Error, "panic during transaction execution: handler panic")
+			continue
+		}
+		require.NoError(t, res.Error)
+	}
+}
+
+func TestDeliverBlockParallelCancelled(t *testing.T) {
+	accounts := bankAccounts(2)
+	s := newBankSTF(t, accounts, 4)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.doTxValidation = func(context.Context, mock.Tx) error {
+		cancel()
+		return nil
+	}
+	_, _, err := s.DeliverBlock(ctx, bankBlock([]mock.Tx{
+		bankSend(accounts[0], accounts[1]),
+		bankSend(accounts[1], accounts[0]),
+	}), mock.DB())
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDeliverBlockParallelAccessList(t *testing.T) {
+	accounts := bankAccounts(8)
+
+	txs := make([]mock.Tx, 0, 2*len(accounts))
+	// independent sends
+	for i := 0; i < len(accounts); i += 2 {
+		txs = append(txs, withBankAccessList(bankSend(accounts[i], accounts[i+1]), accounts[i+1]))
+	}
+	// chained sends, each one overlapping with the previous one
+	for i := 0; i < len(accounts)-1; i++ {
+		txs = append(txs, withBankAccessList(bankSend(accounts[i], accounts[i+1]), accounts[i+1]))
+	}
+	// send without an access list
+	txs = append(txs, bankSend(accounts[3], accounts[0]))
+	// send to an undeclared recipient
+	undeclared := len(txs)
+	txs = append(txs, withBankAccessList(bankSend(accounts[4], accounts[5]), accounts[6]))
+
+	sequential := newBankSTF(t, accounts, 0)
+	parallel := newBankSTF(t, accounts, 4)
+
+	wantResult, wantState, err := sequential.DeliverBlock(context.Background(), bankBlock(txs), mock.DB())
+	require.NoError(t, err)
+	gotResult, gotState, err := parallel.DeliverBlock(context.Background(), bankBlock(txs), mock.DB())
+	require.NoError(t, err)
+
+	require.Equal(t, wantResult, gotResult)
+	require.Equal(t, sortedStateChanges(t, wantState), sortedStateChanges(t, gotState))
+
+	for i, res := range gotResult.TxResults {
+		if i == undeclared {
+			require.ErrorIs(t, res.Error, ErrUndeclaredStateAccess)
+			continue
+		}
+		require.NoError(t, res.Error)
+	}
+}
+
+func TestDeliverBlockParallelSameWave(t *testing.T) {
+	accounts := bankAccounts(4)
+	// two unrelated transfers, which both pay fees to the fee collector during validation.
+	txs := []mock.Tx{
+		withBankAccessList(bankSend(accounts[0], accounts[1]), accounts[1]),
+		withBankAccessList(bankSend(accounts[2], accounts[3]), accounts[3]),
+	}
+	require.Equal(t, len(txs), nextWave(txs, 0))
+
+	sequential := newBankSTF(t, accounts, 0)
+	parallel := newBankSTF(t, accounts, 4)
+	// the post tx handler runs once per message execution, re-executions included.
+	var executions atomic.Int32
+	parallel.postTxExec = func(context.Context, mock.Tx, bool) error {
+		executions.Add(1)
+		return nil
+	}
+
+	wantResult, wantState, err := sequential.DeliverBlock(context.Background(), bankBlock(txs), mock.DB())
+	require.NoError(t, err)
+	gotResult, gotState, err := parallel.DeliverBlock(context.Background(), bankBlock(txs), mock.DB())
+	require.NoError(t, err)
+
+	require.Equal(t, wantResult, gotResult)
+	require.Equal(t, sortedStateChanges(t, wantState), sortedStateChanges(t, gotState))
+	require.Equal(t, int32(len(txs)), executions.Load())
+}
+
+func TestNextWave(t *testing.T) {
+	a, b, c, d := []byte("a"), []byte("b"), []byte("c"), []byte("d")
+	txs := []mock.Tx{
+		withBankAccessList(bankSend(a, b), b),
+		withBankAccessList(bankSend(c, d), d),
+		bankSend(a, c),
+		withBankAccessList(bankSend(b, c), c),
+		withBankAccessList(bankSend(c, a), a),
+	}
+
+	require.Equal(t, 3, nextWave(txs, 0))
+	require.Equal(t, 4, nextWave(txs, 3))
+	require.Equal(t, 5, nextWave(txs, 4))
+
+	// txs only reading the same state do not conflict.
+	readParams := func(tx mock.Tx) mock.Tx {
+		tx.AccessList = append(tx.AccessList, transaction.StateAccess{
+			Actor: bankActor, Prefixes: [][]byte{[]byte("params")}, ReadOnly: true,
+		})
+		return tx
+	}
+	txs = []mock.Tx{
+		readParams(withBankAccessList(bankSend(a, b), b)),
+		readParams(withBankAccessList(bankSend(c, d), d)),
+		withBankAccessList(bankSend([]byte("params"), a), a),
+	}
+	require.Equal(t, 2, nextWave(txs, 0))
+}
+
+func BenchmarkDeliverBlockBankSend(b *testing.B) {
+	const numTxs = 1000
+
+	accounts := bankAccounts(2 * numTxs)
+	independent := make([]mock.Tx, numTxs)
+	for i := range independent {
+		independent[i] = bankSend(accounts[2*i], accounts[2*i+1])
+	}
+	hotspot := make([]mock.Tx, numTxs)
+	for i := range hotspot {
+		hotspot[i] = bankSend(accounts[2*i], accounts[0])
+	}
+
+	for _, bc := range []struct {
+		name string
+		txs  []mock.Tx
+	}{
+		{"independent", independent},
+		{"hotspot", hotspot},
+	} {
+		for _, workers := range []int{0, 4, 8} {
+			b.Run(fmt.Sprintf("%s/workers=%d", bc.name, workers), func(b *testing.B) {
+				s := newBankSTF(b, accounts, workers)
+				block := bankBlock(bc.txs)
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					_, _, err := s.DeliverBlock(context.Background(), block, mock.DB())
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -40,6 +40,8 @@ type STF[T transaction.Tx] struct {
 	branchFn            branchFn // branchFn is a function that given a readonly state it returns a writable version of it.
 	makeGasMeter        makeGasMeterFn
 	makeGasMeteredState makeGasMeteredStateFn
+
+	config Config
 }
 
 // NewSTF returns a new STF instance.
@@ -54,6 +56,7 @@ func NewSTF[T transaction.Tx](
 	doValidatorUpdate func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error),
 	postTxExec func(ctx context.Context, tx T, success bool) error,
 	branch func(store store.ReaderMap) store.WriterMap,
+	opts ...Option,
 ) (*STF[T], error) {
 	msgRouter, err := msgRouterBuilder.Build()
 	if err != nil {
@@ -64,6 +67,11 @@ func NewSTF[T transaction.Tx](
 		return nil, fmt.Errorf("build query router: %w", err)
 	}
 
+	config := DefaultConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
+
 	return &STF[T]{
 		logger:              logger,
 		msgRouter:           msgRouter,
@@ -77,6 +85,7 @@ func NewSTF[T transaction.Tx](
 		branchFn:            branch,
 		makeGasMeter:        stfgas.DefaultGasMeter,
 		makeGasMeteredState: stfgas.DefaultWrapWithGasMeter,
+		config:              config,
 	}, nil
 }
 
@@ -138,14 +147,9 @@ func (s STF[T]) DeliverBlock(
 	}
 
 	// execute txs
-	txResults := make([]appmanager.TxResult, len(block.Txs))
-	// TODO: skip first tx if vote extensions are enabled (marko)
-	for i, txBytes := range block.Txs {
-		// check if we need to return early or continue delivering txs
-		if err = isCtxCancelled(ctx); err != nil {
-			return nil, nil, err
-		}
-		txResults[i] = s.deliverTx(exCtx, newState, txBytes, transaction.ExecModeFinalize, hi)
+	txResults, err := s.deliverTxs(exCtx, newState, block.Txs, hi)
+	if err != nil {
+		return nil, nil, err
 	}
 	// reset events
 	exCtx.events = make([]event.Event, 0)
@@ -165,6 +169,31 @@ func (s STF[T]) DeliverBlock(
 	}, newState, nil
 }
 
+// deliverTxs executes the txs of a block on the provided state and returns their results.
+// If parallel execution is enabled the txs are executed optimistically, otherwise they
+// are executed one after the other.
+func (s STF[T]) deliverTxs(
+	ctx context.Context,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]appmanager.TxResult, error) {
+	if s.config.parallelExecutionEnabled() && len(txs) > 1 {
+		return s.deliverTxsParallel(ctx, state, txs, hi)
+	}
+
+	txResults := make([]appmanager.TxResult, len(txs))
+	// TODO: skip first tx if vote extensions are enabled (marko)
+	for i, tx := range txs {
+		// check if we need to return early or continue delivering txs
+		if err := isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+		txResults[i] = s.deliverTx(ctx, state, tx, transaction.ExecModeFinalize, hi)
+	}
+	return txResults, nil
+}
+
 // deliverTx executes a TX and returns the result.
 func (s STF[T]) deliverTx(
 	ctx context.Context,
@@ -172,13 +201,15 @@ func (s STF[T]) deliverTx(
 	tx T,
 	execMode transaction.ExecMode,
 	hi header.Info,
-) appmanager.TxResult {
+) (txResult appmanager.TxResult) {
 	// recover in the case of a panic
-	var recoveryError error
 	defer func() {
 		if r := recover(); r != nil {
-			recoveryError = fmt.Errorf("panic during transaction execution: %s", r)
+			recoveryError := fmt.Errorf("panic during transaction execution: %s", r)
 			s.logger.Error("panic during transaction execution", "error", recoveryError)
+			txResult = appmanager.TxResult{
+				Error: recoveryError,
+			}
 		}
 	}()
 	// handle error from GetGasLimit
@@ -189,12 +220,6 @@ func (s STF[T]) deliverTx(
 		}
 	}
 
-	if recoveryError != nil {
-		return appmanager.TxResult{
-			Error: recoveryError,
-		}
-	}
-
 	validateGas, validationEvents, err := s.validateTx(ctx, state, gasLimit, tx, execMode)
 	if err != nil {
 		return appmanager.TxResult{
@@ -202,7 +227,7 @@ func (s STF[T]) deliverTx(
 		}
 	}
 
-	execResp, execGas, execEvents, err := s.execTx(ctx, state, gasLimit-validateGas, tx, execMode, hi)
+	execResp, execGas, execEvents, err := s.execTx(ctx, state, gasLimit, validateGas, tx, execMode, hi)
 	return appmanager.TxResult{
 		Events:    append(validationEvents, execEvents...),
 		GasUsed:   execGas + validateGas,
@@ -239,24 +264,30 @@ func (s STF[T]) validateTx(
 	return consumed, validateCtx.events, applyStateChanges(state, validateState)
 }
 
-// execTx executes the tx messages on the provided state. If the tx fails then the state is discarded.
+// execTx executes the tx messages on the provided state, with the gas left by its validation.
+// If the tx fails then the state is discarded.
 func (s STF[T]) execTx(
 	ctx context.Context,
 	state store.WriterMap,
 	gasLimit uint64,
+	validateGas uint64,
 	tx T,
 	execMode transaction.ExecMode,
 	hi header.Info,
 ) ([]transaction.Msg, uint64, []event.Event, error) {
 	execState := s.branchFn(state)
 
-	msgsResp, gasUsed, runTxMsgsEvents, txErr := s.runTxMsgs(ctx, execState, gasLimit, tx, execMode, hi)
+	// the access list of the tx only covers the state accessed by its messages.
+	msgsResp, gasUsed, runTxMsgsEvents, txErr := s.runTxMsgs(withAccessList(ctx, tx), execState, gasLimit-validateGas, tx, execMode, hi)
 	if txErr != nil {
 		// in case of error during message execution, we do not apply the exec state.
 		// instead we run the post exec handler in a new branchFn from the initial state.
 		postTxState := s.branchFn(state)
 		postTxCtx := s.makeContext(ctx, appmanager.RuntimeIdentity, postTxState, execMode)
 		postTxCtx.setHeaderInfo(hi)
+		if err := postTxCtx.setGasConsumed(validateGas + gasUsed); err != nil {
+			return nil, gasUsed, nil, errors.Join(txErr, err)
+		}
 
 		postTxErr := s.postTxExec(postTxCtx, tx, false)
 		if postTxErr != nil {
@@ -277,6 +308,9 @@ func (s STF[T]) execTx(
 	// whole execution step is rolled back.
 	postTxCtx := s.makeContext(ctx, appmanager.RuntimeIdentity, execState, execMode) // NO gas limit.
 	postTxCtx.setHeaderInfo(hi)
+	if err := postTxCtx.setGasConsumed(validateGas + gasUsed); err != nil {
+		return nil, gasUsed, nil, err
+	}
 	postTxErr := s.postTxExec(postTxCtx, tx, true)
 	if postTxErr != nil {
 		// if post tx fails, then we do not apply any state change, we return the post tx error,
@@ -484,6 +518,7 @@ func (s STF[T]) clone() STF[T] {
 		branchFn:            s.branchFn,
 		makeGasMeter:        s.makeGasMeter,
 		makeGasMeteredState: s.makeGasMeteredState,
+		config:              s.config,
 	}
 }
 
@@ -529,6 +564,18 @@ func (e *executionContext) setGasLimit(limit uint64) {
 	e.state = meteredState
 }
 
+// setGasConsumed sets an unlimited gas meter which has consumed the provided gas on the
+// *executionContext, so that the post tx exec handler can read the gas used by the tx from it.
+// The state is left unmetered.
+func (e *executionContext) setGasConsumed(consumed uint64) error {
+	meter := stfgas.NewMeter(gas.NoGasLimit)
+	if err := meter.Consume(consumed, "tx execution"); err != nil {
+		return err
+	}
+	e.meter = meter
+	return nil
+}
+
 // TODO: too many calls to makeContext can be expensive
 // makeContext creates and returns a new execution context for the STF[T] type.
 // It takes in the following parameters:
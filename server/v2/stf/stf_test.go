@@ -123,6 +123,27 @@ func TestSTF(t *testing.T) {
 		require.Equal(t, mockTx.GasLimit, txResult.GasWanted)
 	})
 
+	t.Run("post tx exec reads the gas used by the tx", func(t *testing.T) {
+		s := s.clone()
+		var postTxGasUsed uint64
+		s.postTxExec = func(ctx context.Context, tx mock.Tx, success bool) error {
+			meter := ctx.(*executionContext).meter
+			postTxGasUsed = meter.Limit() - meter.Remaining()
+			kvSet(t, ctx, "post-tx-exec")
+			return nil
+		}
+		result, _, err := s.DeliverBlock(context.Background(), &appmanager.BlockRequest[mock.Tx]{
+			Height:  uint64(1),
+			Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+			AppHash: sum[:],
+			Hash:    sum[:],
+			Txs:     []mock.Tx{mockTx},
+		}, state)
+		require.NoError(t, err)
+		require.NotZero(t, postTxGasUsed)
+		require.Equal(t, result.TxResults[0].GasUsed, postTxGasUsed)
+	})
+
 	t.Run("exec tx out of gas", func(t *testing.T) {
 		s := s.clone()
 
@@ -0,0 +1,199 @@
+package stf
+
+import (
+	"bytes"
+	"context"
+
+	appmanager "cosmossdk.io/core/app"
+	"cosmossdk.io/core/gas"
+	"cosmossdk.io/core/store"
+)
+
+// SimulateWithTrace simulates the execution of a tx like Simulate, additionally returning the
+// ordered list of operations performed on the state alongside the gas charged for each of them.
+// The keys of the actors with a registered decoder are decoded in a human-readable form.
+func (s STF[T]) SimulateWithTrace(
+	ctx context.Context,
+	state store.ReaderMap,
+	gasLimit uint64,
+	tx T,
+	decoders map[string]store.KeyDecoder,
+) (appmanager.TxResult, store.WriterMap, []store.TraceOperation) {
+	tracer := &stateTracer{decoders: decoders}
+
+	traced := s.clone()
+	traced.makeGasMeteredState = func(meter gas.Meter, state store.WriterMap) store.WriterMap {
+		return tracingWriterMap{
+			state:     s.makeGasMeteredState(meter, state),
+			unmetered: state,
+			meter:     meter,
+			tracer:    tracer,
+		}
+	}
+
+	result, simulationState := traced.Simulate(ctx, state, gasLimit, tx)
+	return result, simulationState, tracer.ops
+}
+
+// stateTracer collects the traced state operations in order.
+type stateTracer struct {
+	decoders map[string]store.KeyDecoder
+	ops      []store.TraceOperation
+}
+
+func (t *stateTracer) record(op store.TraceOperation) {
+	store.DecodeTraceKey(&op, t.decoders)
+	t.ops = append(t.ops, op)
+}
+
+var _ store.WriterMap = tracingWriterMap{}
+
+// tracingWriterMap wraps a gas metered store.WriterMap tracing the operations performed on it.
+type tracingWriterMap struct {
+	state store.WriterMap
+	// unmetered is the state wrapped by the gas metered state, used to read
+	// the values overwritten by write operations without consuming gas.
+	unmetered store.WriterMap
+	meter     gas.Meter
+	tracer    *stateTracer
+}
+
+func (t tracingWriterMap) GetReader(actor []byte) (store.Reader, error) {
+	return t.GetWriter(actor)
+}
+
+func (t tracingWriterMap) GetWriter(actor []byte) (store.Writer, error) {
+	state, err := t.state.GetWriter(actor)
+	if err != nil {
+		return nil, err
+	}
+	unmetered, err := t.unmetered.GetWriter(actor)
+	if err != nil {
+		return nil, err
+	}
+	return tracingWriter{
+		Writer:    state,
+		unmetered: unmetered,
+		actor:     string(actor),
+		meter:     t.meter,
+		tracer:    t.tracer,
+	}, nil
+}
+
+func (t tracingWriterMap) ApplyStateChanges(stateChanges []store.StateChanges) error {
+	return t.state.ApplyStateChanges(stateChanges)
+}
+
+func (t tracingWriterMap) GetStateChanges() ([]store.StateChanges, error) {
+	return t.state.GetStateChanges()
+}
+
+// tracingWriter records the operations performed on the state of an actor.
+type tracingWriter struct {
+	store.Writer
+	unmetered store.Writer
+	actor     string
+	meter     gas.Meter
+	tracer    *stateTracer
+}
+
+// trace executes the operation and records it, alongside the gas it consumed.
+func (t tracingWriter) trace(op store.TraceOperation, exec func(op *store.TraceOperation) error) error {
+	remaining := t.meter.Remaining()
+	err := exec(&op)
+	op.StoreKey = t.actor
+	op.Gas = remaining - t.meter.Remaining()
+	t.tracer.record(op)
+	return err
+}
+
+func (t tracingWriter) Get(key []byte) (value []byte, err error) {
+	err = t.trace(store.TraceOperation{Type: store.TraceOperationGet, Key: bytes.Clone(key)}, func(op *store.TraceOperation) error {
+		value, err = t.Writer.Get(key)
+		op.OldValue = bytes.Clone(value)
+		return err
+	})
+	return value, err
+}
+
+func (t tracingWriter) Has(key []byte) (has bool, err error) {
+	err = t.trace(store.TraceOperation{Type: store.TraceOperationHas, Key: bytes.Clone(key)}, func(*store.TraceOperation) error {
+		has, err = t.Writer.Has(key)
+		return err
+	})
+	return has, err
+}
+
+func (t tracingWriter) Set(key, value []byte) error {
+	oldValue, err := t.unmetered.Get(key)
+	if err != nil {
+		return err
+	}
+	op := store.TraceOperation{
+		Type:     store.TraceOperationSet,
+		Key:      bytes.Clone(key),
+		OldValue: bytes.Clone(oldValue),
+		NewValue: bytes.Clone(value),
+	}
+	return t.trace(op, func(*store.TraceOperation) error { return t.Writer.Set(key, value) })
+}
+
+func (t tracingWriter) Delete(key []byte) error {
+	oldValue, err := t.unmetered.Get(key)
+	if err != nil {
+		return err
+	}
+	op := store.TraceOperation{
+		Type:     store.TraceOperationDelete,
+		Key:      bytes.Clone(key),
+		OldValue: bytes.Clone(oldValue),
+	}
+	return t.trace(op, func(*store.TraceOperation) error { return t.Writer.Delete(key) })
+}
+
+func (t tracingWriter) Iterator(start, end []byte) (store.Iterator, error) {
+	iter, err := t.Writer.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingIterator{Iterator: iter, writer: t}, nil
+}
+
+func (t tracingWriter) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	iter, err := t.Writer.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingIterator{Iterator: iter, writer: t}, nil
+}
+
+// tracingIterator records every entry the iterator is positioned on. The gas
+// charged for an entry is consumed when the iterator moves past it.
+type tracingIterator struct {
+	store.Iterator
+	writer tracingWriter
+}
+
+func (t *tracingIterator) Next() {
+	_ = t.writer.trace(t.current(), func(*store.TraceOperation) error {
+		t.Iterator.Next()
+		return nil
+	})
+}
+
+func (t *tracingIterator) Close() error {
+	// record the last entry the iterator was positioned on, if any.
+	if t.Iterator.Valid() {
+		_ = t.writer.trace(t.current(), func(*store.TraceOperation) error { return nil })
+	}
+	return t.Iterator.Close()
+}
+
+func (t *tracingIterator) current() store.TraceOperation {
+	op := store.TraceOperation{Type: store.TraceOperationIterate}
+	if t.Iterator.Valid() {
+		op.Key = bytes.Clone(t.Iterator.Key())
+		op.OldValue = bytes.Clone(t.Iterator.Value())
+	}
+	return op
+}
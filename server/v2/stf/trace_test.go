@@ -0,0 +1,62 @@
+package stf
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+// accountKeyDecoder decodes the keys of the bank and auth actors used in tests.
+type accountKeyDecoder string
+
+func (d accountKeyDecoder) DecodeKey(key []byte) (collection, decodedKey string, err error) {
+	return string(d), fmt.Sprintf("%q", key), nil
+}
+
+func TestSimulateWithTrace(t *testing.T) {
+	accounts := bankAccounts(2)
+	s := newBankSTF(t, accounts, 0)
+
+	_, state, err := s.DeliverBlock(context.Background(), bankBlock(nil), mock.DB())
+	require.NoError(t, err)
+
+	uint64Bytes := func(v uint64) []byte { return binary.BigEndian.AppendUint64(nil, v) }
+	decoders := map[string]store.KeyDecoder{string(bankActor): accountKeyDecoder("balances")}
+
+	result, _, ops := s.SimulateWithTrace(context.Background(), state, 100_000, bankSend(accounts[0], accounts[1]), decoders)
+	require.NoError(t, result.Error)
+
+	type traced struct {
+		typ        store.TraceOperationType
+		storeKey   string
+		key        []byte
+		decodedKey string
+		oldValue   []byte
+		newValue   []byte
+	}
+	want := []traced{
+		{store.TraceOperationGet, "auth", accounts[0], "", nil, nil},
+		{store.TraceOperationSet, "auth", accounts[0], "", nil, uint64Bytes(1)},
+		{store.TraceOperationGet, "bank", feeCollector, `"fee-collector"`, nil, nil},
+		{store.TraceOperationSet, "bank", feeCollector, `"fee-collector"`, nil, uint64Bytes(1)},
+		{store.TraceOperationGet, "bank", accounts[0], `"account-0"`, uint64Bytes(initialBalance), nil},
+		{store.TraceOperationGet, "bank", accounts[1], `"account-1"`, uint64Bytes(initialBalance), nil},
+		{store.TraceOperationSet, "bank", accounts[0], `"account-0"`, uint64Bytes(initialBalance), uint64Bytes(initialBalance - 1)},
+		{store.TraceOperationSet, "bank", accounts[1], `"account-1"`, uint64Bytes(initialBalance), uint64Bytes(initialBalance + 1)},
+	}
+	got := make([]traced, len(ops))
+	var gasUsed uint64
+	for i, op := range ops {
+		got[i] = traced{op.Type, op.StoreKey, op.Key, op.DecodedKey, op.OldValue, op.NewValue}
+		require.NotZero(t, op.Gas)
+		gasUsed += op.Gas
+	}
+	require.Equal(t, want, got)
+	require.LessOrEqual(t, gasUsed, result.GasUsed)
+}
@@ -0,0 +1,193 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/store/v2/backup"
+	"cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/root"
+)
+
+var _ BackupServiceServer = (*backupServer)(nil)
+
+// backupServer implements the admin gRPC service creating the backups of the
+// store of the running node.
+type backupServer struct {
+	manager *backup.Manager
+	store   backup.Store
+}
+
+func (s *backupServer) CreateBackup(_ context.Context, req *CreateBackupRequest) (*CreateBackupResponse, error) {
+	b, err := s.manager.Create(s.store, req.Incremental)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateBackupResponse{Backup: toProtoBackup(b)}, nil
+}
+
+func (s *backupServer) ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error) {
+	backups, err := s.manager.List()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListBackupsResponse{Backups: make([]*Backup, len(backups))}
+	for i, b := range backups {
+		res.Backups[i] = toProtoBackup(b)
+	}
+	return res, nil
+}
+
+func toProtoBackup(b *backup.Backup) *Backup {
+	return &Backup{
+		Height:     b.Height,
+		BaseHeight: b.BaseHeight,
+		CreatedAt:  b.CreatedAt,
+		TotalSize:  b.Size,
+		CopiedSize: b.CopiedSize,
+	}
+}
+
+// newBackupManager returns the manager of the backups in the configured directory,
+// the checkpoints being staged in the data directory to be hard linked.
+func newBackupManager(v *viper.Viper, logger log.Logger) (*backup.Manager, error) {
+	home := v.GetString(serverv2.FlagHome)
+	dir := v.GetString("store.backup.dir")
+	if dir == "" {
+		dir = filepath.Join(home, "data", "backups")
+	}
+
+	return backup.NewManager(dir, filepath.Join(home, "data"), logger)
+}
+
+// BackupCmd returns the command creating a backup of the store of the running node.
+func (s *StoreComponent[T]) BackupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Create a backup of the store databases of the running node",
+		Long: `Create a backup of the store databases of the running node through its admin gRPC endpoint,
+set by the 'admin-address' of the '[store.backup]' configuration.
+
+The backup holds checkpoints of the state commitment and state storage databases
+at their latest height, which requires the 'pebbledb' or 'rocksdb' app-db-backend
+and ss-type 1. With --incremental, the files unchanged since the latest backup are
+shared with it rather than copied.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := serverv2.GetViperFromCmd(cmd)
+
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+			if address == "" {
+				address = v.GetString("store.backup.admin-address")
+			}
+			if address == "" {
+				return fmt.Errorf("the backup admin endpoint is disabled, set the admin-address of the backup configuration or use --%s", FlagAddress)
+			}
+
+			incremental, err := cmd.Flags().GetBool(FlagIncremental)
+			if err != nil {
+				return err
+			}
+
+			conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			res, err := NewBackupServiceClient(conn).CreateBackup(cmd.Context(), &CreateBackupRequest{Incremental: incremental})
+			if err != nil {
+				return fmt.Errorf("failed to create backup: %w", err)
+			}
+
+			b := res.Backup
+			cmd.Printf("Backup created at height %d, base height %d, size %d, copied size %d\n", b.Height, b.BaseHeight, b.TotalSize, b.CopiedSize)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Address of the admin gRPC endpoint of the node, defaults to the configured one")
+	cmd.Flags().Bool(FlagIncremental, false, "Share the files unchanged since the latest backup rather than copying them")
+
+	return cmd
+}
+
+// ListBackupsCmd returns the command to list the local backups.
+func (s *StoreComponent[T]) ListBackupsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-backups",
+		Short: "List local backups of the store databases",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := serverv2.GetViperFromCmd(cmd)
+
+			m, err := newBackupManager(v, log.NewNopLogger())
+			if err != nil {
+				return err
+			}
+			backups, err := m.List()
+			if err != nil {
+				return fmt.Errorf("failed to list backups: %w", err)
+			}
+			for _, b := range backups {
+				cmd.Println("height:", b.Height, "base height:", b.BaseHeight, "created at:", b.CreatedAt, "size:", b.Size)
+			}
+
+			return nil
+		},
+	}
+}
+
+// restoreBackup replaces the store databases with the backup at the given height,
+// the node must be stopped.
+func restoreBackup(cmd *cobra.Command, v *viper.Viper, height uint64) error {
+	logger := log.NewLogger(cmd.OutOrStdout())
+	m, err := newBackupManager(v, logger)
+	if err != nil {
+		return err
+	}
+
+	storeOpts := root.DefaultStoreOptions()
+	if v.Sub("store.options") != nil {
+		if err := v.Sub("store.options").Unmarshal(&storeOpts); err != nil {
+			return fmt.Errorf("failed to store options: %w", err)
+		}
+	}
+
+	home := v.GetString(serverv2.FlagHome)
+	dbType := db.DBType(v.GetString("store.app-db-backend"))
+	if cmd.Flags().Changed(FlagAppDBBackend) {
+		dbStr, err := cmd.Flags().GetString(FlagAppDBBackend)
+		if err != nil {
+			return err
+		}
+		dbType = db.DBType(dbStr)
+	}
+	if dbType != db.DBTypePebbleDB && dbType != db.DBTypeRocksDB {
+		return fmt.Errorf("backups are not supported by the %s app-db-backend", dbType)
+	}
+
+	targets, err := root.CheckpointTargets(home, filepath.Join(home, "data", "application"+db.DBFileSuffix), storeOpts)
+	if err != nil {
+		return err
+	}
+	if err := m.Restore(height, targets); err != nil {
+		return err
+	}
+
+	cmd.Printf("Restored backup at height %d\n", height)
+	return nil
+}
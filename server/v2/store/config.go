@@ -8,10 +8,25 @@ func DefaultConfig() *Config {
 	return &Config{
 		AppDBBackend: "goleveldb",
 		Options:      root.DefaultStoreOptions(),
+		Backup:       DefaultBackupConfig(),
 	}
 }
 
 type Config struct {
 	AppDBBackend string       `mapstructure:"app-db-backend" toml:"app-db-backend" comment:"The type of database for application and snapshots databases."`
 	Options      root.Options `mapstructure:"options" toml:"options"`
+	Backup       BackupConfig `mapstructure:"backup" toml:"backup" comment:"Backups of the store databases created while the node is running, which require the pebbledb or rocksdb app-db-backend and ss-type 1."`
+}
+
+// BackupConfig defines the configuration of the store backups.
+type BackupConfig struct {
+	Dir          string `mapstructure:"dir" toml:"dir" comment:"Directory of the backups, defaults to <home>/data/backups."`
+	AdminAddress string `mapstructure:"admin-address" toml:"admin-address" comment:"Address of the admin gRPC endpoint creating the backups, disabled if empty. It must not be publicly exposed."`
+}
+
+func DefaultBackupConfig() BackupConfig {
+	return BackupConfig{
+		Dir:          "",
+		AdminAddress: "",
+	}
 }
@@ -4,4 +4,7 @@ const (
 	FlagAppDBBackend = "app-db-backend"
 	FlagKeepRecent   = "keep-recent"
 	FlagInterval     = "interval"
+	FlagAddress      = "address"
+	FlagIncremental  = "incremental"
+	FlagHeight       = "height"
 )
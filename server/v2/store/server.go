@@ -3,21 +3,27 @@ package store
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/store/v2/backup"
 )
 
-// StoreComponent manages store config
-// and contains prune & snapshot commands
+// StoreComponent manages store config, serves the backup admin endpoint
+// and contains prune, snapshot & backup commands
 type StoreComponent[T transaction.Tx] struct {
 	config *Config
+	logger log.Logger
 	// saving appCreator for only RestoreSnapshotCmd
 	appCreator serverv2.AppCreator[T]
+	// backupSrv is the backup admin gRPC server, nil if disabled
+	backupSrv *grpc.Server
 }
 
 func New[T transaction.Tx](appCreator serverv2.AppCreator[T]) *StoreComponent[T] {
@@ -32,6 +38,22 @@ func (s *StoreComponent[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger l
 		}
 	}
 	s.config = cfg
+	s.logger = logger.With(log.ModuleKey, s.Name())
+
+	if cfg.Backup.AdminAddress == "" {
+		return nil
+	}
+	rootStore, ok := appI.GetStore().(backup.Store)
+	if !ok {
+		return fmt.Errorf("store %T does not support backups", appI.GetStore())
+	}
+	manager, err := newBackupManager(v, s.logger)
+	if err != nil {
+		return fmt.Errorf("failed to create backup manager: %w", err)
+	}
+	s.backupSrv = grpc.NewServer()
+	RegisterBackupServiceServer(s.backupSrv, &backupServer{manager: manager, store: rootStore})
+
 	return nil
 }
 
@@ -40,10 +62,32 @@ func (s *StoreComponent[T]) Name() string {
 }
 
 func (s *StoreComponent[T]) Start(ctx context.Context) error {
+	if s.backupSrv == nil {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.config.Backup.AdminAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on address %s: %w", s.config.Backup.AdminAddress, err)
+	}
+
+	s.logger.Info("starting backup admin server...", "address", s.config.Backup.AdminAddress)
+	if err := s.backupSrv.Serve(listener); err != nil {
+		s.logger.Error("failed to start backup admin server", "err", err)
+		return err
+	}
+
 	return nil
 }
 
 func (s *StoreComponent[T]) Stop(ctx context.Context) error {
+	if s.backupSrv == nil {
+		return nil
+	}
+
+	s.logger.Info("stopping backup admin server...", "address", s.config.Backup.AdminAddress)
+	s.backupSrv.GracefulStop()
+
 	return nil
 }
 
@@ -57,6 +101,8 @@ func (s *StoreComponent[T]) CLICommands() serverv2.CLIConfig {
 			s.DumpArchiveCmd(),
 			s.LoadArchiveCmd(),
 			s.RestoreSnapshotCmd(s.appCreator),
+			s.BackupCmd(),
+			s.ListBackupsCmd(),
 		},
 	}
 }
@@ -78,13 +78,27 @@ func (s *StoreComponent[T]) ExportSnapshotCmd() *cobra.Command {
 // RestoreSnapshotCmd returns a command to restore a snapshot
 func (s *StoreComponent[T]) RestoreSnapshotCmd(newApp serverv2.AppCreator[T]) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "restore <height> <format>",
-		Short: "Restore app state from local snapshot",
-		Long:  "Restore app state from local snapshot",
-		Args:  cobra.ExactArgs(2),
+		Use:   "restore [<height> <format>]",
+		Short: "Restore app state from local snapshot or backup",
+		Long: `Restore app state from local snapshot, or from the local backup at the given height
+with --height, which replaces the store databases and must be run while the node is stopped.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(FlagHeight) {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := serverv2.GetViperFromCmd(cmd)
 
+			if cmd.Flags().Changed(FlagHeight) {
+				height, err := cmd.Flags().GetUint64(FlagHeight)
+				if err != nil {
+					return err
+				}
+				return restoreBackup(cmd, v, height)
+			}
+
 			height, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
 				return err
@@ -108,6 +122,7 @@ func (s *StoreComponent[T]) RestoreSnapshotCmd(newApp serverv2.AppCreator[T]) *c
 	}
 
 	addSnapshotFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagHeight, 0, "Height of the local backup to restore the store databases from")
 
 	return cmd
 }
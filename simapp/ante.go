@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/x/auth/ante"
 	"cosmossdk.io/x/auth/ante/unorderedtx"
 	circuitante "cosmossdk.io/x/circuit/ante"
+	feemarketante "cosmossdk.io/x/feemarket/ante"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -13,8 +14,9 @@ import (
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	ante.HandlerOptions
-	CircuitKeeper circuitante.CircuitBreaker
-	TxManager     *unorderedtx.Manager
+	CircuitKeeper   circuitante.CircuitBreaker
+	TxManager       *unorderedtx.Manager
+	FeeMarketKeeper feemarketante.FeeMarketKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -33,6 +35,10 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.New("sign mode handler is required for ante builder")
 	}
 
+	if options.FeeMarketKeeper == nil {
+		return nil, errors.New("feemarket keeper is required for ante builder")
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(options.Environment), // outermost AnteDecorator. SetUpContext must be called first
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
@@ -42,6 +48,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, options.TxManager, options.Environment, ante.DefaultSha256Cost),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		feemarketante.NewFeeMarketDecorator(options.FeeMarketKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
@@ -49,3 +56,17 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
+
+// NewPostHandler returns a PostHandler that accounts the gas of the block by the
+// gas used by its transactions rather than by their gas limit.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
+	if options.FeeMarketKeeper == nil {
+		return nil, errors.New("feemarket keeper is required for post handler builder")
+	}
+
+	postDecorators := []sdk.PostDecorator{
+		feemarketante.NewFeeMarketPostDecorator(options.FeeMarketKeeper),
+	}
+
+	return sdk.ChainPostDecorators(postDecorators...), nil
+}
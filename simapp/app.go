@@ -32,7 +32,6 @@ import (
 	"cosmossdk.io/x/auth/ante/unorderedtx"
 	authcodec "cosmossdk.io/x/auth/codec"
 	authkeeper "cosmossdk.io/x/auth/keeper"
-	"cosmossdk.io/x/auth/posthandler"
 	authsims "cosmossdk.io/x/auth/simulation"
 	authtx "cosmossdk.io/x/auth/tx"
 	txmodule "cosmossdk.io/x/auth/tx/config"
@@ -54,6 +53,7 @@ import (
 	distr "cosmossdk.io/x/distribution"
 	distrkeeper "cosmossdk.io/x/distribution/keeper"
 	distrtypes "cosmossdk.io/x/distribution/types"
+	encryptedtxkeeper "cosmossdk.io/x/encryptedtx/keeper"
 	"cosmossdk.io/x/epochs"
 	epochskeeper "cosmossdk.io/x/epochs/keeper"
 	epochstypes "cosmossdk.io/x/epochs/types"
@@ -63,6 +63,9 @@ import (
 	"cosmossdk.io/x/feegrant"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
+	"cosmossdk.io/x/feemarket"
+	feemarketkeeper "cosmossdk.io/x/feemarket/keeper"
+	feemarkettypes "cosmossdk.io/x/feemarket/types"
 	"cosmossdk.io/x/gov"
 	govkeeper "cosmossdk.io/x/gov/keeper"
 	govtypes "cosmossdk.io/x/gov/types"
@@ -95,6 +98,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -134,6 +138,7 @@ var (
 		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:                {authtypes.Burner},
 		nft.ModuleName:                     nil,
+		feemarkettypes.ModuleName:          {authtypes.Burner},
 	}
 )
 
@@ -175,6 +180,8 @@ type SimApp struct {
 	CircuitKeeper         circuitkeeper.Keeper
 	PoolKeeper            poolkeeper.Keeper
 	EpochsKeeper          *epochskeeper.Keeper
+	FeeMarketKeeper       feemarketkeeper.Keeper
+	EncryptedTxKeeper     encryptedtxkeeper.Keeper
 
 	// managers
 	ModuleManager      *module.Manager
@@ -247,12 +254,7 @@ func NewSimApp(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
-	// create and set dummy vote extension handler
-	voteExtOp := func(bApp *baseapp.BaseApp) {
-		voteExtHandler := NewVoteExtensionHandler()
-		voteExtHandler.SetHandlers(bApp)
-	}
-	baseAppOptions = append(baseAppOptions, voteExtOp, baseapp.SetOptimisticExecution(),
+	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution(),
 		baseapp.SetIncludeNestedMsgsGas([]sdk.Msg{&govv1.MsgSubmitProposal{}}))
 
 	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
@@ -261,14 +263,15 @@ func NewSimApp(
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 	bApp.SetTxEncoder(txConfig.TxEncoder())
 
-	keys := storetypes.NewKVStoreKeys(
+	keys := storetypes.NewKVStoreKeys(append([]string{
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, consensustypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, circuittypes.StoreKey,
 		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey, pooltypes.StoreKey,
-		accounts.StoreKey, epochstypes.StoreKey,
-	)
+		accounts.StoreKey, epochstypes.StoreKey, feemarkettypes.StoreKey,
+	}, encryptedTxModuleNames...)...)
+	memKeys := storetypes.NewMemoryStoreKeys(feemarkettypes.MemStoreKey)
 
 	// register streaming services
 	if err := bApp.RegisterStreamingServices(appOpts, keys); err != nil {
@@ -368,6 +371,11 @@ func NewSimApp(
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[feegrant.StoreKey]), logger.With(log.ModuleKey, "x/feegrant")), appCodec, app.AuthKeeper)
 
+	var encryptedTxModules []module.AppModule
+	app.EncryptedTxKeeper, encryptedTxModules = newEncryptedTxModules(appCodec, keys, logger, app.StakingKeeper)
+
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[feemarkettypes.StoreKey]), logger.With(log.ModuleKey, "x/feemarket"), runtime.EnvWithMemStoreService(runtime.NewMemStoreService(memKeys[feemarkettypes.MemStoreKey]))), app.AuthKeeper, app.BankKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper.SetHooks(
@@ -443,13 +451,14 @@ func NewSimApp(
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
-	app.ModuleManager = module.NewManager(
+	app.ModuleManager = module.NewManager(append([]module.AppModule{
 		genutil.NewAppModule(appCodec, app.AuthKeeper, app.StakingKeeper, app, txConfig, genutiltypes.DefaultMessageValidator),
 		accounts.NewAppModule(appCodec, app.AccountsKeeper),
 		auth.NewAppModule(appCodec, app.AuthKeeper, app.AccountsKeeper, authsims.RandomGenesisAccounts),
 		vesting.NewAppModule(app.AuthKeeper, app.BankKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AuthKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		feemarket.NewAppModule(appCodec, app.FeeMarketKeeper),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AuthKeeper, app.BankKeeper, app.PoolKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AuthKeeper, nil),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AuthKeeper, app.BankKeeper, app.StakingKeeper, app.interfaceRegistry, cometService),
@@ -464,7 +473,7 @@ func NewSimApp(
 		circuit.NewAppModule(appCodec, app.CircuitKeeper),
 		protocolpool.NewAppModule(appCodec, app.PoolKeeper, app.AuthKeeper, app.BankKeeper),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
-	)
+	}, encryptedTxModules...)...)
 
 	app.ModuleManager.RegisterLegacyAminoCodec(legacyAmino)
 	app.ModuleManager.RegisterInterfaces(interfaceRegistry)
@@ -477,7 +486,7 @@ func NewSimApp(
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
-	app.ModuleManager.SetOrderBeginBlockers(
+	app.ModuleManager.SetOrderBeginBlockers(append([]string{
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		pooltypes.ModuleName,
@@ -487,20 +496,21 @@ func NewSimApp(
 		genutiltypes.ModuleName,
 		authz.ModuleName,
 		epochstypes.ModuleName,
-	)
-	app.ModuleManager.SetOrderEndBlockers(
+	}, encryptedTxModuleNames...)...)
+	app.ModuleManager.SetOrderEndBlockers(append([]string{
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
 		pooltypes.ModuleName,
-	)
+		feemarkettypes.ModuleName,
+	}, encryptedTxModuleNames...)...)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
-	genesisModuleOrder := []string{
+	genesisModuleOrder := append([]string{
 		consensustypes.ModuleName,
 		accounts.ModuleName,
 		authtypes.ModuleName,
@@ -522,7 +532,8 @@ func NewSimApp(
 		circuittypes.ModuleName,
 		pooltypes.ModuleName,
 		epochstypes.ModuleName,
-	}
+		feemarkettypes.ModuleName,
+	}, encryptedTxModuleNames...)
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
 
@@ -582,6 +593,7 @@ func NewSimApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountMemoryStores(memKeys)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -605,6 +617,9 @@ func NewSimApp(
 	// upgrade.
 	app.setPostHandler()
 
+	// set the vote extension and proposal handlers decrypting encrypted txs
+	app.setEncryptedTxHandlers(appOpts)
+
 	// At startup, after all modules have been registered, check that all prot
 	// annotations are correct.
 	protoFiles, err := proto.MergedRegistry()
@@ -641,6 +656,7 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 			},
 			&app.CircuitKeeper,
 			app.UnorderedTxManager,
+			app.FeeMarketKeeper,
 		},
 	)
 	if err != nil {
@@ -652,8 +668,10 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 }
 
 func (app *SimApp) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	postHandler, err := NewPostHandler(
+		HandlerOptions{
+			FeeMarketKeeper: app.FeeMarketKeeper,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -740,6 +758,7 @@ func (app *SimApp) AutoCliOpts() autocli.AppOptions {
 	return autocli.AppOptions{
 		Modules:       app.ModuleManager.Modules,
 		ModuleOptions: runtimeservices.ExtractAutoCLIOptions(app.ModuleManager.Modules),
+		StoreKeys:     map[string]string{authtypes.ModuleName: authtypes.StoreKey},
 	}
 }
 
@@ -783,6 +802,9 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register mempool gRPC service for grpc-gateway.
+	mempoolservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	app.ModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -794,7 +816,10 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	authtx.RegisterTxService(
+		app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry,
+		authtx.WithSimulateWithTrace(app.BaseApp.SimulateWithTrace),
+	)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -810,6 +835,7 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	mempoolservice.RegisterMempoolService(clientCtx, app.GRPCQueryRouter(), app.Mempool())
 }
 
 // GetMaccPerms returns a copy of the module account permissions
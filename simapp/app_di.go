@@ -29,9 +29,11 @@ import (
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	consensuskeeper "cosmossdk.io/x/consensus/keeper"
 	distrkeeper "cosmossdk.io/x/distribution/keeper"
+	encryptedtxkeeper "cosmossdk.io/x/encryptedtx/keeper"
 	epochskeeper "cosmossdk.io/x/epochs/keeper"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	feemarketkeeper "cosmossdk.io/x/feemarket/keeper"
 	govkeeper "cosmossdk.io/x/gov/keeper"
 	groupkeeper "cosmossdk.io/x/group/keeper"
 	mintkeeper "cosmossdk.io/x/mint/keeper"
@@ -95,6 +97,8 @@ type SimApp struct {
 	CircuitBreakerKeeper  circuitkeeper.Keeper
 	PoolKeeper            poolkeeper.Keeper
 	EpochsKeeper          *epochskeeper.Keeper
+	FeeMarketKeeper       feemarketkeeper.Keeper
+	EncryptedTxKeeper     encryptedtxkeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -180,7 +184,7 @@ func NewSimApp(
 	)
 
 	var appModules map[string]appmodule.AppModule
-	if err := depinject.Inject(appConfig,
+	if err := depinject.Inject(appConfig, append([]any{
 		&appBuilder,
 		&appModules,
 		&app.appCodec,
@@ -205,6 +209,8 @@ func NewSimApp(
 		&app.CircuitBreakerKeeper,
 		&app.PoolKeeper,
 		&app.EpochsKeeper,
+		&app.FeeMarketKeeper,
+	}, encryptedTxOutputs(&app.EncryptedTxKeeper)...)...,
 	); err != nil {
 		panic(err)
 	}
@@ -235,12 +241,7 @@ func NewSimApp(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
-	// create and set dummy vote extension handler
-	voteExtOp := func(bApp *baseapp.BaseApp) {
-		voteExtHandler := NewVoteExtensionHandler()
-		voteExtHandler.SetHandlers(bApp)
-	}
-	baseAppOptions = append(baseAppOptions, voteExtOp, baseapp.SetOptimisticExecution())
+	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
@@ -310,8 +311,12 @@ func NewSimApp(
 		}
 	}
 
-	// set custom ante handlers
+	// set custom ante and post handlers
 	app.setCustomAnteHandler()
+	app.setCustomPostHandler()
+
+	// set the vote extension and proposal handlers decrypting encrypted txs
+	app.setEncryptedTxHandlers(appOpts)
 
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
@@ -335,6 +340,7 @@ func (app *SimApp) setCustomAnteHandler() {
 			},
 			&app.CircuitBreakerKeeper,
 			app.UnorderedTxManager,
+			app.FeeMarketKeeper,
 		},
 	)
 	if err != nil {
@@ -345,6 +351,20 @@ func (app *SimApp) setCustomAnteHandler() {
 	app.SetAnteHandler(anteHandler)
 }
 
+// overwrite the default post handler with a post handler accounting the block gas of x/feemarket
+func (app *SimApp) setCustomPostHandler() {
+	postHandler, err := NewPostHandler(
+		HandlerOptions{
+			FeeMarketKeeper: app.FeeMarketKeeper,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	app.SetPostHandler(postHandler)
+}
+
 // Close implements the Application interface and closes all necessary application
 // resources.
 func (app *SimApp) Close() error {
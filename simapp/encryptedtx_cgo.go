@@ -0,0 +1,91 @@
+//go:build bls12381
+
+package simapp
+
+import (
+	"github.com/spf13/cast"
+
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	encryptedtxmodulev1 "cosmossdk.io/api/cosmos/encryptedtx/module/v1"
+	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/encryptedtx"
+	encryptedtxkeeper "cosmossdk.io/x/encryptedtx/keeper"
+	encryptedtxtypes "cosmossdk.io/x/encryptedtx/types"
+	govtypes "cosmossdk.io/x/gov/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// x/encryptedtx relies on threshold encryption, which requires the bls12381
+// build tag, it is only wired into SimApp when the tag is set.
+
+var (
+	// encryptedTxModuleNames holds the name, which is also the store key, of
+	// x/encryptedtx.
+	encryptedTxModuleNames = []string{encryptedtxtypes.ModuleName}
+
+	// encryptedTxModuleConfigs holds the configuration of x/encryptedtx used by
+	// depinject.
+	encryptedTxModuleConfigs = []*appv1alpha1.ModuleConfig{
+		{
+			Name:   encryptedtxtypes.ModuleName,
+			Config: appconfig.WrapAny(&encryptedtxmodulev1.Module{}),
+		},
+	}
+)
+
+// encryptedTxOutputs returns the outputs of the keepers of x/encryptedtx to
+// inject with depinject.
+func encryptedTxOutputs(keeper *encryptedtxkeeper.Keeper) []any {
+	return []any{keeper}
+}
+
+// newEncryptedTxModules returns the keeper and the module of x/encryptedtx
+// for apps wired without depinject.
+func newEncryptedTxModules(
+	appCodec codec.Codec,
+	keys map[string]*storetypes.KVStoreKey,
+	logger log.Logger,
+	stakingKeeper encryptedtxtypes.StakingKeeper,
+) (encryptedtxkeeper.Keeper, []module.AppModule) {
+	keeper := encryptedtxkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[encryptedtxtypes.StoreKey]), logger.With(log.ModuleKey, "x/encryptedtx")), stakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	return keeper, []module.AppModule{encryptedtx.NewAppModule(appCodec, keeper)}
+}
+
+// setEncryptedTxHandlers sets the vote extension and proposal handlers of
+// x/encryptedtx, which decrypt the encrypted txs of a block at the top of the
+// block after the next one. The DKG key of the validator is loaded from the
+// file set in encryptedtx.dkg-key-file, nodes without one do not publish
+// decryption shares.
+func (app *SimApp) setEncryptedTxHandlers(appOpts servertypes.AppOptions) {
+	var dkgKey *threshold.DKGKey
+	if path := cast.ToString(appOpts.Get("encryptedtx.dkg-key-file")); path != "" {
+		key, err := encryptedtxtypes.LoadDKGKey(path)
+		if err != nil {
+			panic(err)
+		}
+		dkgKey = &key
+	}
+
+	voteExtHandler := encryptedtxkeeper.NewVoteExtensionHandler(app.EncryptedTxKeeper, dkgKey)
+	app.SetExtendVoteHandler(voteExtHandler.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtensionHandler())
+
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app.BaseApp)
+	proposalHandler := encryptedtxkeeper.NewProposalHandler(
+		app.EncryptedTxKeeper,
+		app.StakingKeeper,
+		defaultProposalHandler.PrepareProposalHandler(),
+		defaultProposalHandler.ProcessProposalHandler(),
+	)
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+}
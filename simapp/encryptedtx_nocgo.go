@@ -0,0 +1,33 @@
+//go:build !bls12381
+
+package simapp
+
+import (
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	encryptedtxkeeper "cosmossdk.io/x/encryptedtx/keeper"
+	encryptedtxtypes "cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// x/encryptedtx relies on threshold encryption, which requires the bls12381
+// build tag, it is not wired into SimApp without the tag.
+
+var (
+	encryptedTxModuleNames   []string
+	encryptedTxModuleConfigs []*appv1alpha1.ModuleConfig
+)
+
+func encryptedTxOutputs(*encryptedtxkeeper.Keeper) []any {
+	return nil
+}
+
+func newEncryptedTxModules(codec.Codec, map[string]*storetypes.KVStoreKey, log.Logger, encryptedtxtypes.StakingKeeper) (encryptedtxkeeper.Keeper, []module.AppModule) {
+	return encryptedtxkeeper.Keeper{}, nil
+}
+
+func (app *SimApp) setEncryptedTxHandlers(servertypes.AppOptions) {}
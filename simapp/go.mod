@@ -21,9 +21,11 @@ require (
 	cosmossdk.io/x/circuit v0.0.0-20230613133644-0a778132a60f
 	cosmossdk.io/x/consensus v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/distribution v0.0.0-20240227221813-a248d05f70f4
+	cosmossdk.io/x/encryptedtx v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/epochs v0.0.0-20240522060652-a1ae4c3e0337
 	cosmossdk.io/x/evidence v0.0.0-20230613133644-0a778132a60f
 	cosmossdk.io/x/feegrant v0.0.0-20230613133644-0a778132a60f
+	cosmossdk.io/x/feemarket v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/gov v0.0.0-20231113122742-912390d5fc4a
 	cosmossdk.io/x/group v0.0.0-00010101000000-000000000000
 	cosmossdk.io/x/mint v0.0.0-00010101000000-000000000000
@@ -256,9 +258,11 @@ replace (
 	cosmossdk.io/x/circuit => ../x/circuit
 	cosmossdk.io/x/consensus => ../x/consensus
 	cosmossdk.io/x/distribution => ../x/distribution
+	cosmossdk.io/x/encryptedtx => ../x/encryptedtx
 	cosmossdk.io/x/epochs => ../x/epochs
 	cosmossdk.io/x/evidence => ../x/evidence
 	cosmossdk.io/x/feegrant => ../x/feegrant
+	cosmossdk.io/x/feemarket => ../x/feemarket
 	cosmossdk.io/x/gov => ../x/gov
 	cosmossdk.io/x/group => ../x/group
 	cosmossdk.io/x/mint => ../x/mint
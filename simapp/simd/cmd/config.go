@@ -81,10 +81,16 @@ func initAppConfig() (string, interface{}) {
 		CustomField string `mapstructure:"custom-field"`
 	}
 
+	// EncryptedTxConfig defines the configuration of x/encryptedtx.
+	type EncryptedTxConfig struct {
+		DKGKeyFile string `mapstructure:"dkg-key-file"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
 
-		Custom CustomConfig `mapstructure:"custom"`
+		Custom      CustomConfig      `mapstructure:"custom"`
+		EncryptedTx EncryptedTxConfig `mapstructure:"encryptedtx"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -120,7 +126,12 @@ func initAppConfig() (string, interface{}) {
 [custom]
 # That field will be parsed by server.InterceptConfigsPreRunHandler and held by viper.
 # Do not forget to add quotes around the value if it is a string.
-custom-field = "{{ .Custom.CustomField }}"`
+custom-field = "{{ .Custom.CustomField }}"
+
+[encryptedtx]
+# Path to the DKG key the validator registered with register-dkg-key, used to
+# publish decryption shares of encrypted txs. Leave empty on non-validator nodes.
+dkg-key-file = "{{ .EncryptedTx.DKGKeyFile }}"`
 
 	return customAppTemplate, customAppConfig
 }
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/x/accounts"
 	authkeeper "cosmossdk.io/x/auth/keeper"
 	epochstypes "cosmossdk.io/x/epochs/types"
+	feemarkettypes "cosmossdk.io/x/feemarket/types"
 	protocolpooltypes "cosmossdk.io/x/protocolpool/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -43,12 +44,13 @@ func (app SimApp) RegisterUpgradeHandlers() {
 
 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		storeUpgrades := corestore.StoreUpgrades{
-			Added: []string{
+			Added: append([]string{
 				accounts.StoreKey,
 				protocolpooltypes.StoreKey,
 				epochstypes.StoreKey,
+				feemarkettypes.StoreKey,
 				countertypes.StoreKey, // This module is used for testing purposes only.
-			},
+			}, encryptedTxModuleNames...),
 			Deleted: []string{"crisis"}, // The SDK discontinued the crisis module in v0.52.0
 		}
 
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"context"
 	_ "embed"
 
 	"github.com/spf13/viper"
@@ -21,6 +22,7 @@ import (
 	distrkeeper "cosmossdk.io/x/distribution/keeper"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	feemarketkeeper "cosmossdk.io/x/feemarket/keeper"
 	govkeeper "cosmossdk.io/x/gov/keeper"
 	groupkeeper "cosmossdk.io/x/group/keeper"
 	mintkeeper "cosmossdk.io/x/mint/keeper"
@@ -69,6 +71,7 @@ type SimApp[T transaction.Tx] struct {
 	ConsensusParamsKeeper consensuskeeper.Keeper
 	CircuitBreakerKeeper  circuitkeeper.Keeper
 	PoolKeeper            poolkeeper.Keeper
+	FeeMarketKeeper       feemarketkeeper.Keeper
 }
 
 func init() {
@@ -177,12 +180,18 @@ func NewSimApp[T transaction.Tx](
 		&app.ConsensusParamsKeeper,
 		&app.CircuitBreakerKeeper,
 		&app.PoolKeeper,
+		&app.FeeMarketKeeper,
 	); err != nil {
 		panic(err)
 	}
 
 	var err error
-	app.App, err = appBuilder.Build()
+	app.App, err = appBuilder.Build(
+		// the feemarket post tx exec handler accounts the executed txs by the gas they used.
+		runtime.AppBuilderWithPostTxExec(func(ctx context.Context, tx T, success bool) error {
+			return app.FeeMarketKeeper.PostTxExec(ctx, tx, success)
+		}),
+	)
 	if err != nil {
 		panic(err)
 	}
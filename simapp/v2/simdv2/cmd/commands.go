@@ -6,12 +6,15 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	_ "modernc.org/sqlite" // registers the SQLite driver used by the GraphQL indexer
 
 	"cosmossdk.io/client/v2/offchain"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
 	runtimev2 "cosmossdk.io/runtime/v2"
+	"cosmossdk.io/schema/decoding"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/api/graphql"
 	"cosmossdk.io/server/v2/api/grpc"
 	"cosmossdk.io/server/v2/cometbft"
 	"cosmossdk.io/server/v2/store"
@@ -70,14 +73,27 @@ func initRootCmd[T transaction.Tx](
 		offchain.OffChain(),
 	)
 
+	// the GraphQL server indexes the state of the modules from the blocks finalized by CometBFT
+	modules := make(map[string]interface{}, len(moduleManager.Modules()))
+	for name, module := range moduleManager.Modules() {
+		modules[name] = module
+	}
+	graphqlServer := graphql.NewIndexed[T](
+		decoding.ModuleSetDecoderResolver(modules),
+		txConfig.SigningContext().AddressCodec(),
+	)
+	cometOptions := cometbft.DefaultServerOptions[T]()
+	cometOptions.Listener = graphqlServer.Listener()
+
 	// wire server commands
 	if err = serverv2.AddCommands(
 		rootCmd,
 		newApp,
 		logger,
-		cometbft.New(&genericTxDecoder[T]{txConfig}, cometbft.DefaultServerOptions[T]()),
+		cometbft.New(&genericTxDecoder[T]{txConfig}, cometOptions),
 		grpc.New[T](),
 		store.New[T](newApp),
+		graphqlServer,
 	); err != nil {
 		panic(err)
 	}
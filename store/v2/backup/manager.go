@@ -0,0 +1,322 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corelog "cosmossdk.io/core/log"
+	"cosmossdk.io/store/v2/internal"
+)
+
+const (
+	metadataFile = "backup.json"
+	tmpSuffix    = ".tmp"
+)
+
+// immutableExts are the extensions of the files which are never modified once
+// written, i.e. the PebbleDB and RocksDB table and blob files and the cold storage
+// segments. An incremental backup links them from the previous backup if they
+// are unchanged since.
+var immutableExts = []string{".sst", ".blob", ".seg"}
+
+// Store is a store writing consistent checkpoints of its databases, such as the
+// root store.
+type Store interface {
+	// Checkpoint writes a checkpoint of the databases into the given directory
+	// and returns the version it holds.
+	Checkpoint(dir string) (uint64, error)
+}
+
+// Backup describes a backup of the databases of a store at a height.
+type Backup struct {
+	Height uint64 `json:"height"`
+	// BaseHeight is the height of the backup the unchanged files of an incremental
+	// backup are linked from, it is 0 for a full backup.
+	BaseHeight uint64    `json:"base_height,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	// Size is the total size of the files of the backup, and CopiedSize the size of
+	// the ones which have been copied rather than linked from the base backup.
+	Size       uint64 `json:"size"`
+	CopiedSize uint64 `json:"copied_size"`
+}
+
+// Manager manages the backups of a store, each held by a directory named after
+// its height. A backup is created from a checkpoint of the databases of the
+// store, which is written while the store is running and then copied into the
+// backup directory.
+type Manager struct {
+	logger corelog.Logger
+	// dir is the directory holding the backups.
+	dir string
+	// stagingDir is the directory the checkpoints are written to, it must be on
+	// the same file system as the databases so that the checkpoints link their
+	// files rather than copying them.
+	stagingDir string
+
+	mtx sync.Mutex
+}
+
+// NewManager returns a new Manager of the backups held by the given directory.
+func NewManager(dir, stagingDir string, logger corelog.Logger) (*Manager, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	return &Manager{
+		logger:     logger,
+		dir:        dir,
+		stagingDir: stagingDir,
+	}, nil
+}
+
+// Create creates a backup of the store at its latest version. An incremental
+// backup links the immutable files unchanged since the latest backup from it
+// instead of copying them.
+func (m *Manager) Create(s Store, incremental bool) (_ *Backup, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if err := m.removeInterrupted(); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(m.stagingDir, 0o755); err != nil {
+		return nil, err
+	}
+	checkpointDir, err := os.MkdirTemp(m.stagingDir, "backup-checkpoint-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, os.RemoveAll(checkpointDir))
+	}()
+
+	height, err := s.Checkpoint(checkpointDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to checkpoint the store: %w", err)
+	}
+	dir := m.backupDir(height)
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("backup at height %d already exists", height)
+	}
+
+	backup := &Backup{Height: height, CreatedAt: time.Now().UTC()}
+	baseDir := ""
+	if incremental {
+		backups, err := m.List()
+		if err != nil {
+			return nil, err
+		}
+		if len(backups) > 0 {
+			backup.BaseHeight = backups[len(backups)-1].Height
+			baseDir = m.backupDir(backup.BaseHeight)
+		}
+	}
+
+	tmpDir := dir + tmpSuffix
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, os.RemoveAll(tmpDir))
+		}
+	}()
+	err = filepath.WalkDir(checkpointDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(checkpointDir, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(tmpDir, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		backup.Size += uint64(info.Size())
+
+		if !slices.Contains(immutableExts, filepath.Ext(path)) {
+			backup.CopiedSize += uint64(info.Size())
+			return internal.CopyFile(path, target)
+		}
+		if baseDir != "" {
+			// the file is the same one if it has the same size and modification time
+			baseFile := filepath.Join(baseDir, rel)
+			if baseInfo, err := os.Stat(baseFile); err == nil && baseInfo.Size() == info.Size() && baseInfo.ModTime().Equal(info.ModTime()) {
+				if err := os.Link(baseFile, target); err == nil {
+					return nil
+				}
+			}
+		}
+		backup.CopiedSize += uint64(info.Size())
+		if err := internal.CopyFile(path, target); err != nil {
+			return err
+		}
+		// keep the modification time, so that the next incremental backups can link
+		// the file if unchanged
+		return os.Chtimes(target, info.ModTime(), info.ModTime())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy the checkpoint: %w", err)
+	}
+
+	bz, err := json.Marshal(backup)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, metadataFile), bz, 0o600); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("created backup", "height", backup.Height, "base_height", backup.BaseHeight, "size", backup.Size, "copied_size", backup.CopiedSize)
+	return backup, nil
+}
+
+// removeInterrupted removes the backups whose creation has been interrupted.
+func (m *Manager) removeInterrupted() error {
+	entries, err := os.ReadDir(m.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasSuffix(entry.Name(), tmpSuffix) {
+			if err := os.RemoveAll(filepath.Join(m.dir, entry.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// List returns the backups ordered by height.
+func (m *Manager) List() ([]*Backup, error) {
+	entries, err := os.ReadDir(m.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []*Backup
+	for _, entry := range entries {
+		height, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		backup, err := m.Get(height)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, backup)
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Height < backups[j].Height
+	})
+
+	return backups, nil
+}
+
+// Get returns the backup at the given height.
+func (m *Manager) Get(height uint64) (*Backup, error) {
+	bz, err := os.ReadFile(filepath.Join(m.backupDir(height), metadataFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("backup at height %d not found", height)
+		}
+		return nil, err
+	}
+
+	backup := &Backup{}
+	if err := json.Unmarshal(bz, backup); err != nil {
+		return nil, fmt.Errorf("failed to decode backup at height %d: %w", height, err)
+	}
+	return backup, nil
+}
+
+// Restore restores the backup at the given height, replacing the databases of
+// the store, which must not be running. The targets are the directories the
+// databases are restored to, keyed by their directory in the checkpoints of the
+// store; the ones the backup does not hold are left untouched.
+func (m *Manager) Restore(height uint64, targets map[string]string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if _, err := m.Get(height); err != nil {
+		return err
+	}
+
+	dirs := make([]string, 0, len(targets))
+	for dir := range targets {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	for _, dir := range dirs {
+		src := filepath.Join(m.backupDir(height), dir)
+		if _, err := os.Stat(src); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		target := targets[dir]
+		if err := restoreDir(src, target); err != nil {
+			return fmt.Errorf("failed to restore %s to %s: %w", dir, target, err)
+		}
+		m.logger.Info("restored backup", "height", height, "dir", dir, "target", target)
+	}
+
+	return nil
+}
+
+// restoreDir copies the src directory to a temporary directory, which then
+// replaces the target one.
+func restoreDir(src, target string) error {
+	tmpDir := target + tmpSuffix
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(tmpDir, rel)
+		if d.IsDir() {
+			return os.MkdirAll(dst, 0o755)
+		}
+		if slices.Contains(immutableExts, filepath.Ext(path)) {
+			return internal.LinkOrCopyFile(path, dst)
+		}
+		return internal.CopyFile(path, dst)
+	})
+	if err != nil {
+		return errors.Join(err, os.RemoveAll(tmpDir))
+	}
+
+	if err := os.RemoveAll(target); err != nil {
+		return err
+	}
+	return os.Rename(tmpDir, target)
+}
+
+func (m *Manager) backupDir(height uint64) string {
+	return filepath.Join(m.dir, strconv.FormatUint(height, 10))
+}
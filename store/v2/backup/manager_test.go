@@ -0,0 +1,211 @@
+package backup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/root"
+)
+
+const storeKey = "store1"
+
+func storeOptions() root.Options {
+	opts := root.DefaultStoreOptions()
+	opts.SSType = root.SSTypePebble
+	return opts
+}
+
+// openRootStore opens the root store, whose state commitment database is closed
+// with the test.
+func openRootStore(t *testing.T, rootDir string) (store.RootStore, corestore.KVStoreWithBatch) {
+	t.Helper()
+
+	scDB, err := db.NewPebbleDB("application", filepath.Join(rootDir, "data"))
+	require.NoError(t, err)
+	rs, err := root.CreateRootStore(&root.FactoryOptions{
+		Logger:    coretesting.NewNopLogger(),
+		RootDir:   rootDir,
+		Options:   storeOptions(),
+		StoreKeys: []string{storeKey},
+		SCRawDB:   scDB,
+	})
+	require.NoError(t, err)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	return rs, scDB
+}
+
+func commit(t *testing.T, rs store.RootStore, versions int) {
+	t.Helper()
+
+	for i := 0; i < versions; i++ {
+		version, err := rs.GetLatestVersion()
+		require.NoError(t, err)
+		version++
+
+		cs := corestore.NewChangeset()
+		for j := 0; j < 10; j++ {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, j)), []byte(fmt.Sprintf("value-%d", version)), false)
+		}
+		_, err = rs.Commit(cs)
+		require.NoError(t, err)
+	}
+}
+
+func TestManager(t *testing.T) {
+	rootDir := t.TempDir()
+	rs, scDB := openRootStore(t, rootDir)
+	commit(t, rs, 10)
+
+	m, err := NewManager(filepath.Join(t.TempDir(), "backups"), filepath.Join(rootDir, "data"), coretesting.NewNopLogger())
+	require.NoError(t, err)
+
+	backup, err := m.Create(rs.(Store), false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), backup.Height)
+	require.Equal(t, uint64(0), backup.BaseHeight)
+	require.Equal(t, backup.Size, backup.CopiedSize)
+
+	// a backup already exists at the height
+	_, err = m.Create(rs.(Store), false)
+	require.Error(t, err)
+
+	commit(t, rs, 10)
+	backup, err = m.Create(rs.(Store), true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), backup.Height)
+	require.Equal(t, uint64(10), backup.BaseHeight)
+
+	backups, err := m.List()
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	require.Equal(t, uint64(10), backups[0].Height)
+	require.Equal(t, uint64(20), backups[1].Height)
+
+	// the checkpoints are removed from the staging directory
+	matches, err := filepath.Glob(filepath.Join(rootDir, "data", "backup-checkpoint-*"))
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	commit(t, rs, 5)
+	require.NoError(t, rs.Close())
+	require.NoError(t, scDB.Close())
+
+	targets, err := root.CheckpointTargets(rootDir, filepath.Join(rootDir, "data", "application.db"), storeOptions())
+	require.NoError(t, err)
+	require.Error(t, m.Restore(15, targets))
+	require.NoError(t, m.Restore(10, targets))
+
+	rs, scDB = openRootStore(t, rootDir)
+	version, err := rs.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), version)
+	for v := uint64(1); v <= 10; v++ {
+		res, err := rs.Query([]byte(storeKey), 10, []byte(fmt.Sprintf("key-%d-0", v)), false)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value-%d", v)), res.Value)
+	}
+
+	// the restored store keeps committing from the backup height
+	commit(t, rs, 5)
+	version, err = rs.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), version)
+	require.NoError(t, rs.Close())
+	require.NoError(t, scDB.Close())
+}
+
+func TestManager_Incremental(t *testing.T) {
+	s := &mockStore{files: map[string]string{
+		"sc/000001.sst": "table1",
+		"sc/000002.sst": "table2",
+		"sc/MANIFEST":   "manifest1",
+	}}
+
+	m, err := NewManager(filepath.Join(t.TempDir(), "backups"), t.TempDir(), coretesting.NewNopLogger())
+	require.NoError(t, err)
+
+	// the first incremental backup has no base
+	backup, err := m.Create(s, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), backup.BaseHeight)
+	require.Equal(t, uint64(len("table1table2manifest1")), backup.CopiedSize)
+
+	// the unchanged tables are linked
+	s.height = 2
+	s.files["sc/000003.sst"] = "table3"
+	s.files["sc/MANIFEST"] = "manifest2"
+	delete(s.files, "sc/000001.sst")
+	backup, err = m.Create(s, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), backup.BaseHeight)
+	require.Equal(t, uint64(len("table2table3manifest2")), backup.Size)
+	require.Equal(t, uint64(len("table3manifest2")), backup.CopiedSize)
+
+	// a full backup copies all the files
+	s.height = 3
+	backup, err = m.Create(s, false)
+	require.NoError(t, err)
+	require.Equal(t, backup.Size, backup.CopiedSize)
+
+	target := filepath.Join(t.TempDir(), "sc")
+	require.NoError(t, m.Restore(2, map[string]string{"sc": target, "ss": filepath.Join(t.TempDir(), "ss")}))
+	for name, content := range map[string]string{"000002.sst": "table2", "000003.sst": "table3", "MANIFEST": "manifest2"} {
+		bz, err := os.ReadFile(filepath.Join(target, name))
+		require.NoError(t, err)
+		require.Equal(t, content, string(bz))
+	}
+	_, err = os.Stat(filepath.Join(target, "000001.sst"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// mockStore writes the given files as checkpoints, the tables keeping their
+// modification time as the ones linked by the database checkpoints.
+type mockStore struct {
+	height uint64
+	files  map[string]string
+	dir    string
+}
+
+func (s *mockStore) Checkpoint(dir string) (uint64, error) {
+	if s.height == 0 {
+		s.height = 1
+	}
+	for name, content := range s.files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return 0, err
+		}
+		if filepath.Ext(name) == ".sst" {
+			if s.dir == "" {
+				s.dir = filepath.Join(filepath.Dir(dir), "tables")
+			}
+			table := filepath.Join(s.dir, name)
+			if _, err := os.Stat(table); err != nil {
+				if err := os.MkdirAll(filepath.Dir(table), 0o755); err != nil {
+					return 0, err
+				}
+				if err := os.WriteFile(table, []byte(content), 0o600); err != nil {
+					return 0, err
+				}
+			}
+			if err := os.Link(table, path); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.height, nil
+}
@@ -0,0 +1,127 @@
+package commitment
+
+import (
+	"errors"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+)
+
+var _ store.Brancher = (*CommitStore)(nil)
+
+// errReadOnly is returned by the writes to the database of a branch.
+var errReadOnly = errors.New("cannot write to the database of a branch")
+
+// NewTreeFn is a function that creates the tree of a store key on top of the given
+// database, which the tree must not write to when loaded with ReadOnlyLoader.
+type NewTreeFn func(db corestore.KVStoreWithBatch, storeKey string) (Tree, error)
+
+// SetNewTreeFn sets the function creating the trees of the branches of the store.
+func (c *CommitStore) SetNewTreeFn(newTree NewTreeFn) {
+	c.newTree = newTree
+}
+
+// Branch implements store.Brancher. The trees of the branch read the database of the
+// CommitStore, which is never written to: the changesets written to the branch are
+// only held in memory and committing the branch fails.
+func (c *CommitStore) Branch(version uint64) (store.Committer, error) {
+	if c.newTree == nil {
+		return nil, errors.New("cannot branch the commit store, no tree constructor set")
+	}
+	commitInfo, err := c.metadata.GetCommitInfo(version)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo == nil {
+		return nil, fmt.Errorf("no commit info found for version %d", version)
+	}
+	committed := make(map[string]bool, len(commitInfo.StoreInfos))
+	for _, si := range commitInfo.StoreInfos {
+		committed[string(si.Name)] = true
+	}
+
+	db := readOnlyDB{c.metadata.kv}
+	branch := &CommitStore{
+		logger:         c.logger,
+		metadata:       NewMetadataStore(db),
+		multiTrees:     make(map[string]Tree, len(c.multiTrees)),
+		oldTrees:       make(map[string]Tree),
+		prunedVersions: make(map[string]uint64),
+	}
+	for storeKey := range c.multiTrees {
+		tree, err := c.newTree(db, storeKey)
+		if err != nil {
+			return nil, errors.Join(err, branch.Close())
+		}
+		branch.multiTrees[storeKey] = tree
+
+		if !committed[storeKey] {
+			// the store did not exist at the version, it is empty in the branch
+			err = tree.SetInitialVersion(version + 1)
+		} else if loader, ok := tree.(ReadOnlyLoader); ok {
+			err = loader.LoadVersionReadOnly(version)
+		} else {
+			err = fmt.Errorf("cannot branch the tree of store key %s", storeKey)
+		}
+		if err != nil {
+			return nil, errors.Join(err, branch.Close())
+		}
+	}
+
+	return branch, nil
+}
+
+// readOnlyDB wraps the database of a CommitStore for its branches, failing all the
+// writes. Closing it is a no-op as the database is owned by the CommitStore.
+type readOnlyDB struct {
+	corestore.KVStoreWithBatch
+}
+
+func (readOnlyDB) Set(_, _ []byte) error {
+	return errReadOnly
+}
+
+func (readOnlyDB) Delete(_ []byte) error {
+	return errReadOnly
+}
+
+func (readOnlyDB) NewBatch() corestore.Batch {
+	return readOnlyBatch{}
+}
+
+func (readOnlyDB) NewBatchWithSize(int) corestore.Batch {
+	return readOnlyBatch{}
+}
+
+func (readOnlyDB) Close() error {
+	return nil
+}
+
+// readOnlyBatch is the batch of a readOnlyDB, it can be created and closed but not
+// written.
+type readOnlyBatch struct{}
+
+func (readOnlyBatch) Set(_, _ []byte) error {
+	return errReadOnly
+}
+
+func (readOnlyBatch) Delete(_ []byte) error {
+	return errReadOnly
+}
+
+func (readOnlyBatch) Write() error {
+	return errReadOnly
+}
+
+func (readOnlyBatch) WriteSync() error {
+	return errReadOnly
+}
+
+func (readOnlyBatch) Close() error {
+	return nil
+}
+
+func (readOnlyBatch) GetByteSize() (int, error) {
+	return 0, nil
+}
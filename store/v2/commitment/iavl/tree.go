@@ -14,8 +14,9 @@ import (
 )
 
 var (
-	_ commitment.Tree      = (*IavlTree)(nil)
-	_ store.PausablePruner = (*IavlTree)(nil)
+	_ commitment.Tree           = (*IavlTree)(nil)
+	_ commitment.ReadOnlyLoader = (*IavlTree)(nil)
+	_ store.PausablePruner      = (*IavlTree)(nil)
 )
 
 // IavlTree is a wrapper around iavl.MutableTree.
@@ -61,6 +62,13 @@ func (t *IavlTree) LoadVersion(version uint64) error {
 	return t.tree.LoadVersionForOverwriting(int64(version))
 }
 
+// LoadVersionReadOnly loads the state at the given version, keeping the later versions.
+// The tree must skip the fast storage upgrade for the load not to write to its database.
+func (t *IavlTree) LoadVersionReadOnly(version uint64) error {
+	_, err := t.tree.LoadVersion(int64(version))
+	return err
+}
+
 // Commit commits the current state to the tree.
 func (t *IavlTree) Commit() ([]byte, uint64, error) {
 	hash, v, err := t.tree.SaveVersion()
@@ -31,7 +31,16 @@ func TestCommitterSuite(t *testing.T) {
 				oldTrees[storeKey], _ = mountTreeFn(storeKey)
 			}
 
-			return commitment.NewCommitStore(multiTrees, oldTrees, db, logger)
+			commitStore, err := commitment.NewCommitStore(multiTrees, oldTrees, db, logger)
+			if err != nil {
+				return nil, err
+			}
+			branchCfg := *cfg
+			branchCfg.SkipFastStorageUpgrade = true
+			commitStore.SetNewTreeFn(func(db corestore.KVStoreWithBatch, storeKey string) (commitment.Tree, error) {
+				return NewIavlTree(dbm.NewPrefixDB(db, []byte(storeKey)), logger, &branchCfg), nil
+			})
+			return commitStore, nil
 		},
 	}
 
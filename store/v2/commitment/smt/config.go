@@ -0,0 +1,13 @@
+package smt
+
+// Config is the configuration for the sparse Merkle tree.
+type Config struct {
+	CacheSize int `mapstructure:"cache-size" toml:"cache-size" comment:"CacheSize set the number of nodes of the smt tree cache."`
+}
+
+// DefaultConfig returns the default configuration for the sparse Merkle tree.
+func DefaultConfig() *Config {
+	return &Config{
+		CacheSize: 100_000,
+	}
+}
@@ -0,0 +1,70 @@
+package smt
+
+import (
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// Exporter exports the leaves of a version of the tree, ordered by hashed key.
+//
+// Only the leaves are exported, as the structure of the tree is entirely determined
+// by its leaves.
+type Exporter struct {
+	tree  *SMTTree
+	stack []exportEntry
+}
+
+type exportEntry struct {
+	ref   *nodeRef
+	depth int
+	path  []byte
+}
+
+func newExporter(tree *SMTTree, root *nodeRef) *Exporter {
+	e := &Exporter{tree: tree}
+	if root != nil {
+		e.stack = append(e.stack, exportEntry{ref: root, path: make([]byte, hashSize)})
+	}
+	return e
+}
+
+// Next returns the next item in the exporter.
+func (e *Exporter) Next() (*snapshotstypes.SnapshotIAVLItem, error) {
+	for len(e.stack) > 0 {
+		entry := e.stack[len(e.stack)-1]
+		e.stack = e.stack[:len(e.stack)-1]
+
+		n, err := e.tree.load(entry.ref, entry.depth, entry.path)
+		if err != nil {
+			return nil, err
+		}
+		if n.isLeaf() {
+			return &snapshotstypes.SnapshotIAVLItem{
+				Key:     n.key,
+				Value:   n.value,
+				Version: int64(entry.ref.version),
+				Height:  0,
+			}, nil
+		}
+
+		// the right child is pushed first for the left one to be exported first.
+		for b := 1; b >= 0; b-- {
+			if child := n.children[b]; child != nil {
+				e.stack = append(e.stack, exportEntry{
+					ref:   child,
+					depth: entry.depth + 1,
+					path:  withBit(entry.path, entry.depth, b),
+				})
+			}
+		}
+	}
+
+	return nil, commitment.ErrorExportDone
+}
+
+// Close closes the exporter.
+func (e *Exporter) Close() error {
+	e.stack = nil
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package smt
+
+import (
+	"fmt"
+
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// Importer imports the leaves exported by an Exporter into an empty tree.
+//
+// The imported leaves are flushed to the database every importBatchSize leaves, the
+// version only becomes visible once the importer is committed.
+type Importer struct {
+	tree    *SMTTree
+	version uint64
+	count   int
+}
+
+// Add adds the given item to the importer.
+func (i *Importer) Add(item *snapshotstypes.SnapshotIAVLItem) error {
+	if item.Height != 0 {
+		return fmt.Errorf("unexpected node of height %d, only leaves can be imported", item.Height)
+	}
+	if err := i.tree.Set(item.Key, item.Value); err != nil {
+		return err
+	}
+
+	i.count++
+	if i.count%importBatchSize != 0 {
+		return nil
+	}
+
+	i.tree.mtx.Lock()
+	defer i.tree.mtx.Unlock()
+
+	_, err := i.tree.save(i.version, false)
+	return err
+}
+
+// Commit commits the importer.
+func (i *Importer) Commit() error {
+	i.tree.mtx.Lock()
+	defer i.tree.mtx.Unlock()
+
+	_, err := i.tree.save(i.version, true)
+	return err
+}
+
+// Close closes the importer, discarding the leaves which are not flushed yet.
+func (i *Importer) Close() error {
+	i.tree.mtx.Lock()
+	defer i.tree.mtx.Unlock()
+
+	i.tree.pending = make(map[string]change)
+	i.tree.working = nil
+
+	return nil
+}
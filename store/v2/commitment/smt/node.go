@@ -0,0 +1,230 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// hashSize is the size of the node hashes, and of the hashed keys.
+	hashSize = sha256.Size
+	// maxDepth is the maximum depth of a leaf, the number of bits of a hashed key.
+	maxDepth = hashSize * 8
+)
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+
+	// emptyHash is the hash of an empty subtree, it matches the EmptyChild of ics23.SmtSpec.
+	emptyHash = make([]byte, hashSize)
+)
+
+// Kinds of node references.
+const (
+	refEmpty byte = iota
+	refInner
+	refLeaf
+)
+
+// nodeRef is a reference to a node from its parent, or from the root of a version.
+// A nil nodeRef is an empty subtree.
+type nodeRef struct {
+	hash []byte
+	// version is the version the node was persisted at, zero for the nodes which
+	// are not persisted yet.
+	version uint64
+	leaf    bool
+	// node is only set for the nodes which are not persisted yet.
+	node *node
+}
+
+// node is a node of the tree, either a leaf or an inner node.
+//
+// Leaves are placed at the shortest prefix of their hashed key which is not shared
+// with any other leaf, hence an inner node always has at least two leaves below it,
+// though one of its children may be empty.
+type node struct {
+	// keyHash, key and value are only set for leaves.
+	keyHash []byte
+	key     []byte
+	value   []byte
+
+	// children are only set for inner nodes, the child at index 0 holding the
+	// hashed keys whose bit at the depth of the node is 0.
+	children [2]*nodeRef
+}
+
+func (n *node) isLeaf() bool {
+	return n.keyHash != nil
+}
+
+func newLeaf(keyHash, key, value []byte) *nodeRef {
+	return &nodeRef{
+		hash: leafHash(key, value),
+		leaf: true,
+		node: &node{keyHash: keyHash, key: key, value: value},
+	}
+}
+
+func newInner(left, right *nodeRef) *nodeRef {
+	return &nodeRef{
+		hash: innerHash(left.getHash(), right.getHash()),
+		node: &node{children: [2]*nodeRef{left, right}},
+	}
+}
+
+func (r *nodeRef) getHash() []byte {
+	if r == nil {
+		return emptyHash
+	}
+	return r.hash
+}
+
+// leafHash returns the hash of a leaf as defined by the LeafSpec of ics23.SmtSpec.
+func leafHash(key, value []byte) []byte {
+	keyHash := sha256.Sum256(key)
+	valueHash := sha256.Sum256(value)
+
+	h := sha256.New()
+	h.Write(leafPrefix)
+	h.Write(keyHash[:])
+	h.Write(valueHash[:])
+	return h.Sum(nil)
+}
+
+// innerHash returns the hash of an inner node as defined by the InnerSpec of ics23.SmtSpec.
+func innerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write(innerPrefix)
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+func hashKey(key []byte) []byte {
+	h := sha256.Sum256(key)
+	return h[:]
+}
+
+// bit returns the bit of the hashed key at the given depth.
+func bit(keyHash []byte, depth int) int {
+	return int(keyHash[depth/8]>>(7-depth%8)) & 1
+}
+
+// withBit returns a copy of path with the bit at the given depth set to b.
+func withBit(path []byte, depth, b int) []byte {
+	p := bytes.Clone(path)
+	if b == 1 {
+		p[depth/8] |= 1 << (7 - depth%8)
+	} else {
+		p[depth/8] &^= 1 << (7 - depth%8)
+	}
+	return p
+}
+
+// encodeRef encodes a node reference, it is used for the children of the
+// inner nodes and for the roots of the versions.
+func encodeRef(buf []byte, r *nodeRef) []byte {
+	switch {
+	case r == nil:
+		return append(buf, refEmpty)
+	case r.leaf:
+		buf = append(buf, refLeaf)
+	default:
+		buf = append(buf, refInner)
+	}
+	buf = binary.BigEndian.AppendUint64(buf, r.version)
+	return append(buf, r.hash...)
+}
+
+// decodeRef decodes a node reference, returning the remaining bytes.
+func decodeRef(bz []byte) (*nodeRef, []byte, error) {
+	if len(bz) == 0 {
+		return nil, nil, errors.New("invalid node reference: empty")
+	}
+	kind := bz[0]
+	if kind == refEmpty {
+		return nil, bz[1:], nil
+	}
+	if kind != refInner && kind != refLeaf {
+		return nil, nil, fmt.Errorf("invalid node reference kind %d", kind)
+	}
+	if len(bz) < 1+8+hashSize {
+		return nil, nil, fmt.Errorf("invalid node reference length %d", len(bz))
+	}
+	return &nodeRef{
+		version: binary.BigEndian.Uint64(bz[1:9]),
+		hash:    bytes.Clone(bz[9 : 9+hashSize]),
+		leaf:    kind == refLeaf,
+	}, bz[9+hashSize:], nil
+}
+
+// encode encodes the node. Leaves are encoded as:
+//
+//	0x00 | keyHash | uvarint(len(key)) | key | value
+//
+// and inner nodes as the concatenation of the references to their children:
+//
+//	0x01 | ref(left) | ref(right)
+func (n *node) encode() []byte {
+	if n.isLeaf() {
+		buf := make([]byte, 0, 1+hashSize+binary.MaxVarintLen64+len(n.key)+len(n.value))
+		buf = append(buf, leafPrefix...)
+		buf = append(buf, n.keyHash...)
+		buf = binary.AppendUvarint(buf, uint64(len(n.key)))
+		buf = append(buf, n.key...)
+		return append(buf, n.value...)
+	}
+
+	buf := make([]byte, 0, 1+2*(1+8+hashSize))
+	buf = append(buf, innerPrefix...)
+	buf = encodeRef(buf, n.children[0])
+	return encodeRef(buf, n.children[1])
+}
+
+func decodeNode(bz []byte) (*node, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("invalid node: empty")
+	}
+
+	switch bz[0] {
+	case leafPrefix[0]:
+		bz = bz[1:]
+		if len(bz) < hashSize {
+			return nil, errors.New("invalid leaf: missing key hash")
+		}
+		keyHash := bz[:hashSize]
+		bz = bz[hashSize:]
+		keyLen, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < keyLen {
+			return nil, errors.New("invalid leaf: invalid key length")
+		}
+		bz = bz[n:]
+		return &node{
+			keyHash: bytes.Clone(keyHash),
+			key:     bytes.Clone(bz[:keyLen]),
+			value:   bytes.Clone(bz[keyLen:]),
+		}, nil
+
+	case innerPrefix[0]:
+		left, rest, err := decodeRef(bz[1:])
+		if err != nil {
+			return nil, err
+		}
+		right, rest, err := decodeRef(rest)
+		if err != nil {
+			return nil, err
+		}
+		if len(rest) != 0 {
+			return nil, errors.New("invalid inner node: trailing bytes")
+		}
+		return &node{children: [2]*nodeRef{left, right}}, nil
+
+	default:
+		return nil, fmt.Errorf("invalid node kind %d", bz[0])
+	}
+}
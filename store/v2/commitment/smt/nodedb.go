@@ -0,0 +1,136 @@
+package smt
+
+import (
+	"container/list"
+	"encoding/binary"
+	"sync"
+)
+
+// The tree is persisted with the following layout:
+//
+//	n | version | depth | path -> node
+//	r | version -> reference to the root node of the version
+//	s | staleSince | nodeKey -> empty, for the nodes which are not part of the trees since staleSince
+//
+// Nodes are addressed by the version they were written at and their position in the
+// tree, the first depth bits of path, so that the nodes which are unchanged between
+// versions are shared, while the stale index allows pruning the nodes which are no
+// longer referenced by the remaining versions.
+var (
+	nodeKeyPrefix  = []byte{'n'}
+	rootKeyPrefix  = []byte{'r'}
+	staleKeyPrefix = []byte{'s'}
+)
+
+func nodeKey(version uint64, depth int, path []byte) []byte {
+	pathLen := (depth + 7) / 8
+	key := make([]byte, 0, len(nodeKeyPrefix)+8+2+pathLen)
+	key = append(key, nodeKeyPrefix...)
+	key = binary.BigEndian.AppendUint64(key, version)
+	key = binary.BigEndian.AppendUint16(key, uint16(depth))
+	key = append(key, path[:pathLen]...)
+	// clear the bits of the path below the node
+	if depth%8 != 0 {
+		key[len(key)-1] &= ^byte(0) << (8 - depth%8)
+	}
+	return key
+}
+
+func rootKey(version uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, rootKeyPrefix...), version)
+}
+
+func versionFromRootKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(rootKeyPrefix):])
+}
+
+func staleKey(staleSince uint64, nodeKey []byte) []byte {
+	key := make([]byte, 0, len(staleKeyPrefix)+8+len(nodeKey))
+	key = append(key, staleKeyPrefix...)
+	key = binary.BigEndian.AppendUint64(key, staleSince)
+	return append(key, nodeKey...)
+}
+
+func nodeKeyFromStaleKey(key []byte) []byte {
+	return key[len(staleKeyPrefix)+8:]
+}
+
+// versionKey returns the first key of the given version under the one byte prefix.
+func versionKey(prefix []byte, version uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, prefix...), version)
+}
+
+// prefixEnd returns the end of the range of the keys under the one byte prefix.
+func prefixEnd(prefix []byte) []byte {
+	return []byte{prefix[0] + 1}
+}
+
+// nodeCache is a LRU cache of the decoded persisted nodes, by node key.
+type nodeCache struct {
+	mtx   sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key  string
+	node *node
+}
+
+func newNodeCache(size int) *nodeCache {
+	return &nodeCache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+func (c *nodeCache) get(key []byte) *node {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.items[string(key)]; ok {
+		c.ll.MoveToFront(e)
+		return e.Value.(*cacheEntry).node
+	}
+	return nil
+}
+
+func (c *nodeCache) add(key []byte, n *node) {
+	if c.size <= 0 {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.items[string(key)]; ok {
+		c.ll.MoveToFront(e)
+		e.Value.(*cacheEntry).node = n
+		return
+	}
+	c.items[string(key)] = c.ll.PushFront(&cacheEntry{key: string(key), node: n})
+	if c.ll.Len() > c.size {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *nodeCache) remove(key []byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.items[string(key)]; ok {
+		c.ll.Remove(e)
+		delete(c.items, string(key))
+	}
+}
+
+func (c *nodeCache) reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+}
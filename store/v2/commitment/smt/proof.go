@@ -0,0 +1,130 @@
+package smt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// getProof returns a proof of existence or non-existence of the key in the tree.
+//
+// As the leaves are ordered by hashed key, the non-existence proof holds the existence
+// proofs of the leaves preceding and following the hashed key, see ics23.SmtSpec.
+func (t *SMTTree) getProof(root *nodeRef, key []byte) (*ics23.CommitmentProof, error) {
+	if root == nil {
+		return nil, errors.New("cannot create a proof for an empty tree")
+	}
+
+	keyHash := hashKey(key)
+	ancestors, leaf, err := t.walk(root, keyHash)
+	if err != nil {
+		return nil, err
+	}
+	if leaf != nil && bytes.Equal(leaf.key, key) {
+		return &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Exist{
+				Exist: existenceProof(ancestors, leaf),
+			},
+		}, nil
+	}
+
+	var neighbors [2]*node
+	if leaf != nil {
+		if bytes.Compare(leaf.keyHash, keyHash) < 0 {
+			neighbors[0] = leaf
+		} else {
+			neighbors[1] = leaf
+		}
+	}
+	nonExist := &ics23.NonExistenceProof{Key: key}
+	for side, neighbor := range neighbors {
+		if neighbor == nil {
+			if neighbor, err = t.neighbor(ancestors, keyHash, side); err != nil {
+				return nil, err
+			}
+			if neighbor == nil {
+				continue
+			}
+		}
+		path, leaf, err := t.walk(root, neighbor.keyHash)
+		if err != nil {
+			return nil, err
+		}
+		if leaf == nil || !bytes.Equal(leaf.key, neighbor.key) {
+			return nil, fmt.Errorf("leaf %X not found", neighbor.keyHash)
+		}
+		if side == 0 {
+			nonExist.Left = existenceProof(path, leaf)
+		} else {
+			nonExist.Right = existenceProof(path, leaf)
+		}
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{
+			Nonexist: nonExist,
+		},
+	}, nil
+}
+
+// neighbor returns the closest leaf preceding (side 0) or following (side 1) the hashed
+// key, given the inner nodes on the path of the hashed key.
+func (t *SMTTree) neighbor(ancestors []*node, keyHash []byte, side int) (*node, error) {
+	for depth := len(ancestors) - 1; depth >= 0; depth-- {
+		sibling := ancestors[depth].children[side]
+		if bit(keyHash, depth) == side || sibling == nil {
+			continue
+		}
+
+		// the neighbor is the right-most leaf of the preceding subtree, or the
+		// left-most leaf of the following one.
+		ref, path := sibling, withBit(keyHash, depth, side)
+		for d := depth + 1; ; d++ {
+			n, err := t.load(ref, d, path)
+			if err != nil {
+				return nil, err
+			}
+			if n.isLeaf() {
+				return n, nil
+			}
+			b := 1 - side
+			if n.children[b] == nil {
+				b = side
+			}
+			ref, path = n.children[b], withBit(path, d, b)
+		}
+	}
+	return nil, nil
+}
+
+// existenceProof returns the existence proof of the leaf, given the inner nodes on its path.
+func existenceProof(ancestors []*node, leaf *node) *ics23.ExistenceProof {
+	path := make([]*ics23.InnerOp, 0, len(ancestors))
+	for depth := len(ancestors) - 1; depth >= 0; depth-- {
+		b := bit(leaf.keyHash, depth)
+		sibling := ancestors[depth].children[1-b].getHash()
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if b == 0 {
+			op.Prefix = innerPrefix
+			op.Suffix = sibling
+		} else {
+			op.Prefix = append(bytes.Clone(innerPrefix), sibling...)
+		}
+		path = append(path, op)
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   leaf.key,
+		Value: leaf.value,
+		Leaf: &ics23.LeafOp{
+			Hash:         ics23.HashOp_SHA256,
+			PrehashKey:   ics23.HashOp_SHA256,
+			PrehashValue: ics23.HashOp_SHA256,
+			Length:       ics23.LengthOp_NO_PREFIX,
+			Prefix:       leafPrefix,
+		},
+		Path: path,
+	}
+}
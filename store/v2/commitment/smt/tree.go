@@ -0,0 +1,535 @@
+package smt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/proof"
+)
+
+var (
+	_ commitment.Tree                = (*SMTTree)(nil)
+	_ commitment.CommitmentOpCreator = (*SMTTree)(nil)
+	_ commitment.ReadOnlyLoader      = (*SMTTree)(nil)
+)
+
+// importBatchSize is the number of leaves after which the importer flushes the
+// imported nodes to the database.
+const importBatchSize = 10_000
+
+// SMTTree is a versioned sparse Merkle tree whose proofs follow ics23.SmtSpec.
+//
+// Keys are hashed with SHA-256 and each leaf is placed at the shortest prefix of its
+// hashed key which is not shared with any other leaf. Contrary to IAVL, the structure
+// of the tree does not depend on the order of the writes and no rebalancing happens,
+// a write only rewrites the nodes on the path of its hashed key.
+type SMTTree struct {
+	db     corestore.KVStoreWithBatch
+	logger log.Logger
+	cache  *nodeCache
+
+	mtx sync.RWMutex
+	// root is the root of the latest saved version.
+	root           *nodeRef
+	version        uint64
+	loaded         bool
+	initialVersion uint64
+	// pending are the changes written since the latest saved version, by key.
+	pending map[string]change
+	// working is the result of applying the pending changes, computed lazily.
+	working *workingTree
+}
+
+type workingTree struct {
+	root  *nodeRef
+	stale []staleNode
+}
+
+// NewSMTTree creates a new SMTTree instance.
+func NewSMTTree(db corestore.KVStoreWithBatch, logger log.Logger, cfg *Config) *SMTTree {
+	return &SMTTree{
+		db:      db,
+		logger:  logger,
+		cache:   newNodeCache(cfg.CacheSize),
+		pending: make(map[string]change),
+	}
+}
+
+// Set sets the given key-value pair in the tree.
+func (t *SMTTree) Set(key, value []byte) error {
+	if value == nil {
+		return errors.New("value must not be nil")
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.pending[string(key)] = change{
+		keyHash: hashKey(key),
+		key:     bytes.Clone(key),
+		value:   bytes.Clone(value),
+	}
+	t.working = nil
+	return nil
+}
+
+// Remove removes the given key from the tree.
+func (t *SMTTree) Remove(key []byte) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.pending[string(key)] = change{
+		keyHash: hashKey(key),
+		key:     bytes.Clone(key),
+		remove:  true,
+	}
+	t.working = nil
+	return nil
+}
+
+// Hash returns the hash of the latest saved version of the tree.
+func (t *SMTTree) Hash() []byte {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	return t.root.getHash()
+}
+
+// WorkingHash returns the working hash of the tree.
+func (t *SMTTree) WorkingHash() []byte {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	working, err := t.workingTree()
+	if err != nil {
+		panic(fmt.Errorf("failed to compute the working hash: %w", err))
+	}
+	return working.root.getHash()
+}
+
+// workingTree applies the pending changes to the latest saved version, the caller
+// must hold the write lock.
+func (t *SMTTree) workingTree() (*workingTree, error) {
+	if t.working != nil {
+		return t.working, nil
+	}
+
+	changes := make([]change, 0, len(t.pending))
+	for _, c := range t.pending {
+		changes = append(changes, c)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].keyHash, changes[j].keyHash) < 0
+	})
+
+	u := &updater{tree: t}
+	root, err := u.update(t.root, 0, changes)
+	if err != nil {
+		return nil, err
+	}
+	t.working = &workingTree{root: root, stale: u.stale}
+	return t.working, nil
+}
+
+// LoadVersion loads the state at the given version, deleting the later versions.
+func (t *SMTTree) LoadVersion(version uint64) error {
+	return t.loadVersion(version, true)
+}
+
+// LoadVersionReadOnly loads the state at the given version, keeping the later versions.
+func (t *SMTTree) LoadVersionReadOnly(version uint64) error {
+	return t.loadVersion(version, false)
+}
+
+func (t *SMTTree) loadVersion(version uint64, overwrite bool) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	latest, err := t.latestVersion()
+	if err != nil {
+		return err
+	}
+	if version == 0 {
+		version = latest
+	}
+
+	var root *nodeRef
+	if version > 0 {
+		if root, err = t.getRoot(version); err != nil {
+			return err
+		}
+	}
+	if overwrite && version < latest {
+		if err := t.deleteVersionsFrom(version + 1); err != nil {
+			return err
+		}
+	}
+
+	t.root = root
+	t.version = version
+	t.loaded = true
+	t.pending = make(map[string]change)
+	t.working = nil
+	return nil
+}
+
+// Commit commits the current state to the tree.
+func (t *SMTTree) Commit() ([]byte, uint64, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	version := t.version + 1
+	if t.version == 0 && t.initialVersion > 0 {
+		version = t.initialVersion
+	}
+	hash, err := t.save(version, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	return hash, version, nil
+}
+
+// save persists the working tree at the given version, the caller must hold the
+// write lock. The root of the version is only written if writeRoot is true, which
+// allows the importer to flush the imported nodes before the import is complete.
+func (t *SMTTree) save(version uint64, writeRoot bool) ([]byte, error) {
+	working, err := t.workingTree()
+	if err != nil {
+		return nil, err
+	}
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	// stale nodes must be deleted before the new nodes are written, as a node
+	// written at the same version may replace one of them.
+	for _, stale := range working.stale {
+		if stale.version == version {
+			if err := batch.Delete(stale.key); err != nil {
+				return nil, err
+			}
+			t.cache.remove(stale.key)
+			continue
+		}
+		if err := batch.Set(staleKey(version, stale.key), []byte{}); err != nil {
+			return nil, err
+		}
+	}
+
+	var written []cacheEntry
+	if err := t.persist(batch, working.root, version, 0, make([]byte, hashSize), &written); err != nil {
+		return nil, err
+	}
+	if writeRoot {
+		if err := batch.Set(rootKey(version), encodeRef(nil, working.root)); err != nil {
+			return nil, err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	for _, e := range written {
+		t.cache.add([]byte(e.key), e.node)
+	}
+
+	var root *nodeRef
+	if working.root != nil {
+		root = &nodeRef{hash: working.root.hash, version: working.root.version, leaf: working.root.leaf}
+	}
+	t.root = root
+	t.pending = make(map[string]change)
+	t.working = nil
+	if writeRoot {
+		t.version = version
+		t.loaded = true
+	}
+	return root.getHash(), nil
+}
+
+// persist writes the nodes of the subtree which are not persisted yet.
+func (t *SMTTree) persist(batch corestore.Batch, ref *nodeRef, version uint64, depth int, path []byte, written *[]cacheEntry) error {
+	if ref == nil || ref.version != 0 {
+		return nil
+	}
+
+	n := ref.node
+	if n.isLeaf() {
+		path = n.keyHash
+	} else {
+		// the children are persisted first, for their version to be set.
+		for b, child := range n.children {
+			if err := t.persist(batch, child, version, depth+1, withBit(path, depth, b), written); err != nil {
+				return err
+			}
+			// the persisted children are loaded through the cache from now on.
+			if child != nil {
+				n.children[b] = &nodeRef{hash: child.hash, version: child.version, leaf: child.leaf}
+			}
+		}
+	}
+
+	key := nodeKey(version, depth, path)
+	if err := batch.Set(key, n.encode()); err != nil {
+		return err
+	}
+	ref.version = version
+	*written = append(*written, cacheEntry{key: string(key), node: n})
+	return nil
+}
+
+// SetInitialVersion sets the initial version of the database.
+func (t *SMTTree) SetInitialVersion(version uint64) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.initialVersion = version
+	return nil
+}
+
+// GetLatestVersion returns the latest version of the tree.
+func (t *SMTTree) GetLatestVersion() (uint64, error) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	if t.loaded {
+		return t.version, nil
+	}
+	return t.latestVersion()
+}
+
+// latestVersion returns the latest version persisted in the database.
+func (t *SMTTree) latestVersion() (uint64, error) {
+	iter, err := t.db.ReverseIterator(rootKeyPrefix, prefixEnd(rootKeyPrefix))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, iter.Error()
+	}
+	return versionFromRootKey(iter.Key()), nil
+}
+
+// rootAt returns the root of the given version.
+func (t *SMTTree) rootAt(version uint64) (*nodeRef, error) {
+	t.mtx.RLock()
+	if t.loaded && version == t.version {
+		root := t.root
+		t.mtx.RUnlock()
+		return root, nil
+	}
+	t.mtx.RUnlock()
+
+	return t.getRoot(version)
+}
+
+func (t *SMTTree) getRoot(version uint64) (*nodeRef, error) {
+	bz, err := t.db.Get(rootKey(version))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+	root, _, err := decodeRef(bz)
+	return root, err
+}
+
+// load returns the node referenced by ref, located at the given depth on the path.
+func (t *SMTTree) load(ref *nodeRef, depth int, path []byte) (*node, error) {
+	if ref.node != nil {
+		return ref.node, nil
+	}
+
+	key := nodeKey(ref.version, depth, path)
+	if n := t.cache.get(key); n != nil {
+		return n, nil
+	}
+	bz, err := t.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("node %X not found", key)
+	}
+	n, err := decodeNode(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode node %X: %w", key, err)
+	}
+	t.cache.add(key, n)
+	return n, nil
+}
+
+// Get returns the value of the key at the given version, or nil if the key does not exist.
+func (t *SMTTree) Get(version uint64, key []byte) ([]byte, error) {
+	root, err := t.rootAt(version)
+	if err != nil {
+		return nil, err
+	}
+
+	_, leaf, err := t.walk(root, hashKey(key))
+	if err != nil {
+		return nil, err
+	}
+	if leaf == nil || !bytes.Equal(leaf.key, key) {
+		return nil, nil
+	}
+	return leaf.value, nil
+}
+
+// walk descends the tree along the hashed key, returning the inner nodes on the path
+// and the leaf the path ends on, if any.
+func (t *SMTTree) walk(root *nodeRef, keyHash []byte) (ancestors []*node, leaf *node, err error) {
+	ref := root
+	for depth := 0; ref != nil; depth++ {
+		n, err := t.load(ref, depth, keyHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n.isLeaf() {
+			return ancestors, n, nil
+		}
+		ancestors = append(ancestors, n)
+		ref = n.children[bit(keyHash, depth)]
+	}
+	return ancestors, nil, nil
+}
+
+// GetProof returns a proof of existence or non-existence of the key at the given version.
+func (t *SMTTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	root, err := t.rootAt(version)
+	if err != nil {
+		return nil, err
+	}
+	return t.getProof(root, key)
+}
+
+// CommitmentOp implements commitment.CommitmentOpCreator.
+func (t *SMTTree) CommitmentOp(key []byte, p *ics23.CommitmentProof) proof.CommitmentOp {
+	return proof.NewSMTCommitmentOp(key, p)
+}
+
+// Prune prunes all versions up to and including the provided version.
+func (t *SMTTree) Prune(version uint64) error {
+	latest, err := t.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version >= latest {
+		// all the versions are pruned, e.g. for the trees of the removed stores.
+		t.mtx.Lock()
+		defer t.mtx.Unlock()
+
+		if err := t.deleteVersionsFrom(0); err != nil {
+			return err
+		}
+		t.root = nil
+		t.version = 0
+		t.loaded = true
+		return nil
+	}
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	// the nodes which became stale at version+1 are only referenced by the pruned versions.
+	iter, err := t.db.Iterator(staleKeyPrefix, versionKey(staleKeyPrefix, version+2))
+	if err != nil {
+		return err
+	}
+	for ; iter.Valid(); iter.Next() {
+		key := nodeKeyFromStaleKey(iter.Key())
+		if err := batch.Delete(key); err != nil {
+			iter.Close()
+			return err
+		}
+		if err := batch.Delete(bytes.Clone(iter.Key())); err != nil {
+			iter.Close()
+			return err
+		}
+		t.cache.remove(key)
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	if err := t.deleteRange(batch, rootKeyPrefix, versionKey(rootKeyPrefix, version+1)); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// deleteVersionsFrom deletes the given version and all the later ones.
+func (t *SMTTree) deleteVersionsFrom(version uint64) error {
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	// the nodes which became stale after the version are referenced again, only
+	// their stale entries are deleted.
+	for _, prefix := range [][]byte{nodeKeyPrefix, staleKeyPrefix, rootKeyPrefix} {
+		if err := t.deleteRange(batch, versionKey(prefix, version), prefixEnd(prefix)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	t.cache.reset()
+	return nil
+}
+
+func (t *SMTTree) deleteRange(batch corestore.Batch, start, end []byte) error {
+	iter, err := t.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.Delete(bytes.Clone(iter.Key())); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// Export exports the tree exporter at the given version.
+func (t *SMTTree) Export(version uint64) (commitment.Exporter, error) {
+	root, err := t.rootAt(version)
+	if err != nil {
+		return nil, err
+	}
+	return newExporter(t, root), nil
+}
+
+// Import imports the tree importer at the given version.
+func (t *SMTTree) Import(version uint64) (commitment.Importer, error) {
+	latest, err := t.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if latest != 0 {
+		return nil, fmt.Errorf("found database at version %d, must be 0", latest)
+	}
+	return &Importer{tree: t, version: version}, nil
+}
+
+// Close closes the tree, the underlying database is not closed.
+func (t *SMTTree) Close() error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.pending = make(map[string]change)
+	t.working = nil
+	t.cache.reset()
+	return nil
+}
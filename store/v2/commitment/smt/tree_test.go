@@ -0,0 +1,248 @@
+package smt
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	corelog "cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2/commitment"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+func TestCommitterSuite(t *testing.T) {
+	s := &commitment.CommitStoreTestSuite{
+		NewStore: func(db corestore.KVStoreWithBatch, storeKeys, oldStoreKeys []string, logger corelog.Logger) (*commitment.CommitStore, error) {
+			multiTrees := make(map[string]commitment.Tree)
+			cfg := DefaultConfig()
+			mountTreeFn := func(storeKey string) (commitment.Tree, error) {
+				prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
+				return NewSMTTree(prefixDB, logger, cfg), nil
+			}
+			for _, storeKey := range storeKeys {
+				multiTrees[storeKey], _ = mountTreeFn(storeKey)
+			}
+			oldTrees := make(map[string]commitment.Tree)
+			for _, storeKey := range oldStoreKeys {
+				oldTrees[storeKey], _ = mountTreeFn(storeKey)
+			}
+
+			commitStore, err := commitment.NewCommitStore(multiTrees, oldTrees, db, logger)
+			if err != nil {
+				return nil, err
+			}
+			commitStore.SetNewTreeFn(func(db corestore.KVStoreWithBatch, storeKey string) (commitment.Tree, error) {
+				return NewSMTTree(dbm.NewPrefixDB(db, []byte(storeKey)), logger, cfg), nil
+			})
+			return commitStore, nil
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func generateTree(db corestore.KVStoreWithBatch) *SMTTree {
+	return NewSMTTree(db, coretesting.NewNopLogger(), DefaultConfig())
+}
+
+func TestSMTTree(t *testing.T) {
+	// generate a new tree
+	db := dbm.NewMemDB()
+	tree := generateTree(db)
+	require.NotNil(t, tree)
+
+	initVersion, err := tree.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), initVersion)
+
+	// write a batch of version 1
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1")))
+	require.NoError(t, tree.Set([]byte("key2"), []byte("value2")))
+	require.NoError(t, tree.Set([]byte("key3"), []byte("value3")))
+
+	workingHash := tree.WorkingHash()
+	require.NotNil(t, workingHash)
+	v, err := tree.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), v)
+
+	// commit the batch
+	commitHash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, version, uint64(1))
+	require.Equal(t, workingHash, commitHash)
+	v, err = tree.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v)
+
+	// ensure we can get expected values
+	bz, err := tree.Get(1, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), bz)
+
+	bz, err = tree.Get(2, []byte("key1"))
+	require.Error(t, err)
+	require.Nil(t, bz)
+
+	// write a batch of version 2
+	require.NoError(t, tree.Set([]byte("key4"), []byte("value4")))
+	require.NoError(t, tree.Set([]byte("key5"), []byte("value5")))
+	require.NoError(t, tree.Set([]byte("key6"), []byte("value6")))
+	require.NoError(t, tree.Remove([]byte("key1"))) // delete key1
+	version2Hash := tree.WorkingHash()
+	require.NotNil(t, version2Hash)
+	commitHash, version, err = tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, version, uint64(2))
+	require.Equal(t, version2Hash, commitHash)
+
+	// get proof for key1
+	proof, err := tree.GetProof(1, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, proof.GetExist())
+	require.True(t, ics23.VerifyMembership(ics23.SmtSpec, workingHash, proof, []byte("key1"), []byte("value1")))
+
+	proof, err = tree.GetProof(2, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, proof.GetNonexist())
+	require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, version2Hash, proof, []byte("key1")))
+
+	// write a batch of version 3
+	require.NoError(t, tree.Set([]byte("key7"), []byte("value7")))
+	require.NoError(t, tree.Set([]byte("key8"), []byte("value8")))
+	_, _, err = tree.Commit()
+	require.NoError(t, err)
+
+	// prune version 1
+	require.NoError(t, tree.Prune(1))
+	v, err = tree.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), v)
+	_, err = tree.Get(1, []byte("key2"))
+	require.Error(t, err)
+	bz, err = tree.Get(2, []byte("key2"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), bz)
+
+	// load version 2 in a new tree
+	tree = generateTree(db)
+	require.NoError(t, tree.LoadVersion(2))
+	require.Equal(t, version2Hash, tree.WorkingHash())
+	v, err = tree.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), v)
+	_, err = tree.Get(3, []byte("key7"))
+	require.Error(t, err)
+
+	// close the db
+	require.NoError(t, tree.Close())
+}
+
+func TestSMTTree_Proofs(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tree := generateTree(dbm.NewMemDB())
+	state := make(map[string][]byte)
+
+	for version := uint64(1); version <= 20; version++ {
+		for i := 0; i < 50; i++ {
+			key := []byte(fmt.Sprintf("key-%d", rng.Intn(500)))
+			if rng.Intn(4) == 0 {
+				require.NoError(t, tree.Remove(key))
+				delete(state, string(key))
+				continue
+			}
+			value := []byte(fmt.Sprintf("value-%d-%d", version, i))
+			require.NoError(t, tree.Set(key, value))
+			state[string(key)] = value
+		}
+		hash, _, err := tree.Commit()
+		require.NoError(t, err)
+
+		for i := 0; i < 500; i++ {
+			key := []byte(fmt.Sprintf("key-%d", i))
+			proof, err := tree.GetProof(version, key)
+			require.NoError(t, err)
+			if value, ok := state[string(key)]; ok {
+				require.True(t, ics23.VerifyMembership(ics23.SmtSpec, hash, proof, key, value), "key %s", key)
+			} else {
+				require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, hash, proof, key), "key %s", key)
+			}
+		}
+	}
+}
+
+func TestSMTTree_HashIndependentOfHistory(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	tree := generateTree(dbm.NewMemDB())
+	state := make(map[string][]byte)
+
+	for version := 0; version < 10; version++ {
+		for i := 0; i < 100; i++ {
+			key := []byte(fmt.Sprintf("key-%d", rng.Intn(300)))
+			if rng.Intn(3) == 0 {
+				require.NoError(t, tree.Remove(key))
+				delete(state, string(key))
+				continue
+			}
+			value := []byte(fmt.Sprintf("value-%d", rng.Int()))
+			require.NoError(t, tree.Set(key, value))
+			state[string(key)] = value
+		}
+		_, _, err := tree.Commit()
+		require.NoError(t, err)
+	}
+
+	// the same leaves written at once result in the same hash
+	other := generateTree(dbm.NewMemDB())
+	for key, value := range state {
+		require.NoError(t, other.Set([]byte(key), value))
+	}
+	hash, _, err := other.Commit()
+	require.NoError(t, err)
+	require.Equal(t, tree.Hash(), hash)
+}
+
+func TestSMTTree_ExportImport(t *testing.T) {
+	tree := generateTree(dbm.NewMemDB())
+	for version := 0; version < 5; version++ {
+		for i := 0; i < 3*importBatchSize/10; i++ {
+			require.NoError(t, tree.Set([]byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d", i))))
+		}
+		_, _, err := tree.Commit()
+		require.NoError(t, err)
+	}
+
+	exporter, err := tree.Export(5)
+	require.NoError(t, err)
+	db := dbm.NewMemDB()
+	target := generateTree(db)
+	importer, err := target.Import(5)
+	require.NoError(t, err)
+	count := 0
+	for {
+		item, err := exporter.Next()
+		if err == commitment.ErrorExportDone {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, importer.Add(item))
+		count++
+	}
+	require.Equal(t, 15*importBatchSize/10, count)
+	require.NoError(t, importer.Commit())
+	require.NoError(t, importer.Close())
+	require.NoError(t, exporter.Close())
+
+	target = generateTree(db)
+	require.NoError(t, target.LoadVersion(5))
+	require.Equal(t, tree.Hash(), target.Hash())
+	bz, err := target.Get(5, []byte("key-2-7"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-7"), bz)
+}
@@ -0,0 +1,162 @@
+package smt
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+)
+
+// change is a pending write to the tree.
+type change struct {
+	keyHash []byte
+	key     []byte
+	value   []byte
+	remove  bool
+}
+
+// staleNode is a persisted node replaced by an update.
+type staleNode struct {
+	version uint64
+	key     []byte
+}
+
+// updater applies a batch of changes to a tree, recording the persisted nodes which
+// are replaced. The nodes created by the update are not persisted.
+type updater struct {
+	tree  *SMTTree
+	stale []staleNode
+}
+
+// update applies the changes, sorted by hashed key, to the subtree of ref at the given
+// depth and returns the new subtree. All the changes must belong to the subtree.
+func (u *updater) update(ref *nodeRef, depth int, changes []change) (*nodeRef, error) {
+	if len(changes) == 0 {
+		return ref, nil
+	}
+	if ref == nil {
+		return u.build(depth, sets(changes, nil))
+	}
+
+	path := changes[0].keyHash
+	n, err := u.tree.load(ref, depth, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *nodeRef
+	if n.isLeaf() {
+		updated, err = u.build(depth, sets(changes, n))
+	} else {
+		updated, err = u.updateInner(n, depth, changes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the subtree is unchanged, e.g. if only absent keys were removed.
+	if updated != nil && bytes.Equal(updated.hash, ref.hash) {
+		return ref, nil
+	}
+	u.markStale(ref, depth, path)
+	return updated, nil
+}
+
+func (u *updater) updateInner(n *node, depth int, changes []change) (*nodeRef, error) {
+	split := sort.Search(len(changes), func(i int) bool {
+		return bit(changes[i].keyHash, depth) == 1
+	})
+	parts := [2][]change{changes[:split], changes[split:]}
+
+	var children [2]*nodeRef
+	for b := range children {
+		child, err := u.update(n.children[b], depth+1, parts[b])
+		if err != nil {
+			return nil, err
+		}
+		children[b] = child
+	}
+
+	// a subtree holding a single leaf is replaced by the leaf itself.
+	for b, child := range children {
+		if children[1-b] != nil || child == nil || !child.leaf {
+			continue
+		}
+		if child.version == 0 {
+			return child, nil
+		}
+		// the persisted leaf moves up, it is written again at its new position.
+		path := withBit(changes[0].keyHash, depth, b)
+		leaf, err := u.tree.load(child, depth+1, path)
+		if err != nil {
+			return nil, err
+		}
+		u.markStale(child, depth+1, path)
+		return &nodeRef{hash: child.hash, leaf: true, node: leaf}, nil
+	}
+	if children[0] == nil && children[1] == nil {
+		return nil, nil
+	}
+	return newInner(children[0], children[1]), nil
+}
+
+// build builds the subtree at the given depth holding the given leaves, sorted by hashed key.
+func (u *updater) build(depth int, leaves []change) (*nodeRef, error) {
+	switch len(leaves) {
+	case 0:
+		return nil, nil
+	case 1:
+		return newLeaf(leaves[0].keyHash, leaves[0].key, leaves[0].value), nil
+	}
+	if depth >= maxDepth {
+		return nil, errors.New("hashed key collision")
+	}
+
+	split := sort.Search(len(leaves), func(i int) bool {
+		return bit(leaves[i].keyHash, depth) == 1
+	})
+	left, err := u.build(depth+1, leaves[:split])
+	if err != nil {
+		return nil, err
+	}
+	right, err := u.build(depth+1, leaves[split:])
+	if err != nil {
+		return nil, err
+	}
+	return newInner(left, right), nil
+}
+
+func (u *updater) markStale(ref *nodeRef, depth int, path []byte) {
+	if ref.version == 0 {
+		return
+	}
+	u.stale = append(u.stale, staleNode{
+		version: ref.version,
+		key:     nodeKey(ref.version, depth, path),
+	})
+}
+
+// sets returns the leaves resulting from applying the changes to the given existing
+// leaf, if any, sorted by hashed key.
+func sets(changes []change, leaf *node) []change {
+	leaves := make([]change, 0, len(changes)+1)
+	for _, c := range changes {
+		if leaf != nil && bytes.Equal(c.key, leaf.key) {
+			leaf = nil
+		}
+		if !c.remove {
+			leaves = append(leaves, c)
+		}
+	}
+	if leaf == nil {
+		return leaves
+	}
+
+	// the existing leaf is not overwritten, insert it.
+	i := sort.Search(len(leaves), func(i int) bool {
+		return bytes.Compare(leaves[i].keyHash, leaf.keyHash) >= 0
+	})
+	leaves = append(leaves, change{})
+	copy(leaves[i+1:], leaves[i:])
+	leaves[i] = change{keyHash: leaf.keyHash, key: leaf.key, value: leaf.value}
+	return leaves
+}
@@ -21,10 +21,13 @@ import (
 )
 
 var (
-	_ store.Committer             = (*CommitStore)(nil)
-	_ store.UpgradeableStore      = (*CommitStore)(nil)
-	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
-	_ store.PausablePruner        = (*CommitStore)(nil)
+	_ store.Committer                   = (*CommitStore)(nil)
+	_ store.UpgradeableStore            = (*CommitStore)(nil)
+	_ snapshots.CommitSnapshotter       = (*CommitStore)(nil)
+	_ snapshots.StreamCommitSnapshotter = (*CommitStore)(nil)
+	_ store.PausablePruner              = (*CommitStore)(nil)
+	_ store.StorePruner                 = (*CommitStore)(nil)
+	_ store.Checkpointer                = (*CommitStore)(nil)
 )
 
 // MountTreeFn is a function that mounts a tree given a store key.
@@ -43,15 +46,21 @@ type CommitStore struct {
 	// oldTrees is a map of store keys to old trees that have been deleted or renamed.
 	// It is used to get the proof for the old store keys.
 	oldTrees map[string]Tree
+	// prunedVersions is a map of store keys to the version their tree has been
+	// pruned to, which bounds the pruning of the commit infos.
+	prunedVersions map[string]uint64
+	// newTree creates the trees of the branches of the store, see Branch.
+	newTree NewTreeFn
 }
 
 // NewCommitStore creates a new CommitStore instance.
 func NewCommitStore(trees, oldTrees map[string]Tree, db corestore.KVStoreWithBatch, logger corelog.Logger) (*CommitStore, error) {
 	return &CommitStore{
-		logger:     logger,
-		multiTrees: trees,
-		oldTrees:   oldTrees,
-		metadata:   NewMetadataStore(db),
+		logger:         logger,
+		multiTrees:     trees,
+		oldTrees:       oldTrees,
+		metadata:       NewMetadataStore(db),
+		prunedVersions: make(map[string]uint64),
 	}, nil
 }
 
@@ -267,6 +276,9 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 		return nil, fmt.Errorf("commit info not found for version %d", version)
 	}
 	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
+	if creator, ok := tree.(CommitmentOpCreator); ok {
+		commitOp = creator.CommitmentOp(key, iProof)
+	}
 	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
 	if err != nil {
 		return nil, err
@@ -291,23 +303,66 @@ func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte,
 
 // Prune implements store.Pruner.
 func (c *CommitStore) Prune(version uint64) error {
-	// prune the metadata
-	for v := version; v > 0; v-- {
-		if err := c.metadata.deleteCommitInfo(v); err != nil {
-			return err
-		}
-	}
+	return c.PruneExcept(version, nil)
+}
+
+// PruneExcept implements store.StorePruner. The commit infos are only pruned up
+// to the version the excluded stores have been pruned to, since they are required
+// to prove the state of any store.
+func (c *CommitStore) PruneExcept(version uint64, excludedStoreKeys []string) error {
 	// prune the trees
-	for _, tree := range c.multiTrees {
+	for storeKey, tree := range c.multiTrees {
+		if slices.Contains(excludedStoreKeys, storeKey) {
+			continue
+		}
 		if err := tree.Prune(version); err != nil {
 			return err
 		}
+		c.prunedVersions[storeKey] = version
 	}
 	// prune the removed store keys
 	if err := c.pruneRemovedStoreKeys(version); err != nil {
 		return err
 	}
 
+	return c.pruneCommitInfos()
+}
+
+// PruneStore implements store.StorePruner.
+func (c *CommitStore) PruneStore(storeKey string, version uint64) error {
+	tree, ok := c.multiTrees[storeKey]
+	if !ok {
+		// the removed stores are pruned along with the other stores.
+		if _, ok := c.oldTrees[storeKey]; ok {
+			return nil
+		}
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+	if err := tree.Prune(version); err != nil {
+		return err
+	}
+	c.prunedVersions[storeKey] = version
+
+	return c.pruneCommitInfos()
+}
+
+// pruneCommitInfos prunes the commit infos up to the lowest version the trees
+// have been pruned to.
+func (c *CommitStore) pruneCommitInfos() error {
+	if len(c.multiTrees) == 0 {
+		return nil
+	}
+	version := uint64(math.MaxUint64)
+	for storeKey := range c.multiTrees {
+		version = min(version, c.prunedVersions[storeKey])
+	}
+
+	for v := version; v > 0; v-- {
+		if err := c.metadata.deleteCommitInfo(v); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -333,6 +388,49 @@ func (c *CommitStore) PausePruning(pause bool) {
 
 // Snapshot implements snapshotstypes.CommitSnapshotter.
 func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error {
+	if err := c.checkSnapshotVersion(version); err != nil {
+		return err
+	}
+
+	for storeKey := range c.multiTrees {
+		// TODO: check the parallelism of this loop
+		err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_Store{
+				Store: &snapshotstypes.SnapshotStoreItem{
+					Name: storeKey,
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write store name: %w", err)
+		}
+
+		if err := c.snapshotStore(version, storeKey, protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotStoreKeys implements snapshots.StreamCommitSnapshotter.
+func (c *CommitStore) SnapshotStoreKeys() []string {
+	return slices.Sorted(maps.Keys(c.multiTrees))
+}
+
+// SnapshotStore implements snapshots.StreamCommitSnapshotter.
+func (c *CommitStore) SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error {
+	if err := c.checkSnapshotVersion(version); err != nil {
+		return err
+	}
+	if _, ok := c.multiTrees[storeKey]; !ok {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+
+	return c.snapshotStore(version, storeKey, protoWriter)
+}
+
+func (c *CommitStore) checkSnapshotVersion(version uint64) error {
 	if version == 0 {
 		return errors.New("the snapshot version must be greater than 0")
 	}
@@ -345,46 +443,31 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 		return fmt.Errorf("the snapshot version %d is greater than the latest version %d", version, latestVersion)
 	}
 
-	for storeKey, tree := range c.multiTrees {
-		// TODO: check the parallelism of this loop
-		if err := func() error {
-			exporter, err := tree.Export(version)
-			if err != nil {
-				return fmt.Errorf("failed to export tree for version %d: %w", version, err)
-			}
-			defer exporter.Close()
-
-			err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-				Item: &snapshotstypes.SnapshotItem_Store{
-					Store: &snapshotstypes.SnapshotStoreItem{
-						Name: storeKey,
-					},
-				},
-			})
-			if err != nil {
-				return fmt.Errorf("failed to write store name: %w", err)
-			}
+	return nil
+}
 
-			for {
-				item, err := exporter.Next()
-				if errors.Is(err, ErrorExportDone) {
-					break
-				} else if err != nil {
-					return fmt.Errorf("failed to get the next export node: %w", err)
-				}
+// snapshotStore writes the IAVL nodes of the tree of the given store at the given version.
+func (c *CommitStore) snapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error {
+	exporter, err := c.multiTrees[storeKey].Export(version)
+	if err != nil {
+		return fmt.Errorf("failed to export tree for version %d: %w", version, err)
+	}
+	defer exporter.Close()
 
-				if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-					Item: &snapshotstypes.SnapshotItem_IAVL{
-						IAVL: item,
-					},
-				}); err != nil {
-					return fmt.Errorf("failed to write iavl node: %w", err)
-				}
-			}
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to get the next export node: %w", err)
+		}
 
-			return nil
-		}(); err != nil {
-			return err
+		if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_IAVL{
+				IAVL: item,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to write iavl node: %w", err)
 		}
 	}
 
@@ -440,35 +523,8 @@ loop:
 			if importer == nil {
 				return snapshotstypes.SnapshotItem{}, errors.New("received IAVL node item before store item")
 			}
-			node := item.IAVL
-			if node.Height > int32(math.MaxInt8) {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 {
-				if node.Value == nil {
-					node.Value = []byte{}
-				}
-
-				// If the node is a leaf node, it will be written to the storage.
-				chStorage <- &corestore.StateChanges{
-					Actor: storeKey,
-					StateChanges: []corestore.KVPair{
-						{
-							Key:   node.Key,
-							Value: node.Value,
-						},
-					},
-				}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to add node to importer: %w", err)
+			if err := restoreNode(importer, storeKey, item.IAVL, chStorage); err != nil {
+				return snapshotstypes.SnapshotItem{}, err
 			}
 		default:
 			break loop
@@ -484,6 +540,100 @@ loop:
 	return snapshotItem, c.LoadVersion(version)
 }
 
+// RestoreStore implements snapshots.StreamCommitSnapshotter.
+func (c *CommitStore) RestoreStore(
+	version uint64,
+	storeKey string,
+	protoReader protoio.Reader,
+	chStorage chan<- *corestore.StateChanges,
+) error {
+	tree := c.multiTrees[storeKey]
+	if tree == nil {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+
+	// the tree is already restored if the previous restoration was interrupted after
+	// committing it, the nodes are still read to pass the KV pairs to the storage.
+	latestVersion, err := tree.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	var importer Importer
+	if latestVersion != version {
+		importer, err = tree.Import(version)
+		if err != nil {
+			return fmt.Errorf("failed to import tree for version %d: %w", version, err)
+		}
+		defer importer.Close()
+	}
+
+	for {
+		snapshotItem := snapshotstypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		item, ok := snapshotItem.Item.(*snapshotstypes.SnapshotItem_IAVL)
+		if !ok {
+			return fmt.Errorf("unexpected snapshot item %T in store %s", snapshotItem.Item, storeKey)
+		}
+		if err := restoreNode(importer, []byte(storeKey), item.IAVL, chStorage); err != nil {
+			return err
+		}
+	}
+
+	if importer != nil {
+		if err := importer.Commit(); err != nil {
+			return fmt.Errorf("failed to commit importer: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// FinalizeRestore implements snapshots.StreamCommitSnapshotter.
+func (c *CommitStore) FinalizeRestore(version uint64) error {
+	return c.LoadVersion(version)
+}
+
+// restoreNode adds the node to the importer, if any, and passes the leaf nodes to the storage.
+func restoreNode(importer Importer, storeKey []byte, node *snapshotstypes.SnapshotIAVLItem, chStorage chan<- *corestore.StateChanges) error {
+	if node.Height > int32(math.MaxInt8) {
+		return fmt.Errorf("node height %v cannot exceed %v", node.Height, math.MaxInt8)
+	}
+	// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 {
+		if node.Value == nil {
+			node.Value = []byte{}
+		}
+
+		// If the node is a leaf node, it will be written to the storage.
+		chStorage <- &corestore.StateChanges{
+			Actor: storeKey,
+			StateChanges: []corestore.KVPair{
+				{
+					Key:   node.Key,
+					Value: node.Value,
+				},
+			},
+		}
+	}
+	if importer == nil {
+		return nil
+	}
+	if err := importer.Add(node); err != nil {
+		return fmt.Errorf("failed to add node to importer: %w", err)
+	}
+	return nil
+}
+
 func (c *CommitStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
 	return c.metadata.GetCommitInfo(version)
 }
@@ -492,6 +642,16 @@ func (c *CommitStore) GetLatestVersion() (uint64, error) {
 	return c.metadata.GetLatestVersion()
 }
 
+// Checkpoint implements store.Checkpointer, if the database of the trees does.
+func (c *CommitStore) Checkpoint(dir string) error {
+	db, ok := c.metadata.kv.(store.Checkpointer)
+	if !ok {
+		return errors.New("db does not implement Checkpointer interface")
+	}
+
+	return db.Checkpoint(dir)
+}
+
 func (c *CommitStore) Close() error {
 	for _, tree := range c.multiTrees {
 		if err := tree.Close(); err != nil {
@@ -14,6 +14,7 @@ import (
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/commitment/smt"
 	dbm "cosmossdk.io/store/v2/db"
 )
 
@@ -30,6 +31,14 @@ var (
 			return dbm.NewGoLevelDB("test", dataDir, nil)
 		},
 	}
+	treeBackends = map[string]func(db corestore.KVStoreWithBatch) commitment.Tree{
+		"iavl": func(db corestore.KVStoreWithBatch) commitment.Tree {
+			return iavl.NewIavlTree(db, coretesting.NewNopLogger(), iavl.DefaultConfig())
+		},
+		"smt": func(db corestore.KVStoreWithBatch) commitment.Tree {
+			return smt.NewSMTTree(db, coretesting.NewNopLogger(), smt.DefaultConfig())
+		},
+	}
 	rng        = rand.New(rand.NewSource(543210))
 	changesets = make([]*corestore.Changeset, 1000)
 )
@@ -58,12 +67,12 @@ func init() {
 	}
 }
 
-func getCommitStore(b *testing.B, db corestore.KVStoreWithBatch) *commitment.CommitStore {
+func getCommitStore(b *testing.B, db corestore.KVStoreWithBatch, newTree func(corestore.KVStoreWithBatch) commitment.Tree) *commitment.CommitStore {
 	b.Helper()
 	multiTrees := make(map[string]commitment.Tree)
 	for _, storeKey := range storeKeys {
 		prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
-		multiTrees[storeKey] = iavl.NewIavlTree(prefixDB, coretesting.NewNopLogger(), iavl.DefaultConfig())
+		multiTrees[storeKey] = newTree(prefixDB)
 	}
 
 	sc, err := commitment.NewCommitStore(multiTrees, nil, db, coretesting.NewNopLogger())
@@ -73,57 +82,61 @@ func getCommitStore(b *testing.B, db corestore.KVStoreWithBatch) *commitment.Com
 }
 
 func BenchmarkCommit(b *testing.B) {
-	for ty, fn := range dbBackends {
-		b.Run(fmt.Sprintf("backend_%s", ty), func(b *testing.B) {
-			b.ResetTimer()
-			b.ReportAllocs()
-			b.StopTimer()
-			for i := 0; i < b.N; i++ {
-				db, err := fn(b.TempDir())
-				require.NoError(b, err)
-				sc := getCommitStore(b, db)
-				b.StartTimer()
-				for j, cs := range changesets {
-					require.NoError(b, sc.WriteChangeset(cs))
-					_, err := sc.Commit(uint64(j + 1))
+	for tree, newTree := range treeBackends {
+		for ty, fn := range dbBackends {
+			b.Run(fmt.Sprintf("tree_%s/backend_%s", tree, ty), func(b *testing.B) {
+				b.ResetTimer()
+				b.ReportAllocs()
+				b.StopTimer()
+				for i := 0; i < b.N; i++ {
+					db, err := fn(b.TempDir())
 					require.NoError(b, err)
+					sc := getCommitStore(b, db, newTree)
+					b.StartTimer()
+					for j, cs := range changesets {
+						require.NoError(b, sc.WriteChangeset(cs))
+						_, err := sc.Commit(uint64(j + 1))
+						require.NoError(b, err)
+					}
+					b.StopTimer()
+					require.NoError(b, db.Close())
 				}
-				b.StopTimer()
-				require.NoError(b, db.Close())
-			}
-		})
+			})
+		}
 	}
 }
 
 func BenchmarkGetProof(b *testing.B) {
-	for ty, fn := range dbBackends {
-		db, err := fn(b.TempDir())
-		require.NoError(b, err)
-		sc := getCommitStore(b, db)
+	for tree, newTree := range treeBackends {
+		for ty, fn := range dbBackends {
+			db, err := fn(b.TempDir())
+			require.NoError(b, err)
+			sc := getCommitStore(b, db, newTree)
 
-		b.Run(fmt.Sprintf("backend_%s", ty), func(b *testing.B) {
-			b.ResetTimer()
-			b.ReportAllocs()
-			b.StopTimer()
-			// commit some changesets
-			for i, cs := range changesets {
-				require.NoError(b, sc.WriteChangeset(cs))
-				_, err = sc.Commit(uint64(i + 1))
-				require.NoError(b, err)
-			}
-			b.StartTimer()
+			b.Run(fmt.Sprintf("tree_%s/backend_%s", tree, ty), func(b *testing.B) {
+				b.ResetTimer()
+				b.ReportAllocs()
+				b.StopTimer()
+				// commit some changesets
+				for i, cs := range changesets {
+					require.NoError(b, sc.WriteChangeset(cs))
+					_, err = sc.Commit(uint64(i + 1))
+					require.NoError(b, err)
+				}
+				b.StartTimer()
 
-			for i := 0; i < b.N; i++ {
-				// non-existing proof
-				p, err := sc.GetProof([]byte(storeKeys[0]), 500, []byte("key-1-1"))
-				require.NoError(b, err)
-				require.NotNil(b, p)
-				// existing proof
-				p, err = sc.GetProof([]byte(storeKeys[1]), 500, changesets[499].Changes[1].StateChanges[1].Key)
-				require.NoError(b, err)
-				require.NotNil(b, p)
-			}
-		})
-		require.NoError(b, db.Close())
+				for i := 0; i < b.N; i++ {
+					// non-existing proof
+					p, err := sc.GetProof([]byte(storeKeys[0]), 500, []byte("key-1-1"))
+					require.NoError(b, err)
+					require.NotNil(b, p)
+					// existing proof
+					p, err = sc.GetProof([]byte(storeKeys[1]), 500, changesets[499].Changes[1].StateChanges[1].Key)
+					require.NoError(b, err)
+					require.NotNil(b, p)
+				}
+			})
+			require.NoError(b, db.Close())
+		}
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/sync/errgroup"
 
 	corelog "cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
@@ -125,6 +126,92 @@ func (s *CommitStoreTestSuite) TestStore_Snapshotter() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_StreamSnapshotter() {
+	storeKeys := []string{storeKey2, storeKey1}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	latestVersion := uint64(10)
+	kvCount := 10
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			kvPairs[storeKey] = corestore.KVPairs{}
+			for j := 0; j < kvCount; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", i, j))
+				value := []byte(fmt.Sprintf("value-%d-%d", i, j))
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value})
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+	cInfo := commitStore.WorkingCommitInfo(latestVersion)
+	s.Require().Equal([]string{storeKey1, storeKey2}, commitStore.SnapshotStoreKeys())
+
+	targetStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	// restoreStore snapshots and restores a single store, returning the restored leaves.
+	restoreStore := func(storeKey string) (int, error) {
+		chunks := make(chan io.ReadCloser, kvCount*int(latestVersion))
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			if err := commitStore.SnapshotStore(latestVersion, storeKey, streamWriter); err != nil {
+				streamWriter.CloseWithError(err)
+				return
+			}
+			_ = streamWriter.Close()
+		}()
+
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		if err != nil {
+			return 0, err
+		}
+		defer streamReader.Close()
+		chStorage := make(chan *corestore.StateChanges, 100)
+		leaves := make(chan int)
+		go func() {
+			count := 0
+			for kv := range chStorage {
+				if string(kv.Actor) == storeKey {
+					count += len(kv.StateChanges)
+				}
+			}
+			leaves <- count
+		}()
+		err = targetStore.RestoreStore(latestVersion, storeKey, streamReader, chStorage)
+		close(chStorage)
+		return <-leaves, err
+	}
+
+	// the stores are restored concurrently
+	var eg errgroup.Group
+	for _, storeKey := range storeKeys {
+		eg.Go(func() error {
+			leaves, err := restoreStore(storeKey)
+			if err != nil {
+				return err
+			}
+			if leaves != kvCount*int(latestVersion) {
+				return fmt.Errorf("store %s restored %d leaves", storeKey, leaves)
+			}
+			return nil
+		})
+	}
+	s.Require().NoError(eg.Wait())
+
+	// restoring an already restored store only passes the leaves to the storage again
+	leaves, err := restoreStore(storeKey1)
+	s.Require().NoError(err)
+	s.Require().Equal(kvCount*int(latestVersion), leaves)
+
+	s.Require().NoError(targetStore.FinalizeRestore(latestVersion))
+	s.Require().Equal(cInfo.Hash(), targetStore.WorkingCommitInfo(latestVersion).Hash())
+}
+
 func (s *CommitStoreTestSuite) TestStore_LoadVersion() {
 	storeKeys := []string{storeKey1, storeKey2}
 	mdb := dbm.NewMemDB()
@@ -180,6 +267,70 @@ func (s *CommitStoreTestSuite) TestStore_LoadVersion() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_Branch() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	changeset := func(version uint64, value string) *corestore.Changeset {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			for j := 0; j < 10; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", version, j))
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: []byte(value)})
+			}
+		}
+		return corestore.NewChangesetWithPairs(kvPairs)
+	}
+
+	latestVersion := uint64(5)
+	for i := uint64(1); i <= latestVersion; i++ {
+		s.Require().NoError(commitStore.WriteChangeset(changeset(i, "value")))
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+	latestCommitInfo, err := commitStore.GetCommitInfo(latestVersion)
+	s.Require().NoError(err)
+
+	// replaying the changeset of a version on a branch of the previous one gives
+	// the committed hash
+	committed, err := commitStore.GetCommitInfo(3)
+	s.Require().NoError(err)
+	branch, err := commitStore.Branch(2)
+	s.Require().NoError(err)
+	s.Require().NoError(branch.WriteChangeset(changeset(3, "value")))
+	s.Require().Equal(committed.Hash(), branch.WorkingCommitInfo(3).Hash())
+	// the branch cannot be committed
+	_, err = branch.Commit(3)
+	s.Require().Error(err)
+	s.Require().NoError(branch.Close())
+
+	// a different changeset gives a different hash
+	branch, err = commitStore.Branch(2)
+	s.Require().NoError(err)
+	s.Require().NoError(branch.WriteChangeset(changeset(3, "other value")))
+	s.Require().NotEqual(committed.Hash(), branch.WorkingCommitInfo(3).Hash())
+	s.Require().NoError(branch.Close())
+
+	// a version which was not committed cannot be branched
+	_, err = commitStore.Branch(latestVersion + 1)
+	s.Require().Error(err)
+
+	// the store is left untouched
+	v, err := commitStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(latestVersion, v)
+	for i := uint64(1); i <= latestVersion; i++ {
+		commitInfo, err := commitStore.GetCommitInfo(i)
+		s.Require().NoError(err)
+		s.Require().NotNil(commitInfo)
+	}
+	s.Require().Equal(latestCommitInfo.Hash(), commitStore.WorkingCommitInfo(latestVersion).Hash())
+	s.Require().NoError(commitStore.WriteChangeset(changeset(latestVersion+1, "value")))
+	_, err = commitStore.Commit(latestVersion + 1)
+	s.Require().NoError(err)
+}
+
 func (s *CommitStoreTestSuite) TestStore_Pruning() {
 	storeKeys := []string{storeKey1, storeKey2}
 	pruneOpts := store.NewPruningOptionWithCustom(10, 5)
@@ -221,6 +372,51 @@ func (s *CommitStoreTestSuite) TestStore_Pruning() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_PruneStore() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	latestVersion := uint64(20)
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			kvPairs[storeKey] = corestore.KVPairs{{Key: []byte(fmt.Sprintf("key-%d", i)), Value: []byte(fmt.Sprintf("value-%d", i))}}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+
+	checkCommitInfos := func(pruneVersion uint64) {
+		for i := uint64(1); i <= latestVersion; i++ {
+			commitInfo, _ := commitStore.GetCommitInfo(i)
+			if i <= pruneVersion {
+				s.Require().Nil(commitInfo)
+			} else {
+				s.Require().NotNil(commitInfo)
+			}
+		}
+	}
+
+	// the commit infos are kept as long as store1 is not pruned
+	s.Require().NoError(commitStore.PruneExcept(10, []string{storeKey1}))
+	checkCommitInfos(0)
+	for i := uint64(1); i <= latestVersion; i++ {
+		_, err := commitStore.GetProof([]byte(storeKey1), i, []byte(fmt.Sprintf("key-%d", i)))
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(commitStore.PruneStore(storeKey1, 5))
+	checkCommitInfos(5)
+
+	s.Require().NoError(commitStore.PruneStore(storeKey1, 15))
+	checkCommitInfos(10)
+
+	s.Require().Error(commitStore.PruneStore("unknown", 15))
+}
+
 func (s *CommitStoreTestSuite) TestStore_GetProof() {
 	storeKeys := []string{storeKey1, storeKey2}
 	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
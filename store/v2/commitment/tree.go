@@ -6,6 +6,7 @@ import (
 
 	ics23 "github.com/cosmos/ics23/go"
 
+	"cosmossdk.io/store/v2/proof"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -42,6 +43,21 @@ type Tree interface {
 	io.Closer
 }
 
+// CommitmentOpCreator is an optional interface implemented by the trees whose proofs
+// are not IAVL proofs. It wraps a proof of the tree in the commitment op matching
+// its proof spec.
+type CommitmentOpCreator interface {
+	CommitmentOp(key []byte, proof *ics23.CommitmentProof) proof.CommitmentOp
+}
+
+// ReadOnlyLoader is an optional interface implemented by the trees which can be
+// loaded without writing to their database, which is required to branch them.
+type ReadOnlyLoader interface {
+	// LoadVersionReadOnly loads the state at the given version, keeping the later
+	// versions.
+	LoadVersionReadOnly(version uint64) error
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
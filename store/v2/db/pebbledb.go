@@ -15,7 +15,10 @@ import (
 	storeerrors "cosmossdk.io/store/v2/errors"
 )
 
-var _ corestore.KVStoreWithBatch = (*PebbleDB)(nil)
+var (
+	_ corestore.KVStoreWithBatch = (*PebbleDB)(nil)
+	_ store.Checkpointer         = (*PebbleDB)(nil)
+)
 
 // PebbleDB implements `corestore.KVStoreWithBatch` using PebbleDB as the underlying storage engine.
 // It is used for only store v2 migration, since some clients use PebbleDB as
@@ -50,6 +53,12 @@ func NewPebbleDBWithOpts(name, dataDir string, opts store.DBOptions) (*PebbleDB,
 	return &PebbleDB{storage: db}, nil
 }
 
+// Checkpoint implements store.Checkpointer, the files of the checkpoint are hard
+// linked to the ones of the database when possible.
+func (db *PebbleDB) Checkpoint(dir string) error {
+	return db.storage.Checkpoint(dir, pebble.WithFlushedWAL())
+}
+
 func (db *PebbleDB) Close() error {
 	err := db.storage.Close()
 	db.storage = nil
@@ -75,7 +84,8 @@ func (db *PebbleDB) Get(key []byte) ([]byte, error) {
 		return nil, closer.Close()
 	}
 
-	return bz, closer.Close()
+	// the value is only valid until the closer is closed
+	return slices.Clone(bz), closer.Close()
 }
 
 func (db *PebbleDB) Has(key []byte) (bool, error) {
@@ -13,11 +13,13 @@ import (
 	"github.com/linxGnu/grocksdb"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
 	storeerrors "cosmossdk.io/store/v2/errors"
 )
 
 var (
 	_ corestore.KVStoreWithBatch = (*RocksDB)(nil)
+	_ store.Checkpointer         = (*RocksDB)(nil)
 
 	defaultReadOpts = grocksdb.NewDefaultReadOptions()
 )
@@ -67,6 +69,19 @@ func NewRocksDBWithOpts(name, dataDir string, opts *grocksdb.Options) (*RocksDB,
 	}, nil
 }
 
+// Checkpoint implements store.Checkpointer, the files of the checkpoint are hard
+// linked to the ones of the database when possible.
+func (db *RocksDB) Checkpoint(dir string) error {
+	cp, err := db.storage.NewCheckpoint()
+	if err != nil {
+		return err
+	}
+	defer cp.Destroy()
+
+	// always flush the memtables, so that the checkpoint holds no WAL to replay
+	return cp.CreateCheckpoint(dir, 0)
+}
+
 func (db *RocksDB) Close() error {
 	db.storage.Close()
 	db.storage = nil
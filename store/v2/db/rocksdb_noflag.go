@@ -8,7 +8,10 @@ import (
 	"cosmossdk.io/store/v2"
 )
 
-var _ corestore.KVStoreWithBatch = (*RocksDB)(nil)
+var (
+	_ corestore.KVStoreWithBatch = (*RocksDB)(nil)
+	_ store.Checkpointer         = (*RocksDB)(nil)
+)
 
 // RocksDB implements `corestore.KVStoreWithBatch` using RocksDB as the underlying storage engine.
 // It is used for only store v2 migration, since some clients use RocksDB as
@@ -23,6 +26,10 @@ func NewRocksDBWithOpts(dataDir string, opts store.DBOptions) (*RocksDB, error)
 	panic("rocksdb must be built with -tags rocksdb")
 }
 
+func (db *RocksDB) Checkpoint(dir string) error {
+	panic("rocksdb must be built with -tags rocksdb")
+}
+
 func (db *RocksDB) Close() error {
 	panic("rocksdb must be built with -tags rocksdb")
 }
@@ -1,7 +1,43 @@
 package internal
 
-import "strings"
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
 
 func IsMemoryStoreKey(key string) bool {
 	return strings.HasPrefix(key, "memory:")
 }
+
+// LinkOrCopyFile hard links the src file to dst, or copies it if they are not on
+// the same file system. It must only be used for immutable files.
+func LinkOrCopyFile(src, dst string) error {
+	if err := os.Link(src, dst); err == nil {
+		return nil
+	}
+	return CopyFile(src, dst)
+}
+
+// CopyFile copies the src file to dst and syncs it.
+func CopyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, out.Close())
+	}()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return err
+	}
+	return out.Sync()
+}
@@ -1,6 +1,10 @@
 package pruning
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
 	"cosmossdk.io/store/v2"
 )
 
@@ -10,10 +14,16 @@ type Manager struct {
 	scPruner store.Pruner
 	// scPruningOption are the pruning options for the SC.
 	scPruningOption *store.PruningOption
+	// scStorePruningOptions are the per-store pruning options for the SC, which
+	// override scPruningOption for the given store keys.
+	scStorePruningOptions map[string]*store.PruningOption
 	// ssPruner is the pruner for the SS.
 	ssPruner store.Pruner
 	// ssPruningOption are the pruning options for the SS.
 	ssPruningOption *store.PruningOption
+	// ssStorePruningOptions are the per-store pruning options for the SS, which
+	// override ssPruningOption for the given store keys.
+	ssStorePruningOptions map[string]*store.PruningOption
 }
 
 // NewManager creates a new Pruning Manager.
@@ -26,28 +36,77 @@ func NewManager(scPruner, ssPruner store.Pruner, scPruningOption, ssPruningOptio
 	}
 }
 
+// NewManagerWithStoreOptions creates a new Pruning Manager with per-store pruning
+// options overriding the default ones for the given store keys. A store whose
+// override is nil or has a zero Interval is never pruned.
+//
+// The pruners must implement the store.StorePruner interface if they have any
+// per-store pruning options.
+func NewManagerWithStoreOptions(
+	scPruner, ssPruner store.Pruner,
+	scPruningOption, ssPruningOption *store.PruningOption,
+	scStorePruningOptions, ssStorePruningOptions map[string]*store.PruningOption,
+) (*Manager, error) {
+	if _, ok := scPruner.(store.StorePruner); !ok && len(scStorePruningOptions) > 0 {
+		return nil, fmt.Errorf("SC pruner %T does not support per-store pruning options", scPruner)
+	}
+	if _, ok := ssPruner.(store.StorePruner); !ok && len(ssStorePruningOptions) > 0 {
+		return nil, fmt.Errorf("SS pruner %T does not support per-store pruning options", ssPruner)
+	}
+
+	m := NewManager(scPruner, ssPruner, scPruningOption, ssPruningOption)
+	m.scStorePruningOptions = scStorePruningOptions
+	m.ssStorePruningOptions = ssStorePruningOptions
+	return m, nil
+}
+
 // Prune prunes the SC and SS to the provided version.
 //
 // NOTE: It can be called outside of the store manually.
 func (m *Manager) Prune(version uint64) error {
 	// Prune the SC.
-	if m.scPruningOption != nil {
-		if prune, pruneTo := m.scPruningOption.ShouldPrune(version); prune {
-			if err := m.scPruner.Prune(pruneTo); err != nil {
-				return err
+	if err := prune(m.scPruner, m.scPruningOption, m.scStorePruningOptions, version); err != nil {
+		return err
+	}
+
+	// Prune the SS.
+	return prune(m.ssPruner, m.ssPruningOption, m.ssStorePruningOptions, version)
+}
+
+// prune prunes the stores of the pruner according to the default and per-store
+// pruning options.
+func prune(pruner store.Pruner, option *store.PruningOption, storeOptions map[string]*store.PruningOption, version uint64) error {
+	if len(storeOptions) == 0 {
+		if option != nil {
+			if prune, pruneTo := option.ShouldPrune(version); prune {
+				return pruner.Prune(pruneTo)
 			}
 		}
+		return nil
 	}
 
-	// Prune the SS.
-	if m.ssPruningOption != nil {
-		if prune, pruneTo := m.ssPruningOption.ShouldPrune(version); prune {
-			if err := m.ssPruner.Prune(pruneTo); err != nil {
+	storePruner := pruner.(store.StorePruner)
+	storeKeys := slices.Sorted(maps.Keys(storeOptions))
+	if option != nil {
+		if prune, pruneTo := option.ShouldPrune(version); prune {
+			if err := storePruner.PruneExcept(pruneTo, storeKeys); err != nil {
 				return err
 			}
 		}
 	}
 
+	for _, storeKey := range storeKeys {
+		storeOption := storeOptions[storeKey]
+		if storeOption == nil {
+			continue
+		}
+		if prune, pruneTo := storeOption.ShouldPrune(version); prune {
+			if err := storePruner.PruneStore(storeKey, pruneTo); err != nil {
+				return fmt.Errorf("failed to prune store %s: %w", storeKey, err)
+			}
+		}
+	}
+
 	return nil
 }
 
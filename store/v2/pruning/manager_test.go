@@ -106,6 +106,73 @@ func (s *PruningManagerTestSuite) TestPrune() {
 	}
 }
 
+func (s *PruningManagerTestSuite) TestPruneStoreOptions() {
+	// store1 is never pruned, store2 is pruned more aggressively than the others
+	storeOptions := map[string]*store.PruningOption{
+		storeKeys[0]: store.NewPruningOptionWithCustom(0, 0),
+		storeKeys[1]: store.NewPruningOptionWithCustom(0, 1),
+	}
+	var err error
+	s.manager, err = NewManagerWithStoreOptions(s.sc, s.ss, s.manager.scPruningOption, s.manager.ssPruningOption, storeOptions, storeOptions)
+	s.Require().NoError(err)
+
+	toVersion := uint64(100)
+	keyCount := 10
+	for version := uint64(1); version <= toVersion; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			for i := 0; i < keyCount; i++ {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d-%d", version, i)), false)
+			}
+		}
+		s.Require().NoError(s.manager.SignalCommit(true, version))
+
+		s.Require().NoError(s.sc.WriteChangeset(cs))
+		_, err := s.sc.Commit(version)
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.ss.ApplyChangeset(version, cs))
+
+		s.Require().NoError(s.manager.SignalCommit(false, version))
+	}
+
+	// wait for the pruning to finish in the commitment store, the store1 history is kept
+	checkSCPrune := func() bool {
+		for _, storeKey := range storeKeys[1:] {
+			if _, err := s.sc.GetProof([]byte(storeKey), toVersion/2, []byte(fmt.Sprintf("key-%d-%d", toVersion/2, 0))); err == nil {
+				return false
+			}
+		}
+		return true
+	}
+	s.Require().Eventually(checkSCPrune, 10*time.Second, 1*time.Second)
+	for version := uint64(1); version <= toVersion; version++ {
+		_, err := s.sc.GetProof([]byte(storeKeys[0]), version, []byte(fmt.Sprintf("key-%d-%d", version, 0)))
+		s.Require().NoError(err)
+	}
+
+	// check the storage store
+	_, pruneVersion := s.manager.ssPruningOption.ShouldPrune(toVersion)
+	storePruneVersions := map[string]uint64{
+		storeKeys[0]: 0,
+		storeKeys[1]: toVersion - 1,
+		storeKeys[2]: pruneVersion,
+	}
+	for version := uint64(1); version <= toVersion; version++ {
+		for storeKey, pruneVersion := range storePruneVersions {
+			key := []byte(fmt.Sprintf("key-%d-%d", version, 0))
+			value, err := s.ss.Get([]byte(storeKey), version, key)
+			if version <= pruneVersion {
+				s.Require().Nil(value)
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal([]byte(fmt.Sprintf("value-%d-%d", version, 0)), value)
+			}
+		}
+	}
+}
+
 func TestPruningOption(t *testing.T) {
 	testCases := []struct {
 		name         string
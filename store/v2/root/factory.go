@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
@@ -11,10 +12,12 @@ import (
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
 	"cosmossdk.io/store/v2/commitment/mem"
+	"cosmossdk.io/store/v2/commitment/smt"
 	"cosmossdk.io/store/v2/db"
 	"cosmossdk.io/store/v2/internal"
 	"cosmossdk.io/store/v2/pruning"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/cold"
 	"cosmossdk.io/store/v2/storage/pebbledb"
 	"cosmossdk.io/store/v2/storage/sqlite"
 )
@@ -30,15 +33,22 @@ const (
 	SSTypeRocks  SSType = 2
 	SCTypeIavl   SCType = 0
 	SCTypeIavlV2 SCType = 1
+	SCTypeSMT    SCType = 2
 )
 
 // app.toml config options
 type Options struct {
 	SSType          SSType               `mapstructure:"ss-type" toml:"ss-type" comment:"State storage database type. Currently we support: 0 for SQLite, 1 for Pebble"`
-	SCType          SCType               `mapstructure:"sc-type" toml:"sc-type" comment:"State commitment database type. Currently we support:0 for iavl, 1 for iavl v2"`
+	SCType          SCType               `mapstructure:"sc-type" toml:"sc-type" comment:"State commitment database type. Currently we support:0 for iavl, 1 for iavl v2, 2 for smt"`
 	SSPruningOption *store.PruningOption `mapstructure:"ss-pruning-option" toml:"ss-pruning-option" comment:"Pruning options for state storage"`
 	SCPruningOption *store.PruningOption `mapstructure:"sc-pruning-option" toml:"sc-pruning-option" comment:"Pruning options for state commitment"`
-	IavlConfig      *iavl.Config         `mapstructure:"iavl-config" toml:"iavl-config"`
+	// SSStorePruningOptions and SCStorePruningOptions override the pruning options
+	// for the given store keys, a store with a zero interval is never pruned.
+	SSStorePruningOptions map[string]*store.PruningOption `mapstructure:"ss-store-pruning-options" toml:"ss-store-pruning-options" comment:"Per-store pruning options for state storage, overriding ss-pruning-option for the given store keys"`
+	SCStorePruningOptions map[string]*store.PruningOption `mapstructure:"sc-store-pruning-options" toml:"sc-store-pruning-options" comment:"Per-store pruning options for state commitment, overriding sc-pruning-option for the given store keys"`
+	SSColdStorageConfig   *cold.Config                    `mapstructure:"ss-cold-storage-config" toml:"ss-cold-storage-config" comment:"Cold storage of the pruned heights of the state storage"`
+	IavlConfig            *iavl.Config                    `mapstructure:"iavl-config" toml:"iavl-config"`
+	SMTConfig             *smt.Config                     `mapstructure:"smt-config" toml:"smt-config"`
 }
 
 type FactoryOptions struct {
@@ -61,13 +71,36 @@ func DefaultStoreOptions() Options {
 			KeepRecent: 2,
 			Interval:   100,
 		},
+		SSColdStorageConfig: cold.DefaultConfig(),
 		IavlConfig: &iavl.Config{
 			CacheSize:              100_000,
 			SkipFastStorageUpgrade: true,
 		},
+		SMTConfig: smt.DefaultConfig(),
 	}
 }
 
+// CheckpointTargets returns the directories of the databases of a root store
+// created by CreateRootStore, keyed by their directory in the checkpoints of the
+// store. scDir is the directory of the SC database, which is opened by the caller.
+func CheckpointTargets(rootDir, scDir string, opts Options) (map[string]string, error) {
+	var ssDir string
+	switch opts.SSType {
+	case SSTypeSQLite:
+		ssDir = fmt.Sprintf("%s/data/ss/sqlite", rootDir)
+	case SSTypePebble:
+		ssDir = fmt.Sprintf("%s/data/ss/pebble", rootDir)
+	default:
+		return nil, fmt.Errorf("unsupported state storage type %d", opts.SSType)
+	}
+
+	return map[string]string{
+		CheckpointSCDir: scDir,
+		filepath.Join(CheckpointSSDir, storage.CheckpointDBDir):   ssDir,
+		filepath.Join(CheckpointSSDir, storage.CheckpointColdDir): fmt.Sprintf("%s/data/ss/cold", rootDir),
+	}, nil
+}
+
 // CreateRootStore is a convenience function to create a root store based on the
 // provided FactoryOptions. Strictly speaking app developers can create the root
 // store directly by calling root.New, so this function is not
@@ -107,7 +140,18 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	ss = storage.NewStorageStore(ssDb, opts.Logger)
+	if cfg := storeOpts.SSColdStorageConfig; cfg != nil && cfg.Enable {
+		coldStore, err := cold.New(fmt.Sprintf("%s/data/ss/cold", opts.RootDir), cfg)
+		if err != nil {
+			return nil, err
+		}
+		ss, err = storage.NewStorageStoreWithColdStorage(ssDb, coldStore, opts.Logger)
+		if err != nil {
+			return nil, errors.Join(err, coldStore.Close())
+		}
+	} else {
+		ss = storage.NewStorageStore(ssDb, opts.Logger)
+	}
 
 	metadata := commitment.NewMetadataStore(opts.SCRawDB)
 	latestVersion, err := metadata.GetLatestVersion()
@@ -131,15 +175,17 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 		return nil, err
 	}
 
-	newTreeFn := func(key string) (commitment.Tree, error) {
+	newTreeFn := func(scDB corestore.KVStoreWithBatch, key string, iavlConfig *iavl.Config) (commitment.Tree, error) {
 		if internal.IsMemoryStoreKey(key) {
 			return mem.New(), nil
 		} else {
 			switch storeOpts.SCType {
 			case SCTypeIavl:
-				return iavl.NewIavlTree(db.NewPrefixDB(opts.SCRawDB, []byte(key)), opts.Logger, storeOpts.IavlConfig), nil
+				return iavl.NewIavlTree(db.NewPrefixDB(scDB, []byte(key)), opts.Logger, iavlConfig), nil
 			case SCTypeIavlV2:
 				return nil, fmt.Errorf("iavl v2 not supported")
+			case SCTypeSMT:
+				return smt.NewSMTTree(db.NewPrefixDB(scDB, []byte(key)), opts.Logger, storeOpts.SMTConfig), nil
 			default:
 				return nil, fmt.Errorf("unsupported commitment store type")
 			}
@@ -148,7 +194,7 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 
 	trees := make(map[string]commitment.Tree, len(opts.StoreKeys))
 	for _, key := range opts.StoreKeys {
-		tree, err := newTreeFn(key)
+		tree, err := newTreeFn(opts.SCRawDB, key, storeOpts.IavlConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -156,7 +202,7 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 	}
 	oldTrees := make(map[string]commitment.Tree, len(opts.StoreKeys))
 	for _, key := range removedStoreKeys {
-		tree, err := newTreeFn(string(key))
+		tree, err := newTreeFn(opts.SCRawDB, string(key), storeOpts.IavlConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -166,8 +212,26 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the trees of the branches must not write to the database, which rules out
+	// upgrading the fast storage of the IAVL trees
+	var branchIavlConfig *iavl.Config
+	if storeOpts.IavlConfig != nil {
+		cfg := *storeOpts.IavlConfig
+		cfg.SkipFastStorageUpgrade = true
+		branchIavlConfig = &cfg
+	}
+	sc.SetNewTreeFn(func(scDB corestore.KVStoreWithBatch, key string) (commitment.Tree, error) {
+		return newTreeFn(scDB, key, branchIavlConfig)
+	})
 
-	pm := pruning.NewManager(sc, ss, storeOpts.SCPruningOption, storeOpts.SSPruningOption)
+	pm, err := pruning.NewManagerWithStoreOptions(
+		sc, ss,
+		storeOpts.SCPruningOption, storeOpts.SSPruningOption,
+		storeOpts.SCStorePruningOptions, storeOpts.SSStorePruningOptions,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	return New(opts.Logger, ss, sc, pm, nil, nil)
 }
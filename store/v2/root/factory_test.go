@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	corestore "cosmossdk.io/core/store"
 	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/db"
 )
 
@@ -22,8 +24,52 @@ func TestFactory(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, f)
 
+	fop.Options.SCType = SCTypeSMT
+	fop.SCRawDB = db.NewMemDB()
+	f, err = CreateRootStore(&fop)
+	require.NoError(t, err)
+	require.NotNil(t, f)
+
 	fop.Options.SCType = SCTypeIavlV2
 	f, err = CreateRootStore(&fop)
 	require.Error(t, err)
 	require.Nil(t, f)
 }
+
+func TestFactory_Branch(t *testing.T) {
+	for name, scType := range map[string]SCType{"iavl": SCTypeIavl, "smt": SCTypeSMT} {
+		t.Run(name, func(t *testing.T) {
+			fop := FactoryOptions{
+				Logger:    coretesting.NewNopLogger(),
+				RootDir:   t.TempDir(),
+				Options:   DefaultStoreOptions(),
+				StoreKeys: storeKeys,
+				SCRawDB:   db.NewMemDB(),
+			}
+			fop.Options.SCType = scType
+			rs, err := CreateRootStore(&fop)
+			require.NoError(t, err)
+			require.NoError(t, rs.LoadLatestVersion())
+
+			changeset := func(value string) *corestore.Changeset {
+				cs := corestore.NewChangeset()
+				for _, storeKey := range storeKeys {
+					cs.Add([]byte(storeKey), []byte("key"), []byte(value), false)
+				}
+				return cs
+			}
+			_, err = rs.Commit(changeset("value1"))
+			require.NoError(t, err)
+			hash, err := rs.Commit(changeset("value2"))
+			require.NoError(t, err)
+
+			brancher, ok := rs.GetStateCommitment().(store.Brancher)
+			require.True(t, ok)
+			branch, err := brancher.Branch(1)
+			require.NoError(t, err)
+			require.NoError(t, branch.WriteChangeset(changeset("value2")))
+			require.Equal(t, hash, branch.WorkingCommitInfo(2).Hash())
+			require.NoError(t, branch.Close())
+		})
+	}
+}
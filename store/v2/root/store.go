@@ -5,6 +5,8 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -20,6 +22,13 @@ import (
 	"cosmossdk.io/store/v2/pruning"
 )
 
+// CheckpointSCDir and CheckpointSSDir are the directories of the checkpoints of
+// the SC and SS backends within a checkpoint of the Store.
+const (
+	CheckpointSCDir = "sc"
+	CheckpointSSDir = "ss"
+)
+
 var (
 	_ store.RootStore        = (*Store)(nil)
 	_ store.UpgradeableStore = (*Store)(nil)
@@ -62,6 +71,10 @@ type Store struct {
 	chDone chan struct{}
 	// isMigrating reflects whether the store is currently migrating
 	isMigrating bool
+
+	// commitMtx serializes the commits and the checkpoints, so that the SS and SC
+	// checkpoints hold the same version
+	commitMtx sync.Mutex
 }
 
 // New creates a new root Store instance.
@@ -350,6 +363,9 @@ func (s *Store) WorkingHash(cs *corestore.Changeset) ([]byte, error) {
 // from the SC tree. Finally, it commits the SC tree and returns the hash of
 // the CommitInfo.
 func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
+	s.commitMtx.Lock()
+	defer s.commitMtx.Unlock()
+
 	if s.telemetry != nil {
 		now := time.Now()
 		defer s.telemetry.MeasureSince(now, "root_store", "commit")
@@ -517,3 +533,42 @@ func (s *Store) commitSC() error {
 func (s *Store) Prune(version uint64) error {
 	return s.pruningManager.Prune(version)
 }
+
+// Checkpoint writes a consistent checkpoint of the SC and SS backends, which must
+// implement the store.Checkpointer interface, into the CheckpointSCDir and
+// CheckpointSSDir directories of the given one. It returns the version held by
+// the checkpoint, the commits being blocked while it is written.
+func (s *Store) Checkpoint(dir string) (uint64, error) {
+	sc, ok := s.stateCommitment.(store.Checkpointer)
+	if !ok {
+		return 0, errors.New("SC store does not implement Checkpointer interface")
+	}
+	ss, ok := s.stateStorage.(store.Checkpointer)
+	if !ok {
+		return 0, errors.New("SS store does not implement Checkpointer interface")
+	}
+
+	s.commitMtx.Lock()
+	defer s.commitMtx.Unlock()
+
+	// the SS is not written to while migrating
+	if s.isMigrating {
+		return 0, errors.New("cannot checkpoint the store while migrating")
+	}
+
+	version, err := s.GetLatestVersion()
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, err
+	}
+	if err := sc.Checkpoint(filepath.Join(dir, CheckpointSCDir)); err != nil {
+		return 0, fmt.Errorf("failed to checkpoint SC store: %w", err)
+	}
+	if err := ss.Checkpoint(filepath.Join(dir, CheckpointSSDir)); err != nil {
+		return 0, fmt.Errorf("failed to checkpoint SS store: %w", err)
+	}
+
+	return version, nil
+}
@@ -8,6 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -311,3 +314,114 @@ func (s *extSnapshotter) RestoreExtension(height uint64, format uint32, payloadR
 	// finalize restoration
 	return nil
 }
+
+// mockStreamCommitSnapshotter is a snapshots.StreamCommitSnapshotter holding payloads per store.
+type mockStreamCommitSnapshotter struct {
+	mockCommitSnapshotter
+
+	mtx    sync.Mutex
+	stores map[string][][]byte
+	// restores counts the restorations of each store.
+	restores map[string]int
+	// failing stores return an error once their payloads have been restored.
+	failing   map[string]bool
+	finalized bool
+}
+
+var _ snapshots.StreamCommitSnapshotter = (*mockStreamCommitSnapshotter)(nil)
+
+func newMockStreamCommitSnapshotter(stores map[string][][]byte) *mockStreamCommitSnapshotter {
+	if stores == nil {
+		stores = map[string][][]byte{}
+	}
+	return &mockStreamCommitSnapshotter{
+		stores:   stores,
+		restores: map[string]int{},
+		failing:  map[string]bool{},
+	}
+}
+
+func (m *mockStreamCommitSnapshotter) SnapshotStoreKeys() []string {
+	return slices.Sorted(maps.Keys(m.stores))
+}
+
+func (m *mockStreamCommitSnapshotter) SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error {
+	for _, item := range m.stores[storeKey] {
+		if err := snapshotstypes.WriteExtensionPayload(protoWriter, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockStreamCommitSnapshotter) RestoreStore(
+	version uint64, storeKey string, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges,
+) error {
+	var items [][]byte
+	for {
+		var item snapshotstypes.SnapshotItem
+		err := protoReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("invalid protobuf message: %w", err)
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			return fmt.Errorf("unexpected snapshot item %T", item.Item)
+		}
+		items = append(items, payload.Payload)
+		chStorage <- &corestore.StateChanges{
+			Actor: []byte(storeKey),
+			StateChanges: []corestore.KVPair{
+				{
+					Key:   []byte(fmt.Sprintf("key-%d", len(items))),
+					Value: payload.Payload,
+				},
+			},
+		}
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.restores[storeKey]++
+	if m.failing[storeKey] {
+		return fmt.Errorf("mock restore error for store %s", storeKey)
+	}
+	m.stores[storeKey] = items
+	return nil
+}
+
+func (m *mockStreamCommitSnapshotter) FinalizeRestore(version uint64) error {
+	m.finalized = true
+	return nil
+}
+
+// mockStreamStorageSnapshotter is a snapshots.StreamStorageSnapshotter holding values per store.
+type mockStreamStorageSnapshotter struct {
+	mtx   sync.Mutex
+	items map[string]map[string][]byte
+}
+
+var _ snapshots.StreamStorageSnapshotter = (*mockStreamStorageSnapshotter)(nil)
+
+func (m *mockStreamStorageSnapshotter) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	return m.RestoreStore(version, chStorage)
+}
+
+func (m *mockStreamStorageSnapshotter) RestoreStore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	for change := range chStorage {
+		m.mtx.Lock()
+		if m.items == nil {
+			m.items = map[string]map[string][]byte{}
+		}
+		if m.items[string(change.Actor)] == nil {
+			m.items[string(change.Actor)] = map[string][]byte{}
+		}
+		for _, kv := range change.StateChanges {
+			m.items[string(change.Actor)][string(kv.Key)] = kv.Value
+		}
+		m.mtx.Unlock()
+	}
+	return nil
+}
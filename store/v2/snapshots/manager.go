@@ -187,9 +187,35 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	switch format := m.snapshotFormat(); format {
+	case types.CurrentFormat:
+		go m.createSnapshot(height, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+		return m.store.Save(height, format, ch)
+
+	case types.StreamsFormat:
+		commitSnapshotter, _, err := m.streamSnapshotters()
+		if err != nil {
+			return nil, err
+		}
+		var streams []*types.SnapshotStream
+		go m.createStreamsSnapshot(height, commitSnapshotter, ch, &streams)
+
+		return m.store.save(height, format, ch, func(snapshot *types.Snapshot) error {
+			return setStreamHashes(snapshot, streams)
+		})
+
+	default:
+		return nil, errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", format)
+	}
+}
+
+// snapshotFormat returns the format of the snapshots to take.
+func (m *Manager) snapshotFormat() uint32 {
+	if m.opts.Format == 0 {
+		return types.CurrentFormat
+	}
+	return m.opts.Format
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
@@ -310,7 +336,16 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	switch snapshot.Format {
+	case types.CurrentFormat:
+	case types.StreamsFormat:
+		if _, _, err := m.streamSnapshotters(); err != nil {
+			return err
+		}
+		if err := validateStreams(snapshot); err != nil {
+			return err
+		}
+	default:
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -373,6 +408,10 @@ func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan
 
 // doRestoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	if snapshot.Format == types.StreamsFormat {
+		return m.doRestoreStreams(snapshot, chChunks)
+	}
+
 	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
 	if err := os.MkdirAll(dir, 0o750); err != nil {
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
@@ -516,7 +555,7 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 
 // RestoreLocalSnapshot restores app state from a local snapshot.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	snapshot, err := m.store.Get(height, format)
 	if err != nil {
 		return err
 	}
@@ -534,6 +573,22 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
+	var ch <-chan io.ReadCloser
+	if format == types.StreamsFormat {
+		// the chunk files are opened directly, so that the streams can be read concurrently.
+		chunkIDs := make(chan uint32, snapshot.Chunks)
+		for i := range snapshot.Chunks {
+			chunkIDs <- i
+		}
+		close(chunkIDs)
+		ch = m.loadChunkStream(height, format, chunkIDs)
+	} else {
+		_, ch, err = m.store.Load(height, format)
+		if err != nil {
+			return err
+		}
+	}
+
 	return m.doRestoreSnapshot(*snapshot, ch)
 }
 
@@ -3,6 +3,8 @@ package snapshots_test
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -535,3 +537,125 @@ func TestSnapshot_SnapshotIfApplicable(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(0), pruned)
 }
+
+func TestSnapshot_Take_Restore_Streams(t *testing.T) {
+	store := setupStore(t)
+	stores := map[string][][]byte{
+		"bank":    {{1, 2, 3}, {4, 5, 6}},
+		"auth":    {{7, 8, 9}},
+		"staking": {{10, 11}, {12}, {13, 14, 15}},
+	}
+	source := newMockStreamCommitSnapshotter(stores)
+	streamsOpts := opts
+	streamsOpts.Format = types.StreamsFormat
+	manager := snapshots.NewManager(store, streamsOpts, source, &mockStreamStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.StreamsFormat, snapshot.Format)
+	require.Equal(t, uint32(len(snapshot.Metadata.ChunkHashes)), snapshot.Chunks)
+
+	// each store and extension is written to its own stream
+	names := make([]string, len(snapshot.Metadata.Streams))
+	for i, stream := range snapshot.Metadata.Streams {
+		names[i] = stream.Name
+		end := stream.FirstChunk + stream.Chunks
+		require.Equal(t, types.StreamHash(snapshot.Metadata.ChunkHashes[stream.FirstChunk:end]), stream.Hash)
+	}
+	require.Equal(t, []string{"auth", "bank", "staking", "mock"}, names)
+
+	storeSnapshot, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, storeSnapshot)
+
+	// restore the snapshot into new snapshotters through the ABCI methods
+	target := newMockStreamCommitSnapshotter(nil)
+	storage := &mockStreamStorageSnapshotter{}
+	extension := newExtSnapshotter(0)
+	manager = snapshots.NewManager(setupStore(t), opts, target, storage, nil, coretesting.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(extension))
+
+	require.NoError(t, manager.Restore(*snapshot))
+	restoreChunks := readChunks(chunks)
+	for i, chunk := range restoreChunks {
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == len(restoreChunks)-1, done)
+	}
+
+	require.Equal(t, stores, target.stores)
+	require.True(t, target.finalized)
+	require.Equal(t, 10, len(extension.state))
+	require.Equal(t, []byte{12}, storage.items["staking"]["key-2"])
+}
+
+func TestManager_Restore_Streams_Resume(t *testing.T) {
+	store := setupStore(t)
+	stores := map[string][][]byte{
+		"auth":    {{1}, {2}},
+		"bank":    {{3}},
+		"staking": {{4}, {5}, {6}},
+	}
+	streamsOpts := opts
+	streamsOpts.Format = types.StreamsFormat
+	manager := snapshots.NewManager(store, streamsOpts, newMockStreamCommitSnapshotter(stores), &mockStreamStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	// the restore of the staking store fails, the previous stores are restored
+	target := newMockStreamCommitSnapshotter(nil)
+	target.failing["staking"] = true
+	manager = snapshots.NewManager(store, opts, target, &mockStreamStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.ErrorContains(t, err, "mock restore error for store staking")
+	require.False(t, target.finalized)
+	require.Equal(t, map[string]int{"auth": 1, "bank": 1, "staking": 1}, target.restores)
+
+	// resuming the restore only restores the staking store
+	delete(target.failing, "staking")
+	require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	require.True(t, target.finalized)
+	require.Equal(t, map[string]int{"auth": 1, "bank": 1, "staking": 2}, target.restores)
+	require.Equal(t, stores, target.stores)
+
+	// the progress is removed once the restore completes
+	_, err = os.Stat(filepath.Join(filepath.Dir(store.PathChunk(snapshot.Height, snapshot.Format, 0)), "restore-progress"))
+	require.True(t, os.IsNotExist(err))
+
+	// a new restore of the same snapshot restores all the stores again
+	require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	require.Equal(t, map[string]int{"auth": 2, "bank": 2, "staking": 3}, target.restores)
+}
+
+func TestManager_Restore_Streams_Invalid(t *testing.T) {
+	store := setupStore(t)
+	streamsOpts := opts
+	streamsOpts.Format = types.StreamsFormat
+	source := newMockStreamCommitSnapshotter(map[string][][]byte{"auth": {{1}}, "bank": {{2}}})
+	manager := snapshots.NewManager(store, streamsOpts, source, &mockStreamStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	// snapshotters which don't support the format reject the snapshot
+	manager = snapshots.NewManager(setupStore(t), opts, &mockCommitSnapshotter{}, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	require.ErrorIs(t, manager.Restore(*snapshot), types.ErrUnknownFormat)
+
+	// as well as the snapshots whose streams don't match the chunks
+	manager = snapshots.NewManager(setupStore(t), opts, newMockStreamCommitSnapshotter(nil), &mockStreamStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	invalid := *snapshot
+	invalid.Metadata.Streams = []*types.SnapshotStream{
+		{Name: "auth", FirstChunk: 0, Chunks: 1, Hash: []byte{1}},
+		snapshot.Metadata.Streams[1],
+	}
+	require.ErrorIs(t, manager.Restore(invalid), types.ErrInvalidMetadata)
+	invalid.Metadata.Streams = snapshot.Metadata.Streams[:1]
+	require.ErrorIs(t, manager.Restore(invalid), types.ErrInvalidMetadata)
+	invalid.Metadata.Streams = nil
+	require.ErrorIs(t, manager.Restore(invalid), types.ErrInvalidMetadata)
+
+	// creating a snapshot of the format requires supporting snapshotters
+	manager = snapshots.NewManager(setupStore(t), streamsOpts, &mockCommitSnapshotter{}, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	_, err = manager.Create(5)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+}
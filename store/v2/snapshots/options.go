@@ -8,6 +8,10 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Format defines the format of the snapshots taken, types.CurrentFormat if not set.
+	// Snapshots of any supported format can be restored regardless of it.
+	Format uint32
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
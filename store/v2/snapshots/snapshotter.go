@@ -23,6 +23,38 @@ type StorageSnapshotter interface {
 	Restore(version uint64, chStorage <-chan *corestore.StateChanges) error
 }
 
+// StreamCommitSnapshotter is a CommitSnapshotter able to snapshot and restore the commitment
+// state of each store independently, as required by the types.StreamsFormat.
+type StreamCommitSnapshotter interface {
+	CommitSnapshotter
+
+	// SnapshotStoreKeys returns the keys of the stores to snapshot, in a deterministic order.
+	SnapshotStoreKeys() []string
+
+	// SnapshotStore writes a snapshot of the commitment state of the given store at the given
+	// version. The snapshot does not include the store item, which is written by the caller.
+	SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error
+
+	// RestoreStore restores the commitment state of the given store from the snapshot reader,
+	// reading until io.EOF. It is called concurrently for different stores, and may be called
+	// again for a store whose restoration was interrupted.
+	RestoreStore(version uint64, storeKey string, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) error
+
+	// FinalizeRestore is called once the commitment state of all the stores has been restored.
+	FinalizeRestore(version uint64) error
+}
+
+// StreamStorageSnapshotter is a StorageSnapshotter able to restore the storage state of
+// each store independently, as required by the types.StreamsFormat.
+type StreamStorageSnapshotter interface {
+	StorageSnapshotter
+
+	// RestoreStore restores the storage state of a single store from the given channel.
+	// It is called concurrently for different stores, and may be called again for a
+	// store whose restoration was interrupted.
+	RestoreStore(version uint64, chStorage <-chan *corestore.StateChanges) error
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -246,6 +246,14 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, chunks, nil)
+}
+
+// save saves a snapshot to disk, returning it. If not nil, finalize is called once all the chunks
+// have been saved, before the snapshot metadata is saved.
+func (s *Store) save(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, finalize func(*types.Snapshot) error,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -291,6 +299,11 @@ func (s *Store) Save(
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
+	if finalize != nil {
+		if err := finalize(snapshot); err != nil {
+			return nil, err
+		}
+	}
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
@@ -353,6 +366,11 @@ func (s *Store) pathSnapshot(height uint64, format uint32) string {
 	return filepath.Join(s.pathHeight(height), strconv.FormatUint(uint64(format), 10))
 }
 
+// pathRestoreProgress generates the path of the file recording the progress of a snapshot restoration.
+func (s *Store) pathRestoreProgress(height uint64, format uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), "restore-progress")
+}
+
 func (s *Store) pathMetadataDir() string {
 	return filepath.Join(s.dir, "metadata")
 }
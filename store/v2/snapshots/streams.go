@@ -0,0 +1,455 @@
+package snapshots
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"golang.org/x/sync/errgroup"
+
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+// streamSnapshotters returns the commitment and storage snapshotters supporting the
+// types.StreamsFormat, or an error if any of them does not.
+func (m *Manager) streamSnapshotters() (StreamCommitSnapshotter, StreamStorageSnapshotter, error) {
+	commitSnapshotter, ok := m.commitSnapshotter.(StreamCommitSnapshotter)
+	if !ok {
+		return nil, nil, errorsmod.Wrapf(types.ErrUnknownFormat,
+			"commitment snapshotter does not support format %v", types.StreamsFormat)
+	}
+	storageSnapshotter, ok := m.storageSnapshotter.(StreamStorageSnapshotter)
+	if !ok {
+		return nil, nil, errorsmod.Wrapf(types.ErrUnknownFormat,
+			"storage snapshotter does not support format %v", types.StreamsFormat)
+	}
+	return commitSnapshotter, storageSnapshotter, nil
+}
+
+// createStreamsSnapshot writes a snapshot of the types.StreamsFormat to the channel, one stream
+// after the other: first the stores, then the extensions. The streams are appended to the
+// provided slice, which must only be read once the channel is closed.
+func (m *Manager) createStreamsSnapshot(
+	height uint64,
+	commitSnapshotter StreamCommitSnapshotter,
+	ch chan<- io.ReadCloser,
+	streams *[]*types.SnapshotStream,
+) {
+	defer close(ch)
+
+	// writeStream writes a stream with its own pipeline, forwarding its chunks to the channel.
+	writeStream := func(name string, write func(protoWriter protoio.Writer) error) error {
+		chStream := make(chan io.ReadCloser)
+		errs := make(chan error, 1)
+		go func() {
+			defer close(errs)
+			streamWriter := NewStreamWriter(chStream)
+			if streamWriter == nil {
+				errs <- errors.New("failed to create stream writer")
+				return
+			}
+			if err := write(streamWriter); err != nil {
+				streamWriter.CloseWithError(err)
+				errs <- err
+				return
+			}
+			if err := streamWriter.Close(); err != nil {
+				streamWriter.CloseWithError(err)
+				errs <- err
+			}
+		}()
+
+		stream := &types.SnapshotStream{Name: name}
+		if n := len(*streams); n > 0 {
+			stream.FirstChunk = (*streams)[n-1].FirstChunk + (*streams)[n-1].Chunks
+		}
+		for chunk := range chStream {
+			ch <- chunk
+			stream.Chunks++
+		}
+		*streams = append(*streams, stream)
+		return <-errs
+	}
+
+	for _, storeKey := range commitSnapshotter.SnapshotStoreKeys() {
+		err := writeStream(storeKey, func(protoWriter protoio.Writer) error {
+			err := protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_Store{
+					Store: &types.SnapshotStoreItem{
+						Name: storeKey,
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to write store name: %w", err)
+			}
+			return commitSnapshotter.SnapshotStore(height, storeKey, protoWriter)
+		})
+		if err != nil {
+			// the error is propagated to the reader by the failed chunk
+			return
+		}
+	}
+
+	for _, name := range m.sortedExtensionNames() {
+		extension := m.extensions[name]
+		err := writeStream(name, func(protoWriter protoio.Writer) error {
+			err := protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_Extension{
+					Extension: &types.SnapshotExtensionMeta{
+						Name:   name,
+						Format: extension.SnapshotFormat(),
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			payloadWriter := func(payload []byte) error {
+				return types.WriteExtensionPayload(protoWriter, payload)
+			}
+			return extension.SnapshotExtension(height, payloadWriter)
+		})
+		if err != nil {
+			return
+		}
+	}
+}
+
+// setStreamHashes sets the hash of the snapshot streams, once all the chunks have been saved.
+func setStreamHashes(snapshot *types.Snapshot, streams []*types.SnapshotStream) error {
+	for _, stream := range streams {
+		end := uint64(stream.FirstChunk) + uint64(stream.Chunks)
+		if end > uint64(len(snapshot.Metadata.ChunkHashes)) {
+			return errorsmod.Wrapf(storeerrors.ErrLogic, "stream %s exceeds the %d snapshot chunks",
+				stream.Name, len(snapshot.Metadata.ChunkHashes))
+		}
+		stream.Hash = types.StreamHash(snapshot.Metadata.ChunkHashes[stream.FirstChunk:end])
+	}
+	snapshot.Metadata.Streams = streams
+	return nil
+}
+
+// validateStreams checks that the streams of a snapshot of the types.StreamsFormat
+// are contiguous, cover all the snapshot chunks, and match the chunk hashes.
+func validateStreams(snapshot types.Snapshot) error {
+	streams := snapshot.Metadata.Streams
+	if len(streams) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidMetadata, "no streams")
+	}
+	next := uint64(0)
+	for i, stream := range streams {
+		if stream.Chunks == 0 {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "stream %d has no chunks", i)
+		}
+		if uint64(stream.FirstChunk) != next {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "stream %d starts at chunk %d, expected %d",
+				i, stream.FirstChunk, next)
+		}
+		next += uint64(stream.Chunks)
+		if next > uint64(len(snapshot.Metadata.ChunkHashes)) {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "stream %d exceeds the %d snapshot chunks",
+				i, len(snapshot.Metadata.ChunkHashes))
+		}
+		if hash := types.StreamHash(snapshot.Metadata.ChunkHashes[stream.FirstChunk:next]); !bytes.Equal(hash, stream.Hash) {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "stream %d hash mismatch, expected %x, got %x",
+				i, stream.Hash, hash)
+		}
+	}
+	if next != uint64(snapshot.Chunks) {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "streams have %d chunks, but snapshot has %d",
+			next, snapshot.Chunks)
+	}
+	return nil
+}
+
+// doRestoreStreams restores a snapshot of the types.StreamsFormat. The chunks of each stream are
+// dispatched to a dedicated restoration as they arrive, so the stores are restored concurrently.
+// Extensions are restored once all the preceding streams have been restored.
+//
+// The restored streams are recorded along the snapshot chunks, so an interrupted restoration
+// of the same snapshot resumes from the streams not restored yet.
+func (m *Manager) doRestoreStreams(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	defer DrainChunks(chChunks)
+
+	commitSnapshotter, storageSnapshotter, err := m.streamSnapshotters()
+	if err != nil {
+		return err
+	}
+	if err := validateStreams(snapshot); err != nil {
+		return err
+	}
+
+	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
+	}
+	progress, err := openRestoreProgress(m.store.pathRestoreProgress(snapshot.Height, snapshot.Format), snapshot.Hash)
+	if err != nil {
+		return err
+	}
+	defer progress.Close()
+
+	streams := snapshot.Metadata.Streams
+	// done is closed once the restoration of the corresponding stream ends, successfully or not.
+	done := make([]chan struct{}, len(streams))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	g, ctx := errgroup.WithContext(context.Background())
+	g.SetLimit(runtime.NumCPU())
+
+	var (
+		index     int
+		remaining uint32
+		chStream  chan io.ReadCloser
+	)
+	for chunk := range chChunks {
+		if ctx.Err() != nil || index >= len(streams) {
+			_ = chunk.Close()
+			continue
+		}
+		if chStream == nil {
+			stream := streams[index]
+			remaining = stream.Chunks
+			chStream = make(chan io.ReadCloser, chunkBufferSize)
+			if progress.IsRestored(index) {
+				m.logger.Debug("skipping restored snapshot stream", "stream", stream.Name)
+				close(done[index])
+				go DrainChunks(chStream)
+			} else {
+				i, chunks := index, chStream
+				g.Go(func() error {
+					defer close(done[i])
+					if err := m.restoreStream(snapshot.Height, stream, chunks, done[:i], commitSnapshotter, storageSnapshotter); err != nil {
+						return errorsmod.Wrapf(err, "stream %s restore", stream.Name)
+					}
+					return progress.MarkRestored(i)
+				})
+			}
+		}
+		chStream <- chunk
+		remaining--
+		if remaining == 0 {
+			close(chStream)
+			chStream = nil
+			index++
+		}
+	}
+	if chStream != nil {
+		close(chStream)
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	if index != len(streams) {
+		return errorsmod.Wrap(storeerrors.ErrLogic, "restore ended prematurely")
+	}
+
+	if err := commitSnapshotter.FinalizeRestore(snapshot.Height); err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+	return progress.Remove()
+}
+
+// restoreStream restores a single stream of a snapshot of the types.StreamsFormat, holding either
+// the state of a store or of an extension. Extensions wait for the previous streams to be restored.
+func (m *Manager) restoreStream(
+	height uint64,
+	stream *types.SnapshotStream,
+	chChunks <-chan io.ReadCloser,
+	previous []chan struct{},
+	commitSnapshotter StreamCommitSnapshotter,
+	storageSnapshotter StreamStorageSnapshotter,
+) error {
+	streamReader, err := NewStreamReader(chChunks)
+	if err != nil {
+		DrainChunks(chChunks)
+		return err
+	}
+	defer streamReader.Close()
+
+	var nextItem types.SnapshotItem
+	if err := streamReader.ReadMsg(&nextItem); err != nil {
+		return fmt.Errorf("invalid protobuf message: %w", err)
+	}
+
+	switch item := nextItem.Item.(type) {
+	case *types.SnapshotItem_Store:
+		if item.Store.Name != stream.Name {
+			return errorsmod.Wrapf(storeerrors.ErrLogic, "unexpected store %s", item.Store.Name)
+		}
+
+		// chStorage is the channel to pass the KV pairs to the storage snapshotter.
+		chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
+		storageErrs := make(chan error, 1)
+		go func() {
+			defer close(storageErrs)
+			if err := storageSnapshotter.RestoreStore(height, chStorage); err != nil {
+				storageErrs <- err
+			}
+		}()
+
+		err := commitSnapshotter.RestoreStore(height, item.Store.Name, streamReader, chStorage)
+		close(chStorage)
+		if err != nil {
+			return errorsmod.Wrap(err, "multistore restore")
+		}
+		if err := <-storageErrs; err != nil {
+			return errorsmod.Wrap(err, "storage snapshotter")
+		}
+
+	case *types.SnapshotItem_Extension:
+		metadata := item.Extension
+		if metadata.Name != stream.Name {
+			return errorsmod.Wrapf(storeerrors.ErrLogic, "unexpected extension %s", metadata.Name)
+		}
+		extension, ok := m.extensions[metadata.Name]
+		if !ok {
+			return errorsmod.Wrapf(storeerrors.ErrLogic, "unknown extension snapshotter %s", metadata.Name)
+		}
+		if !IsFormatSupported(extension, metadata.Format) {
+			return errorsmod.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
+		}
+
+		for _, ch := range previous {
+			<-ch
+		}
+
+		// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at the end of the stream.
+		payloadReader := func() ([]byte, error) {
+			nextItem.Reset()
+			if err := streamReader.ReadMsg(&nextItem); err != nil {
+				return nil, err
+			}
+			payload := nextItem.GetExtensionPayload()
+			if payload == nil {
+				return nil, errorsmod.Wrapf(storeerrors.ErrLogic, "unexpected snapshot item %T", nextItem.Item)
+			}
+			return payload.Payload, nil
+		}
+		if err := extension.RestoreExtension(height, metadata.Format, payloadReader); err != nil {
+			return errorsmod.Wrapf(err, "extension %s restore", metadata.Name)
+		}
+		nextItem.Reset()
+		if err := streamReader.ReadMsg(&nextItem); !errors.Is(err, io.EOF) {
+			return fmt.Errorf("extension %s don't exhausted payload stream", metadata.Name)
+		}
+
+	default:
+		return errorsmod.Wrapf(storeerrors.ErrLogic, "unknown snapshot item %T", nextItem.Item)
+	}
+
+	return nil
+}
+
+// restoreProgress records the streams restored from a snapshot of the types.StreamsFormat.
+// The progress file starts with the hex encoded snapshot hash, followed by the index of
+// each restored stream on its own line.
+type restoreProgress struct {
+	mtx      sync.Mutex
+	file     *os.File
+	restored map[int]bool
+}
+
+// openRestoreProgress opens the progress file of the restoration of the snapshot with the given
+// hash, discarding the recorded progress if it belongs to a different snapshot.
+func openRestoreProgress(path string, snapshotHash []byte) (*restoreProgress, error) {
+	progress := &restoreProgress{restored: make(map[int]bool)}
+	header := hex.EncodeToString(snapshotHash)
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to open restore progress %q", path)
+	}
+	progress.file = file
+
+	scanner := bufio.NewScanner(file)
+	if scanner.Scan() && scanner.Text() == header {
+		for scanner.Scan() {
+			index, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
+			if err != nil {
+				// the last line may be truncated by a crash
+				break
+			}
+			progress.restored[index] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		_ = file.Close()
+		return nil, errorsmod.Wrapf(err, "failed to read restore progress %q", path)
+	}
+
+	// rewrite the progress file, which also drops any truncated line.
+	if err := progress.rewrite(header); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return progress, nil
+}
+
+func (p *restoreProgress) rewrite(header string) error {
+	var buf strings.Builder
+	buf.WriteString(header + "\n")
+	for index := range p.restored {
+		buf.WriteString(strconv.Itoa(index) + "\n")
+	}
+	if err := p.file.Truncate(0); err != nil {
+		return errorsmod.Wrap(err, "failed to truncate restore progress")
+	}
+	if _, err := p.file.WriteAt([]byte(buf.String()), 0); err != nil {
+		return errorsmod.Wrap(err, "failed to write restore progress")
+	}
+	if _, err := p.file.Seek(0, io.SeekEnd); err != nil {
+		return errorsmod.Wrap(err, "failed to write restore progress")
+	}
+	return p.file.Sync()
+}
+
+// IsRestored returns true if the stream with the given index was restored.
+func (p *restoreProgress) IsRestored(index int) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.restored[index]
+}
+
+// MarkRestored durably records the stream with the given index as restored.
+func (p *restoreProgress) MarkRestored(index int) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if _, err := p.file.WriteString(strconv.Itoa(index) + "\n"); err != nil {
+		return errorsmod.Wrap(err, "failed to write restore progress")
+	}
+	if err := p.file.Sync(); err != nil {
+		return errorsmod.Wrap(err, "failed to sync restore progress")
+	}
+	p.restored[index] = true
+	return nil
+}
+
+// Close closes the progress file, keeping the recorded progress.
+func (p *restoreProgress) Close() error {
+	return p.file.Close()
+}
+
+// Remove removes the progress file, once the restoration is complete.
+func (p *restoreProgress) Remove() error {
+	if err := p.file.Close(); err != nil {
+		return err
+	}
+	return os.Remove(p.file.Name())
+}
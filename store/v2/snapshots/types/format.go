@@ -4,3 +4,13 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// StreamsFormat is the format of snapshots where the state of each store and extension is
+// written to a separate stream of chunks, described by the snapshot metadata. Streams are
+// hashed separately and can be restored independently and concurrently.
+const StreamsFormat uint32 = 4
+
+// IsSupportedFormat returns true if snapshots of the given format can be restored.
+func IsSupportedFormat(format uint32) bool {
+	return format == CurrentFormat || format == StreamsFormat
+}
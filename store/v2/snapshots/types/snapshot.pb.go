@@ -104,6 +104,9 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// streams are the independently restorable streams the snapshot chunks are split into.
+	// They are only set by snapshot formats splitting the state in multiple streams.
+	Streams []*SnapshotStream `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -146,12 +149,92 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetStreams() []*SnapshotStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// SnapshotStream describes a separately hashed and independently restorable
+// stream of snapshot chunks, holding the state of a single store or extension.
+type SnapshotStream struct {
+	// name is the name of the store or extension the stream holds the state of.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// first_chunk is the index of the first chunk of the stream.
+	FirstChunk uint32 `protobuf:"varint,2,opt,name=first_chunk,json=firstChunk,proto3" json:"first_chunk,omitempty"`
+	// chunks is the number of chunks of the stream.
+	Chunks uint32 `protobuf:"varint,3,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// hash is the SHA-256 hash of the concatenated hashes of the stream chunks.
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SnapshotStream) Reset()         { *m = SnapshotStream{} }
+func (m *SnapshotStream) String() string { return proto.CompactTextString(m) }
+func (*SnapshotStream) ProtoMessage()    {}
+func (*SnapshotStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6851f1463fcbb80c, []int{2}
+}
+func (m *SnapshotStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotStream.Merge(m, src)
+}
+func (m *SnapshotStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotStream proto.InternalMessageInfo
+
+func (m *SnapshotStream) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SnapshotStream) GetFirstChunk() uint32 {
+	if m != nil {
+		return m.FirstChunk
+	}
+	return 0
+}
+
+func (m *SnapshotStream) GetChunks() uint32 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *SnapshotStream) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
@@ -163,7 +246,7 @@ func (m *SnapshotItem) Reset()         { *m = SnapshotItem{} }
 func (m *SnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotItem) ProtoMessage()    {}
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6851f1463fcbb80c, []int{2}
+	return fileDescriptor_6851f1463fcbb80c, []int{3}
 }
 func (m *SnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +353,7 @@ func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
 func (m *SnapshotStoreItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotStoreItem) ProtoMessage()    {}
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6851f1463fcbb80c, []int{3}
+	return fileDescriptor_6851f1463fcbb80c, []int{4}
 }
 func (m *SnapshotStoreItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +403,7 @@ func (m *SnapshotIAVLItem) Reset()         { *m = SnapshotIAVLItem{} }
 func (m *SnapshotIAVLItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotIAVLItem) ProtoMessage()    {}
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6851f1463fcbb80c, []int{4}
+	return fileDescriptor_6851f1463fcbb80c, []int{5}
 }
 func (m *SnapshotIAVLItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +470,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6851f1463fcbb80c, []int{5}
+	return fileDescriptor_6851f1463fcbb80c, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +522,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6851f1463fcbb80c, []int{6}
+	return fileDescriptor_6851f1463fcbb80c, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,6 +561,7 @@ func (m *SnapshotExtensionPayload) GetPayload() []byte {
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.store.snapshots.v2.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.store.snapshots.v2.Metadata")
+	proto.RegisterType((*SnapshotStream)(nil), "cosmos.store.snapshots.v2.SnapshotStream")
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v2.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v2.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v2.SnapshotIAVLItem")
@@ -490,41 +574,44 @@ func init() {
 }
 
 var fileDescriptor_6851f1463fcbb80c = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0x69, 0x36, 0x31, 0x7d, 0xbb, 0x62, 0x3a, 0x56, 0x59, 0x7b, 0xd8, 0xae, 0x11, 0x61,
-	0x41, 0xb3, 0x29, 0x5b, 0xf1, 0x20, 0x82, 0x18, 0x2c, 0x24, 0xa8, 0x50, 0xa6, 0x20, 0xe2, 0x25,
-	0x4c, 0x9b, 0x31, 0x1b, 0x92, 0xcd, 0x84, 0xcc, 0x34, 0x98, 0xa3, 0xff, 0xc0, 0x3f, 0xe2, 0xcd,
-	0x1f, 0xd1, 0x63, 0xf1, 0xe4, 0xa9, 0x48, 0xf2, 0x17, 0xfc, 0x01, 0x32, 0x33, 0xbb, 0xb1, 0xd4,
-	0x8d, 0xd4, 0xdb, 0xfb, 0xde, 0xbc, 0xef, 0x9b, 0x79, 0xdf, 0xcc, 0x3c, 0x08, 0x4f, 0xb8, 0x48,
-	0xb9, 0x68, 0x0a, 0xc9, 0xa7, 0xac, 0x29, 0xc6, 0x74, 0x22, 0x12, 0x2e, 0x45, 0x73, 0x16, 0xaf,
-	0x40, 0x34, 0x99, 0x72, 0xc9, 0xf1, 0x3d, 0x53, 0x19, 0xe9, 0xca, 0x68, 0x55, 0x19, 0xcd, 0xe2,
-	0x9d, 0xed, 0x3e, 0xef, 0x73, 0x5d, 0xd5, 0x54, 0x91, 0x21, 0xec, 0x64, 0x84, 0xae, 0x59, 0xc8,
-	0xd8, 0x1a, 0xd4, 0xbf, 0x22, 0xa8, 0x1e, 0x65, 0x0a, 0xf8, 0x2e, 0x54, 0x12, 0x36, 0xe8, 0x27,
-	0xd2, 0x43, 0x01, 0x0a, 0x6d, 0x92, 0x21, 0x95, 0xff, 0xc8, 0xa7, 0x29, 0x95, 0xde, 0x46, 0x80,
-	0xc2, 0x9b, 0x24, 0x43, 0x2a, 0x7f, 0x92, 0x9c, 0x8e, 0x87, 0xc2, 0x2b, 0x99, 0xbc, 0x41, 0x18,
-	0x83, 0x9d, 0x50, 0x91, 0x78, 0x76, 0x80, 0x42, 0x97, 0xe8, 0x18, 0x1f, 0x40, 0x35, 0x65, 0x92,
-	0xf6, 0xa8, 0xa4, 0x5e, 0x39, 0x40, 0xa1, 0x13, 0x3f, 0x88, 0xd6, 0xf6, 0x11, 0xbd, 0xcd, 0x4a,
-	0x5b, 0xf6, 0xd9, 0xc5, 0xae, 0x45, 0x56, 0xd4, 0x7a, 0x03, 0xaa, 0xf9, 0x1a, 0xbe, 0x0f, 0xae,
-	0xde, 0xb0, 0xab, 0x36, 0x60, 0xc2, 0x43, 0x41, 0x29, 0x74, 0x89, 0xa3, 0x73, 0x6d, 0x9d, 0xaa,
-	0xff, 0xda, 0x00, 0x37, 0x6f, 0xaf, 0x23, 0x59, 0x8a, 0x5f, 0x41, 0x59, 0x6f, 0xa7, 0x3b, 0x74,
-	0xe2, 0xc7, 0xff, 0x38, 0x43, 0xce, 0x3b, 0x52, 0x4b, 0x8a, 0xdc, 0xb6, 0x88, 0x21, 0xe3, 0xd7,
-	0x60, 0x0f, 0xe8, 0x6c, 0xa4, 0xed, 0x70, 0xe2, 0x47, 0xd7, 0x10, 0xe9, 0xbc, 0x7c, 0xf7, 0x46,
-	0x69, 0xb4, 0xaa, 0x8b, 0x8b, 0x5d, 0x5b, 0xa1, 0xb6, 0x45, 0xb4, 0x08, 0x3e, 0x84, 0x4d, 0xf6,
-	0x49, 0xb2, 0xb1, 0x18, 0xf0, 0xb1, 0x36, 0xd2, 0x89, 0xf7, 0xae, 0xa1, 0x78, 0x90, 0x73, 0x94,
-	0x1f, 0x6d, 0x8b, 0xfc, 0x11, 0xc1, 0xc7, 0xb0, 0xb5, 0x02, 0xdd, 0x09, 0x9d, 0x8f, 0x38, 0xed,
-	0xe9, 0xcb, 0x70, 0xe2, 0xfd, 0xff, 0x51, 0x3e, 0x34, 0xd4, 0xb6, 0x45, 0x6a, 0xec, 0x4a, 0xee,
-	0xd9, 0xed, 0xef, 0xdf, 0x1a, 0xb7, 0x8c, 0x56, 0x43, 0xf4, 0x86, 0xc1, 0x5e, 0xf4, 0xe4, 0x69,
-	0xab, 0x02, 0xf6, 0x40, 0xb2, 0xb4, 0xfe, 0x1c, 0xb6, 0xfe, 0x72, 0x4f, 0xbd, 0x8a, 0x31, 0x4d,
-	0x8d, 0xf3, 0x9b, 0x44, 0xc7, 0x85, 0x2a, 0xf5, 0xcf, 0x08, 0x6a, 0x57, 0x7d, 0xc3, 0x35, 0x28,
-	0x0d, 0xd9, 0x5c, 0x93, 0x5d, 0xa2, 0x42, 0xbc, 0x0d, 0xe5, 0x19, 0x1d, 0x9d, 0x32, 0x7d, 0x0b,
-	0x2e, 0x31, 0x00, 0x7b, 0x70, 0x63, 0xc6, 0xa6, 0x2b, 0x2f, 0x4b, 0x24, 0x87, 0x97, 0x5e, 0xb7,
-	0xb2, 0xa2, 0x9c, 0xbf, 0xee, 0xe2, 0x33, 0xbc, 0x87, 0x3b, 0x85, 0x46, 0x17, 0x75, 0xb1, 0xee,
-	0x7f, 0x14, 0x2b, 0x77, 0xc0, 0x5b, 0x67, 0xb4, 0x3a, 0x7c, 0x7e, 0x5d, 0xa6, 0xd1, 0x1c, 0x16,
-	0xdb, 0xfd, 0xe2, 0x6c, 0xe1, 0xa3, 0xf3, 0x85, 0x8f, 0x7e, 0x2e, 0x7c, 0xf4, 0x65, 0xe9, 0x5b,
-	0xe7, 0x4b, 0xdf, 0xfa, 0xb1, 0xf4, 0xad, 0x0f, 0x0f, 0x4d, 0xa9, 0xe8, 0x0d, 0xa3, 0x01, 0xcf,
-	0x46, 0xca, 0xa5, 0x41, 0x22, 0x9a, 0x72, 0x3e, 0x61, 0xe2, 0xb8, 0xa2, 0x87, 0xc0, 0xfe, 0xef,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x23, 0x48, 0xd5, 0x7c, 0x04, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0xb5, 0x1b, 0xa7, 0x4d, 0xaf, 0xfd, 0x1e, 0xed, 0x50, 0x90, 0xe9, 0xc2, 0x35, 0x46, 0x48,
+	0x46, 0x50, 0xa7, 0x72, 0x11, 0x0b, 0x84, 0x84, 0x48, 0xa9, 0x94, 0x08, 0x90, 0xaa, 0xa9, 0x84,
+	0x10, 0x9b, 0x68, 0xda, 0x4c, 0x63, 0x2b, 0x71, 0x26, 0x78, 0xa6, 0x11, 0x59, 0xf2, 0x07, 0xfc,
+	0x08, 0x3b, 0x3e, 0xa2, 0xcb, 0x8a, 0x15, 0xab, 0x0a, 0x25, 0xbf, 0xc0, 0x07, 0xa0, 0x19, 0xdb,
+	0xa1, 0x14, 0x07, 0xa5, 0xbb, 0x39, 0xc7, 0xf7, 0x9c, 0x3b, 0xf7, 0x8c, 0x67, 0xc0, 0x3f, 0x66,
+	0x3c, 0x61, 0xbc, 0xce, 0x05, 0x4b, 0x69, 0x9d, 0x0f, 0xc8, 0x90, 0x47, 0x4c, 0xf0, 0xfa, 0x28,
+	0x9c, 0x81, 0x60, 0x98, 0x32, 0xc1, 0xd0, 0x9d, 0xac, 0x32, 0x50, 0x95, 0xc1, 0xac, 0x32, 0x18,
+	0x85, 0x9b, 0x1b, 0x5d, 0xd6, 0x65, 0xaa, 0xaa, 0x2e, 0x57, 0x99, 0x60, 0x33, 0x17, 0xb4, 0xb3,
+	0x0f, 0xb9, 0x5a, 0x01, 0xef, 0x8b, 0x0e, 0xb5, 0xc3, 0xdc, 0x01, 0xdd, 0x86, 0xe5, 0x88, 0xc6,
+	0xdd, 0x48, 0xd8, 0xba, 0xab, 0xfb, 0x06, 0xce, 0x91, 0xe4, 0x4f, 0x58, 0x9a, 0x10, 0x61, 0x2f,
+	0xb9, 0xba, 0xff, 0x1f, 0xce, 0x91, 0xe4, 0x8f, 0xa3, 0xd3, 0x41, 0x8f, 0xdb, 0x95, 0x8c, 0xcf,
+	0x10, 0x42, 0x60, 0x44, 0x84, 0x47, 0xb6, 0xe1, 0xea, 0xbe, 0x85, 0xd5, 0x1a, 0xed, 0x43, 0x2d,
+	0xa1, 0x82, 0x74, 0x88, 0x20, 0x76, 0xd5, 0xd5, 0x7d, 0x33, 0xbc, 0x17, 0xcc, 0x9d, 0x23, 0x78,
+	0x93, 0x97, 0x36, 0x8c, 0xb3, 0x8b, 0x2d, 0x0d, 0xcf, 0xa4, 0x5e, 0x0a, 0xb5, 0xe2, 0x1b, 0xba,
+	0x0b, 0x96, 0x6a, 0xd8, 0x96, 0x0d, 0x28, 0xb7, 0x75, 0xb7, 0xe2, 0x5b, 0xd8, 0x54, 0x5c, 0x53,
+	0x51, 0x68, 0x0f, 0x56, 0xb8, 0x48, 0x29, 0x49, 0xb8, 0xbd, 0xe4, 0x56, 0x7c, 0x33, 0x7c, 0xf0,
+	0x8f, 0xa6, 0x45, 0x0e, 0x87, 0x4a, 0x81, 0x0b, 0xa5, 0xf7, 0x01, 0xfe, 0xff, 0xf3, 0x93, 0x1c,
+	0x70, 0x40, 0x12, 0xaa, 0x62, 0x5a, 0xc5, 0x6a, 0x8d, 0xb6, 0xc0, 0x3c, 0x89, 0x53, 0x2e, 0xda,
+	0xaa, 0x7f, 0x9e, 0x14, 0x28, 0x6a, 0x4f, 0x32, 0xd7, 0x49, 0xcb, 0xfb, 0xb9, 0x04, 0x56, 0xd1,
+	0xb3, 0x25, 0x68, 0x82, 0x5e, 0x42, 0x55, 0xed, 0x58, 0xb5, 0x34, 0xc3, 0x47, 0x0b, 0x8d, 0xc1,
+	0x52, 0x2a, 0xc5, 0x4d, 0x0d, 0x67, 0x62, 0xf4, 0x0a, 0x8c, 0x98, 0x8c, 0xfa, 0x6a, 0x73, 0x66,
+	0xf8, 0x70, 0x01, 0x93, 0xd6, 0x8b, 0xb7, 0xaf, 0xa5, 0x47, 0xa3, 0x36, 0xb9, 0xd8, 0x32, 0x24,
+	0x6a, 0x6a, 0x58, 0x99, 0xa0, 0x03, 0x58, 0xa5, 0x1f, 0x05, 0x1d, 0xf0, 0x98, 0x0d, 0xd4, 0x48,
+	0x66, 0xb8, 0xb3, 0x80, 0xe3, 0x7e, 0xa1, 0x91, 0xe7, 0xd8, 0xd4, 0xf0, 0x6f, 0x13, 0x74, 0x04,
+	0xeb, 0x33, 0xd0, 0x1e, 0x92, 0x71, 0x9f, 0x91, 0x8e, 0x8a, 0xc5, 0x0c, 0x77, 0xaf, 0xe3, 0x7c,
+	0x90, 0x49, 0x9b, 0x1a, 0x5e, 0xa3, 0x57, 0xb8, 0xa7, 0x37, 0xbf, 0x7d, 0xdd, 0xbe, 0x91, 0x79,
+	0x6d, 0xf3, 0x4e, 0xcf, 0xdd, 0x09, 0x1e, 0x3f, 0x69, 0x2c, 0x83, 0x11, 0x0b, 0x9a, 0x78, 0xcf,
+	0x60, 0xfd, 0xaf, 0xf4, 0xca, 0x0e, 0xbb, 0xd4, 0xc5, 0xfb, 0xa4, 0xc3, 0xda, 0xd5, 0xdc, 0xd0,
+	0x1a, 0x54, 0x7a, 0x74, 0xac, 0xc4, 0x16, 0x96, 0x4b, 0xb4, 0x01, 0xd5, 0x11, 0xe9, 0x9f, 0x52,
+	0x75, 0x0a, 0x16, 0xce, 0x00, 0xb2, 0x61, 0x65, 0x44, 0xd3, 0x59, 0x96, 0x15, 0x5c, 0xc0, 0x4b,
+	0xb7, 0x52, 0x46, 0x51, 0x2d, 0x6e, 0x65, 0xf9, 0x1e, 0xde, 0xc1, 0xad, 0xd2, 0xa0, 0x4b, 0x7f,
+	0xd9, 0x39, 0xf7, 0xba, 0xdc, 0xb9, 0x05, 0xf6, 0xbc, 0xa0, 0xe5, 0xe6, 0x8b, 0xe3, 0xca, 0x06,
+	0x2d, 0x60, 0x79, 0xdc, 0xcf, 0xcf, 0x26, 0x8e, 0x7e, 0x3e, 0x71, 0xf4, 0x1f, 0x13, 0x47, 0xff,
+	0x3c, 0x75, 0xb4, 0xf3, 0xa9, 0xa3, 0x7d, 0x9f, 0x3a, 0xda, 0xfb, 0xfb, 0x59, 0x29, 0xef, 0xf4,
+	0x82, 0x98, 0xe5, 0x4f, 0xe1, 0xa5, 0x07, 0x90, 0xd7, 0xc5, 0x78, 0x48, 0xf9, 0xd1, 0xb2, 0x7a,
+	0xbc, 0x76, 0x7f, 0x0d, 0x00, 0x9c, 0x35, 0xcc, 0x1b, 0x34, 0x05, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -602,6 +689,20 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -614,6 +715,53 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FirstChunk != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.FirstChunk))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -919,6 +1067,35 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SnapshotStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.FirstChunk != 0 {
+		n += 1 + sovSnapshot(uint64(m.FirstChunk))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovSnapshot(uint64(m.Chunks))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
 	return n
 }
 
@@ -1288,6 +1465,194 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, &SnapshotStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstChunk", wireType)
+			}
+			m.FirstChunk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstChunk |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
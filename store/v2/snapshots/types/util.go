@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/binary"
 
 	protoio "github.com/cosmos/gogoproto/io"
@@ -17,6 +18,15 @@ func WriteExtensionPayload(protoWriter protoio.Writer, payload []byte) error {
 	})
 }
 
+// StreamHash returns the hash of a snapshot stream, given the hashes of its chunks.
+func StreamHash(chunkHashes [][]byte) []byte {
+	hasher := sha256.New()
+	for _, chunkHash := range chunkHashes {
+		hasher.Write(chunkHash)
+	}
+	return hasher.Sum(nil)
+}
+
 // Uint64ToBigEndian - marshals uint64 to a big endian byte slice so it can be sorted
 func Uint64ToBigEndian(i uint64) []byte {
 	b := make([]byte, 8)
@@ -0,0 +1,15 @@
+package cold
+
+// Config is the configuration of the cold storage.
+type Config struct {
+	Enable          bool   `mapstructure:"enable" toml:"enable" comment:"Enable moving the state storage heights pruned per ss-pruning-option into compressed segment files, which still serve the historical queries."`
+	SegmentInterval uint64 `mapstructure:"segment-interval" toml:"segment-interval" comment:"Number of heights moved into each segment file."`
+}
+
+// DefaultConfig returns the default configuration of the cold storage.
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:          false,
+		SegmentInterval: 100_000,
+	}
+}
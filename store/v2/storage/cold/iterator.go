@@ -0,0 +1,250 @@
+package cold
+
+import (
+	"bytes"
+	"sort"
+
+	corestore "cosmossdk.io/core/store"
+)
+
+var _ corestore.Iterator = (*iterator)(nil)
+
+// cursor iterates the keys of a store in a segment, with the latest write to each
+// key at or below the target version.
+type cursor struct {
+	segment    *segment
+	blocks     []blockHandle
+	version    uint64
+	start, end []byte
+	reverse    bool
+
+	// block is the index of the loaded block, entries are its resolved writes in
+	// the iteration order.
+	block   int
+	entries []entry
+	pos     int
+	err     error
+}
+
+func newCursor(s *segment, storeKey []byte, version uint64, start, end []byte, reverse bool) *cursor {
+	c := &cursor{
+		segment: s,
+		version: version,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}
+	index, ok := s.stores[string(storeKey)]
+	if !ok {
+		return c
+	}
+	c.blocks = index.blocks
+
+	// seek the block holding the first key of the domain in the iteration order
+	if reverse {
+		c.block = len(c.blocks)
+		if end != nil {
+			c.block = sort.Search(len(c.blocks), func(i int) bool {
+				return bytes.Compare(c.blocks[i].firstKey, end) >= 0
+			})
+		}
+		c.block--
+	} else {
+		c.block = 0
+		if start != nil {
+			c.block = max(sort.Search(len(c.blocks), func(i int) bool {
+				return bytes.Compare(c.blocks[i].firstKey, start) > 0
+			})-1, 0)
+		}
+	}
+	c.load()
+
+	return c
+}
+
+// load loads the entries of the current block, moving to the next blocks until
+// one holds a key of the domain.
+func (c *cursor) load() {
+	for c.block >= 0 && c.block < len(c.blocks) {
+		h := c.blocks[c.block]
+		if !c.reverse && c.end != nil && bytes.Compare(h.firstKey, c.end) >= 0 {
+			break
+		}
+
+		entries, err := c.segment.readBlock(h)
+		if err != nil {
+			c.err = err
+			break
+		}
+		c.entries = c.resolve(entries)
+		c.pos = 0
+		if len(c.entries) > 0 {
+			return
+		}
+
+		if c.reverse {
+			// the previous blocks only hold keys before the domain
+			if c.start != nil && bytes.Compare(h.firstKey, c.start) < 0 {
+				break
+			}
+			c.block--
+		} else {
+			c.block++
+		}
+	}
+
+	c.block = -1
+	c.entries = nil
+}
+
+// resolve returns the latest write to each key of the domain at or below the
+// target version, in the iteration order.
+func (c *cursor) resolve(entries []entry) []entry {
+	resolved := make([]entry, 0, len(entries))
+	for i, e := range entries {
+		if e.version > c.version || (c.start != nil && bytes.Compare(e.key, c.start) < 0) || (c.end != nil && bytes.Compare(e.key, c.end) >= 0) {
+			continue
+		}
+		if n := len(resolved); n > 0 && bytes.Equal(resolved[n-1].key, e.key) {
+			resolved[n-1] = entries[i]
+		} else {
+			resolved = append(resolved, entries[i])
+		}
+	}
+
+	if c.reverse {
+		for i, j := 0, len(resolved)-1; i < j; i, j = i+1, j-1 {
+			resolved[i], resolved[j] = resolved[j], resolved[i]
+		}
+	}
+	return resolved
+}
+
+func (c *cursor) valid() bool {
+	return c.pos < len(c.entries)
+}
+
+func (c *cursor) current() *entry {
+	return &c.entries[c.pos]
+}
+
+func (c *cursor) next() {
+	c.pos++
+	if c.pos < len(c.entries) {
+		return
+	}
+
+	if c.reverse {
+		if c.start != nil && bytes.Compare(c.blocks[c.block].firstKey, c.start) < 0 {
+			c.block = -1
+		} else {
+			c.block--
+		}
+	} else {
+		c.block++
+	}
+	c.load()
+}
+
+// iterator merges the cursors of the segments, the write to a key held by the
+// latest segment prevailing over the older ones.
+type iterator struct {
+	// cursors are ordered from the oldest segment to the latest one.
+	cursors    []*cursor
+	start, end []byte
+	reverse    bool
+
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+func newIterator(cursors []*cursor, start, end []byte, reverse bool) *iterator {
+	itr := &iterator{
+		cursors: cursors,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+		valid:   true,
+	}
+	itr.Next()
+
+	return itr
+}
+
+func (itr *iterator) Domain() (start, end []byte) {
+	return itr.start, itr.end
+}
+
+func (itr *iterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *iterator) Key() []byte {
+	itr.assertIsValid()
+	return itr.key
+}
+
+func (itr *iterator) Value() []byte {
+	itr.assertIsValid()
+	return itr.value
+}
+
+func (itr *iterator) Next() {
+	itr.assertIsValid()
+
+	for {
+		var latest *entry
+		for i := len(itr.cursors) - 1; i >= 0; i-- {
+			c := itr.cursors[i]
+			if c.err != nil {
+				itr.err = c.err
+				itr.valid = false
+				return
+			}
+			if !c.valid() {
+				continue
+			}
+			e := c.current()
+			if latest == nil {
+				latest = e
+				continue
+			}
+			// the first key in the iteration order, or the latest write to it
+			if cmp := bytes.Compare(e.key, latest.key); (cmp < 0 && !itr.reverse) || (cmp > 0 && itr.reverse) {
+				latest = e
+			}
+		}
+		if latest == nil {
+			itr.valid = false
+			return
+		}
+
+		key, value, removed := latest.key, latest.value, latest.removed
+		for _, c := range itr.cursors {
+			if c.valid() && bytes.Equal(c.current().key, key) {
+				c.next()
+			}
+		}
+		if !removed {
+			itr.key, itr.value = key, value
+			return
+		}
+	}
+}
+
+func (itr *iterator) Error() error {
+	return itr.err
+}
+
+func (itr *iterator) Close() error {
+	itr.cursors = nil
+	itr.valid = false
+	return nil
+}
+
+func (itr *iterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
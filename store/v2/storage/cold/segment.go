@@ -0,0 +1,396 @@
+package cold
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+const (
+	// blockSize is the uncompressed size from which a block is flushed, a block
+	// only ends at a key boundary so that all the versions of a key are held by
+	// the same block.
+	blockSize = 64 * 1024
+	// compressionLevel is the zlib compression level of the blocks.
+	compressionLevel = 7
+
+	footerSize = 24
+)
+
+// segmentMagic identifies a segment file, it is suffixed by the format version.
+var segmentMagic = []byte("CSEGv001")
+
+// entry is a write to a key at a version, either a set or a removal.
+type entry struct {
+	key     []byte
+	version uint64
+	value   []byte
+	removed bool
+}
+
+// blockHandle locates a compressed block of entries in the segment file.
+type blockHandle struct {
+	firstKey []byte
+	offset   uint64
+	length   uint64
+}
+
+// storeIndex indexes the blocks of a store in a segment.
+type storeIndex struct {
+	// earliestVersion is the earliest version of the store held by the segment.
+	earliestVersion uint64
+	blocks          []blockHandle
+}
+
+// segment is an immutable file holding the writes to the stores within the
+// (fromVersion, toVersion] range, ordered by store, key and version. The first
+// segment also holds the state of the stores at their earliest version.
+//
+// The file is laid out as the zlib compressed blocks of entries, followed by the
+// zlib compressed index of the blocks and a fixed size footer locating the index.
+type segment struct {
+	file        *os.File
+	fromVersion uint64
+	toVersion   uint64
+	stores      map[string]*storeIndex
+}
+
+// openSegment opens the segment file and loads its index.
+func openSegment(path string, fromVersion, toVersion uint64) (*segment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &segment{
+		file:        file,
+		fromVersion: fromVersion,
+		toVersion:   toVersion,
+	}
+	if err := s.loadIndex(); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to load the index of segment %s: %w", path, err), file.Close())
+	}
+
+	return s, nil
+}
+
+func (s *segment) loadIndex() error {
+	info, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() < footerSize {
+		return errors.New("segment file too small")
+	}
+
+	footer := make([]byte, footerSize)
+	if _, err := s.file.ReadAt(footer, info.Size()-footerSize); err != nil {
+		return err
+	}
+	if !bytes.Equal(footer[16:], segmentMagic) {
+		return errors.New("invalid segment magic")
+	}
+
+	bz, err := s.readCompressed(binary.BigEndian.Uint64(footer[:8]), binary.BigEndian.Uint64(footer[8:16]))
+	if err != nil {
+		return err
+	}
+
+	r := bytes.NewReader(bz)
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	s.stores = make(map[string]*storeIndex, count)
+	for i := uint64(0); i < count; i++ {
+		storeKey, err := readBytes(r)
+		if err != nil {
+			return err
+		}
+		index := &storeIndex{}
+		if index.earliestVersion, err = binary.ReadUvarint(r); err != nil {
+			return err
+		}
+		blocks, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		index.blocks = make([]blockHandle, blocks)
+		for j := range index.blocks {
+			h := &index.blocks[j]
+			if h.firstKey, err = readBytes(r); err != nil {
+				return err
+			}
+			if h.offset, err = binary.ReadUvarint(r); err != nil {
+				return err
+			}
+			if h.length, err = binary.ReadUvarint(r); err != nil {
+				return err
+			}
+		}
+		s.stores[string(storeKey)] = index
+	}
+
+	return nil
+}
+
+func (s *segment) readCompressed(offset, length uint64) ([]byte, error) {
+	zReader, err := zlib.NewReader(io.NewSectionReader(s.file, int64(offset), int64(length)))
+	if err != nil {
+		return nil, err
+	}
+	defer zReader.Close()
+
+	return io.ReadAll(zReader)
+}
+
+// readBlock reads and decodes the entries of the block.
+func (s *segment) readBlock(h blockHandle) ([]entry, error) {
+	bz, err := s.readCompressed(h.offset, h.length)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read segment block: %w", err)
+	}
+
+	var entries []entry
+	r := bytes.NewReader(bz)
+	for r.Len() > 0 {
+		var (
+			e   entry
+			err error
+		)
+		if e.key, err = readBytes(r); err != nil {
+			return nil, err
+		}
+		if e.version, err = binary.ReadUvarint(r); err != nil {
+			return nil, err
+		}
+		flag, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		e.removed = flag == 1
+		if !e.removed {
+			if e.value, err = readBytes(r); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// get returns the latest write to the key of the store at or below the given
+// version, or nil if the segment holds none.
+func (s *segment) get(storeKey, key []byte, version uint64) (*entry, error) {
+	index, ok := s.stores[string(storeKey)]
+	if !ok {
+		return nil, nil
+	}
+
+	// the key is held by the last block starting at or before it.
+	i := sort.Search(len(index.blocks), func(i int) bool {
+		return bytes.Compare(index.blocks[i].firstKey, key) > 0
+	}) - 1
+	if i < 0 {
+		return nil, nil
+	}
+	entries, err := s.readBlock(index.blocks[i])
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *entry
+	for j := range entries {
+		if c := bytes.Compare(entries[j].key, key); c > 0 {
+			break
+		} else if c == 0 && entries[j].version <= version {
+			latest = &entries[j]
+		}
+	}
+	return latest, nil
+}
+
+func (s *segment) close() error {
+	return s.file.Close()
+}
+
+// SegmentWriter writes the history of the stores to a new segment. The stores
+// are written one after the other, and the writes to a store must be added
+// ordered by key and version.
+type SegmentWriter struct {
+	file   *os.File
+	writer *bufio.Writer
+	offset uint64
+
+	storeKeys [][]byte
+	stores    []*storeIndex
+
+	block         bytes.Buffer
+	blockFirstKey []byte
+	lastKey       []byte
+	lastVersion   uint64
+}
+
+func newSegmentWriter(file *os.File) *SegmentWriter {
+	return &SegmentWriter{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}
+}
+
+// StartStore starts writing the given store, whose earliest version held by the
+// segment is earliestVersion.
+func (w *SegmentWriter) StartStore(storeKey []byte, earliestVersion uint64) error {
+	if err := w.flushBlock(); err != nil {
+		return err
+	}
+	for _, sk := range w.storeKeys {
+		if bytes.Equal(sk, storeKey) {
+			return fmt.Errorf("store %s already written to the segment", storeKey)
+		}
+	}
+
+	w.storeKeys = append(w.storeKeys, bytes.Clone(storeKey))
+	w.stores = append(w.stores, &storeIndex{earliestVersion: earliestVersion})
+	w.lastKey = nil
+	return nil
+}
+
+// Add adds a write to the key of the current store at the given version, the
+// value is ignored if the key is removed.
+func (w *SegmentWriter) Add(key []byte, version uint64, value []byte, removed bool) error {
+	if len(w.stores) == 0 {
+		return errors.New("no store started")
+	}
+
+	if w.lastKey != nil {
+		c := bytes.Compare(key, w.lastKey)
+		if c < 0 || (c == 0 && version <= w.lastVersion) {
+			return fmt.Errorf("write to key %X at version %d out of order", key, version)
+		}
+		if c > 0 && w.block.Len() >= blockSize {
+			if err := w.flushBlock(); err != nil {
+				return err
+			}
+		}
+	}
+	if w.block.Len() == 0 {
+		w.blockFirstKey = bytes.Clone(key)
+	}
+	w.lastKey = bytes.Clone(key)
+	w.lastVersion = version
+
+	writeBytes(&w.block, key)
+	w.block.Write(binary.AppendUvarint(nil, version))
+	if removed {
+		w.block.WriteByte(1)
+	} else {
+		w.block.WriteByte(0)
+		writeBytes(&w.block, value)
+	}
+
+	return nil
+}
+
+func (w *SegmentWriter) flushBlock() error {
+	if w.block.Len() == 0 {
+		return nil
+	}
+
+	length, err := w.writeCompressed(w.block.Bytes())
+	if err != nil {
+		return err
+	}
+	index := w.stores[len(w.stores)-1]
+	index.blocks = append(index.blocks, blockHandle{
+		firstKey: w.blockFirstKey,
+		offset:   w.offset,
+		length:   length,
+	})
+	w.offset += length
+	w.block.Reset()
+
+	return nil
+}
+
+func (w *SegmentWriter) writeCompressed(bz []byte) (uint64, error) {
+	var buf bytes.Buffer
+	zWriter, err := zlib.NewWriterLevel(&buf, compressionLevel)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := zWriter.Write(bz); err != nil {
+		return 0, err
+	}
+	if err := zWriter.Close(); err != nil {
+		return 0, err
+	}
+	if _, err := w.writer.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+
+	return uint64(buf.Len()), nil
+}
+
+// finish writes the index and the footer, and syncs the segment file.
+func (w *SegmentWriter) finish() error {
+	if err := w.flushBlock(); err != nil {
+		return err
+	}
+
+	var index bytes.Buffer
+	index.Write(binary.AppendUvarint(nil, uint64(len(w.stores))))
+	for i, store := range w.stores {
+		writeBytes(&index, w.storeKeys[i])
+		index.Write(binary.AppendUvarint(nil, store.earliestVersion))
+		index.Write(binary.AppendUvarint(nil, uint64(len(store.blocks))))
+		for _, h := range store.blocks {
+			writeBytes(&index, h.firstKey)
+			index.Write(binary.AppendUvarint(nil, h.offset))
+			index.Write(binary.AppendUvarint(nil, h.length))
+		}
+	}
+	length, err := w.writeCompressed(index.Bytes())
+	if err != nil {
+		return err
+	}
+
+	footer := make([]byte, 0, footerSize)
+	footer = binary.BigEndian.AppendUint64(footer, w.offset)
+	footer = binary.BigEndian.AppendUint64(footer, length)
+	footer = append(footer, segmentMagic...)
+	if _, err := w.writer.Write(footer); err != nil {
+		return err
+	}
+	if err := w.writer.Flush(); err != nil {
+		return err
+	}
+
+	return w.file.Sync()
+}
+
+func writeBytes(buf *bytes.Buffer, bz []byte) {
+	buf.Write(binary.AppendUvarint(nil, uint64(len(bz))))
+	buf.Write(bz)
+}
+
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	bz := make([]byte, n)
+	_, err = io.ReadFull(r, bz)
+	return bz, err
+}
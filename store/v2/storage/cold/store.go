@@ -0,0 +1,298 @@
+package cold
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/internal"
+)
+
+var _ store.Checkpointer = (*Store)(nil)
+
+const (
+	segmentExt    = ".seg"
+	segmentTmpExt = ".tmp"
+	segmentTpl    = "%020d-%020d" + segmentExt
+)
+
+// Store is the cold storage of the historical state, it holds immutable segment
+// files each covering a contiguous range of versions of all the stores. The
+// versions are moved from the state storage into a new segment once they are
+// pruned, so that historical queries are still served.
+type Store struct {
+	dir             string
+	segmentInterval uint64
+
+	mtx sync.RWMutex
+	// segments are ordered by version, the range of a segment starting where the
+	// previous one ends.
+	segments []*segment
+}
+
+// New opens the cold storage in the given directory, creating it if needed.
+func New(dir string, cfg *Config) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cold storage directory: %w", err)
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Store{
+		dir:             dir,
+		segmentInterval: cfg.SegmentInterval,
+	}
+	for _, file := range files {
+		name := file.Name()
+		switch filepath.Ext(name) {
+		case segmentTmpExt:
+			// a segment whose write was interrupted
+			if err := os.Remove(filepath.Join(dir, name)); err != nil {
+				return nil, errors.Join(err, s.Close())
+			}
+		case segmentExt:
+			var fromVersion, toVersion uint64
+			if _, err := fmt.Sscanf(name, segmentTpl, &fromVersion, &toVersion); err != nil {
+				return nil, errors.Join(fmt.Errorf("invalid segment file name %s: %w", name, err), s.Close())
+			}
+			seg, err := openSegment(filepath.Join(dir, name), fromVersion, toVersion)
+			if err != nil {
+				return nil, errors.Join(err, s.Close())
+			}
+			s.segments = append(s.segments, seg)
+		}
+	}
+
+	sort.Slice(s.segments, func(i, j int) bool {
+		return s.segments[i].fromVersion < s.segments[j].fromVersion
+	})
+	for i := 1; i < len(s.segments); i++ {
+		if s.segments[i].fromVersion != s.segments[i-1].toVersion {
+			return nil, errors.Join(fmt.Errorf("missing segment for versions %d to %d", s.segments[i-1].toVersion+1, s.segments[i].fromVersion), s.Close())
+		}
+	}
+
+	return s, nil
+}
+
+// Height returns the latest version held by the cold storage, or 0 if it is empty.
+func (s *Store) Height() uint64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.height()
+}
+
+func (s *Store) height() uint64 {
+	if len(s.segments) == 0 {
+		return 0
+	}
+	return s.segments[len(s.segments)-1].toVersion
+}
+
+// ShouldWriteSegment returns true if the versions up to the given one should be
+// moved into a new segment.
+func (s *Store) ShouldWriteSegment(version uint64) bool {
+	return s.segmentInterval > 0 && version >= s.Height()+s.segmentInterval
+}
+
+// WriteSegment writes the segment covering the (fromVersion, toVersion] range of
+// versions, fromVersion being the height of the cold storage unless it is empty.
+// The history of the stores is written to the segment by the given function.
+func (s *Store) WriteSegment(fromVersion, toVersion uint64, write func(w *SegmentWriter) error) error {
+	s.mtx.RLock()
+	height := s.height()
+	s.mtx.RUnlock()
+	if height > 0 && fromVersion != height {
+		return fmt.Errorf("segment starting at version %d does not follow the cold storage height %d", fromVersion, height)
+	}
+	if toVersion <= fromVersion {
+		return fmt.Errorf("invalid segment versions %d to %d", fromVersion, toVersion)
+	}
+
+	path := filepath.Join(s.dir, fmt.Sprintf(segmentTpl, fromVersion, toVersion))
+	if err := writeSegmentFile(path, write); err != nil {
+		return err
+	}
+
+	seg, err := openSegment(path, fromVersion, toVersion)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	s.segments = append(s.segments, seg)
+	s.mtx.Unlock()
+
+	return nil
+}
+
+// writeSegmentFile writes the segment to a temporary file, which is renamed once
+// complete so that the segment files are never partially written.
+func writeSegmentFile(path string, write func(w *SegmentWriter) error) (err error) {
+	file, err := os.Create(path + segmentTmpExt)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, os.Remove(file.Name()))
+		}
+	}()
+
+	w := newSegmentWriter(file)
+	if err := write(w); err != nil {
+		return errors.Join(err, file.Close())
+	}
+	if err := w.finish(); err != nil {
+		return errors.Join(fmt.Errorf("failed to write segment: %w", err), file.Close())
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// EarliestVersion returns the earliest version of the store held by the cold storage.
+func (s *Store) EarliestVersion(storeKey []byte) uint64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.earliestVersion(storeKey)
+}
+
+func (s *Store) earliestVersion(storeKey []byte) uint64 {
+	if len(s.segments) == 0 {
+		return 0
+	}
+	// the stores added after the first segment hold no state before being added
+	first := s.segments[0]
+	if index, ok := first.stores[string(storeKey)]; ok {
+		return index.earliestVersion
+	}
+	return first.fromVersion + 1
+}
+
+// segmentsAt returns the segments up to the one holding the given version.
+func (s *Store) segmentsAt(storeKey []byte, version uint64) ([]*segment, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	if version > s.height() {
+		return nil, fmt.Errorf("version %d is not in the cold storage, whose height is %d", version, s.height())
+	}
+	if earliestVersion := s.earliestVersion(storeKey); version < earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: earliestVersion, RequestedVersion: version}
+	}
+
+	i := sort.Search(len(s.segments), func(i int) bool {
+		return s.segments[i].toVersion >= version
+	})
+	return s.segments[:i+1], nil
+}
+
+// Get returns the value of the key in the store at the given version.
+func (s *Store) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
+	segments, err := s.segmentsAt(storeKey, version)
+	if err != nil {
+		return nil, err
+	}
+
+	// the latest segment holding a write to the key prevails
+	for i := len(segments) - 1; i >= 0; i-- {
+		e, err := segments[i].get(storeKey, key, version)
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			continue
+		}
+		if e.removed {
+			return nil, nil
+		}
+		return e.value, nil
+	}
+
+	return nil, nil
+}
+
+// Iterator returns an iterator over the domain of the store at the given version.
+func (s *Store) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	return s.iterator(storeKey, version, start, end, false)
+}
+
+// ReverseIterator returns an iterator over the domain of the store at the given
+// version, in reverse order.
+func (s *Store) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	return s.iterator(storeKey, version, start, end, true)
+}
+
+func (s *Store) iterator(storeKey []byte, version uint64, start, end []byte, reverse bool) (corestore.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return nil, storeerrors.ErrStartAfterEnd
+	}
+
+	segments, err := s.segmentsAt(storeKey, version)
+	if err != nil {
+		var errPruned storeerrors.ErrVersionPruned
+		if !errors.As(err, &errPruned) {
+			return nil, err
+		}
+		// pruned versions are iterated as empty, as in the state storage
+		segments = nil
+	}
+
+	cursors := make([]*cursor, len(segments))
+	for i, seg := range segments {
+		cursors[i] = newCursor(seg, storeKey, version, start, end, reverse)
+	}
+	return newIterator(cursors, start, end, reverse), nil
+}
+
+// Checkpoint implements store.Checkpointer, the segment files are hard linked
+// into the given directory when possible.
+func (s *Store) Checkpoint(dir string) error {
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		return err
+	}
+
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	for _, seg := range s.segments {
+		name := filepath.Base(seg.file.Name())
+		if err := internal.LinkOrCopyFile(seg.file.Name(), filepath.Join(dir, name)); err != nil {
+			return fmt.Errorf("failed to checkpoint segment %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the segment files.
+func (s *Store) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var errs []error
+	for _, seg := range s.segments {
+		errs = append(errs, seg.close())
+	}
+	s.segments = nil
+
+	return errors.Join(errs...)
+}
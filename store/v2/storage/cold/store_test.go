@@ -0,0 +1,248 @@
+package cold
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+var storeKey = []byte("store1")
+
+// history is a reference model of the versioned state of a store.
+type history struct {
+	states []map[string][]byte // state by version
+	writes map[string][]write  // writes by key
+}
+
+type write struct {
+	version uint64
+	value   []byte
+	removed bool
+}
+
+func newHistory(rng *rand.Rand, versions, keys int) *history {
+	h := &history{
+		states: []map[string][]byte{{}},
+		writes: make(map[string][]write),
+	}
+	for v := 1; v <= versions; v++ {
+		state := make(map[string][]byte, len(h.states[v-1]))
+		for key, value := range h.states[v-1] {
+			state[key] = value
+		}
+		for i := 0; i < keys/10; i++ {
+			key := fmt.Sprintf("key-%05d", rng.Intn(keys))
+			wr := write{version: uint64(v)}
+			if _, ok := state[key]; ok && rng.Intn(3) == 0 {
+				delete(state, key)
+				wr.removed = true
+			} else {
+				wr.value = []byte(fmt.Sprintf("value-%d-%d", v, rng.Int()))
+				state[key] = wr.value
+			}
+			// only the last write to a key at a version is kept
+			if w := h.writes[key]; len(w) > 0 && w[len(w)-1].version == wr.version {
+				w[len(w)-1] = wr
+			} else {
+				h.writes[key] = append(w, wr)
+			}
+		}
+		h.states = append(h.states, state)
+	}
+
+	return h
+}
+
+func (h *history) writeSegment(t *testing.T, s *Store, fromVersion, toVersion uint64) {
+	t.Helper()
+
+	keys := make([]string, 0, len(h.writes))
+	for key := range h.writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	require.NoError(t, s.WriteSegment(fromVersion, toVersion, func(w *SegmentWriter) error {
+		if err := w.StartStore(storeKey, fromVersion+1); err != nil {
+			return err
+		}
+		for _, key := range keys {
+			for _, wr := range h.writes[key] {
+				if wr.version <= fromVersion || wr.version > toVersion {
+					continue
+				}
+				if err := w.Add([]byte(key), wr.version, wr.value, wr.removed); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}))
+}
+
+func (h *history) check(t *testing.T, s *Store, version uint64, rng *rand.Rand) {
+	t.Helper()
+
+	state := h.states[version]
+	for i := 0; i < 50; i++ {
+		key := fmt.Sprintf("key-%05d", rng.Intn(2000))
+		value, err := s.Get(storeKey, version, []byte(key))
+		require.NoError(t, err)
+		require.Equal(t, state[key], value, "key %s at version %d", key, version)
+	}
+
+	keys := make([]string, 0, len(state))
+	for key := range state {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// iterate a random domain in both directions
+	start, end := fmt.Sprintf("key-%05d", rng.Intn(1000)), fmt.Sprintf("key-%05d", 1000+rng.Intn(1000))
+	var expected []string
+	for _, key := range keys {
+		if key >= start && key < end {
+			expected = append(expected, key)
+		}
+	}
+
+	itr, err := s.Iterator(storeKey, version, []byte(start), []byte(end))
+	require.NoError(t, err)
+	var actual []string
+	for ; itr.Valid(); itr.Next() {
+		require.Equal(t, state[string(itr.Key())], itr.Value())
+		actual = append(actual, string(itr.Key()))
+	}
+	require.NoError(t, itr.Error())
+	require.NoError(t, itr.Close())
+	require.Equal(t, expected, actual, "version %d", version)
+
+	itr, err = s.ReverseIterator(storeKey, version, []byte(start), []byte(end))
+	require.NoError(t, err)
+	actual = actual[:0]
+	for ; itr.Valid(); itr.Next() {
+		require.Equal(t, state[string(itr.Key())], itr.Value())
+		actual = append(actual, string(itr.Key()))
+	}
+	require.NoError(t, itr.Close())
+	for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+		expected[i], expected[j] = expected[j], expected[i]
+	}
+	require.Equal(t, expected, actual, "version %d", version)
+
+	// iterate the whole store
+	itr, err = s.Iterator(storeKey, version, nil, nil)
+	require.NoError(t, err)
+	count := 0
+	for ; itr.Valid(); itr.Next() {
+		count++
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, len(keys), count)
+}
+
+func TestStore(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	h := newHistory(rng, 60, 2000)
+
+	dir := t.TempDir()
+	s, err := New(dir, DefaultConfig())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), s.Height())
+
+	h.writeSegment(t, s, 0, 20)
+	h.writeSegment(t, s, 20, 45)
+	h.writeSegment(t, s, 45, 60)
+	require.Equal(t, uint64(60), s.Height())
+
+	// the segments must be contiguous
+	require.Error(t, s.WriteSegment(50, 70, func(*SegmentWriter) error { return nil }))
+
+	for version := uint64(1); version <= 60; version++ {
+		h.check(t, s, version, rng)
+	}
+
+	_, err = s.Get(storeKey, 61, []byte("key-00001"))
+	require.Error(t, err)
+
+	// the segments are loaded from the directory
+	require.NoError(t, s.Close())
+	s, err = New(dir, DefaultConfig())
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), s.Height())
+	for version := uint64(1); version <= 60; version += 7 {
+		h.check(t, s, version, rng)
+	}
+	require.NoError(t, s.Close())
+}
+
+func TestStore_EarliestVersion(t *testing.T) {
+	s, err := New(t.TempDir(), DefaultConfig())
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.NoError(t, s.WriteSegment(0, 10, func(w *SegmentWriter) error {
+		if err := w.StartStore(storeKey, 6); err != nil {
+			return err
+		}
+		// the state of the store at its earliest version
+		return w.Add([]byte("key"), 3, []byte("value"), false)
+	}))
+
+	_, err = s.Get(storeKey, 5, []byte("key"))
+	require.ErrorAs(t, err, &storeerrors.ErrVersionPruned{})
+	itr, err := s.Iterator(storeKey, 5, nil, nil)
+	require.NoError(t, err)
+	require.False(t, itr.Valid())
+
+	value, err := s.Get(storeKey, 6, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	// a store missing from the first segment holds no state
+	value, err = s.Get([]byte("store2"), 1, []byte("key"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestStore_Writer(t *testing.T) {
+	dir := t.TempDir()
+	s, err := New(dir, DefaultConfig())
+	require.NoError(t, err)
+
+	// the writes must be ordered
+	err = s.WriteSegment(0, 10, func(w *SegmentWriter) error {
+		if err := w.StartStore(storeKey, 1); err != nil {
+			return err
+		}
+		if err := w.Add([]byte("b"), 1, []byte("value"), false); err != nil {
+			return err
+		}
+		return w.Add([]byte("a"), 2, []byte("value"), false)
+	})
+	require.Error(t, err)
+	require.Equal(t, uint64(0), s.Height())
+
+	// no partially written segment is left
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	// an interrupted write is removed on load
+	require.NoError(t, os.WriteFile(filepath.Join(dir, fmt.Sprintf(segmentTpl, 0, 10)+segmentTmpExt), []byte("partial"), 0o600))
+	require.NoError(t, s.Close())
+	s, err = New(dir, DefaultConfig())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), s.Height())
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+	require.NoError(t, s.Close())
+}
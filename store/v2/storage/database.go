@@ -24,3 +24,16 @@ type Database interface {
 
 	io.Closer
 }
+
+// HistoryDatabase is implemented by the databases able to export the history of
+// their stores, which is required to move it into the cold storage.
+type HistoryDatabase interface {
+	// StoreKeys returns the keys of the stores held by the database.
+	StoreKeys() ([][]byte, error)
+	// EarliestVersion returns the earliest version available in the given store.
+	EarliestVersion(storeKey []byte) uint64
+	// IterateHistory calls fn with the writes to the keys of the given store at the
+	// versions within the (fromVersion, toVersion] range, ordered by key and version.
+	// The value is nil if the key is removed at the version.
+	IterateHistory(storeKey []byte, fromVersion, toVersion uint64, fn func(key []byte, version uint64, value []byte, removed bool) error) error
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"slices"
+	"sync"
 
 	"github.com/cockroachdb/pebble"
 
@@ -26,16 +28,20 @@ const (
 	// batchBufferSize defines the maximum size of a batch before it is committed.
 	batchBufferSize = 100_000
 
-	StorePrefixTpl        = "s/k:%s/"         // s/k:<storeKey>
-	removedStoreKeyPrefix = "s/_removed_key"  // NB: removedStoreKeys key must be lexically smaller than StorePrefixTpl
-	latestVersionKey      = "s/_latest"       // NB: latestVersionKey key must be lexically smaller than StorePrefixTpl
-	pruneHeightKey        = "s/_prune_height" // NB: pruneHeightKey key must be lexically smaller than StorePrefixTpl
+	StorePrefixTpl        = "s/k:%s/"          // s/k:<storeKey>
+	removedStoreKeyPrefix = "s/_removed_key"   // NB: removedStoreKeys key must be lexically smaller than StorePrefixTpl
+	latestVersionKey      = "s/_latest"        // NB: latestVersionKey key must be lexically smaller than StorePrefixTpl
+	pruneHeightKey        = "s/_prune_height"  // NB: pruneHeightKey key must be lexically smaller than StorePrefixTpl
+	storePruneHeightKey   = "s/_prune_height/" // s/_prune_height/<storeKey>, NB: must be lexically smaller than StorePrefixTpl
 	tombstoneVal          = "TOMBSTONE"
 )
 
 var (
 	_ storage.Database         = (*Database)(nil)
 	_ store.UpgradableDatabase = (*Database)(nil)
+	_ store.StorePruner        = (*Database)(nil)
+	_ storage.HistoryDatabase  = (*Database)(nil)
+	_ store.Checkpointer       = (*Database)(nil)
 )
 
 type Database struct {
@@ -45,6 +51,11 @@ type Database struct {
 	// only updated when the database is pruned.
 	earliestVersion uint64
 
+	// storePruneHeights defines the prune height of the stores which have been
+	// pruned independently of the others, overriding earliestVersion.
+	storePruneHeights map[string]uint64
+	pruneHeightsMtx   sync.RWMutex
+
 	// Sync is whether to sync writes through the OS buffer cache and down onto
 	// the actual disk, if applicable. Setting Sync is required for durability of
 	// individual write operations but can result in slower writes.
@@ -72,11 +83,16 @@ func New(dataDir string) (*Database, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get prune height: %w", err)
 	}
+	storePruneHeights, err := getStorePruneHeights(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store prune heights: %w", err)
+	}
 
 	return &Database{
-		storage:         db,
-		earliestVersion: pruneHeight + 1,
-		sync:            true,
+		storage:           db,
+		earliestVersion:   pruneHeight + 1,
+		storePruneHeights: storePruneHeights,
+		sync:              true,
 	}, nil
 }
 
@@ -85,11 +101,16 @@ func NewWithDB(storage *pebble.DB, sync bool) *Database {
 	if err != nil {
 		panic(fmt.Errorf("failed to get prune height: %w", err))
 	}
+	storePruneHeights, err := getStorePruneHeights(storage)
+	if err != nil {
+		panic(fmt.Errorf("failed to get store prune heights: %w", err))
+	}
 
 	return &Database{
-		storage:         storage,
-		earliestVersion: pruneHeight + 1,
-		sync:            sync,
+		storage:           storage,
+		earliestVersion:   pruneHeight + 1,
+		storePruneHeights: storePruneHeights,
+		sync:              sync,
 	}
 }
 
@@ -97,6 +118,12 @@ func (db *Database) SetSync(sync bool) {
 	db.sync = sync
 }
 
+// Checkpoint implements store.Checkpointer, the files of the checkpoint are hard
+// linked to the ones of the database when possible.
+func (db *Database) Checkpoint(dir string) error {
+	return db.storage.Checkpoint(dir, pebble.WithFlushedWAL())
+}
+
 func (db *Database) Close() error {
 	err := db.storage.Close()
 	db.storage = nil
@@ -146,6 +173,49 @@ func (db *Database) setPruneHeight(pruneVersion uint64) error {
 	return db.storage.Set([]byte(pruneHeightKey), ts[:], &pebble.WriteOptions{Sync: db.sync})
 }
 
+// setStorePruneHeights sets the prune heights of the given stores and deletes the
+// ones of the reset stores, which fall back to the prune height of the database.
+func (db *Database) setStorePruneHeights(pruneHeights map[string]uint64, resetStoreKeys []string) error {
+	batch := db.storage.NewBatch()
+	defer batch.Close()
+
+	for _, storeKey := range resetStoreKeys {
+		if err := batch.Delete(storePruneHeightMVCCKey(storeKey), nil); err != nil {
+			return err
+		}
+	}
+	for storeKey, pruneHeight := range pruneHeights {
+		var ts [VersionSize]byte
+		binary.LittleEndian.PutUint64(ts[:], pruneHeight)
+		if err := batch.Set(storePruneHeightMVCCKey(storeKey), ts[:], nil); err != nil {
+			return err
+		}
+	}
+	if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
+		return err
+	}
+
+	db.pruneHeightsMtx.Lock()
+	defer db.pruneHeightsMtx.Unlock()
+	for _, storeKey := range resetStoreKeys {
+		delete(db.storePruneHeights, storeKey)
+	}
+	maps.Copy(db.storePruneHeights, pruneHeights)
+
+	return nil
+}
+
+// storeEarliestVersion returns the earliest version available in the given store.
+func (db *Database) storeEarliestVersion(storeKey []byte) uint64 {
+	db.pruneHeightsMtx.RLock()
+	defer db.pruneHeightsMtx.RUnlock()
+
+	if pruneHeight, ok := db.storePruneHeights[string(storeKey)]; ok {
+		return pruneHeight + 1
+	}
+	return db.earliestVersion
+}
+
 func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
 	val, err := db.Get(storeKey, version, key)
 	if err != nil {
@@ -156,8 +226,8 @@ func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, erro
 }
 
 func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]byte, error) {
-	if targetVersion < db.earliestVersion {
-		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: targetVersion}
+	if earliestVersion := db.storeEarliestVersion(storeKey); targetVersion < earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: earliestVersion, RequestedVersion: targetVersion}
 	}
 
 	prefixedVal, err := getMVCCSlice(db.storage, storeKey, key, targetVersion)
@@ -204,7 +274,67 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 //
 // See: https://github.com/cockroachdb/cockroach/blob/33623e3ee420174a4fd3226d1284b03f0e3caaac/pkg/storage/mvcc.go#L3182
 func (db *Database) Prune(version uint64) error {
-	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: []byte("s/k:")})
+	return db.PruneExcept(version, nil)
+}
+
+// PruneExcept removes all versions of all keys that are <= the given version,
+// except for the keys of the excluded stores, see Prune.
+func (db *Database) PruneExcept(version uint64, excludedStoreKeys []string) error {
+	excludedPrefixes := make([][]byte, 0, len(excludedStoreKeys))
+	for _, storeKey := range excludedStoreKeys {
+		excludedPrefixes = append(excludedPrefixes, storePrefix([]byte(storeKey)))
+	}
+	if err := db.prune(version, []byte("s/k:"), nil, excludedPrefixes); err != nil {
+		return err
+	}
+
+	if err := db.deleteRemovedStoreKeys(version); err != nil {
+		return err
+	}
+
+	// The excluded stores keep their current earliest version, while the stores
+	// pruned further than the given version keep their own prune height.
+	db.pruneHeightsMtx.RLock()
+	var (
+		pruneHeights   = make(map[string]uint64)
+		resetStoreKeys []string
+	)
+	for storeKey, pruneHeight := range db.storePruneHeights {
+		if pruneHeight <= version && !slices.Contains(excludedStoreKeys, storeKey) {
+			resetStoreKeys = append(resetStoreKeys, storeKey)
+		}
+	}
+	for _, storeKey := range excludedStoreKeys {
+		if _, ok := db.storePruneHeights[storeKey]; !ok {
+			pruneHeights[storeKey] = db.earliestVersion - 1
+		}
+	}
+	db.pruneHeightsMtx.RUnlock()
+	if err := db.setStorePruneHeights(pruneHeights, resetStoreKeys); err != nil {
+		return err
+	}
+
+	return db.setPruneHeight(version)
+}
+
+// PruneStore removes all versions of the keys of the given store that are <=
+// the given version, see Prune.
+func (db *Database) PruneStore(storeKey string, version uint64) error {
+	prefix := storePrefix([]byte(storeKey))
+	if err := db.prune(version, MVCCEncode(prefix, 0), MVCCEncode(util.CopyIncr(prefix), 0), nil); err != nil {
+		return err
+	}
+
+	if earliestVersion := db.storeEarliestVersion([]byte(storeKey)); version < earliestVersion {
+		version = earliestVersion - 1
+	}
+	return db.setStorePruneHeights(map[string]uint64{storeKey: version}, nil)
+}
+
+// prune removes all versions of the keys within the given bounds that are <= the
+// given version, skipping the keys with any of the excluded prefixes.
+func (db *Database) prune(version uint64, lowerBound, upperBound []byte, excludedPrefixes [][]byte) error {
+	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: lowerBound, UpperBound: upperBound})
 	if err != nil {
 		return err
 	}
@@ -222,6 +352,14 @@ func (db *Database) Prune(version uint64) error {
 	for itr.First(); itr.Valid(); {
 		prefixedKey := slices.Clone(itr.Key())
 
+		// seek past the keys of an excluded store
+		if i := slices.IndexFunc(excludedPrefixes, func(prefix []byte) bool {
+			return bytes.HasPrefix(prefixedKey, prefix)
+		}); i >= 0 {
+			itr.SeekGE(MVCCEncode(util.CopyIncr(excludedPrefixes[i]), 0))
+			continue
+		}
+
 		keyBz, verBz, ok := SplitMVCCKey(prefixedKey)
 		if !ok {
 			return fmt.Errorf("invalid PebbleDB MVCC key: %s", prefixedKey)
@@ -276,11 +414,7 @@ func (db *Database) Prune(version uint64) error {
 		}
 	}
 
-	if err := db.deleteRemovedStoreKeys(version); err != nil {
-		return err
-	}
-
-	return db.setPruneHeight(version)
+	return nil
 }
 
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
@@ -304,7 +438,7 @@ func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte)
 		return nil, err
 	}
 
-	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, false), nil
+	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.storeEarliestVersion(storeKey), false), nil
 }
 
 func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
@@ -328,7 +462,101 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 		return nil, err
 	}
 
-	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, true), nil
+	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.storeEarliestVersion(storeKey), true), nil
+}
+
+// StoreKeys implements storage.HistoryDatabase.
+func (db *Database) StoreKeys() ([][]byte, error) {
+	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: []byte("s/k:")})
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var storeKeys [][]byte
+	for itr.First(); itr.Valid(); {
+		key := bytes.TrimPrefix(itr.Key(), []byte("s/k:"))
+		i := bytes.IndexByte(key, '/')
+		if i < 0 {
+			return nil, fmt.Errorf("invalid PebbleDB key: %s", itr.Key())
+		}
+		storeKey := slices.Clone(key[:i])
+		storeKeys = append(storeKeys, storeKey)
+
+		// seek past the keys of the store
+		itr.SeekGE(MVCCEncode(util.CopyIncr(storePrefix(storeKey)), 0))
+	}
+
+	return storeKeys, itr.Error()
+}
+
+// EarliestVersion implements storage.HistoryDatabase.
+func (db *Database) EarliestVersion(storeKey []byte) uint64 {
+	return db.storeEarliestVersion(storeKey)
+}
+
+// IterateHistory implements storage.HistoryDatabase.
+func (db *Database) IterateHistory(storeKey []byte, fromVersion, toVersion uint64, fn func(key []byte, version uint64, value []byte, removed bool) error) error {
+	prefix := storePrefix(storeKey)
+	itr, err := db.storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode(prefix, 0),
+		UpperBound: MVCCEncode(util.CopyIncr(prefix), 0),
+	})
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	inRange := func(version uint64) bool {
+		return version > fromVersion && version <= toVersion
+	}
+	for itr.First(); itr.Valid(); {
+		keyBz, verBz, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+		keyVersion, err := decodeUint64Ascending(verBz)
+		if err != nil {
+			return fmt.Errorf("failed to decode key version: %w", err)
+		}
+
+		// the versions of a key are ordered, seek to the next key
+		if keyVersion > toVersion {
+			itr.NextPrefix()
+			continue
+		}
+
+		value, err := itr.ValueAndErr()
+		if err != nil {
+			return err
+		}
+		valBz, tombBz, ok := SplitMVCCKey(value)
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB MVCC value: %s", value)
+		}
+		var tombstone uint64
+		if len(tombBz) > 0 {
+			if tombstone, err = decodeUint64Ascending(tombBz); err != nil {
+				return fmt.Errorf("failed to decode value tombstone: %w", err)
+			}
+		}
+
+		key := keyBz[len(prefix):]
+		if tombstone != keyVersion && inRange(keyVersion) {
+			if err := fn(key, keyVersion, valBz, false); err != nil {
+				return err
+			}
+		}
+		if tombstone > 0 && inRange(tombstone) {
+			if err := fn(key, tombstone, nil, true); err != nil {
+				return err
+			}
+		}
+
+		itr.Next()
+	}
+
+	return itr.Error()
 }
 
 func (db *Database) PruneStoreKeys(storeKeys []string, version uint64) error {
@@ -370,6 +598,37 @@ func getPruneHeight(storage *pebble.DB) (uint64, error) {
 	return binary.LittleEndian.Uint64(bz), closer.Close()
 }
 
+func storePruneHeightMVCCKey(storeKey string) []byte {
+	return MVCCEncode([]byte(storePruneHeightKey+storeKey), 0)
+}
+
+func getStorePruneHeights(storage *pebble.DB) (map[string]uint64, error) {
+	itr, err := storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode([]byte(storePruneHeightKey), 0),
+		UpperBound: MVCCEncode(util.CopyIncr([]byte(storePruneHeightKey)), 0),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	pruneHeights := make(map[string]uint64)
+	for itr.First(); itr.Valid(); itr.Next() {
+		value, err := itr.ValueAndErr()
+		if err != nil {
+			return nil, err
+		}
+		key, _, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return nil, fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+		storeKey := string(key[len(storePruneHeightKey):])
+		pruneHeights[storeKey] = binary.LittleEndian.Uint64(value)
+	}
+
+	return pruneHeights, itr.Error()
+}
+
 func valTombstoned(value []byte) bool {
 	if value == nil {
 		return false
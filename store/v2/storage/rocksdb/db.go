@@ -7,7 +7,10 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"maps"
+	"math"
 	"slices"
+	"sync"
 
 	"github.com/linxGnu/grocksdb"
 
@@ -21,13 +24,28 @@ import (
 const (
 	TimestampSize = 8
 
-	StorePrefixTpl   = "s/k:%s/"
-	latestVersionKey = "s/latest"
+	StorePrefixTpl      = "s/k:%s/"
+	latestVersionKey    = "s/latest"
+	pruneHeightKey      = "s/_prune_height"
+	storePruneHeightKey = "s/_prune_height/" // s/_prune_height/<storeKey>
+
+	// The value types of the internal keys returned by the iterators reading the
+	// history of the keys, see the ValueType enum of RocksDB's db/dbformat.h.
+	typeDeletion              = 0x0
+	typeValue                 = 0x1
+	typeSingleDeletion        = 0x7
+	typeDeletionWithTimestamp = 0x14
+	// internalKeyFooterSize is the size of the sequence number and value type
+	// suffixing the internal keys.
+	internalKeyFooterSize = 8
 )
 
 var (
 	_ storage.Database         = (*Database)(nil)
 	_ store.UpgradableDatabase = (*Database)(nil)
+	_ store.StorePruner        = (*Database)(nil)
+	_ storage.HistoryDatabase  = (*Database)(nil)
+	_ store.Checkpointer       = (*Database)(nil)
 
 	defaultWriteOpts = grocksdb.NewDefaultWriteOptions()
 	defaultReadOpts  = grocksdb.NewDefaultReadOptions()
@@ -40,6 +58,16 @@ type Database struct {
 	// tsLow reflects the full_history_ts_low CF value, which is earliest version
 	// supported
 	tsLow uint64
+
+	// earliestVersion defines the earliest version served by the database, which
+	// is only updated when the database is pruned. The versions between tsLow and
+	// earliestVersion are not served, even though they are not compacted yet.
+	earliestVersion uint64
+
+	// storePruneHeights defines the prune height of the stores which have been
+	// pruned independently of the others, overriding earliestVersion.
+	storePruneHeights map[string]uint64
+	pruneHeightsMtx   sync.RWMutex
 }
 
 func New(dataDir string) (*Database, error) {
@@ -48,6 +76,10 @@ func New(dataDir string) (*Database, error) {
 		return nil, fmt.Errorf("failed to open RocksDB: %w", err)
 	}
 
+	return NewWithDB(storage, cfHandle)
+}
+
+func NewWithDB(storage *grocksdb.DB, cfHandle *grocksdb.ColumnFamilyHandle) (*Database, error) {
 	slice, err := storage.GetFullHistoryTsLow(cfHandle)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get full_history_ts_low: %w", err)
@@ -59,32 +91,43 @@ func New(dataDir string) (*Database, error) {
 		tsLow = binary.LittleEndian.Uint64(tsLowBz)
 	}
 
-	return &Database{
-		storage:  storage,
-		cfHandle: cfHandle,
-		tsLow:    tsLow,
-	}, nil
-}
-
-func NewWithDB(storage *grocksdb.DB, cfHandle *grocksdb.ColumnFamilyHandle) (*Database, error) {
-	slice, err := storage.GetFullHistoryTsLow(cfHandle)
+	// the databases pruned before the prune height was persisted only have tsLow
+	earliestVersion := max(tsLow, 1)
+	pruneHeightBz, err := storage.GetBytes(defaultReadOpts, []byte(pruneHeightKey))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get full_history_ts_low: %w", err)
+		return nil, fmt.Errorf("failed to get prune height: %w", err)
+	}
+	if len(pruneHeightBz) > 0 {
+		earliestVersion = binary.LittleEndian.Uint64(pruneHeightBz) + 1
 	}
 
-	var tsLow uint64
-	tsLowBz := copyAndFreeSlice(slice)
-	if len(tsLowBz) > 0 {
-		tsLow = binary.LittleEndian.Uint64(tsLowBz)
+	storePruneHeights, err := getStorePruneHeights(storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store prune heights: %w", err)
 	}
 
 	return &Database{
-		storage:  storage,
-		cfHandle: cfHandle,
-		tsLow:    tsLow,
+		storage:           storage,
+		cfHandle:          cfHandle,
+		tsLow:             tsLow,
+		earliestVersion:   earliestVersion,
+		storePruneHeights: storePruneHeights,
 	}, nil
 }
 
+// Checkpoint implements store.Checkpointer, the files of the checkpoint are hard
+// linked to the ones of the database when possible.
+func (db *Database) Checkpoint(dir string) error {
+	cp, err := db.storage.NewCheckpoint()
+	if err != nil {
+		return err
+	}
+	defer cp.Destroy()
+
+	// always flush the memtables, so that the checkpoint holds no WAL to replay
+	return cp.CreateCheckpoint(dir, 0)
+}
+
 func (db *Database) Close() error {
 	db.storage.Close()
 
@@ -99,8 +142,8 @@ func (db *Database) NewBatch(version uint64) (store.Batch, error) {
 }
 
 func (db *Database) getSlice(storeKey []byte, version uint64, key []byte) (*grocksdb.Slice, error) {
-	if version < db.tsLow {
-		return nil, errors.ErrVersionPruned{EarliestVersion: db.tsLow, RequestedVersion: version}
+	if earliestVersion := db.storeEarliestVersion(storeKey); version < earliestVersion {
+		return nil, errors.ErrVersionPruned{EarliestVersion: earliestVersion, RequestedVersion: version}
 	}
 
 	return db.storage.GetCF(
@@ -153,7 +196,100 @@ func (db *Database) Get(storeKey []byte, version uint64, key []byte) ([]byte, er
 // Internally, this performs a manual compaction, the data with older timestamp
 // will be GCed by compaction.
 func (db *Database) Prune(version uint64) error {
-	tsLow := version + 1 // we increment by 1 to include the provided version
+	return db.PruneExcept(version, nil)
+}
+
+// PruneExcept prunes all versions up to and including the provided version of
+// all the stores but the excluded ones, see Prune.
+func (db *Database) PruneExcept(version uint64, excludedStoreKeys []string) error {
+	// The excluded stores keep their current earliest version, while the stores
+	// pruned further than the given version keep their own prune height.
+	db.pruneHeightsMtx.RLock()
+	var (
+		pruneHeights   = make(map[string]uint64)
+		resetStoreKeys []string
+	)
+	for storeKey, pruneHeight := range db.storePruneHeights {
+		if pruneHeight <= version && !slices.Contains(excludedStoreKeys, storeKey) {
+			resetStoreKeys = append(resetStoreKeys, storeKey)
+		}
+	}
+	for _, storeKey := range excludedStoreKeys {
+		if _, ok := db.storePruneHeights[storeKey]; !ok {
+			pruneHeights[storeKey] = db.earliestVersion - 1
+		}
+	}
+	db.pruneHeightsMtx.RUnlock()
+
+	if err := db.setPruneHeights(&version, pruneHeights, resetStoreKeys); err != nil {
+		return err
+	}
+	db.compact()
+	return nil
+}
+
+// PruneStore prunes all versions up to and including the provided version of the
+// given store, see Prune. The versions of the store are only GCed by compaction
+// once all the other stores are pruned up to them.
+func (db *Database) PruneStore(storeKey string, version uint64) error {
+	if earliestVersion := db.storeEarliestVersion([]byte(storeKey)); version < earliestVersion {
+		version = earliestVersion - 1
+	}
+	if err := db.setPruneHeights(nil, map[string]uint64{storeKey: version}, nil); err != nil {
+		return err
+	}
+	db.compact()
+	return nil
+}
+
+// setPruneHeights persists the prune height of the database if not nil, sets the
+// prune heights of the given stores and deletes the ones of the reset stores, which
+// fall back to the prune height of the database.
+func (db *Database) setPruneHeights(pruneHeight *uint64, storePruneHeights map[string]uint64, resetStoreKeys []string) error {
+	batch := grocksdb.NewWriteBatch()
+	defer batch.Destroy()
+
+	var ts [TimestampSize]byte
+	if pruneHeight != nil {
+		binary.LittleEndian.PutUint64(ts[:], *pruneHeight)
+		batch.Put([]byte(pruneHeightKey), ts[:])
+	}
+	for _, storeKey := range resetStoreKeys {
+		batch.Delete([]byte(storePruneHeightKey + storeKey))
+	}
+	for storeKey, height := range storePruneHeights {
+		binary.LittleEndian.PutUint64(ts[:], height)
+		batch.Put([]byte(storePruneHeightKey+storeKey), ts[:])
+	}
+	if err := db.storage.Write(defaultWriteOpts, batch); err != nil {
+		return err
+	}
+
+	db.pruneHeightsMtx.Lock()
+	defer db.pruneHeightsMtx.Unlock()
+	if pruneHeight != nil {
+		db.earliestVersion = *pruneHeight + 1
+	}
+	for _, storeKey := range resetStoreKeys {
+		delete(db.storePruneHeights, storeKey)
+	}
+	maps.Copy(db.storePruneHeights, storePruneHeights)
+
+	return nil
+}
+
+// compact raises full_history_ts_low up to the earliest version served by any of
+// the stores, the versions below it are GCed by the compaction.
+func (db *Database) compact() {
+	db.pruneHeightsMtx.RLock()
+	tsLow := db.earliestVersion
+	for _, pruneHeight := range db.storePruneHeights {
+		tsLow = min(tsLow, pruneHeight+1)
+	}
+	db.pruneHeightsMtx.RUnlock()
+	if tsLow <= db.tsLow {
+		return
+	}
 
 	var ts [TimestampSize]byte
 	binary.LittleEndian.PutUint64(ts[:], tsLow)
@@ -162,7 +298,17 @@ func (db *Database) Prune(version uint64) error {
 	db.storage.CompactRangeCFOpt(db.cfHandle, grocksdb.Range{}, compactOpts)
 
 	db.tsLow = tsLow
-	return nil
+}
+
+// storeEarliestVersion returns the earliest version served by the given store.
+func (db *Database) storeEarliestVersion(storeKey []byte) uint64 {
+	db.pruneHeightsMtx.RLock()
+	defer db.pruneHeightsMtx.RUnlock()
+
+	if pruneHeight, ok := db.storePruneHeights[string(storeKey)]; ok {
+		return pruneHeight + 1
+	}
+	return db.earliestVersion
 }
 
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
@@ -177,8 +323,12 @@ func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte)
 	prefix := storePrefix(storeKey)
 	start, end = util.IterateWithPrefix(prefix, start, end)
 
-	itr := db.storage.NewIteratorCF(newTSReadOptions(version), db.cfHandle)
-	return newRocksDBIterator(itr, prefix, start, end, false), nil
+	itr := newRocksDBIterator(db.storage.NewIteratorCF(newTSReadOptions(version), db.cfHandle), prefix, start, end, false)
+	// the pruned versions of the store are not served, even if not compacted yet
+	if version < db.storeEarliestVersion(storeKey) {
+		itr.invalid = true
+	}
+	return itr, nil
 }
 
 func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
@@ -193,8 +343,119 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	prefix := storePrefix(storeKey)
 	start, end = util.IterateWithPrefix(prefix, start, end)
 
-	itr := db.storage.NewIteratorCF(newTSReadOptions(version), db.cfHandle)
-	return newRocksDBIterator(itr, prefix, start, end, true), nil
+	itr := newRocksDBIterator(db.storage.NewIteratorCF(newTSReadOptions(version), db.cfHandle), prefix, start, end, true)
+	// the pruned versions of the store are not served, even if not compacted yet
+	if version < db.storeEarliestVersion(storeKey) {
+		itr.invalid = true
+	}
+	return itr, nil
+}
+
+// StoreKeys implements storage.HistoryDatabase.
+func (db *Database) StoreKeys() ([][]byte, error) {
+	itr, readOpts := db.newHistoryIterator(0, math.MaxUint64)
+	defer readOpts.Destroy()
+	defer itr.Close()
+
+	var storeKeys [][]byte
+	prefix := []byte("s/k:")
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); {
+		key := readOnlySlice(itr.Key())[len(prefix):]
+		i := bytes.IndexByte(key, '/')
+		if i < 0 {
+			return nil, fmt.Errorf("invalid RocksDB key: %s", key)
+		}
+		storeKey := slices.Clone(key[:i])
+		storeKeys = append(storeKeys, storeKey)
+
+		// seek past the keys of the store
+		itr.Seek(util.CopyIncr(storePrefix(storeKey)))
+	}
+
+	return storeKeys, itr.Err()
+}
+
+// EarliestVersion implements storage.HistoryDatabase.
+func (db *Database) EarliestVersion(storeKey []byte) uint64 {
+	return db.storeEarliestVersion(storeKey)
+}
+
+// IterateHistory implements storage.HistoryDatabase.
+func (db *Database) IterateHistory(storeKey []byte, fromVersion, toVersion uint64, fn func(key []byte, version uint64, value []byte, removed bool) error) error {
+	itr, readOpts := db.newHistoryIterator(fromVersion+1, toVersion)
+	defer readOpts.Destroy()
+	defer itr.Close()
+
+	type write struct {
+		version uint64
+		value   []byte
+		removed bool
+	}
+	var (
+		prefix    = storePrefix(storeKey)
+		lastKey   []byte
+		keyWrites []write
+	)
+	// the versions of a key are ordered from the newest, they are buffered to be
+	// passed to fn from the oldest
+	flush := func() error {
+		for i := len(keyWrites) - 1; i >= 0; i-- {
+			w := keyWrites[i]
+			if err := fn(lastKey, w.version, w.value, w.removed); err != nil {
+				return err
+			}
+		}
+		keyWrites = keyWrites[:0]
+		return nil
+	}
+
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); itr.Next() {
+		// the keys are internal keys, suffixed with the timestamp and the footer
+		internalKey := readOnlySlice(itr.Key())
+		if len(internalKey) < len(prefix)+TimestampSize+internalKeyFooterSize {
+			return fmt.Errorf("invalid RocksDB internal key: %X", internalKey)
+		}
+		footer := internalKey[len(internalKey)-internalKeyFooterSize:]
+		keyAndTS := internalKey[:len(internalKey)-internalKeyFooterSize]
+		key := keyAndTS[len(prefix) : len(keyAndTS)-TimestampSize]
+		version := binary.LittleEndian.Uint64(keyAndTS[len(keyAndTS)-TimestampSize:])
+
+		if !bytes.Equal(key, lastKey) {
+			if err := flush(); err != nil {
+				return err
+			}
+			lastKey = slices.Clone(key)
+		} else if len(keyWrites) > 0 && version == keyWrites[len(keyWrites)-1].version {
+			// an older write to the key at the same version
+			continue
+		}
+
+		switch footer[0] {
+		case typeValue:
+			keyWrites = append(keyWrites, write{version: version, value: slices.Clone(readOnlySlice(itr.Value()))})
+		case typeDeletion, typeSingleDeletion, typeDeletionWithTimestamp:
+			keyWrites = append(keyWrites, write{version: version, removed: true})
+		default:
+			return fmt.Errorf("unexpected value type %#x of RocksDB internal key: %X", footer[0], internalKey)
+		}
+	}
+	if err := itr.Err(); err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+// newHistoryIterator returns an iterator over all the writes at the versions within
+// the [fromVersion, toVersion] range, removals included. The keys of the iterator
+// are the internal keys of RocksDB.
+func (db *Database) newHistoryIterator(fromVersion, toVersion uint64) (*grocksdb.Iterator, *grocksdb.ReadOptions) {
+	var fromTS [TimestampSize]byte
+	binary.LittleEndian.PutUint64(fromTS[:], fromVersion)
+
+	readOpts := newTSReadOptions(toVersion)
+	readOpts.SetIterStartTimestamp(fromTS[:])
+	return db.storage.NewIteratorCF(readOpts, db.cfHandle), readOpts
 }
 
 // PruneStoreKeys will do nothing for RocksDB, it will be pruned by compaction
@@ -214,6 +475,21 @@ func newTSReadOptions(version uint64) *grocksdb.ReadOptions {
 	return readOpts
 }
 
+func getStorePruneHeights(storage *grocksdb.DB) (map[string]uint64, error) {
+	itr := storage.NewIterator(defaultReadOpts)
+	defer itr.Close()
+
+	pruneHeights := make(map[string]uint64)
+	prefix := []byte(storePruneHeightKey)
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); itr.Next() {
+		key := copyAndFreeSlice(itr.Key())
+		storeKey := string(key[len(prefix):])
+		pruneHeights[storeKey] = binary.LittleEndian.Uint64(copyAndFreeSlice(itr.Value()))
+	}
+
+	return pruneHeights, itr.Err()
+}
+
 func storePrefix(storeKey []byte) []byte {
 	return []byte(fmt.Sprintf(StorePrefixTpl, storeKey))
 }
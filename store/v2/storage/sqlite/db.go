@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 
 	_ "github.com/mattn/go-sqlite3"
 
@@ -22,6 +24,7 @@ const (
 	reservedStoreKey  = "_RESERVED_"
 	keyLatestHeight   = "latest_height"
 	keyPruneHeight    = "prune_height"
+	keyStorePrefix    = "prune_height/" // prune_height/<storeKey>
 	valueRemovedStore = "removed_store"
 
 	reservedUpsertStmt = `
@@ -47,6 +50,8 @@ const (
 var (
 	_ storage.Database         = (*Database)(nil)
 	_ store.UpgradableDatabase = (*Database)(nil)
+	_ store.StorePruner        = (*Database)(nil)
+	_ storage.HistoryDatabase  = (*Database)(nil)
 )
 
 type Database struct {
@@ -55,6 +60,11 @@ type Database struct {
 	// earliestVersion defines the earliest version set in the database, which is
 	// only updated when the database is pruned.
 	earliestVersion uint64
+
+	// storeEarliestVersions defines the earliest version of the stores which have
+	// been pruned independently of the others, overriding earliestVersion.
+	storeEarliestVersions map[string]uint64
+	earliestVersionsMtx   sync.RWMutex
 }
 
 func New(dataDir string) (*Database, error) {
@@ -86,9 +96,19 @@ func New(dataDir string) (*Database, error) {
 		return nil, fmt.Errorf("failed to get prune height: %w", err)
 	}
 
+	storePruneHeights, err := getStorePruneHeights(storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store prune heights: %w", err)
+	}
+	storeEarliestVersions := make(map[string]uint64, len(storePruneHeights))
+	for storeKey, pruneHeight := range storePruneHeights {
+		storeEarliestVersions[storeKey] = pruneHeight + 1
+	}
+
 	return &Database{
-		storage:         storage,
-		earliestVersion: pruneHeight,
+		storage:               storage,
+		earliestVersion:       pruneHeight + 1,
+		storeEarliestVersions: storeEarliestVersions,
 	}, nil
 }
 
@@ -142,8 +162,8 @@ func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, erro
 }
 
 func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]byte, error) {
-	if targetVersion < db.earliestVersion {
-		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: targetVersion}
+	if earliestVersion := db.storeEarliestVersion(storeKey); targetVersion < earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: earliestVersion, RequestedVersion: targetVersion}
 	}
 
 	stmt, err := db.storage.Prepare(`
@@ -186,26 +206,38 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 // We perform the prune by deleting all versions of a key, excluding reserved keys,
 // that are <= the given version, except for the latest version of the key.
 func (db *Database) Prune(version uint64) error {
+	return db.PruneExcept(version, nil)
+}
+
+// PruneExcept removes all versions of all keys that are <= the given version,
+// except for the keys of the excluded stores, see Prune.
+func (db *Database) PruneExcept(version uint64, excludedStoreKeys []string) (err error) {
 	tx, err := db.storage.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to create SQL transaction: %w", err)
 	}
 	defer func() {
 		if err != nil {
-			err = tx.Rollback()
+			err = errors.Join(err, tx.Rollback())
 		}
 	}()
 
 	// prune all keys of old versions
-	pruneStmt := `DELETE FROM state_storage
+	storeClause := "store_key != ?"
+	args := []any{version, reservedStoreKey}
+	for _, storeKey := range excludedStoreKeys {
+		storeClause += " AND store_key != ?"
+		args = append(args, []byte(storeKey))
+	}
+	pruneStmt := fmt.Sprintf(`DELETE FROM state_storage
 	WHERE version < (
 		SELECT max(version) FROM state_storage t2 WHERE
 		t2.store_key = state_storage.store_key AND
 		t2.key = state_storage.key AND
 		t2.version <= ?
-	) AND store_key != ?;
-	`
-	if _, err := tx.Exec(pruneStmt, version, reservedStoreKey); err != nil {
+	) AND %s;
+	`, storeClause)
+	if _, err := tx.Exec(pruneStmt, args...); err != nil {
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
 	}
 
@@ -231,6 +263,29 @@ func (db *Database) Prune(version uint64) error {
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
 	}
 
+	// The excluded stores keep their current earliest version, while the stores
+	// pruned further than the given version keep their own prune height.
+	db.earliestVersionsMtx.RLock()
+	storeEarliestVersions := make(map[string]uint64)
+	for storeKey, earliestVersion := range db.storeEarliestVersions {
+		if earliestVersion > version+1 || slices.Contains(excludedStoreKeys, storeKey) {
+			storeEarliestVersions[storeKey] = earliestVersion
+		} else if _, err := tx.Exec("DELETE FROM state_storage WHERE store_key = ? AND key = ?", reservedStoreKey, keyStorePrefix+storeKey); err != nil {
+			db.earliestVersionsMtx.RUnlock()
+			return fmt.Errorf("failed to exec SQL statement: %w", err)
+		}
+	}
+	db.earliestVersionsMtx.RUnlock()
+	for _, storeKey := range excludedStoreKeys {
+		if _, ok := storeEarliestVersions[storeKey]; ok {
+			continue
+		}
+		storeEarliestVersions[storeKey] = db.earliestVersion
+		if err := setStorePruneHeight(tx, storeKey, db.earliestVersion-1); err != nil {
+			return err
+		}
+	}
+
 	// set the prune height so we can return <nil> for queries below this height
 	if _, err := tx.Exec(reservedUpsertStmt, reservedStoreKey, keyPruneHeight, version, 0, version); err != nil {
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
@@ -240,10 +295,68 @@ func (db *Database) Prune(version uint64) error {
 		return fmt.Errorf("failed to write SQL transaction: %w", err)
 	}
 
+	db.earliestVersionsMtx.Lock()
+	db.storeEarliestVersions = storeEarliestVersions
 	db.earliestVersion = version + 1
+	db.earliestVersionsMtx.Unlock()
+	return nil
+}
+
+// PruneStore removes all versions of the keys of the given store that are <=
+// the given version, see Prune.
+func (db *Database) PruneStore(storeKey string, version uint64) (err error) {
+	earliestVersion := db.storeEarliestVersion([]byte(storeKey))
+	if version < earliestVersion {
+		return nil
+	}
+
+	tx, err := db.storage.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to create SQL transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, tx.Rollback())
+		}
+	}()
+
+	pruneStmt := `DELETE FROM state_storage
+	WHERE version < (
+		SELECT max(version) FROM state_storage t2 WHERE
+		t2.store_key = state_storage.store_key AND
+		t2.key = state_storage.key AND
+		t2.version <= ?
+	) AND store_key = ?;
+	`
+	if _, err := tx.Exec(pruneStmt, version, []byte(storeKey)); err != nil {
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	if err := setStorePruneHeight(tx, storeKey, version); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to write SQL transaction: %w", err)
+	}
+
+	db.earliestVersionsMtx.Lock()
+	db.storeEarliestVersions[storeKey] = version + 1
+	db.earliestVersionsMtx.Unlock()
 	return nil
 }
 
+// storeEarliestVersion returns the earliest version available in the given store.
+func (db *Database) storeEarliestVersion(storeKey []byte) uint64 {
+	db.earliestVersionsMtx.RLock()
+	defer db.earliestVersionsMtx.RUnlock()
+
+	if earliestVersion, ok := db.storeEarliestVersions[string(storeKey)]; ok {
+		return earliestVersion
+	}
+	return db.earliestVersion
+}
+
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
@@ -268,6 +381,71 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	return newIterator(db, storeKey, version, start, end, true)
 }
 
+// StoreKeys implements storage.HistoryDatabase.
+func (db *Database) StoreKeys() ([][]byte, error) {
+	rows, err := db.storage.Query("SELECT DISTINCT store_key FROM state_storage WHERE store_key != ? ORDER BY store_key", reservedStoreKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+	defer rows.Close()
+
+	var storeKeys [][]byte
+	for rows.Next() {
+		var storeKey []byte
+		if err := rows.Scan(&storeKey); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		storeKeys = append(storeKeys, storeKey)
+	}
+
+	return storeKeys, rows.Err()
+}
+
+// EarliestVersion implements storage.HistoryDatabase.
+func (db *Database) EarliestVersion(storeKey []byte) uint64 {
+	return db.storeEarliestVersion(storeKey)
+}
+
+// IterateHistory implements storage.HistoryDatabase.
+func (db *Database) IterateHistory(storeKey []byte, fromVersion, toVersion uint64, fn func(key []byte, version uint64, value []byte, removed bool) error) error {
+	rows, err := db.storage.Query(`
+	SELECT key, value, version, tombstone FROM state_storage
+	WHERE store_key = ? AND ((version > ? AND version <= ?) OR (tombstone > ? AND tombstone <= ?))
+	ORDER BY key ASC, version ASC;
+	`, storeKey, fromVersion, toVersion, fromVersion, toVersion)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+	defer rows.Close()
+
+	inRange := func(version uint64) bool {
+		return version > fromVersion && version <= toVersion
+	}
+	for rows.Next() {
+		var (
+			key, value []byte
+			version    uint64
+			tomb       uint64
+		)
+		if err := rows.Scan(&key, &value, &version, &tomb); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if tomb != version && inRange(version) {
+			if err := fn(key, version, value, false); err != nil {
+				return err
+			}
+		}
+		if tomb > 0 && inRange(tomb) {
+			if err := fn(key, tomb, nil, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return rows.Err()
+}
+
 func (db *Database) PruneStoreKeys(storeKeys []string, version uint64) (err error) {
 	tx, err := db.storage.Begin()
 	if err != nil {
@@ -345,3 +523,33 @@ func getPruneHeight(storage *sql.DB) (uint64, error) {
 
 	return value, nil
 }
+
+func getStorePruneHeights(storage *sql.DB) (map[string]uint64, error) {
+	rows, err := storage.Query(`SELECT key, value FROM state_storage WHERE store_key = ? AND key LIKE ?`, reservedStoreKey, keyStorePrefix+"%")
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+	defer rows.Close()
+
+	pruneHeights := make(map[string]uint64)
+	for rows.Next() {
+		var (
+			key   string
+			value uint64
+		)
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		pruneHeights[strings.TrimPrefix(key, keyStorePrefix)] = value
+	}
+
+	return pruneHeights, rows.Err()
+}
+
+func setStorePruneHeight(tx *sql.Tx, storeKey string, pruneHeight uint64) error {
+	if _, err := tx.Exec(reservedUpsertStmt, reservedStoreKey, keyStorePrefix+storeKey, pruneHeight, 0, pruneHeight); err != nil {
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	return nil
+}
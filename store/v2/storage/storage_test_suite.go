@@ -2,6 +2,8 @@ package storage
 
 import (
 	"fmt"
+	"maps"
+	"math/rand"
 	"slices"
 	"testing"
 
@@ -10,6 +12,7 @@ import (
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage/cold"
 )
 
 const (
@@ -586,6 +589,78 @@ func (s *StorageTestSuite) TestDatabase_Prune() {
 	}
 }
 
+func (s *StorageTestSuite) TestDatabase_PruneStore() {
+	if slices.Contains(s.SkipTests, "TestDatabase_PruneStore") {
+		s.T().SkipNow()
+	}
+
+	dir := s.T().TempDir()
+	db, err := s.NewDB(dir)
+	s.Require().NoError(err)
+
+	storeKeys := []string{storeKey1, "store2", "store3"}
+
+	// for versions 1-50, set a key in every store
+	for v := uint64(1); v <= 50; v++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key%03d", v)), []byte(fmt.Sprintf("val%03d", v)), false)
+		}
+		s.Require().NoError(db.ApplyChangeset(v, cs))
+	}
+
+	checkEarliestVersion := func(storeKey string, earliestVersion uint64) {
+		for v := uint64(1); v <= 50; v++ {
+			key := []byte(fmt.Sprintf("key%03d", v))
+			bz, err := db.Get([]byte(storeKey), v, key)
+			if v < earliestVersion {
+				s.Require().Error(err, "store %s version %d", storeKey, v)
+				s.Require().Nil(bz)
+			} else {
+				s.Require().NoError(err, "store %s version %d", storeKey, v)
+				s.Require().Equal([]byte(fmt.Sprintf("val%03d", v)), bz)
+			}
+		}
+	}
+
+	// prune all the stores but store2 and store3 up to version 25
+	s.Require().NoError(db.PruneExcept(25, []string{"store2", "store3"}))
+	checkEarliestVersion(storeKey1, 26)
+	checkEarliestVersion("store2", 1)
+	checkEarliestVersion("store3", 1)
+
+	// prune store2 further than the other stores
+	s.Require().NoError(db.PruneStore("store2", 40))
+	checkEarliestVersion(storeKey1, 26)
+	checkEarliestVersion("store2", 41)
+	checkEarliestVersion("store3", 1)
+
+	itr, err := db.Iterator([]byte("store2"), 40, nil, nil)
+	s.Require().NoError(err)
+	s.Require().False(itr.Valid())
+	s.Require().NoError(itr.Close())
+
+	// the prune heights of the stores are persisted
+	s.Require().NoError(db.Close())
+	db, err = s.NewDB(dir)
+	s.Require().NoError(err)
+	defer db.Close()
+	checkEarliestVersion(storeKey1, 26)
+	checkEarliestVersion("store2", 41)
+	checkEarliestVersion("store3", 1)
+
+	// prune all the stores, store2 keeps its higher prune height
+	s.Require().NoError(db.Prune(30))
+	checkEarliestVersion(storeKey1, 31)
+	checkEarliestVersion("store2", 41)
+	checkEarliestVersion("store3", 31)
+
+	s.Require().NoError(db.Prune(45))
+	for _, storeKey := range storeKeys {
+		checkEarliestVersion(storeKey, 46)
+	}
+}
+
 func (s *StorageTestSuite) TestDatabase_Prune_KeepRecent() {
 	if slices.Contains(s.SkipTests, s.T().Name()) {
 		s.T().SkipNow()
@@ -877,3 +952,107 @@ func dbApplyChangeset(
 
 	require.NoError(t, db.ApplyChangeset(version, cs))
 }
+
+func (s *StorageTestSuite) TestDatabase_ColdStorage() {
+	if slices.Contains(s.SkipTests, "TestDatabase_ColdStorage") {
+		s.T().SkipNow()
+	}
+
+	dir, coldDir := s.T().TempDir(), s.T().TempDir()
+	newDB := func() *StorageStore {
+		ss, err := s.NewDB(dir)
+		s.Require().NoError(err)
+		coldStore, err := cold.New(coldDir, &cold.Config{Enable: true, SegmentInterval: 10})
+		s.Require().NoError(err)
+		db, err := NewStorageStoreWithColdStorage(ss.db, coldStore, ss.logger)
+		s.Require().NoError(err)
+		return db
+	}
+	db := newDB()
+
+	const (
+		versions   = 50
+		keepRecent = 5
+	)
+	storeKeys := []string{storeKey1, "store2"}
+
+	// states holds the state of the stores by version
+	states := []map[string]map[string]string{{storeKey1: {}, "store2": {}}}
+	rng := rand.New(rand.NewSource(1))
+	for v := uint64(1); v <= versions; v++ {
+		cs := corestore.NewChangeset()
+		state := make(map[string]map[string]string, len(storeKeys))
+		for _, storeKey := range storeKeys {
+			state[storeKey] = maps.Clone(states[v-1][storeKey])
+			for i, k := range rng.Perm(50)[:10] {
+				key := fmt.Sprintf("key%03d", k)
+				if _, ok := state[storeKey][key]; ok && rng.Intn(3) == 0 {
+					delete(state[storeKey], key)
+					cs.Add([]byte(storeKey), []byte(key), nil, true)
+				} else {
+					value := fmt.Sprintf("val%03d-%d", v, i)
+					state[storeKey][key] = value
+					cs.Add([]byte(storeKey), []byte(key), []byte(value), false)
+				}
+			}
+		}
+		states = append(states, state)
+		s.Require().NoError(db.ApplyChangeset(v, cs))
+
+		if v > keepRecent {
+			s.Require().NoError(db.Prune(v - keepRecent))
+		}
+	}
+
+	check := func(db *StorageStore) {
+		for v := uint64(1); v <= versions; v++ {
+			for _, storeKey := range storeKeys {
+				state := states[v][storeKey]
+				for i := 0; i < 50; i++ {
+					key := fmt.Sprintf("key%03d", i)
+					bz, err := db.Get([]byte(storeKey), v, []byte(key))
+					s.Require().NoError(err, "store %s version %d", storeKey, v)
+					if value, ok := state[key]; ok {
+						s.Require().Equal([]byte(value), bz, "store %s version %d key %s", storeKey, v, key)
+					} else {
+						s.Require().Nil(bz, "store %s version %d key %s", storeKey, v, key)
+					}
+				}
+
+				// the iteration of the versions kept by the backends is covered by
+				// the other tests
+				if v > db.cold.Height() {
+					continue
+				}
+				keys := slices.Sorted(maps.Keys(state))
+				itr, err := db.Iterator([]byte(storeKey), v, nil, nil)
+				s.Require().NoError(err)
+				var actual []string
+				for ; itr.Valid(); itr.Next() {
+					s.Require().Equal([]byte(state[string(itr.Key())]), itr.Value())
+					actual = append(actual, string(itr.Key()))
+				}
+				s.Require().NoError(itr.Close())
+				s.Require().Equal(keys, actual, "store %s version %d", storeKey, v)
+
+				itr, err = db.ReverseIterator([]byte(storeKey), v, nil, nil)
+				s.Require().NoError(err)
+				actual = actual[:0]
+				for ; itr.Valid(); itr.Next() {
+					actual = append(actual, string(itr.Key()))
+				}
+				s.Require().NoError(itr.Close())
+				slices.Reverse(keys)
+				s.Require().Equal(keys, actual, "store %s version %d", storeKey, v)
+			}
+		}
+	}
+
+	// the versions moved into the cold storage are still served
+	check(db)
+
+	s.Require().NoError(db.Close())
+	db = newDB()
+	defer db.Close()
+	check(db)
+}
@@ -3,29 +3,47 @@ package storage
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
 
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/storage/cold"
 )
 
 const (
 	// TODO: it is a random number, need to be tuned
 	defaultBatchBufferSize = 100000
+
+	// CheckpointDBDir and CheckpointColdDir are the directories of the checkpoints
+	// of the db and of the cold storage within a checkpoint of the StorageStore.
+	CheckpointDBDir   = "db"
+	CheckpointColdDir = "cold"
 )
 
 var (
-	_ store.VersionedDatabase      = (*StorageStore)(nil)
-	_ snapshots.StorageSnapshotter = (*StorageStore)(nil)
-	_ store.Pruner                 = (*StorageStore)(nil)
-	_ store.UpgradableDatabase     = (*StorageStore)(nil)
+	_ store.VersionedDatabase            = (*StorageStore)(nil)
+	_ snapshots.StorageSnapshotter       = (*StorageStore)(nil)
+	_ snapshots.StreamStorageSnapshotter = (*StorageStore)(nil)
+	_ store.Pruner                       = (*StorageStore)(nil)
+	_ store.StorePruner                  = (*StorageStore)(nil)
+	_ store.UpgradableDatabase           = (*StorageStore)(nil)
+	_ store.Checkpointer                 = (*StorageStore)(nil)
 )
 
 // StorageStore is a wrapper around the store.VersionedDatabase interface.
 type StorageStore struct {
 	logger log.Logger
 	db     Database
+	// cold is the cold storage the pruned versions are moved into, if any.
+	cold *cold.Store
+
+	// restoreMtx serializes the batch writes of concurrent store restorations.
+	restoreMtx sync.Mutex
 }
 
 // NewStorageStore returns a reference to a new StorageStore.
@@ -36,16 +54,44 @@ func NewStorageStore(db Database, logger log.Logger) *StorageStore {
 	}
 }
 
+// NewStorageStoreWithColdStorage returns a reference to a new StorageStore, which
+// moves the pruned versions into the given cold storage rather than deleting them.
+// The versions held by the cold storage are still served by the queries.
+func NewStorageStoreWithColdStorage(db Database, coldStore *cold.Store, logger log.Logger) (*StorageStore, error) {
+	if _, ok := db.(HistoryDatabase); !ok {
+		return nil, errors.New("db does not implement HistoryDatabase interface")
+	}
+
+	ss := NewStorageStore(db, logger)
+	ss.cold = coldStore
+	return ss, nil
+}
+
 // Has returns true if the key exists in the store.
 func (ss *StorageStore) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
+	if ss.inColdStorage(storeKey, version) {
+		val, err := ss.cold.Get(storeKey, version, key)
+		return val != nil, err
+	}
+
 	return ss.db.Has(storeKey, version, key)
 }
 
 // Get returns the value associated with the given key.
 func (ss *StorageStore) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
+	if ss.inColdStorage(storeKey, version) {
+		return ss.cold.Get(storeKey, version, key)
+	}
+
 	return ss.db.Get(storeKey, version, key)
 }
 
+// inColdStorage returns true if the version of the store has been moved into the
+// cold storage.
+func (ss *StorageStore) inColdStorage(storeKey []byte, version uint64) bool {
+	return ss.cold != nil && version <= ss.cold.Height() && version < ss.db.(HistoryDatabase).EarliestVersion(storeKey)
+}
+
 // ApplyChangeset applies the given changeset to the storage.
 func (ss *StorageStore) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
 	b, err := ss.db.NewBatch(version)
@@ -86,17 +132,118 @@ func (ss *StorageStore) SetLatestVersion(version uint64) error {
 
 // Iterator returns an iterator over the specified domain and prefix.
 func (ss *StorageStore) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	if ss.inColdStorage(storeKey, version) {
+		return ss.cold.Iterator(storeKey, version, start, end)
+	}
+
 	return ss.db.Iterator(storeKey, version, start, end)
 }
 
 // ReverseIterator returns an iterator over the specified domain and prefix in reverse.
 func (ss *StorageStore) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	if ss.inColdStorage(storeKey, version) {
+		return ss.cold.ReverseIterator(storeKey, version, start, end)
+	}
+
 	return ss.db.ReverseIterator(storeKey, version, start, end)
 }
 
-// Prune prunes the store up to the given version.
+// Prune prunes the store up to the given version. With a cold storage, the store
+// is only pruned up to the versions moved into it.
 func (ss *StorageStore) Prune(version uint64) error {
-	return ss.db.Prune(version)
+	if ss.cold == nil {
+		return ss.db.Prune(version)
+	}
+
+	pruneTo, prune, err := ss.moveToColdStorage(version, nil)
+	if err != nil || !prune {
+		return err
+	}
+	return ss.db.Prune(pruneTo)
+}
+
+// PruneStore prunes the given store up to the given version, if the db implements
+// the store.StorePruner interface.
+func (ss *StorageStore) PruneStore(storeKey string, version uint64) error {
+	pruner, ok := ss.db.(store.StorePruner)
+	if !ok {
+		return errors.New("db does not implement StorePruner interface")
+	}
+	if ss.cold == nil {
+		return pruner.PruneStore(storeKey, version)
+	}
+
+	pruneTo, prune, err := ss.moveToColdStorage(version, func(sk []byte) bool {
+		return string(sk) == storeKey
+	})
+	if err != nil || !prune {
+		return err
+	}
+	return pruner.PruneStore(storeKey, pruneTo)
+}
+
+// PruneExcept prunes all the stores but the excluded ones up to the given version,
+// if the db implements the store.StorePruner interface.
+func (ss *StorageStore) PruneExcept(version uint64, excludedStoreKeys []string) error {
+	pruner, ok := ss.db.(store.StorePruner)
+	if !ok {
+		return errors.New("db does not implement StorePruner interface")
+	}
+	if ss.cold == nil {
+		return pruner.PruneExcept(version, excludedStoreKeys)
+	}
+
+	pruneTo, prune, err := ss.moveToColdStorage(version, func(sk []byte) bool {
+		return !slices.Contains(excludedStoreKeys, string(sk))
+	})
+	if err != nil || !prune {
+		return err
+	}
+	return pruner.PruneExcept(pruneTo, excludedStoreKeys)
+}
+
+// moveToColdStorage moves the versions up to the given one into a new segment of
+// the cold storage if due. It returns the version the stores matching the filter,
+// or all the stores if nil, can be pruned to, which is bounded by the cold storage
+// height, and whether pruning them to it would remove any version.
+func (ss *StorageStore) moveToColdStorage(version uint64, filter func(storeKey []byte) bool) (uint64, bool, error) {
+	db := ss.db.(HistoryDatabase)
+	storeKeys, err := db.StoreKeys()
+	if err != nil {
+		return 0, false, err
+	}
+
+	if ss.cold.ShouldWriteSegment(version) {
+		fromVersion := ss.cold.Height()
+		err := ss.cold.WriteSegment(fromVersion, version, func(w *cold.SegmentWriter) error {
+			for _, storeKey := range storeKeys {
+				// the first segment also holds the state at the earliest version
+				earliestVersion := fromVersion + 1
+				if fromVersion == 0 {
+					earliestVersion = db.EarliestVersion(storeKey)
+				}
+				if err := w.StartStore(storeKey, earliestVersion); err != nil {
+					return err
+				}
+				if err := db.IterateHistory(storeKey, fromVersion, version, w.Add); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to move versions %d to %d into the cold storage: %w", fromVersion+1, version, err)
+		}
+		ss.logger.Info("moved versions into the cold storage", "from", fromVersion+1, "to", version)
+	}
+
+	pruneTo := min(version, ss.cold.Height())
+	for _, storeKey := range storeKeys {
+		if (filter == nil || filter(storeKey)) && pruneTo >= db.EarliestVersion(storeKey) {
+			return pruneTo, true, nil
+		}
+	}
+	return pruneTo, false, nil
 }
 
 // Restore restores the store from the given channel.
@@ -109,18 +256,34 @@ func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.Stat
 		return fmt.Errorf("the snapshot version %d is not greater than latest version %d", version, latestVersion)
 	}
 
+	return ss.restore(version, chStorage)
+}
+
+// RestoreStore restores a single store from the given channel. Unlike Restore, it can be
+// called concurrently, and for a version which has already been partially restored.
+func (ss *StorageStore) RestoreStore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	return ss.restore(version, chStorage)
+}
+
+func (ss *StorageStore) restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
 	b, err := ss.db.NewBatch(version)
 	if err != nil {
 		return err
 	}
 
+	write := func() error {
+		ss.restoreMtx.Lock()
+		defer ss.restoreMtx.Unlock()
+		return b.Write()
+	}
+
 	for kvPair := range chStorage {
 		for _, kv := range kvPair.StateChanges {
 			if err := b.Set(kvPair.Actor, kv.Key, kv.Value); err != nil {
 				return err
 			}
 			if b.Size() > defaultBatchBufferSize {
-				if err := b.Write(); err != nil {
+				if err := write(); err != nil {
 					return err
 				}
 				if err := b.Reset(); err != nil {
@@ -131,7 +294,7 @@ func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.Stat
 	}
 
 	if b.Size() > 0 {
-		if err := b.Write(); err != nil {
+		if err := write(); err != nil {
 			return err
 		}
 	}
@@ -150,7 +313,35 @@ func (ss *StorageStore) PruneStoreKeys(storeKeys []string, version uint64) error
 	return gdb.PruneStoreKeys(storeKeys, version)
 }
 
+// Checkpoint implements store.Checkpointer, if the db does. The checkpoint of the
+// db is written into the CheckpointDBDir directory, and the one of the cold storage
+// if any into the CheckpointColdDir directory.
+func (ss *StorageStore) Checkpoint(dir string) error {
+	db, ok := ss.db.(store.Checkpointer)
+	if !ok {
+		return errors.New("db does not implement Checkpointer interface")
+	}
+
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		return err
+	}
+	if err := db.Checkpoint(filepath.Join(dir, CheckpointDBDir)); err != nil {
+		return err
+	}
+	if ss.cold == nil {
+		return nil
+	}
+
+	// the versions are only pruned from the db once moved into a segment, so the
+	// segments written since the db checkpoint only duplicate versions it holds
+	return ss.cold.Checkpoint(filepath.Join(dir, CheckpointColdDir))
+}
+
 // Close closes the store.
 func (ss *StorageStore) Close() error {
+	if ss.cold != nil {
+		return errors.Join(ss.db.Close(), ss.cold.Close())
+	}
+
 	return ss.db.Close()
 }
@@ -99,6 +99,35 @@ type PausablePruner interface {
 	PausePruning(pause bool)
 }
 
+// StorePruner extends the Pruner interface to include the API for pruning the
+// stores independently of each other, which is required to apply per-store
+// pruning options.
+type StorePruner interface {
+	Pruner
+
+	// PruneStore prunes the given store to the provided version.
+	PruneStore(storeKey string, version uint64) error
+	// PruneExcept prunes all the stores but the excluded ones to the provided version.
+	PruneExcept(version uint64, excludedStoreKeys []string) error
+}
+
+// Checkpointer defines the interface for writing a consistent, point-in-time
+// copy of a database while it is written to.
+type Checkpointer interface {
+	// Checkpoint writes a checkpoint of the database into the given directory,
+	// which must not exist.
+	Checkpoint(dir string) error
+}
+
+// Brancher defines the interface for branching a Committer at a committed version,
+// which allows computing the commitment of a version without modifying the Committer.
+type Brancher interface {
+	// Branch returns a Committer holding the state committed at the given version,
+	// which keeps the changesets written to it in memory and cannot be committed.
+	// The branch must be closed once done.
+	Branch(version uint64) (Committer, error)
+}
+
 // QueryResult defines the response type to performing a query on a RootStore.
 type QueryResult struct {
 	Key      []byte
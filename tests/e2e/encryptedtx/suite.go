@@ -0,0 +1,295 @@
+package encryptedtx
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/simapp"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	consensustypes "cosmossdk.io/x/consensus/types"
+	encryptedtxtypes "cosmossdk.io/x/encryptedtx/types"
+	govtypes "cosmossdk.io/x/gov/types"
+	govv1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// dkgPhaseBlocks is the number of blocks of each phase of the distributed key
+// generation.
+const dkgPhaseBlocks = 8
+
+// E2ETestSuite runs a distributed key generation among the validators of a
+// network, whose nodes then decrypt encrypted txs with their vote extensions.
+type E2ETestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network network.NetworkI
+}
+
+func NewE2ETestSuite(cfg network.Config) *E2ETestSuite {
+	return &E2ETestSuite{cfg: cfg}
+}
+
+func (s *E2ETestSuite) SetupSuite() {
+	s.T().Log("setting up e2e test suite")
+
+	// every node publishes decryption shares with the DKG key stored in its home
+	s.cfg.AppConstructor = func(val network.ValidatorI) servertypes.Application {
+		dkgKeyFile := dkgKeyFile(val)
+		if _, err := encryptedtxtypes.LoadDKGKey(dkgKeyFile); errors.Is(err, os.ErrNotExist) {
+			key, err := threshold.GenerateDKGKey(rand.Reader)
+			if err != nil {
+				panic(err)
+			}
+			if err := encryptedtxtypes.SaveDKGKey(dkgKeyFile, key); err != nil {
+				panic(err)
+			}
+		}
+
+		appOpts := simtestutil.AppOptionsMap{
+			flags.FlagHome:             client.GetConfigFromViper(val.GetViper()).RootDir,
+			"encryptedtx.dkg-key-file": dkgKeyFile,
+		}
+
+		return simapp.NewSimApp(
+			val.GetLogger(), dbm.NewMemDB(), nil, true, appOpts,
+			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
+			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+			baseapp.SetChainID(val.GetViper().GetString(flags.FlagChainID)),
+		)
+	}
+
+	genesisState := s.cfg.GenesisState
+
+	var govGenesis govv1.GenesisState
+	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(genesisState[govtypes.ModuleName], &govGenesis))
+	votingPeriod := 8 * time.Second
+	expeditedVotingPeriod := 4 * time.Second
+	govGenesis.Params.VotingPeriod = &votingPeriod
+	govGenesis.Params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	govGenesisBz, err := s.cfg.Codec.MarshalJSON(&govGenesis)
+	s.Require().NoError(err)
+	genesisState[govtypes.ModuleName] = govGenesisBz
+
+	var encryptedTxGenesis encryptedtxtypes.GenesisState
+	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(genesisState[encryptedtxtypes.ModuleName], &encryptedTxGenesis))
+	encryptedTxGenesis.Params.DkgPhaseBlocks = dkgPhaseBlocks
+	encryptedTxGenesisBz, err := s.cfg.Codec.MarshalJSON(&encryptedTxGenesis)
+	s.Require().NoError(err)
+	genesisState[encryptedtxtypes.ModuleName] = encryptedTxGenesisBz
+
+	s.cfg.GenesisState = genesisState
+
+	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+}
+
+func (s *E2ETestSuite) TearDownSuite() {
+	s.T().Log("tearing down e2e test suite")
+	s.network.Cleanup()
+}
+
+func (s *E2ETestSuite) TestEncryptedTx() {
+	vals := s.network.GetValidators()
+	clientCtx := vals[0].GetClientCtx()
+	queryClient := encryptedtxtypes.NewQueryClient(clientCtx)
+
+	voteExtensionsEnableHeight := s.startDKG()
+	dkgRes, err := queryClient.DKG(context.Background(), &encryptedtxtypes.QueryDKGRequest{})
+	s.Require().NoError(err)
+	dkg := dkgRes.Dkg
+
+	// every validator registers its DKG key, then deals shares to the others
+	for _, val := range vals {
+		key, err := encryptedtxtypes.LoadDKGKey(dkgKeyFile(val))
+		s.Require().NoError(err)
+		encryptionKey, err := key.PublicKey()
+		s.Require().NoError(err)
+
+		s.submitTx(val, &encryptedtxtypes.MsgRegisterDKGKey{
+			ValidatorAddress: val.GetValAddress().String(),
+			EncryptionKey:    encryptionKey,
+		})
+	}
+
+	_, err = s.network.WaitForHeight(dkg.RegistrationEndHeight + 1)
+	s.Require().NoError(err)
+	dkgRes, err = queryClient.DKG(context.Background(), &encryptedtxtypes.QueryDKGRequest{})
+	s.Require().NoError(err)
+	dkg = dkgRes.Dkg
+	s.Require().Len(dkg.Registered(), len(vals))
+
+	for _, val := range vals {
+		dealing, err := threshold.NewDealing(rand.Reader, int(dkg.Threshold), dkg.EncryptionKeys())
+		s.Require().NoError(err)
+
+		s.submitTx(val, encryptedtxtypes.NewMsgSubmitDealing(val.GetValAddress().String(), dealing))
+	}
+
+	// the threshold key is generated once the complaint phase ends
+	_, err = s.network.WaitForHeight(max(dkg.ComplaintEndHeight, voteExtensionsEnableHeight) + 1)
+	s.Require().NoError(err)
+	keyRes, err := queryClient.ThresholdKey(context.Background(), &encryptedtxtypes.QueryThresholdKeyRequest{})
+	s.Require().NoError(err)
+	s.Require().NotNil(keyRes.ThresholdKey)
+	s.Require().Equal(dkg.Id, keyRes.ThresholdKey.DkgId)
+	s.Require().Len(keyRes.ThresholdKey.KeyHolders, len(vals))
+
+	// the second validator sends tokens in a tx encrypted and submitted by the
+	// first one
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amount := sdk.NewCoin(s.cfg.BondDenom, math.NewInt(100))
+	txBytes := s.signTx(vals[1], banktypes.NewMsgSend(vals[1].GetAddress().String(), recipient.String(), sdk.NewCoins(amount)))
+
+	msg, err := encryptedtxtypes.NewMsgSubmitEncryptedTx(rand.Reader, keyRes.ThresholdKey, vals[0].GetAddress().String(), txBytes)
+	s.Require().NoError(err)
+	s.submitTx(vals[0], msg)
+
+	// the tx is decrypted at the top of the block following the one ordering it
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(s.network.WaitForNextBlock())
+	}
+
+	balanceRes, err := banktypes.NewQueryClient(clientCtx).Balance(context.Background(), &banktypes.QueryBalanceRequest{
+		Address: recipient.String(),
+		Denom:   s.cfg.BondDenom,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(amount, *balanceRes.Balance)
+}
+
+// startDKG submits a governance proposal starting a distributed key generation
+// among all the validators and enabling vote extensions, and waits for the
+// generation to start. It returns the height vote extensions are enabled at.
+func (s *E2ETestSuite) startDKG() int64 {
+	vals := s.network.GetValidators()
+	clientCtx := vals[0].GetClientCtx()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	paramsRes, err := consensustypes.NewQueryClient(clientCtx).Params(context.Background(), &consensustypes.QueryParamsRequest{})
+	s.Require().NoError(err)
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+	voteExtensionsEnableHeight := height + 20
+
+	validators := make([]string, len(vals))
+	for i, val := range vals {
+		validators[i] = val.GetValAddress().String()
+	}
+
+	msgs := []sdk.Msg{
+		&consensustypes.MsgUpdateParams{
+			Authority: authority,
+			Block:     paramsRes.Params.Block,
+			Evidence:  paramsRes.Params.Evidence,
+			Validator: paramsRes.Params.Validator,
+			Feature: &cmtproto.FeatureParams{
+				VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: voteExtensionsEnableHeight},
+			},
+		},
+		&encryptedtxtypes.MsgStartDKG{
+			Authority:  authority,
+			Threshold:  uint32(len(vals)*2/3 + 1),
+			Validators: validators,
+		},
+	}
+	proposal, err := govv1.NewMsgSubmitProposal(
+		msgs,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, govv1.DefaultMinDepositTokens)),
+		vals[0].GetAddress().String(),
+		"",
+		"Encrypted txs",
+		"Start a distributed key generation and enable vote extensions",
+		govv1.ProposalType_PROPOSAL_TYPE_STANDARD,
+	)
+	s.Require().NoError(err)
+	s.submitTx(vals[0], proposal)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	proposalsRes, err := govv1.NewQueryClient(clientCtx).Proposals(context.Background(), &govv1.QueryProposalsRequest{})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(proposalsRes.Proposals)
+	proposalID := proposalsRes.Proposals[len(proposalsRes.Proposals)-1].Id
+
+	for _, val := range vals {
+		s.submitTx(val, govv1.NewMsgVote(val.GetAddress().String(), proposalID, govv1.OptionYes, ""))
+	}
+
+	s.Require().Eventually(func() bool {
+		res, err := encryptedtxtypes.NewQueryClient(clientCtx).DKG(context.Background(), &encryptedtxtypes.QueryDKGRequest{})
+		return err == nil && res.Dkg != nil
+	}, time.Minute, time.Second)
+
+	return voteExtensionsEnableHeight
+}
+
+// submitTx signs msg with the key of val and broadcasts it, the tx must pass
+// CheckTx.
+func (s *E2ETestSuite) submitTx(val network.ValidatorI, msg sdk.Msg) {
+	out, err := clitestutil.SubmitTestTx(val.GetClientCtx(), msg, val.GetAddress(), clitestutil.TestTxConfig{Gas: 1_000_000})
+	s.Require().NoError(err)
+
+	var res sdk.TxResponse
+	s.Require().NoError(val.GetClientCtx().Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().Zero(res.Code, res.RawLog)
+}
+
+// signTx returns the encoded tx holding msg signed with the key of val.
+func (s *E2ETestSuite) signTx(val network.ValidatorI, msg sdk.Msg) []byte {
+	clientCtx := val.GetClientCtx()
+
+	txBuilder := clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, math.NewInt(10))))
+	txBuilder.SetGasLimit(flags.DefaultGasLimit)
+
+	accNum, accSeq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, val.GetAddress())
+	s.Require().NoError(err)
+	keyRecord, err := clientCtx.Keyring.KeyByAddress(val.GetAddress())
+	s.Require().NoError(err)
+
+	txFactory := tx.Factory{}.
+		WithChainID(clientCtx.ChainID).
+		WithKeybase(clientCtx.Keyring).
+		WithTxConfig(clientCtx.TxConfig).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
+		WithAccountNumber(accNum).
+		WithSequence(accSeq)
+	s.Require().NoError(tx.Sign(context.Background(), txFactory, keyRecord.Name, txBuilder, true))
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	return txBytes
+}
+
+// dkgKeyFile returns the path of the DKG key of the node of val.
+func dkgKeyFile(val network.ValidatorI) string {
+	return filepath.Join(client.GetConfigFromViper(val.GetViper()).RootDir, "config", "dkg_key.json")
+}
@@ -199,6 +199,34 @@ func (s *E2ETestSuite) TestSimulateTx_GRPC() {
 	}
 }
 
+func (s *E2ETestSuite) TestSimulateWithTrace_GRPC() {
+	val := s.network.GetValidators()[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.GetClientCtx().TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	_, err = s.queryClient.SimulateWithTrace(context.Background(), &tx.SimulateWithTraceRequest{})
+	s.Require().ErrorContains(err, "empty txBytes is not allowed")
+
+	res, err := s.queryClient.SimulateWithTrace(context.Background(), &tx.SimulateWithTraceRequest{TxBytes: txBytes})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Error)
+	s.Require().Equal(10, len(res.GetResult().GetEvents())) // See TestSimulateTx_GRPC for the 10 events.
+	s.Require().NotEmpty(res.Operations)
+
+	var gasUsed uint64
+	bankWrites := 0
+	for _, op := range res.Operations {
+		gasUsed += op.Gas
+		if op.StoreKey == banktypes.StoreKey && op.OperationType == "set" {
+			bankWrites++
+		}
+	}
+	// the fee is sent to the fee collector and the amount to the recipient.
+	s.Require().GreaterOrEqual(bankWrites, 2)
+	s.Require().LessOrEqual(gasUsed, res.GetGasInfo().GetGasUsed())
+}
+
 func (s *E2ETestSuite) TestSimulateTx_GRPCGateway() {
 	val := s.network.GetValidators()[0]
 	txBuilder := s.mkTxBuilder()
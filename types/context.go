@@ -61,6 +61,15 @@ type Context struct {
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.Info
 	headerInfo           header.Info
+	storeTracer          StoreTracer
+}
+
+// StoreTracer traces the operations performed on the stores accessed through a Context.
+type StoreTracer interface {
+	// TraceKVStore wraps the gas metered store of the provided key. The parent store
+	// is the store wrapped by the gas metered store, and can be used to read
+	// state without consuming gas.
+	TraceKVStore(key storetypes.StoreKey, store, parent storetypes.KVStore, gasMeter storetypes.GasMeter) storetypes.KVStore
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -89,6 +98,7 @@ func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.trans
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
 func (c Context) CometInfo() comet.Info                         { return c.cometInfo }
 func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
+func (c Context) StoreTracer() StoreTracer                      { return c.storeTracer }
 
 // BlockHeader returns the header by value.
 func (c Context) BlockHeader() cmtproto.Header {
@@ -306,6 +316,12 @@ func (c Context) WithCometInfo(cometInfo comet.Info) Context {
 	return c
 }
 
+// WithStoreTracer returns a Context with an updated store tracer
+func (c Context) WithStoreTracer(tracer StoreTracer) Context {
+	c.storeTracer = tracer
+	return c
+}
+
 // WithHeaderInfo returns a Context with an updated header info
 func (c Context) WithHeaderInfo(headerInfo header.Info) Context {
 	// Set time to UTC
@@ -338,12 +354,22 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	parent := c.ms.GetKVStore(key)
+	store := gaskv.NewStore(parent, c.gasMeter, c.kvGasConfig)
+	if c.storeTracer != nil {
+		return c.storeTracer.TraceKVStore(key, store, parent, c.gasMeter)
+	}
+	return store
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
+	parent := c.ms.GetKVStore(key)
+	store := gaskv.NewStore(parent, c.gasMeter, c.transientKVGasConfig)
+	if c.storeTracer != nil {
+		return c.storeTracer.TraceKVStore(key, store, parent, c.gasMeter)
+	}
+	return store
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -0,0 +1,503 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/huandu/skiplist"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ InspectableMempool = (*FeeMarketMempool)(nil)
+	_ Iterator           = (*feeMarketIterator)(nil)
+)
+
+var (
+	ErrInsufficientFee        = errors.New("tx fee per gas is below the base fee")
+	ErrReplacementUnderpriced = errors.New("replacement tx fee per gas is below the minimum bump")
+	ErrSenderTxMaxCapacity    = errors.New("sender reached max tx capacity")
+)
+
+const (
+	// DefaultBaseFeeChangeDenominator bounds the base fee change between two
+	// blocks to 1/8th, as in EIP-1559.
+	DefaultBaseFeeChangeDenominator = 8
+
+	// DefaultMinReplacementBump is the default minimum fee per gas increase, in
+	// percent, required to replace a transaction.
+	DefaultMinReplacementBump = 10
+)
+
+type (
+	// FeeMarketMempoolConfig defines the configuration used to configure the
+	// FeeMarketMempool.
+	FeeMarketMempoolConfig struct {
+		// FeeDenom is the denom of the fees taken into account when computing the
+		// fee per gas of a transaction.
+		FeeDenom string
+
+		// InitialBaseFee is the base fee per gas used until the first block is
+		// recorded with UpdateBaseFee.
+		InitialBaseFee sdkmath.LegacyDec
+
+		// MinBaseFee is the floor of the base fee per gas.
+		MinBaseFee sdkmath.LegacyDec
+
+		// TargetBlockGas is the amount of gas a block is expected to consume. The
+		// base fee increases after blocks consuming more gas and decreases after
+		// blocks consuming less. If TargetBlockGas == 0 the base fee never changes.
+		TargetBlockGas uint64
+
+		// BaseFeeChangeDenominator bounds the change of the base fee between two
+		// blocks to 1/BaseFeeChangeDenominator. Defaults to DefaultBaseFeeChangeDenominator.
+		BaseFeeChangeDenominator uint64
+
+		// MinReplacementBump is the minimum increase, in percent, of the fee per gas
+		// a transaction must pay to replace a transaction with the same sender and nonce.
+		MinReplacementBump uint64
+
+		// MaxTx sets the maximum number of transactions allowed in the mempool with
+		// the semantics:
+		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
+		// - if MaxTx > 0, the mempool will cap the number of transactions it stores,
+		//   and will evict the lowest fee per gas transactions to make room for
+		//   better paying ones.
+		// - if MaxTx < 0, `Insert` is a no-op.
+		MaxTx int
+
+		// MaxTxPerSender sets the maximum number of transactions a single sender can
+		// have in the mempool. If MaxTxPerSender == 0 there is no cap.
+		MaxTxPerSender int
+
+		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
+		SignerExtractor SignerExtractionAdapter
+	}
+
+	// FeeMarketMempool is a mempool implementation ordering txs by fee per gas
+	// while respecting sender-nonce (sequence number) order. It tracks an
+	// EIP-1559 style base fee which is adjusted after each block from the gas the
+	// block consumed: txs paying less than the base fee are rejected on insert and
+	// skipped on select.
+	//
+	// A tx with the same sender and nonce as a tx in the mempool replaces it only
+	// if its fee per gas is higher by at least MinReplacementBump percent. When the
+	// mempool is full, the lowest fee per gas tx is evicted in favor of a better
+	// paying one. Only the last nonce of a sender can be evicted, so that eviction
+	// never leaves a nonce gap.
+	FeeMarketMempool struct {
+		eventBus
+
+		mtx     sync.Mutex
+		senders map[string]*skiplist.SkipList
+		count   int
+		baseFee sdkmath.LegacyDec
+		cfg     FeeMarketMempoolConfig
+	}
+
+	// feeMarketIterator iterates over a snapshot of the mempool taken on Select().
+	feeMarketIterator struct {
+		txs []sdk.Tx
+		idx int
+	}
+
+	// feeMarketTx stores a transaction along with its fee per gas
+	feeMarketTx struct {
+		tx        sdk.Tx
+		sender    string
+		nonce     uint64
+		feePerGas sdkmath.LegacyDec
+	}
+)
+
+// DefaultFeeMarketMempoolConfig returns a FeeMarketMempoolConfig for fees paid
+// in feeDenom, with a constant zero base fee and no capacity limits.
+func DefaultFeeMarketMempoolConfig(feeDenom string) FeeMarketMempoolConfig {
+	return FeeMarketMempoolConfig{
+		FeeDenom:                 feeDenom,
+		InitialBaseFee:           sdkmath.LegacyZeroDec(),
+		MinBaseFee:               sdkmath.LegacyZeroDec(),
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		MinReplacementBump:       DefaultMinReplacementBump,
+		SignerExtractor:          NewDefaultSignerExtractionAdapter(),
+	}
+}
+
+// NewFeeMarketMempool returns a mempool ordering txs by fee per gas and
+// sender-nonce, with an EIP-1559 style base fee.
+func NewFeeMarketMempool(cfg FeeMarketMempoolConfig) *FeeMarketMempool {
+	if cfg.SignerExtractor == nil {
+		cfg.SignerExtractor = NewDefaultSignerExtractionAdapter()
+	}
+	if cfg.BaseFeeChangeDenominator == 0 {
+		cfg.BaseFeeChangeDenominator = DefaultBaseFeeChangeDenominator
+	}
+	if cfg.MinBaseFee.IsNil() {
+		cfg.MinBaseFee = sdkmath.LegacyZeroDec()
+	}
+	if cfg.InitialBaseFee.IsNil() || cfg.InitialBaseFee.LT(cfg.MinBaseFee) {
+		cfg.InitialBaseFee = cfg.MinBaseFee
+	}
+
+	return &FeeMarketMempool{
+		senders: make(map[string]*skiplist.SkipList),
+		baseFee: cfg.InitialBaseFee,
+		cfg:     cfg,
+	}
+}
+
+// BaseFee returns the current base fee per gas.
+func (mp *FeeMarketMempool) BaseFee() sdkmath.LegacyDec {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.baseFee
+}
+
+// UpdateBaseFee adjusts the base fee from the gas consumed by the last block,
+// following EIP-1559. It must be called once per committed block, e.g. from the
+// application's PrepareCheckStater.
+func (mp *FeeMarketMempool) UpdateBaseFee(blockGasUsed uint64) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.cfg.TargetBlockGas == 0 || blockGasUsed == mp.cfg.TargetBlockGas {
+		return
+	}
+
+	target := sdkmath.NewIntFromUint64(mp.cfg.TargetBlockGas)
+	denominator := sdkmath.NewIntFromUint64(mp.cfg.BaseFeeChangeDenominator)
+
+	if blockGasUsed > mp.cfg.TargetBlockGas {
+		delta := mp.baseFee.MulInt(sdkmath.NewIntFromUint64(blockGasUsed - mp.cfg.TargetBlockGas)).QuoInt(target).QuoInt(denominator)
+		// always increase the base fee, otherwise a zero base fee could never grow
+		if delta.IsZero() {
+			delta = sdkmath.LegacySmallestDec()
+		}
+		mp.baseFee = mp.baseFee.Add(delta)
+		return
+	}
+
+	delta := mp.baseFee.MulInt(sdkmath.NewIntFromUint64(mp.cfg.TargetBlockGas - blockGasUsed)).QuoInt(target).QuoInt(denominator)
+	mp.baseFee = sdkmath.LegacyMaxDec(mp.baseFee.Sub(delta), mp.cfg.MinBaseFee)
+}
+
+// Insert attempts to insert a Tx into the app-side mempool in O(log n) time,
+// returning an error if unsuccessful. Sender and nonce are derived from the
+// transaction's first signature, the tx must implement sdk.FeeTx.
+//
+// Inserting a tx with the same sender and nonce as an existing tx replaces it
+// if its fee per gas is at least MinReplacementBump percent higher.
+func (mp *FeeMarketMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.cfg.MaxTx < 0 {
+		return nil
+	}
+
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return errors.New("tx must have at least one signer")
+	}
+
+	feePerGas, err := mp.feePerGas(tx)
+	if err != nil {
+		return err
+	}
+	if feePerGas.LT(mp.baseFee) {
+		return fmt.Errorf("%w: fee per gas %s, base fee %s", ErrInsufficientFee, feePerGas, mp.baseFee)
+	}
+
+	sig := sigs[0]
+	fmTx := &feeMarketTx{
+		tx:        tx,
+		sender:    sig.Signer.String(),
+		nonce:     sig.Sequence,
+		feePerGas: feePerGas,
+	}
+
+	senderIndex, ok := mp.senders[fmTx.sender]
+	if ok {
+		if existing := senderIndex.Get(fmTx.nonce); existing != nil {
+			old := existing.Value.(*feeMarketTx)
+			minFeePerGas := old.feePerGas.MulInt64(int64(100 + mp.cfg.MinReplacementBump)).QuoInt64(100)
+			if feePerGas.LT(minFeePerGas) {
+				return fmt.Errorf("%w: fee per gas %s, minimum %s", ErrReplacementUnderpriced, feePerGas, minFeePerGas)
+			}
+
+			senderIndex.Set(fmTx.nonce, fmTx)
+			mp.publish(EventTypeReplace, old.info())
+			mp.publish(EventTypeInsert, fmTx.info())
+			return nil
+		}
+
+		if mp.cfg.MaxTxPerSender > 0 && senderIndex.Len() >= mp.cfg.MaxTxPerSender {
+			return ErrSenderTxMaxCapacity
+		}
+	}
+
+	if mp.cfg.MaxTx > 0 && mp.count >= mp.cfg.MaxTx && !mp.evict(fmTx) {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	if !ok {
+		senderIndex = skiplist.New(skiplist.Uint64)
+		mp.senders[fmTx.sender] = senderIndex
+	}
+	senderIndex.Set(fmTx.nonce, fmTx)
+	mp.count++
+	mp.publish(EventTypeInsert, fmTx.info())
+
+	return nil
+}
+
+// feePerGas returns the fee paid in the fee denom per unit of gas of a tx.
+func (mp *FeeMarketMempool) feePerGas(tx sdk.Tx) (sdkmath.LegacyDec, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return sdkmath.LegacyDec{}, errors.New("tx must implement sdk.FeeTx")
+	}
+
+	gas := feeTx.GetGas()
+	if gas == 0 {
+		return sdkmath.LegacyDec{}, errors.New("tx gas limit must be greater than zero")
+	}
+
+	fee := feeTx.GetFee().AmountOf(mp.cfg.FeeDenom)
+	return sdkmath.LegacyNewDecFromInt(fee).QuoInt(sdkmath.NewIntFromUint64(gas)), nil
+}
+
+// evict removes the lowest fee per gas tx among the last nonce of every other
+// sender, if it pays less than the incoming tx. It returns false if no tx could
+// be evicted.
+func (mp *FeeMarketMempool) evict(incoming *feeMarketTx) bool {
+	var lowest *feeMarketTx
+	for sender, senderIndex := range mp.senders {
+		if sender == incoming.sender || senderIndex.Len() == 0 {
+			continue
+		}
+
+		last := senderIndex.Back().Value.(*feeMarketTx)
+		if lowest == nil || last.feePerGas.LT(lowest.feePerGas) ||
+			(last.feePerGas.Equal(lowest.feePerGas) && last.sender < lowest.sender) {
+			lowest = last
+		}
+	}
+
+	if lowest == nil || lowest.feePerGas.GTE(incoming.feePerGas) {
+		return false
+	}
+
+	mp.remove(lowest.sender, lowest.nonce)
+	mp.publish(EventTypeEvict, lowest.info())
+	return true
+}
+
+// remove deletes the tx of sender with the given nonce and returns it, or nil
+// if it is not in the mempool.
+func (mp *FeeMarketMempool) remove(sender string, nonce uint64) *feeMarketTx {
+	senderIndex, ok := mp.senders[sender]
+	if !ok {
+		return nil
+	}
+
+	elem := senderIndex.Remove(nonce)
+	if elem == nil {
+		return nil
+	}
+
+	if senderIndex.Len() == 0 {
+		delete(mp.senders, sender)
+	}
+	mp.count--
+	return elem.Value.(*feeMarketTx)
+}
+
+// Select returns a set of transactions from the mempool, ordered by fee per gas
+// and sender-nonce in O(n log s) time, where s is the number of senders. The
+// passed in list of transactions are ignored. This is a readonly operation, the
+// mempool is not modified.
+//
+// Txs paying less than the current base fee are skipped, along with the
+// following txs of the same sender.
+//
+// The returned iterator walks a snapshot of the mempool and it is therefore
+// safe to remove transactions from the mempool while iterating.
+func (mp *FeeMarketMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	selected := mp.selectTxs()
+	if len(selected) == 0 {
+		return nil
+	}
+
+	txs := make([]sdk.Tx, len(selected))
+	for i, fmTx := range selected {
+		txs[i] = fmTx.tx
+	}
+
+	return &feeMarketIterator{txs: txs}
+}
+
+// selectTxs returns the selectable txs ordered by fee per gas and sender-nonce.
+func (mp *FeeMarketMempool) selectTxs() []*feeMarketTx {
+	cursors := make(feeMarketCursors, 0, len(mp.senders))
+	for _, senderIndex := range mp.senders {
+		cursors = append(cursors, senderIndex.Front())
+	}
+	heap.Init(&cursors)
+
+	txs := make([]*feeMarketTx, 0, mp.count)
+	for cursors.Len() > 0 {
+		fmTx := cursors[0].Value.(*feeMarketTx)
+		if fmTx.feePerGas.LT(mp.baseFee) {
+			heap.Pop(&cursors)
+			continue
+		}
+
+		txs = append(txs, fmTx)
+		if next := cursors[0].Next(); next != nil {
+			cursors[0] = next
+			heap.Fix(&cursors, 0)
+		} else {
+			heap.Pop(&cursors)
+		}
+	}
+
+	return txs
+}
+
+// Position returns the position of the tx of sender with the given nonce in the
+// order txs are selected, or false if the tx is not in the mempool or is not
+// selectable because it pays less than the base fee.
+func (mp *FeeMarketMempool) Position(sender string, nonce uint64) (int, bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for i, fmTx := range mp.selectTxs() {
+		if fmTx.sender == sender && fmTx.nonce == nonce {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *FeeMarketMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.count
+}
+
+// Remove removes a transaction from the mempool in O(log n) time, returning an
+// error if unsuccessful.
+func (mp *FeeMarketMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return errors.New("attempted to remove a tx with no signatures")
+	}
+
+	sig := sigs[0]
+	removed := mp.remove(sig.Signer.String(), sig.Sequence)
+	if removed == nil {
+		return ErrTxNotFound
+	}
+
+	mp.publish(EventTypeRemove, removed.info())
+	return nil
+}
+
+// Txs returns the txs of sender ordered by nonce, or the txs of all senders
+// ordered by sender and nonce if sender is empty. The priority of a tx is its
+// fee per gas.
+func (mp *FeeMarketMempool) Txs(sender string) []TxInfo {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	senders := []string{sender}
+	if sender == "" {
+		senders = make([]string, 0, len(mp.senders))
+		for s := range mp.senders {
+			senders = append(senders, s)
+		}
+		sort.Strings(senders)
+	}
+
+	var txs []TxInfo
+	for _, s := range senders {
+		senderIndex, ok := mp.senders[s]
+		if !ok {
+			continue
+		}
+
+		for elem := senderIndex.Front(); elem != nil; elem = elem.Next() {
+			txs = append(txs, elem.Value.(*feeMarketTx).info())
+		}
+	}
+
+	return txs
+}
+
+func (i *feeMarketIterator) Next() Iterator {
+	i.idx++
+	if i.idx >= len(i.txs) {
+		return nil
+	}
+
+	return i
+}
+
+func (i *feeMarketIterator) Tx() sdk.Tx {
+	return i.txs[i.idx]
+}
+
+// feeMarketCursors is a max-heap of sender cursors ordered by the fee per gas of
+// the tx they point to. Ties are broken by sender so that the order is
+// deterministic.
+type feeMarketCursors []*skiplist.Element
+
+func (c feeMarketCursors) Len() int { return len(c) }
+
+func (c feeMarketCursors) Less(i, j int) bool {
+	a, b := c[i].Value.(*feeMarketTx), c[j].Value.(*feeMarketTx)
+	if !a.feePerGas.Equal(b.feePerGas) {
+		return a.feePerGas.GT(b.feePerGas)
+	}
+	return a.sender < b.sender
+}
+
+func (c feeMarketCursors) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+
+func (c *feeMarketCursors) Push(x any) { *c = append(*c, x.(*skiplist.Element)) }
+
+func (c *feeMarketCursors) Pop() any {
+	old := *c
+	n := len(old)
+	x := old[n-1]
+	*c = old[:n-1]
+	return x
+}
+
+func (t *feeMarketTx) info() TxInfo {
+	return TxInfo{
+		Tx:       t.tx,
+		Sender:   t.sender,
+		Nonce:    t.nonce,
+		Priority: t.feePerGas.String(),
+	}
+}
@@ -0,0 +1,274 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// testFeeTx is a dummy implementation of FeeTx used for testing.
+type testFeeTx struct {
+	testTx
+	gas uint64
+	fee sdk.Coins
+}
+
+func (tx testFeeTx) GetGas() uint64 { return tx.gas }
+
+func (tx testFeeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx testFeeTx) FeePayer() []byte { return tx.address }
+
+func (tx testFeeTx) FeeGranter() []byte { return nil }
+
+var _ sdk.FeeTx = (*testFeeTx)(nil)
+
+// newTestFeeTx returns a tx paying feePerGas stake for each of its 100 gas.
+func newTestFeeTx(id int, address sdk.AccAddress, nonce uint64, feePerGas int64) testFeeTx {
+	return testFeeTx{
+		testTx: testTx{id: id, nonce: nonce, address: address},
+		gas:    100,
+		fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100*feePerGas)),
+	}
+}
+
+func selectTxIDs(mp mempool.Mempool) []int {
+	var ids []int
+	for _, tx := range fetchTxs(mp.Select(sdk.NewContext(nil, false, log.NewNopLogger()), nil), 1000) {
+		ids = append(ids, tx.(testFeeTx).id)
+	}
+	return ids
+}
+
+func TestFeeMarketMempool_Select(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	mp := mempool.NewFeeMarketMempool(mempool.DefaultFeeMarketMempoolConfig("stake"))
+
+	txs := []testFeeTx{
+		newTestFeeTx(0, sa, 0, 5),
+		newTestFeeTx(1, sa, 1, 30),
+		newTestFeeTx(2, sb, 0, 20),
+		newTestFeeTx(3, sb, 1, 1),
+		newTestFeeTx(4, sc, 0, 10),
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 5, mp.CountTx())
+
+	// sa's second tx pays the most but must wait for its first tx
+	require.Equal(t, []int{2, 4, 0, 1, 3}, selectTxIDs(mp))
+
+	require.NoError(t, mp.Remove(txs[2]))
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+	require.Equal(t, []int{4, 0, 1, 3}, selectTxIDs(mp))
+
+	// a tx without fees is not accepted
+	require.Error(t, mp.Insert(ctx, txs[0].testTx))
+}
+
+func TestFeeMarketMempool_ReplaceByFee(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	sa := accounts[0].Address
+
+	mp := mempool.NewFeeMarketMempool(mempool.DefaultFeeMarketMempoolConfig("stake"))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(0, sa, 0, 10)))
+
+	// a replacement must pay at least 10% more per gas
+	err := mp.Insert(ctx, newTestFeeTx(1, sa, 0, 10))
+	require.ErrorIs(t, err, mempool.ErrReplacementUnderpriced)
+	err = mp.Insert(ctx, newTestFeeTx(1, sa, 0, 5))
+	require.ErrorIs(t, err, mempool.ErrReplacementUnderpriced)
+
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(1, sa, 0, 11)))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []int{1}, selectTxIDs(mp))
+}
+
+func TestFeeMarketMempool_Eviction(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig("stake")
+	cfg.MaxTx = 3
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(0, sa, 0, 1)))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(1, sa, 1, 20)))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(2, sb, 0, 10)))
+
+	// a tx paying less than every evictable tx is rejected, sa's first tx is not
+	// evictable as it would leave a nonce gap
+	err := mp.Insert(ctx, newTestFeeTx(3, sc, 0, 5))
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	// sb's tx is the lowest paying evictable tx
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(4, sc, 0, 15)))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []int{4, 0, 1}, selectTxIDs(mp))
+
+	// sa's last tx is evicted first
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(5, sc, 1, 100)))
+	require.Equal(t, []int{4, 5, 0}, selectTxIDs(mp))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(6, sc, 2, 100)))
+	require.Equal(t, []int{4, 5, 6}, selectTxIDs(mp))
+
+	// a sender cannot evict its own txs
+	err = mp.Insert(ctx, newTestFeeTx(7, sc, 3, 100))
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestFeeMarketMempool_MaxTxPerSender(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig("stake")
+	cfg.MaxTxPerSender = 2
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(0, sa, 0, 1)))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(1, sa, 1, 1)))
+	require.ErrorIs(t, mp.Insert(ctx, newTestFeeTx(2, sa, 2, 1)), mempool.ErrSenderTxMaxCapacity)
+
+	// replacements do not take a new slot
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(3, sa, 1, 2)))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(4, sb, 0, 1)))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestFeeMarketMempool_BaseFee(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig("stake")
+	cfg.InitialBaseFee = sdkmath.LegacyNewDec(8)
+	cfg.MinBaseFee = sdkmath.LegacyNewDec(4)
+	cfg.TargetBlockGas = 1000
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	err := mp.Insert(ctx, newTestFeeTx(0, sa, 0, 7))
+	require.ErrorIs(t, err, mempool.ErrInsufficientFee)
+
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(0, sa, 0, 8)))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(1, sa, 1, 20)))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(2, sb, 0, 9)))
+
+	// a block using twice the target gas increases the base fee by 1/8th
+	mp.UpdateBaseFee(2000)
+	requireBaseFee(t, mp, "9")
+
+	// txs paying less than the base fee are skipped along with the following txs
+	// of the same sender
+	require.Equal(t, []int{2}, selectTxIDs(mp))
+	require.Equal(t, 3, mp.CountTx())
+	_, ok := mp.Position(sa.String(), 0)
+	require.False(t, ok)
+
+	// a block at target keeps the base fee, empty blocks decrease it
+	mp.UpdateBaseFee(1000)
+	requireBaseFee(t, mp, "9")
+	mp.UpdateBaseFee(0)
+	requireBaseFee(t, mp, "7.875")
+	require.Equal(t, []int{2, 0, 1}, selectTxIDs(mp))
+
+	// the base fee never goes below the minimum
+	for i := 0; i < 10; i++ {
+		mp.UpdateBaseFee(0)
+	}
+	requireBaseFee(t, mp, "4")
+}
+
+func requireBaseFee(t *testing.T, mp *mempool.FeeMarketMempool, expected string) {
+	t.Helper()
+	require.True(t, sdkmath.LegacyMustNewDecFromStr(expected).Equal(mp.BaseFee()), "expected base fee %s, got %s", expected, mp.BaseFee())
+}
+
+func TestFeeMarketMempool_Inspect(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig("stake")
+	cfg.MaxTx = 3
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	events, cancel := mp.Subscribe(10)
+	requireEvent := func(eventType mempool.EventType, id int) {
+		t.Helper()
+		event := <-events
+		require.Equal(t, eventType, event.Type)
+		require.Equal(t, id, event.Tx.(testFeeTx).id)
+	}
+
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(0, sa, 1, 10)))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(1, sa, 0, 20)))
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(2, sb, 0, 5)))
+	requireEvent(mempool.EventTypeInsert, 0)
+	requireEvent(mempool.EventTypeInsert, 1)
+	requireEvent(mempool.EventTypeInsert, 2)
+
+	txs := mp.Txs(sa.String())
+	require.Len(t, txs, 2)
+	require.Equal(t, 1, txs[0].Tx.(testFeeTx).id)
+	require.Equal(t, uint64(0), txs[0].Nonce)
+	require.Equal(t, sa.String(), txs[0].Sender)
+	require.Equal(t, sdkmath.LegacyNewDec(20).String(), txs[0].Priority)
+	require.Equal(t, 0, txs[1].Tx.(testFeeTx).id)
+	require.Len(t, mp.Txs(""), 3)
+	require.Empty(t, mp.Txs(sc.String()))
+
+	position, ok := mp.Position(sa.String(), 1)
+	require.True(t, ok)
+	require.Equal(t, 1, position)
+	position, ok = mp.Position(sb.String(), 0)
+	require.True(t, ok)
+	require.Equal(t, 2, position)
+	_, ok = mp.Position(sc.String(), 0)
+	require.False(t, ok)
+
+	info, ok := mp.TxByHash(newTestFeeTx(2, sb, 0, 5).Hash())
+	require.True(t, ok)
+	require.Equal(t, sb.String(), info.Sender)
+
+	// replacement, eviction and removal
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(3, sa, 1, 15)))
+	requireEvent(mempool.EventTypeReplace, 0)
+	requireEvent(mempool.EventTypeInsert, 3)
+
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(4, sc, 0, 30)))
+	requireEvent(mempool.EventTypeEvict, 2)
+	requireEvent(mempool.EventTypeInsert, 4)
+
+	require.NoError(t, mp.Remove(newTestFeeTx(4, sc, 0, 30)))
+	requireEvent(mempool.EventTypeRemove, 4)
+
+	// replaced, evicted and removed txs leave the hash index
+	for _, id := range []int{0, 2, 4} {
+		_, ok = mp.TxByHash(newTestFeeTx(id, sa, 0, 0).Hash())
+		require.False(t, ok)
+	}
+	_, ok = mp.TxByHash(newTestFeeTx(3, sa, 1, 15).Hash())
+	require.True(t, ok)
+
+	// no more events are received once the subscription is canceled
+	cancel()
+	cancel()
+	require.NoError(t, mp.Insert(ctx, newTestFeeTx(5, sc, 0, 30)))
+	_, ok = <-events
+	require.False(t, ok)
+}
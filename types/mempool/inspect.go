@@ -0,0 +1,126 @@
+package mempool
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventType defines the kind of change of a mempool Event.
+type EventType int
+
+const (
+	// EventTypeInsert is emitted when a tx is inserted in the mempool.
+	EventTypeInsert EventType = iota + 1
+	// EventTypeRemove is emitted when a tx is removed from the mempool with
+	// Remove, e.g. once included in a block or when failing recheck.
+	EventTypeRemove
+	// EventTypeReplace is emitted when a tx is replaced by a tx with the same
+	// sender and nonce, the event carries the replaced tx.
+	EventTypeReplace
+	// EventTypeEvict is emitted when a tx is evicted to make room for a better
+	// paying tx.
+	EventTypeEvict
+)
+
+type (
+	// TxInfo describes a transaction pending in the mempool.
+	TxInfo struct {
+		Tx     sdk.Tx
+		Sender string
+		Nonce  uint64
+		// Priority is the effective priority of the tx in the mempool ordering,
+		// e.g. its fee per gas for the FeeMarketMempool.
+		Priority string
+	}
+
+	// Event describes a change of the mempool content.
+	Event struct {
+		Type EventType
+		TxInfo
+	}
+
+	// InspectableMempool defines a mempool exposing its content and its changes,
+	// e.g. to let clients follow the state of their pending transactions.
+	InspectableMempool interface {
+		Mempool
+
+		// Txs returns the txs of sender ordered by nonce, or the txs of all senders
+		// if sender is empty.
+		Txs(sender string) []TxInfo
+
+		// Position returns the position of the tx of sender with the given nonce
+		// in the order txs are selected, or false if the tx is not in the mempool
+		// or would not be selected.
+		Position(sender string, nonce uint64) (int, bool)
+
+		// TxByHash returns the tx with the given hash, as returned by its Hash
+		// method, or false if the tx is not in the mempool.
+		TxByHash(hash [32]byte) (TxInfo, bool)
+
+		// Subscribe returns a channel receiving the mempool events and a function
+		// canceling the subscription. Events are dropped when the channel buffer is
+		// full, so that slow subscribers never block the mempool.
+		Subscribe(bufferSize int) (<-chan Event, func())
+	}
+)
+
+// eventBus dispatches mempool events to subscribers and indexes the txs of the
+// mempool by hash from these events. The zero value is ready to use.
+type eventBus struct {
+	mtx  sync.RWMutex
+	subs map[chan Event]struct{}
+	txs  map[[32]byte]TxInfo
+}
+
+// TxByHash implements InspectableMempool.
+func (b *eventBus) TxByHash(hash [32]byte) (TxInfo, bool) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	info, ok := b.txs[hash]
+	return info, ok
+}
+
+// Subscribe implements InspectableMempool.
+func (b *eventBus) Subscribe(bufferSize int) (<-chan Event, func()) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.subs == nil {
+		b.subs = make(map[chan Event]struct{})
+	}
+
+	ch := make(chan Event, bufferSize)
+	b.subs[ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mtx.Lock()
+			defer b.mtx.Unlock()
+			delete(b.subs, ch)
+			close(ch)
+		})
+	}
+}
+
+// publish must be called for every tx inserted in or leaving the mempool, so that
+// the hash index stays in sync with the mempool content.
+func (b *eventBus) publish(eventType EventType, info TxInfo) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if eventType == EventTypeInsert {
+		if b.txs == nil {
+			b.txs = make(map[[32]byte]TxInfo)
+		}
+		b.txs[info.Tx.Hash()] = info
+	} else {
+		delete(b.txs, info.Tx.Hash())
+	}
+
+	for ch := range b.subs {
+		select {
+		case ch <- Event{Type: eventType, TxInfo: info}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneIterator)(nil)
+)
+
+// ErrNoMatchingLane is returned when inserting a tx matched by none of the lanes
+// of a LaneMempool.
+var ErrNoMatchingLane = errors.New("no lane matches tx")
+
+type (
+	// Lane defines a section of the block reserved to the txs it matches, e.g.
+	// oracle updates, IBC relays or governance txs.
+	Lane struct {
+		// Name identifies the lane.
+		Name string
+
+		// Mempool stores the pending txs of the lane, in the order they are
+		// selected for a proposal.
+		Mempool Mempool
+
+		// Match returns true if tx belongs to the lane. A tx belongs to the first
+		// lane matching it, the last lane usually matches every tx, see MatchAll.
+		// Match must be deterministic as it is used to verify proposals.
+		Match func(tx sdk.Tx) bool
+
+		// MaxBlockShare is the maximum share, in (0, 1], of the block bytes and of
+		// the block gas the txs of the lane can use.
+		MaxBlockShare sdkmath.LegacyDec
+	}
+
+	// LaneMempool is a mempool dispatching txs to the mempool of the first lane
+	// matching them. Select iterates over the lanes in order, block builders
+	// honoring the lane limits should select txs lane by lane, see
+	// baseapp.LaneProposalHandler.
+	LaneMempool struct {
+		lanes []Lane
+	}
+
+	// laneIterator iterates over the txs of each lane in order.
+	laneIterator struct {
+		ctx      context.Context
+		txs      [][]byte
+		lanes    []Lane
+		iterator Iterator
+	}
+)
+
+// MatchAll matches every tx, it is usually used by the last, default, lane.
+func MatchAll(sdk.Tx) bool { return true }
+
+// NewLaneMempool returns a mempool dispatching txs to the given lanes, ordered
+// by decreasing precedence. It panics if the lanes are invalid.
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	if err := validateLanes(lanes); err != nil {
+		panic(err)
+	}
+
+	return &LaneMempool{lanes: lanes}
+}
+
+func validateLanes(lanes []Lane) error {
+	if len(lanes) == 0 {
+		return errors.New("at least one lane is required")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return errors.New("lane name cannot be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Mempool == nil {
+			return fmt.Errorf("lane %s: mempool cannot be nil", lane.Name)
+		}
+		if lane.Match == nil {
+			return fmt.Errorf("lane %s: match function cannot be nil", lane.Name)
+		}
+		if lane.MaxBlockShare.IsNil() || !lane.MaxBlockShare.IsPositive() || lane.MaxBlockShare.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("lane %s: max block share must be in (0, 1], got %s", lane.Name, lane.MaxBlockShare)
+		}
+	}
+
+	return nil
+}
+
+// Lanes returns the lanes of the mempool, ordered by decreasing precedence.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the first lane matching tx, or false if no lane
+// matches it.
+func (mp *LaneMempool) LaneIndex(tx sdk.Tx) (int, bool) {
+	for i, lane := range mp.lanes {
+		if lane.Match(tx) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// Insert inserts tx in the mempool of the first lane matching it.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i, ok := mp.LaneIndex(tx)
+	if !ok {
+		return ErrNoMatchingLane
+	}
+
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the txs of every lane, lane after lane.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	it := &laneIterator{ctx: ctx, txs: txs, lanes: mp.lanes}
+	return it.nextLane()
+}
+
+// CountTx returns the number of transactions of all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes tx from the mempool of the first lane matching it.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	i, ok := mp.LaneIndex(tx)
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	return mp.lanes[i].Mempool.Remove(tx)
+}
+
+func (i *laneIterator) nextLane() Iterator {
+	for len(i.lanes) > 0 {
+		i.iterator = i.lanes[0].Mempool.Select(i.ctx, i.txs)
+		i.lanes = i.lanes[1:]
+		if i.iterator != nil {
+			return i
+		}
+	}
+
+	return nil
+}
+
+func (i *laneIterator) Next() Iterator {
+	if i.iterator = i.iterator.Next(); i.iterator != nil {
+		return i
+	}
+
+	return i.nextLane()
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iterator.Tx()
+}
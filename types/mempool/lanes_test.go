@@ -0,0 +1,144 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// matchPriority matches the test txs with a priority of at least minPriority.
+func matchPriority(minPriority int64) func(sdk.Tx) bool {
+	return func(tx sdk.Tx) bool {
+		return tx.(testTx).priority >= minPriority
+	}
+}
+
+func newTestLanes() []mempool.Lane {
+	return []mempool.Lane{
+		{
+			Name:          "high",
+			Mempool:       mempool.DefaultPriorityMempool(),
+			Match:         matchPriority(100),
+			MaxBlockShare: sdkmath.LegacyNewDecWithPrec(2, 1),
+		},
+		{
+			Name:          "default",
+			Mempool:       mempool.DefaultPriorityMempool(),
+			Match:         mempool.MatchAll,
+			MaxBlockShare: sdkmath.LegacyOneDec(),
+		},
+	}
+}
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	lanes := newTestLanes()
+	mp := mempool.NewLaneMempool(lanes...)
+	require.Len(t, mp.Lanes(), 2)
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 0, address: sa},
+		{id: 1, priority: 150, nonce: 0, address: sb},
+		{id: 2, priority: 20, nonce: 1, address: sa},
+		{id: 3, priority: 100, nonce: 1, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// txs are dispatched to the first lane matching them
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, lanes[0].Mempool.CountTx())
+	require.Equal(t, 2, lanes[1].Mempool.CountTx())
+	laneIdx, ok := mp.LaneIndex(txs[3])
+	require.True(t, ok)
+	require.Equal(t, 0, laneIdx)
+
+	// the txs of each lane are selected lane after lane
+	var order []int
+	for _, tx := range fetchTxs(mp.Select(ctx, nil), 100) {
+		order = append(order, tx.(testTx).id)
+	}
+	require.Equal(t, []int{1, 3, 0, 2}, order)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Equal(t, 1, lanes[0].Mempool.CountTx())
+	require.Equal(t, 1, lanes[1].Mempool.CountTx())
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+
+	require.NoError(t, mp.Remove(txs[2]))
+	require.NoError(t, mp.Remove(txs[3]))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(ctx, nil))
+}
+
+func TestLaneMempool_NoMatchingLane(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	lanes := newTestLanes()
+	mp := mempool.NewLaneMempool(lanes[0])
+
+	tx := testTx{priority: 10, address: accounts[0].Address}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), tx), mempool.ErrNoMatchingLane)
+	require.ErrorIs(t, mp.Remove(tx), mempool.ErrTxNotFound)
+	_, ok := mp.LaneIndex(tx)
+	require.False(t, ok)
+}
+
+func TestNewLaneMempool_Validation(t *testing.T) {
+	testCases := map[string]func(lanes []mempool.Lane) []mempool.Lane{
+		"no lanes": func([]mempool.Lane) []mempool.Lane {
+			return nil
+		},
+		"empty name": func(lanes []mempool.Lane) []mempool.Lane {
+			lanes[0].Name = ""
+			return lanes
+		},
+		"duplicate name": func(lanes []mempool.Lane) []mempool.Lane {
+			lanes[1].Name = lanes[0].Name
+			return lanes
+		},
+		"nil mempool": func(lanes []mempool.Lane) []mempool.Lane {
+			lanes[0].Mempool = nil
+			return lanes
+		},
+		"nil match": func(lanes []mempool.Lane) []mempool.Lane {
+			lanes[1].Match = nil
+			return lanes
+		},
+		"nil share": func(lanes []mempool.Lane) []mempool.Lane {
+			lanes[0].MaxBlockShare = sdkmath.LegacyDec{}
+			return lanes
+		},
+		"zero share": func(lanes []mempool.Lane) []mempool.Lane {
+			lanes[0].MaxBlockShare = sdkmath.LegacyZeroDec()
+			return lanes
+		},
+		"share above one": func(lanes []mempool.Lane) []mempool.Lane {
+			lanes[1].MaxBlockShare = sdkmath.LegacyNewDecWithPrec(11, 1)
+			return lanes
+		},
+	}
+
+	for name, malleate := range testCases {
+		t.Run(name, func(t *testing.T) {
+			lanes := malleate(newTestLanes())
+			require.Panics(t, func() { mempool.NewLaneMempool(lanes...) })
+		})
+	}
+
+	require.NotPanics(t, func() { mempool.NewLaneMempool(newTestLanes()...) })
+}
@@ -1,6 +1,7 @@
 package mempool_test
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -82,7 +83,9 @@ func (tx testTx) Bytes() []byte {
 }
 
 func (tx testTx) Hash() [32]byte {
-	return [32]byte{}
+	var hash [32]byte
+	binary.BigEndian.PutUint64(hash[:], uint64(tx.id))
+	return hash
 }
 
 func (tx testTx) GetGasLimit() (uint64, error) {
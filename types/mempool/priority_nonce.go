@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 
 	"github.com/huandu/skiplist"
@@ -13,8 +14,8 @@ import (
 )
 
 var (
-	_ Mempool  = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator = (*PriorityNonceIterator[int64])(nil)
+	_ InspectableMempool = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator           = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -53,6 +54,8 @@ type (
 	// priority to other sender txs and must be partially ordered by both sender-nonce
 	// and priority.
 	PriorityNonceMempool[C comparable] struct {
+		eventBus
+
 		mtx            sync.Mutex
 		priorityIndex  *skiplist.SkipList
 		priorityCounts map[C]int
@@ -243,8 +246,10 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
 	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
+	oldScore, txExists := mp.scores[sk]
+	if txExists {
+		oldTx := senderIndex.Get(key).Value.(sdk.Tx)
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, oldTx, tx) {
 			return fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
 				oldScore.priority,
@@ -261,6 +266,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 			weight:   oldScore.weight,
 		})
 		mp.priorityCounts[oldScore.priority]--
+		mp.publish(EventTypeReplace, TxInfo{Tx: oldTx, Sender: sender, Nonce: nonce, Priority: fmt.Sprint(oldScore.priority)})
 	}
 
 	mp.priorityCounts[priority]++
@@ -271,6 +277,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 
 	mp.scores[sk] = txMeta[C]{priority: priority}
 	mp.priorityIndex.Set(key, tx)
+	mp.publish(EventTypeInsert, TxInfo{Tx: tx, Sender: sender, Nonce: nonce, Priority: fmt.Sprint(priority)})
 
 	return nil
 }
@@ -354,6 +361,10 @@ func (i *PriorityNonceIterator[C]) Tx() sdk.Tx {
 func (mp *PriorityNonceMempool[C]) Select(_ context.Context, _ [][]byte) Iterator {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	return mp.doSelect()
+}
+
+func (mp *PriorityNonceMempool[C]) doSelect() Iterator {
 	if mp.priorityIndex.Len() == 0 {
 		return nil
 	}
@@ -457,13 +468,79 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	}
 
 	mp.priorityIndex.Remove(tk)
-	senderTxs.Remove(tk)
+	removed := senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
 
+	if removed != nil {
+		mp.publish(EventTypeRemove, TxInfo{Tx: removed.Value.(sdk.Tx), Sender: sender, Nonce: nonce, Priority: fmt.Sprint(score.priority)})
+	}
+
 	return nil
 }
 
+// Position returns the position of the tx of sender with the given nonce in the
+// order txs are selected, or false if the tx is not in the mempool. It walks the
+// mempool in O(n) time while holding the mempool lock.
+func (mp *PriorityNonceMempool[C]) Position(sender string, nonce uint64) (int, bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if _, ok := mp.scores[txMeta[C]{nonce: nonce, sender: sender}]; !ok {
+		return 0, false
+	}
+
+	position := 0
+	for iterator := mp.doSelect(); iterator != nil; iterator = iterator.Next() {
+		i := iterator.(*PriorityNonceIterator[C])
+		if i.sender == sender && i.senderCursors[i.sender].Key().(txMeta[C]).nonce == nonce {
+			return position, true
+		}
+		position++
+	}
+
+	return 0, false
+}
+
+// Txs returns the txs of sender ordered by nonce, or the txs of all senders
+// ordered by sender and nonce if sender is empty. The priority of a tx is the
+// string representation of its TxPriority.
+func (mp *PriorityNonceMempool[C]) Txs(sender string) []TxInfo {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	senders := []string{sender}
+	if sender == "" {
+		senders = make([]string, 0, len(mp.senderIndices))
+		for s := range mp.senderIndices {
+			senders = append(senders, s)
+		}
+		sort.Strings(senders)
+	}
+
+	var txs []TxInfo
+	for _, s := range senders {
+		senderIndex, ok := mp.senderIndices[s]
+		if !ok {
+			continue
+		}
+
+		senderTxs := make([]TxInfo, 0, senderIndex.Len())
+		for elem := senderIndex.Front(); elem != nil; elem = elem.Next() {
+			nonce := elem.Key().(txMeta[C]).nonce
+			senderTxs = append(senderTxs, TxInfo{
+				Tx:       elem.Value.(sdk.Tx),
+				Sender:   s,
+				Nonce:    nonce,
+				Priority: fmt.Sprint(mp.scores[txMeta[C]{nonce: nonce, sender: s}].priority),
+			})
+		}
+		sort.Slice(senderTxs, func(i, j int) bool { return senderTxs[i].Nonce < senderTxs[j].Nonce })
+		txs = append(txs, senderTxs...)
+	}
+
+	return txs
+}
+
 func IsEmpty[C comparable](mempool Mempool) error {
 	mp := mempool.(*PriorityNonceMempool[C])
 	if mp.priorityIndex.Len() != 0 {
@@ -890,3 +890,70 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestPriorityNonceMempool_Inspect(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := mempool.DefaultPriorityMempool()
+	events, cancel := mp.Subscribe(10)
+	defer cancel()
+
+	txs := []testTx{
+		{id: 0, priority: 20, nonce: 2, address: sa},
+		{id: 1, priority: 10, nonce: 1, address: sa},
+		{id: 2, priority: 15, nonce: 1, address: sb},
+		{id: 3, priority: 30, nonce: 2, address: sa},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	for _, expected := range []struct {
+		eventType mempool.EventType
+		id        int
+	}{
+		{mempool.EventTypeInsert, 0},
+		{mempool.EventTypeInsert, 1},
+		{mempool.EventTypeInsert, 2},
+		{mempool.EventTypeReplace, 0},
+		{mempool.EventTypeInsert, 3},
+	} {
+		event := <-events
+		require.Equal(t, expected.eventType, event.Type)
+		require.Equal(t, expected.id, event.Tx.(testTx).id)
+	}
+
+	saTxs := mp.Txs(sa.String())
+	require.Len(t, saTxs, 2)
+	require.Equal(t, testTx{id: 1, priority: 10, nonce: 1, address: sa}, saTxs[0].Tx)
+	require.Equal(t, "10", saTxs[0].Priority)
+	require.Equal(t, testTx{id: 3, priority: 30, nonce: 2, address: sa}, saTxs[1].Tx)
+	require.Equal(t, "30", saTxs[1].Priority)
+	require.Equal(t, uint64(2), saTxs[1].Nonce)
+	require.Len(t, mp.Txs(""), 3)
+
+	// sb's tx is selected first as sa's highest priority tx follows a lower
+	// priority tx
+	position, ok := mp.Position(sb.String(), 1)
+	require.True(t, ok)
+	require.Equal(t, 0, position)
+	position, ok = mp.Position(sa.String(), 2)
+	require.True(t, ok)
+	require.Equal(t, 2, position)
+
+	info, ok := mp.TxByHash(txs[2].Hash())
+	require.True(t, ok)
+	require.Equal(t, sb.String(), info.Sender)
+	_, ok = mp.TxByHash(txs[0].Hash())
+	require.False(t, ok)
+
+	require.NoError(t, mp.Remove(txs[2]))
+	event := <-events
+	require.Equal(t, mempool.EventTypeRemove, event.Type)
+	require.Equal(t, sb.String(), event.Sender)
+	require.Empty(t, mp.Txs(sb.String()))
+	_, ok = mp.TxByHash(txs[2].Hash())
+	require.False(t, ok)
+}
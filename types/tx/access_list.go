@@ -0,0 +1,30 @@
+package tx
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/transaction"
+)
+
+// ValidateBasic performs stateless validation of the access list.
+func (m *AccessList) ValidateBasic() error {
+	for i, entry := range m.Entries {
+		if entry.StoreKey == "" {
+			return fmt.Errorf("empty store key in access list entry %d", i)
+		}
+	}
+	return nil
+}
+
+// StateAccesses returns the state accesses declared by the access list.
+func (m *AccessList) StateAccesses() []transaction.StateAccess {
+	accesses := make([]transaction.StateAccess, len(m.Entries))
+	for i, entry := range m.Entries {
+		accesses[i] = transaction.StateAccess{
+			Actor:    []byte(entry.StoreKey),
+			Prefixes: entry.Prefixes,
+			ReadOnly: entry.ReadOnly,
+		}
+	}
+	return accesses
+}
@@ -496,6 +496,243 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+// SimulateWithTraceRequest is the request type for the Service.SimulateWithTrace
+// RPC method.
+type SimulateWithTraceRequest struct {
+	// tx_bytes is the raw transaction.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *SimulateWithTraceRequest) Reset()         { *m = SimulateWithTraceRequest{} }
+func (m *SimulateWithTraceRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateWithTraceRequest) ProtoMessage()    {}
+func (*SimulateWithTraceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{6}
+}
+func (m *SimulateWithTraceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateWithTraceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateWithTraceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateWithTraceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateWithTraceRequest.Merge(m, src)
+}
+func (m *SimulateWithTraceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateWithTraceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateWithTraceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateWithTraceRequest proto.InternalMessageInfo
+
+func (m *SimulateWithTraceRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// SimulateWithTraceResponse is the response type for the
+// Service.SimulateWithTrace RPC method.
+type SimulateWithTraceResponse struct {
+	// gas_info is the information about gas used in the simulation.
+	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// result is the result of the simulation, unset if the transaction failed.
+	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// error is the error the transaction failed with, if any.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// operations are the store operations performed by the transaction, in
+	// order. If the transaction failed they end with the operation which
+	// caused the failure, if any.
+	Operations []*StoreOperation `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (m *SimulateWithTraceResponse) Reset()         { *m = SimulateWithTraceResponse{} }
+func (m *SimulateWithTraceResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateWithTraceResponse) ProtoMessage()    {}
+func (*SimulateWithTraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{7}
+}
+func (m *SimulateWithTraceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateWithTraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateWithTraceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateWithTraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateWithTraceResponse.Merge(m, src)
+}
+func (m *SimulateWithTraceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateWithTraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateWithTraceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateWithTraceResponse proto.InternalMessageInfo
+
+func (m *SimulateWithTraceResponse) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *SimulateWithTraceResponse) GetResult() *types.Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SimulateWithTraceResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SimulateWithTraceResponse) GetOperations() []*StoreOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+// StoreOperation is a store operation traced while simulating a transaction.
+type StoreOperation struct {
+	// operation_type is the type of the operation: get, has, set, delete or
+	// iterate.
+	OperationType string `protobuf:"bytes,1,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	// store_key is the key of the accessed store.
+	StoreKey string `protobuf:"bytes,2,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// key is the raw accessed key. For iterations it is the key the iterator
+	// was positioned on.
+	Key []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// collection is the name of the collection owning the key, if the key
+	// could be decoded.
+	Collection string `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
+	// decoded_key is the human readable representation of the key, if the key
+	// could be decoded.
+	DecodedKey string `protobuf:"bytes,5,opt,name=decoded_key,json=decodedKey,proto3" json:"decoded_key,omitempty"`
+	// old_value is the value stored at the key before the operation. For reads
+	// it is the value read.
+	OldValue []byte `protobuf:"bytes,6,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// new_value is the value stored at the key after a set operation.
+	NewValue []byte `protobuf:"bytes,7,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	// gas is the gas charged for the operation.
+	Gas uint64 `protobuf:"varint,8,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *StoreOperation) Reset()         { *m = StoreOperation{} }
+func (m *StoreOperation) String() string { return proto.CompactTextString(m) }
+func (*StoreOperation) ProtoMessage()    {}
+func (*StoreOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *StoreOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreOperation.Merge(m, src)
+}
+func (m *StoreOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreOperation proto.InternalMessageInfo
+
+func (m *StoreOperation) GetOperationType() string {
+	if m != nil {
+		return m.OperationType
+	}
+	return ""
+}
+
+func (m *StoreOperation) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreOperation) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StoreOperation) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *StoreOperation) GetDecodedKey() string {
+	if m != nil {
+		return m.DecodedKey
+	}
+	return ""
+}
+
+func (m *StoreOperation) GetOldValue() []byte {
+	if m != nil {
+		return m.OldValue
+	}
+	return nil
+}
+
+func (m *StoreOperation) GetNewValue() []byte {
+	if m != nil {
+		return m.NewValue
+	}
+	return nil
+}
+
+func (m *StoreOperation) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -507,7 +744,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +792,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +848,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +906,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +974,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +1021,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +1068,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +1115,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1161,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1207,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,7 +1253,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{19}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1299,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{20}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1107,6 +1344,9 @@ func init() {
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
 	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*SimulateWithTraceRequest)(nil), "cosmos.tx.v1beta1.SimulateWithTraceRequest")
+	proto.RegisterType((*SimulateWithTraceResponse)(nil), "cosmos.tx.v1beta1.SimulateWithTraceResponse")
+	proto.RegisterType((*StoreOperation)(nil), "cosmos.tx.v1beta1.StoreOperation")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
@@ -1124,89 +1364,103 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x13, 0x47,
-	0x14, 0xc7, 0xb3, 0x9b, 0x5f, 0xce, 0x73, 0x42, 0xcc, 0x24, 0x80, 0x31, 0xe0, 0x98, 0x85, 0x10,
-	0x13, 0x89, 0x5d, 0x62, 0x88, 0xa8, 0x50, 0xab, 0xca, 0x6b, 0x9b, 0x34, 0x50, 0x30, 0x5a, 0xa7,
-	0xaa, 0xa8, 0x2a, 0x59, 0x6b, 0x7b, 0xb0, 0xb7, 0xd8, 0xbb, 0xc6, 0x3b, 0x89, 0xd6, 0xa2, 0xa8,
-	0x55, 0x4f, 0x6d, 0xd5, 0xaa, 0x95, 0xfa, 0x17, 0xf4, 0xde, 0x23, 0x7f, 0x44, 0xc5, 0x09, 0xd1,
-	0x4b, 0xd5, 0x53, 0x05, 0x3d, 0xf5, 0xaf, 0xa8, 0x76, 0x66, 0xd6, 0xde, 0xb5, 0x77, 0x49, 0xe2,
-	0xde, 0x66, 0x76, 0xde, 0xbc, 0xf7, 0x99, 0xef, 0xcc, 0xbc, 0x37, 0x0b, 0x6b, 0x75, 0xcb, 0xee,
-	0x58, 0xb6, 0x42, 0x1c, 0xe5, 0x60, 0xab, 0x86, 0x89, 0xbe, 0xa5, 0xd8, 0xb8, 0x77, 0x60, 0xd4,
-	0xb1, 0xdc, 0xed, 0x59, 0xc4, 0x42, 0x27, 0x99, 0x81, 0x4c, 0x1c, 0x99, 0x1b, 0xa4, 0xce, 0x37,
-	0x2d, 0xab, 0xd9, 0xc6, 0x8a, 0xde, 0x35, 0x14, 0xdd, 0x34, 0x2d, 0xa2, 0x13, 0xc3, 0x32, 0x6d,
-	0x36, 0x21, 0x75, 0x89, 0x7b, 0xac, 0xe9, 0x36, 0x56, 0xf4, 0x5a, 0xdd, 0x18, 0x38, 0x76, 0x3b,
-	0xdc, 0x28, 0x35, 0x1e, 0x96, 0x38, 0x7c, 0x6c, 0xd3, 0xef, 0xe0, 0xe9, 0x3e, 0xee, 0xf5, 0x07,
-	0x36, 0x5d, 0xbd, 0x69, 0x98, 0x34, 0x1a, 0xb7, 0xbd, 0x50, 0xb7, 0x3a, 0x98, 0xd4, 0x1e, 0x13,
-	0x85, 0xf4, 0xbb, 0xd8, 0x56, 0x0e, 0xb6, 0x94, 0x5a, 0xdb, 0xaa, 0x3f, 0x89, 0x1e, 0xa6, 0x0d,
-	0x3e, 0x7c, 0x96, 0x45, 0xaa, 0xd2, 0x9e, 0xc2, 0x17, 0x4a, 0x3b, 0xd2, 0xb7, 0x22, 0xa0, 0x1d,
-	0x4c, 0xf6, 0x1c, 0xbb, 0x74, 0x80, 0x4d, 0xa2, 0xe1, 0xa7, 0xfb, 0xd8, 0x26, 0x28, 0x05, 0x73,
-	0xd8, 0xed, 0xdb, 0x49, 0x21, 0x33, 0x9d, 0x5d, 0x50, 0xc5, 0xa4, 0xa0, 0xf1, 0x2f, 0xe8, 0x2e,
-	0xc0, 0x90, 0x2f, 0x29, 0x66, 0x84, 0x6c, 0x3c, 0x77, 0x45, 0xe6, 0x5e, 0xdd, 0xc5, 0xc8, 0x74,
-	0x31, 0x9e, 0x8c, 0xf2, 0x43, 0xbd, 0x89, 0xb9, 0x5f, 0xea, 0xc7, 0x37, 0x1b, 0x6d, 0x43, 0xcc,
-	0xea, 0x35, 0x70, 0xaf, 0x5a, 0xeb, 0x27, 0xa7, 0x33, 0x42, 0xf6, 0x44, 0x2e, 0x25, 0x8f, 0x6d,
-	0x84, 0x5c, 0x76, 0x4d, 0xd4, 0xbe, 0x36, 0x6f, 0xb1, 0x06, 0x42, 0x30, 0xd3, 0xd5, 0x9b, 0x38,
-	0x39, 0x93, 0x11, 0xb2, 0x33, 0x1a, 0x6d, 0xa3, 0x55, 0x98, 0x6d, 0x1b, 0x1d, 0x83, 0x24, 0x67,
-	0xe9, 0x47, 0xd6, 0x41, 0x57, 0x61, 0x96, 0xd2, 0x24, 0xe7, 0x32, 0x42, 0x76, 0x41, 0x5d, 0xf9,
-	0xeb, 0xc5, 0xb5, 0x65, 0x16, 0xe0, 0x9a, 0xdd, 0x78, 0x92, 0xb9, 0x2e, 0x6f, 0x5f, 0xd7, 0x98,
-	0x85, 0xf4, 0xaf, 0x00, 0x2b, 0x01, 0x29, 0xec, 0xae, 0x65, 0xda, 0x18, 0x6d, 0xc0, 0x34, 0x71,
-	0x98, 0x10, 0xf1, 0xdc, 0xa9, 0x10, 0xbc, 0x3d, 0x47, 0x73, 0x2d, 0xd0, 0x0e, 0x2c, 0x12, 0xa7,
-	0xda, 0xe3, 0xf3, 0xec, 0xa4, 0x48, 0x67, 0x5c, 0x0e, 0x48, 0x43, 0xcf, 0x86, 0x6f, 0x22, 0x37,
-	0xd6, 0xe2, 0x64, 0xd0, 0xb6, 0xd1, 0xbd, 0x80, 0xc2, 0xd3, 0x54, 0xe1, 0x8d, 0x43, 0x15, 0x66,
-	0xb3, 0xc7, 0x24, 0x5e, 0x85, 0x59, 0x62, 0x11, 0xbd, 0xcd, 0xc5, 0x62, 0x1d, 0x09, 0x03, 0x52,
-	0x7b, 0x96, 0xde, 0xa8, 0xeb, 0x36, 0x71, 0x31, 0xd8, 0xb6, 0x9f, 0x85, 0x18, 0x71, 0xaa, 0xb5,
-	0x3e, 0xc1, 0xee, 0x7a, 0x85, 0xec, 0xa2, 0x36, 0x4f, 0x1c, 0xd5, 0xed, 0xa2, 0x9b, 0x30, 0xd3,
-	0xb1, 0x1a, 0x98, 0xee, 0xf7, 0x89, 0x5c, 0x26, 0x44, 0x86, 0x81, 0xbf, 0xfb, 0x56, 0x03, 0x6b,
-	0xd4, 0x5a, 0xfa, 0x1c, 0x56, 0x02, 0x61, 0xb8, 0xa4, 0x25, 0x88, 0xfb, 0x94, 0xa2, 0xa1, 0x8e,
-	0x2a, 0x14, 0x0c, 0x85, 0x92, 0xda, 0xb0, 0x5c, 0x31, 0x3a, 0xfb, 0x6d, 0x9d, 0x78, 0x07, 0x0c,
-	0x5d, 0x05, 0x91, 0x38, 0xdc, 0x61, 0xf8, 0x5e, 0x51, 0x81, 0x44, 0xe2, 0x20, 0xd9, 0xb7, 0x58,
-	0x77, 0x55, 0x8b, 0x21, 0xa7, 0xe3, 0xe6, 0x8d, 0x81, 0x02, 0xd2, 0xf7, 0x02, 0x24, 0x86, 0xe1,
-	0xf8, 0x4a, 0xde, 0x87, 0x58, 0x53, 0xb7, 0xab, 0x86, 0xf9, 0xd8, 0xe2, 0x51, 0x2f, 0x46, 0x2f,
-	0x63, 0x47, 0xb7, 0x77, 0xcd, 0xc7, 0x96, 0x36, 0xdf, 0x64, 0x0d, 0xf4, 0x1e, 0xcc, 0xf5, 0xb0,
-	0xbd, 0xdf, 0x26, 0xfc, 0x1a, 0x65, 0xa2, 0xe7, 0x6a, 0xd4, 0x4e, 0xe3, 0xf6, 0x92, 0x04, 0x8b,
-	0xf4, 0xac, 0x7a, 0xeb, 0x46, 0x30, 0xd3, 0xd2, 0xed, 0x16, 0x65, 0x58, 0xd0, 0x68, 0x5b, 0x7a,
-	0x0e, 0x4b, 0xdc, 0x86, 0xc3, 0xae, 0x1f, 0x2a, 0x0e, 0x15, 0x66, 0x64, 0x77, 0xc4, 0x09, 0x77,
-	0xe7, 0x27, 0x01, 0x4e, 0xef, 0x60, 0xa2, 0xba, 0x79, 0xea, 0x53, 0x83, 0xb4, 0xf6, 0x1c, 0xdb,
-	0xa3, 0x3d, 0x0d, 0x73, 0x2d, 0x6c, 0x34, 0x5b, 0x84, 0xc2, 0x4c, 0x6b, 0xbc, 0x87, 0xee, 0x4c,
-	0x9e, 0x5a, 0xfc, 0x67, 0xfe, 0xf6, 0xa9, 0xd7, 0x2f, 0xae, 0x9d, 0x0c, 0x6e, 0xe4, 0xb6, 0x9c,
-	0x93, 0xbe, 0x13, 0xe1, 0xcc, 0x18, 0xd1, 0x71, 0x6f, 0xf9, 0x36, 0xc4, 0x68, 0xea, 0xad, 0x1a,
-	0x0d, 0x4e, 0xe8, 0xa6, 0x2c, 0x96, 0x7e, 0x65, 0x96, 0x75, 0x0f, 0xb6, 0x64, 0x1a, 0x63, 0xb7,
-	0xa8, 0xcd, 0x53, 0xdb, 0xdd, 0x06, 0x92, 0x61, 0x96, 0x36, 0xf9, 0x75, 0x4e, 0x46, 0xcd, 0xd1,
-	0x98, 0x19, 0xda, 0x09, 0x48, 0x31, 0x73, 0xac, 0x1c, 0x70, 0x14, 0x2d, 0xf2, 0xb0, 0xbc, 0xe7,
-	0x14, 0x71, 0xdd, 0xbd, 0xab, 0x87, 0xde, 0xfe, 0xdb, 0x2b, 0xaf, 0xc7, 0x6e, 0xc6, 0x2d, 0xe9,
-	0x01, 0x24, 0x86, 0x2e, 0x8e, 0x75, 0xc4, 0xc2, 0xfd, 0xdd, 0x77, 0x91, 0x4a, 0xa6, 0x1f, 0xe9,
-	0xff, 0xb8, 0x53, 0x5d, 0x3c, 0xcf, 0x1d, 0xc7, 0x3b, 0xee, 0x12, 0xef, 0xc2, 0xaa, 0xe7, 0x23,
-	0xdf, 0x31, 0x4c, 0xcb, 0xe3, 0xba, 0x00, 0xa0, 0xbb, 0xfd, 0xea, 0x17, 0xb6, 0x65, 0xf2, 0x4b,
-	0xb7, 0x40, 0xbf, 0xdc, 0xb5, 0x2d, 0x33, 0xdc, 0x57, 0x19, 0x4e, 0x8d, 0xf8, 0xe2, 0x50, 0x17,
-	0x61, 0x91, 0x39, 0xab, 0x19, 0xa6, 0xde, 0xeb, 0x73, 0xb0, 0x38, 0xfd, 0xa6, 0xd2, 0x4f, 0x51,
-	0xfa, 0xaf, 0x7a, 0xfa, 0x07, 0xe0, 0x26, 0xf5, 0x77, 0xcf, 0x05, 0x0c, 0xf8, 0xe3, 0x80, 0x13,
-	0xac, 0x76, 0xf3, 0x23, 0x98, 0xe7, 0x65, 0x1b, 0x25, 0x61, 0xb5, 0xac, 0x15, 0x4b, 0x5a, 0x55,
-	0x7d, 0x54, 0xfd, 0xe4, 0x41, 0xe5, 0x61, 0xa9, 0xb0, 0x7b, 0x67, 0xb7, 0x54, 0x4c, 0x4c, 0xa1,
-	0x04, 0x2c, 0x0e, 0x46, 0xf2, 0x95, 0x42, 0x42, 0x40, 0x27, 0x61, 0x69, 0xf0, 0xa5, 0x58, 0xaa,
-	0x14, 0x12, 0xe2, 0xe6, 0xd7, 0x02, 0x2c, 0x05, 0x6a, 0x0b, 0x4a, 0x43, 0x4a, 0xd5, 0xca, 0xf9,
-	0x62, 0x21, 0x5f, 0xd9, 0xab, 0xde, 0x2f, 0x17, 0x4b, 0x23, 0x6e, 0xcf, 0xc3, 0xea, 0xc8, 0xb8,
-	0xfa, 0x71, 0xb9, 0x70, 0x2f, 0x21, 0xa4, 0xc4, 0x98, 0x80, 0xce, 0xc0, 0xca, 0xc8, 0x68, 0xe5,
-	0xd1, 0x83, 0x42, 0x42, 0x74, 0x39, 0x47, 0x06, 0xf2, 0x74, 0x64, 0x3a, 0xf7, 0x23, 0xc0, 0x7c,
-	0x85, 0x3d, 0x17, 0xd1, 0x33, 0x88, 0x79, 0x55, 0x00, 0x49, 0x21, 0x47, 0x72, 0xa4, 0x22, 0xa5,
-	0x2e, 0xbd, 0xd3, 0x86, 0xe7, 0xca, 0x2b, 0xdf, 0xfc, 0xf1, 0xcf, 0x2f, 0x62, 0xe6, 0xb6, 0xb0,
-	0x29, 0x9d, 0x53, 0x42, 0x9e, 0xaa, 0x5e, 0xc0, 0xa7, 0x30, 0x4b, 0x53, 0x3a, 0x5a, 0x0b, 0xf1,
-	0xea, 0x2f, 0x08, 0xa9, 0x4c, 0xb4, 0x01, 0x8f, 0xb9, 0x4e, 0x63, 0xae, 0xa1, 0x0b, 0x4a, 0xd8,
-	0x23, 0xd5, 0x56, 0x9e, 0xb9, 0x45, 0xe4, 0x39, 0xfa, 0x0a, 0xe2, 0xbe, 0x12, 0x8e, 0xd6, 0xdf,
-	0x55, 0xf9, 0x87, 0xe1, 0xaf, 0x1c, 0x66, 0xc6, 0x21, 0x2e, 0x52, 0x88, 0x73, 0xee, 0xc2, 0x4f,
-	0x87, 0x73, 0xa0, 0x2f, 0x21, 0xee, 0x7b, 0x96, 0x85, 0x02, 0x8c, 0xbf, 0x60, 0x43, 0x01, 0x42,
-	0x5e, 0x77, 0x52, 0x9a, 0x02, 0x24, 0x51, 0x54, 0xf4, 0xdf, 0x04, 0x58, 0x1e, 0xa9, 0x19, 0xe8,
-	0x6a, 0xb8, 0xef, 0x90, 0x4a, 0x97, 0xda, 0x3c, 0x8a, 0x29, 0x47, 0x51, 0x5f, 0x86, 0x65, 0x6a,
-	0xca, 0xb7, 0x81, 0xd6, 0x23, 0x76, 0x89, 0x56, 0x0b, 0xe5, 0x19, 0x2b, 0xa0, 0xcf, 0xd1, 0x0f,
-	0x02, 0xc4, 0xbc, 0x4b, 0x1c, 0x7a, 0x3c, 0x47, 0x92, 0x7e, 0xe8, 0xf1, 0x1c, 0xcd, 0xea, 0xd2,
-	0xad, 0x97, 0xe3, 0x37, 0x9c, 0x72, 0xa5, 0xdd, 0x8d, 0x3b, 0x1b, 0x82, 0xd6, 0x60, 0x04, 0x0c,
-	0x87, 0x25, 0xbd, 0x08, 0x9c, 0x40, 0xc2, 0x8f, 0xc0, 0x09, 0x66, 0xf1, 0x09, 0x70, 0x30, 0x23,
-	0xf8, 0x55, 0x80, 0xa5, 0x40, 0x0e, 0x46, 0x1b, 0xef, 0x88, 0xe7, 0x4f, 0xaa, 0xa9, 0xec, 0xe1,
-	0x86, 0x9c, 0xee, 0x83, 0x28, 0xba, 0xcb, 0x2e, 0xdd, 0x5a, 0x24, 0x9d, 0x42, 0x33, 0x2a, 0x67,
-	0xf4, 0xa5, 0xe1, 0x08, 0xc6, 0xf1, 0xc4, 0x1f, 0xc1, 0x18, 0x92, 0xd1, 0x27, 0x60, 0x64, 0x1b,
-	0xca, 0x18, 0xd5, 0x0f, 0x7f, 0x7f, 0x93, 0x16, 0x5e, 0xbd, 0x49, 0x0b, 0x7f, 0xbf, 0x49, 0x0b,
-	0x3f, 0xbf, 0x4d, 0x4f, 0xbd, 0x7a, 0x9b, 0x9e, 0xfa, 0xf3, 0x6d, 0x7a, 0xea, 0xb3, 0xf5, 0xa6,
-	0x41, 0x5a, 0xfb, 0x35, 0xf7, 0x75, 0xe3, 0x39, 0x19, 0x86, 0xe1, 0x7f, 0xa7, 0xc4, 0xa9, 0xcd,
-	0xd1, 0xbf, 0xcf, 0x1b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x1d, 0xd5, 0xa1, 0x97, 0x0f,
+	// 1538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x25, 0xd9, 0x96, 0x46, 0xfe, 0x90, 0xd7, 0x76, 0x22, 0xd3, 0x89, 0xac, 0x28, 0xf1,
+	0x47, 0x9c, 0x37, 0x64, 0xec, 0xc4, 0xc8, 0x8b, 0xe0, 0x7d, 0x51, 0x58, 0x96, 0xe2, 0xda, 0x6e,
+	0xe2, 0x80, 0x72, 0x5b, 0xa4, 0x28, 0x40, 0x50, 0xd2, 0x46, 0x66, 0x23, 0x73, 0x15, 0x72, 0xed,
+	0x50, 0x48, 0x83, 0x7e, 0x9c, 0xd2, 0xa2, 0x40, 0x0b, 0xf4, 0x17, 0xe4, 0xde, 0x4b, 0x81, 0xfc,
+	0x88, 0x22, 0xa7, 0x20, 0xbd, 0x14, 0x3d, 0x15, 0x49, 0x4f, 0xfd, 0x15, 0x05, 0x97, 0x4b, 0x89,
+	0x92, 0x28, 0xc9, 0x76, 0x0a, 0xf4, 0xb6, 0xbb, 0xf3, 0xcc, 0xcc, 0x33, 0xb3, 0xbb, 0x33, 0x4b,
+	0xc2, 0x5c, 0x89, 0x58, 0x07, 0xc4, 0x92, 0xa9, 0x2d, 0x1f, 0xad, 0x14, 0x31, 0xd5, 0x56, 0x64,
+	0x0b, 0x9b, 0x47, 0x7a, 0x09, 0x4b, 0x35, 0x93, 0x50, 0x82, 0x26, 0x5c, 0x80, 0x44, 0x6d, 0x89,
+	0x03, 0xc4, 0x73, 0x15, 0x42, 0x2a, 0x55, 0x2c, 0x6b, 0x35, 0x5d, 0xd6, 0x0c, 0x83, 0x50, 0x8d,
+	0xea, 0xc4, 0xb0, 0x5c, 0x05, 0xf1, 0x22, 0xb7, 0x58, 0xd4, 0x2c, 0x2c, 0x6b, 0xc5, 0x92, 0xde,
+	0x30, 0xec, 0x4c, 0x38, 0x48, 0xec, 0x74, 0x4b, 0x6d, 0x2e, 0x5b, 0xf6, 0x1b, 0x78, 0x74, 0x88,
+	0xcd, 0x7a, 0x03, 0x53, 0xd3, 0x2a, 0xba, 0xc1, 0xbc, 0x71, 0xec, 0xf9, 0x12, 0x39, 0xc0, 0xb4,
+	0xf8, 0x80, 0xca, 0xb4, 0x5e, 0xc3, 0x96, 0x7c, 0xb4, 0x22, 0x17, 0xab, 0xa4, 0xf4, 0xb0, 0xbb,
+	0x98, 0x0d, 0xb8, 0x78, 0xc6, 0xf5, 0xa4, 0xb2, 0x99, 0xec, 0x4e, 0x5c, 0x51, 0xe6, 0x59, 0x08,
+	0xd0, 0x26, 0xa6, 0x7b, 0xb6, 0x95, 0x3f, 0xc2, 0x06, 0x55, 0xf0, 0xa3, 0x43, 0x6c, 0x51, 0x24,
+	0xc2, 0x10, 0x76, 0xe6, 0x56, 0x52, 0x48, 0x87, 0x97, 0x62, 0xd9, 0x50, 0x52, 0x50, 0xf8, 0x0a,
+	0xda, 0x06, 0x68, 0xf2, 0x4b, 0x86, 0xd2, 0xc2, 0x52, 0x7c, 0x75, 0x41, 0xe2, 0x56, 0x9d, 0x60,
+	0x24, 0x16, 0x8c, 0x97, 0x46, 0xe9, 0x9e, 0x56, 0xc1, 0xdc, 0x2e, 0xb3, 0xe3, 0xd3, 0x46, 0x6b,
+	0x10, 0x25, 0x66, 0x19, 0x9b, 0x6a, 0xb1, 0x9e, 0x0c, 0xa7, 0x85, 0xa5, 0xb1, 0x55, 0x51, 0xea,
+	0xd8, 0x08, 0x69, 0xd7, 0x81, 0x64, 0xeb, 0xca, 0x30, 0x71, 0x07, 0x08, 0x41, 0xa4, 0xa6, 0x55,
+	0x70, 0x32, 0x92, 0x16, 0x96, 0x22, 0x0a, 0x1b, 0xa3, 0x29, 0x18, 0xac, 0xea, 0x07, 0x3a, 0x4d,
+	0x0e, 0xb2, 0x45, 0x77, 0x82, 0x2e, 0xc3, 0x20, 0x63, 0x93, 0x1c, 0x4a, 0x0b, 0x4b, 0xb1, 0xec,
+	0xe4, 0xef, 0x2f, 0xae, 0x8e, 0xbb, 0x0e, 0xae, 0x5a, 0xe5, 0x87, 0xe9, 0x6b, 0xd2, 0xda, 0x35,
+	0xc5, 0x45, 0x64, 0xfe, 0x12, 0x60, 0xb2, 0x25, 0x15, 0x56, 0x8d, 0x18, 0x16, 0x46, 0x8b, 0x10,
+	0xa6, 0xb6, 0x9b, 0x88, 0xf8, 0xea, 0x74, 0x00, 0xbd, 0x3d, 0x5b, 0x71, 0x10, 0x68, 0x13, 0x46,
+	0xa8, 0xad, 0x9a, 0x5c, 0xcf, 0x4a, 0x86, 0x98, 0xc6, 0xa5, 0x96, 0xd4, 0xb0, 0xb3, 0xe1, 0x53,
+	0xe4, 0x60, 0x25, 0x4e, 0x1b, 0x63, 0x0b, 0xed, 0xb4, 0x64, 0x38, 0xcc, 0x32, 0xbc, 0xd8, 0x37,
+	0xc3, 0xae, 0x76, 0x47, 0x8a, 0xa7, 0x60, 0x90, 0x12, 0xaa, 0x55, 0x79, 0xb2, 0xdc, 0x49, 0x06,
+	0x03, 0xca, 0x9a, 0x44, 0x2b, 0x97, 0x34, 0x8b, 0xee, 0xd9, 0x7c, 0x7b, 0xd0, 0x0c, 0x44, 0xa9,
+	0xad, 0x16, 0xeb, 0x14, 0x3b, 0xf1, 0x0a, 0x4b, 0x23, 0xca, 0x30, 0xb5, 0xb3, 0xce, 0x14, 0xdd,
+	0x80, 0xc8, 0x01, 0x29, 0x63, 0xb6, 0xdf, 0x63, 0xab, 0xe9, 0x80, 0x34, 0x34, 0xec, 0xdd, 0x21,
+	0x65, 0xac, 0x30, 0x74, 0xe6, 0x53, 0x98, 0x6c, 0x71, 0xc3, 0x53, 0x9a, 0x87, 0xb8, 0x2f, 0x53,
+	0xcc, 0xd5, 0x71, 0x13, 0x05, 0xcd, 0x44, 0x65, 0xaa, 0x30, 0x5e, 0xd0, 0x0f, 0x0e, 0xab, 0x1a,
+	0xf5, 0x0e, 0x18, 0xba, 0x0c, 0x21, 0x6a, 0x73, 0x83, 0xc1, 0x7b, 0xc5, 0x12, 0x14, 0xa2, 0x36,
+	0x92, 0x7c, 0xc1, 0x3a, 0x51, 0x8d, 0x04, 0x9c, 0x8e, 0x1b, 0xd7, 0x1b, 0x19, 0xc8, 0x7c, 0x2b,
+	0x40, 0xa2, 0xe9, 0x8e, 0x47, 0xf2, 0x3f, 0x88, 0x56, 0x34, 0x4b, 0xd5, 0x8d, 0x07, 0x84, 0x7b,
+	0xbd, 0xd0, 0x3d, 0x8c, 0x4d, 0xcd, 0xda, 0x32, 0x1e, 0x10, 0x65, 0xb8, 0xe2, 0x0e, 0xd0, 0x7f,
+	0x61, 0xc8, 0xc4, 0xd6, 0x61, 0x95, 0xf2, 0x6b, 0x94, 0xee, 0xae, 0xab, 0x30, 0x9c, 0xc2, 0xf1,
+	0x99, 0x6d, 0x48, 0x7a, 0x5c, 0x3e, 0xd6, 0xe9, 0xfe, 0x9e, 0xa9, 0x95, 0x70, 0xff, 0x5d, 0xbc,
+	0x35, 0xf9, 0xba, 0xe3, 0xfc, 0xaf, 0x66, 0xbe, 0x0a, 0xc1, 0x4c, 0x80, 0xb1, 0x7f, 0x37, 0x42,
+	0xe7, 0xdc, 0x62, 0xd3, 0x24, 0x26, 0x3b, 0xff, 0x31, 0xc5, 0x9d, 0xa0, 0x75, 0x00, 0x52, 0xc3,
+	0xa6, 0x5b, 0x89, 0x93, 0x91, 0x74, 0xd8, 0xcf, 0xc7, 0xb7, 0xcf, 0x05, 0x4a, 0x4c, 0xbc, 0xeb,
+	0x21, 0x15, 0x9f, 0x52, 0x70, 0x0e, 0x9e, 0x85, 0x60, 0xac, 0x55, 0x07, 0xcd, 0xc3, 0x58, 0x43,
+	0x4b, 0x75, 0xca, 0x29, 0x0b, 0x3f, 0xa6, 0x8c, 0x36, 0x56, 0xf7, 0xea, 0x35, 0x8c, 0x66, 0x21,
+	0x66, 0x39, 0x8a, 0xea, 0x43, 0x5c, 0x67, 0x41, 0xc6, 0x94, 0x28, 0x5b, 0xd8, 0xc1, 0x75, 0x94,
+	0x80, 0xb0, 0xb3, 0x1c, 0x66, 0xbb, 0xe0, 0x0c, 0x51, 0x0a, 0xa0, 0x44, 0xaa, 0x55, 0x5c, 0x62,
+	0x77, 0x3b, 0xc2, 0xf0, 0xbe, 0x15, 0x34, 0x07, 0xf1, 0x32, 0x2e, 0x91, 0x32, 0x2e, 0x33, 0x83,
+	0x83, 0x2e, 0x80, 0x2f, 0x39, 0x26, 0x67, 0x21, 0x46, 0xaa, 0x65, 0xf5, 0x48, 0xab, 0x1e, 0x62,
+	0x56, 0xd5, 0x46, 0x94, 0x28, 0xa9, 0x96, 0x3f, 0x72, 0xe6, 0x8e, 0xd0, 0xc0, 0x8f, 0xb9, 0x70,
+	0xd8, 0x15, 0x1a, 0xf8, 0xb1, 0x2b, 0x4c, 0x40, 0xb8, 0xa2, 0x59, 0xc9, 0x28, 0xab, 0x03, 0xce,
+	0x30, 0x38, 0x15, 0x19, 0x18, 0x61, 0x65, 0xd0, 0x3b, 0x4e, 0x08, 0x22, 0xfb, 0x9a, 0xb5, 0xcf,
+	0xa3, 0x67, 0xe3, 0xcc, 0x53, 0x18, 0xe5, 0x18, 0x7e, 0x4a, 0xe6, 0xfb, 0xde, 0x3b, 0x76, 0xe7,
+	0xda, 0x2e, 0x7e, 0xe8, 0x94, 0x17, 0xff, 0x7b, 0x01, 0xce, 0x6c, 0x62, 0x9a, 0x75, 0x5a, 0x20,
+	0x3b, 0xb1, 0xb6, 0xe5, 0xb1, 0x3d, 0x03, 0x43, 0xfb, 0x58, 0xaf, 0xec, 0x53, 0x46, 0x26, 0xac,
+	0xf0, 0x19, 0xba, 0x7d, 0xfa, 0xae, 0xe5, 0x2f, 0xa7, 0xb7, 0xa6, 0x5f, 0xbf, 0xb8, 0x3a, 0xd1,
+	0x5a, 0x23, 0xd6, 0xa4, 0xd5, 0xcc, 0x37, 0x21, 0x38, 0xdb, 0xc1, 0xe8, 0xa4, 0x0d, 0x64, 0x0d,
+	0xa2, 0xac, 0xab, 0xab, 0x7a, 0x99, 0x33, 0x14, 0x25, 0xaf, 0xb3, 0x4b, 0x6e, 0x43, 0x3f, 0x5a,
+	0x91, 0x98, 0x8f, 0xad, 0x9c, 0x32, 0xcc, 0xb0, 0x5b, 0x65, 0x24, 0xc1, 0x20, 0x1b, 0xf2, 0x4e,
+	0x91, 0xec, 0xa6, 0xa3, 0xb8, 0x30, 0xb4, 0xd9, 0x92, 0x8a, 0xc8, 0x89, 0xda, 0xcb, 0x71, 0x72,
+	0xb1, 0x0e, 0xe3, 0x7b, 0x76, 0x8e, 0x9d, 0xd8, 0x53, 0x95, 0xa4, 0x1b, 0x37, 0x33, 0x77, 0x21,
+	0xd1, 0x34, 0x71, 0xa2, 0x23, 0x16, 0x6c, 0xef, 0x8e, 0x43, 0x29, 0x6f, 0xf8, 0x29, 0xbd, 0x8b,
+	0xb9, 0x2c, 0x24, 0x9a, 0xe6, 0x38, 0xbd, 0x93, 0x86, 0xb8, 0x0d, 0x53, 0x9e, 0x8d, 0xf5, 0x03,
+	0xdd, 0x20, 0x1e, 0xaf, 0xf3, 0x00, 0x9a, 0x33, 0x57, 0x3f, 0xb3, 0x88, 0xc1, 0x2f, 0x5d, 0x8c,
+	0xad, 0x6c, 0x5b, 0xc4, 0x08, 0xb6, 0xb5, 0x0b, 0xd3, 0x6d, 0xb6, 0x38, 0xa9, 0x0b, 0x30, 0xe2,
+	0x1a, 0x2b, 0xea, 0x86, 0x66, 0xd6, 0x39, 0xb1, 0x38, 0x5b, 0xcb, 0xb2, 0xa5, 0x6e, 0xf9, 0x9f,
+	0xf2, 0xf2, 0xdf, 0x42, 0xee, 0xb4, 0xf6, 0x76, 0x60, 0xba, 0xcd, 0x1e, 0x27, 0x78, 0x8a, 0x68,
+	0x97, 0xdf, 0x87, 0x61, 0xfe, 0x22, 0x44, 0x49, 0x98, 0xda, 0x55, 0x72, 0x79, 0x45, 0xcd, 0xde,
+	0x57, 0x3f, 0xbc, 0x5b, 0xb8, 0x97, 0xdf, 0xd8, 0xba, 0xbd, 0x95, 0xcf, 0x25, 0x06, 0x50, 0x02,
+	0x46, 0x1a, 0x92, 0xf5, 0xc2, 0x46, 0x42, 0x40, 0x13, 0x30, 0xda, 0x58, 0xc9, 0xe5, 0x0b, 0x1b,
+	0x89, 0xd0, 0xf2, 0x97, 0x02, 0x8c, 0xb6, 0x3c, 0x5b, 0x50, 0x0a, 0xc4, 0xac, 0xb2, 0xbb, 0x9e,
+	0xdb, 0x58, 0x2f, 0xec, 0xa9, 0x77, 0x76, 0x73, 0xf9, 0x36, 0xb3, 0xe7, 0x60, 0xaa, 0x4d, 0x9e,
+	0xfd, 0x60, 0x77, 0x63, 0x27, 0x21, 0x88, 0xa1, 0xa8, 0x80, 0xce, 0xc2, 0x64, 0x9b, 0xb4, 0x70,
+	0xff, 0xee, 0x46, 0x22, 0xe4, 0xf0, 0x6c, 0x13, 0xac, 0x33, 0x49, 0x78, 0xf5, 0xe7, 0x38, 0x0c,
+	0x17, 0xdc, 0x2f, 0x11, 0xf4, 0x04, 0xa2, 0x5e, 0x1f, 0x46, 0x99, 0xa0, 0xa6, 0xd6, 0xfa, 0xd8,
+	0x11, 0x2f, 0xf6, 0xc4, 0xf0, 0x5a, 0xb9, 0xf0, 0xf5, 0xaf, 0x7f, 0xfe, 0x18, 0x4a, 0xdf, 0x12,
+	0x96, 0x33, 0xb3, 0x72, 0xc0, 0x57, 0x90, 0xe7, 0xf0, 0xb9, 0x00, 0x13, 0x1d, 0xaf, 0x00, 0x74,
+	0xa5, 0x87, 0x8b, 0xf6, 0x87, 0x87, 0xf8, 0x9f, 0xe3, 0x81, 0x39, 0xb1, 0x15, 0x46, 0xec, 0x8a,
+	0x43, 0x6c, 0xa1, 0x07, 0x31, 0xf5, 0xb1, 0x4e, 0xf7, 0x55, 0xca, 0xd8, 0x3c, 0x82, 0x41, 0xd6,
+	0x76, 0xd0, 0x5c, 0x80, 0x27, 0x7f, 0xd3, 0x12, 0xd3, 0xdd, 0x01, 0xdc, 0xfd, 0x3c, 0x73, 0x3f,
+	0x87, 0xce, 0xcb, 0x41, 0xdf, 0x68, 0x96, 0xfc, 0xc4, 0x69, 0x74, 0x4f, 0xd1, 0x17, 0x10, 0xf7,
+	0xbd, 0x60, 0xd1, 0x7c, 0xaf, 0x87, 0x6f, 0xd3, 0xfd, 0x42, 0x3f, 0x18, 0x27, 0x71, 0x81, 0x91,
+	0x98, 0x75, 0x72, 0x70, 0x26, 0x98, 0x07, 0xfa, 0x1c, 0xe2, 0xbe, 0xaf, 0x92, 0x40, 0x02, 0x9d,
+	0x1f, 0x70, 0xe2, 0x42, 0x3f, 0x18, 0x27, 0x90, 0x62, 0x04, 0x92, 0xa8, 0x9b, 0xf7, 0x9f, 0x04,
+	0x18, 0x6f, 0xeb, 0x6b, 0xe8, 0x72, 0xb0, 0xed, 0x80, 0x6e, 0x2c, 0x2e, 0x1f, 0x07, 0xca, 0xa9,
+	0x64, 0x5f, 0x06, 0x75, 0x13, 0xc6, 0x6f, 0x11, 0xcd, 0x77, 0xd9, 0x25, 0xd6, 0xd1, 0xe4, 0x27,
+	0x6e, 0x93, 0x7f, 0x8a, 0xbe, 0x13, 0x20, 0xea, 0x15, 0x9a, 0xc0, 0x2b, 0xd4, 0xd6, 0x98, 0xc4,
+	0x8b, 0x3d, 0x31, 0x9c, 0xd9, 0xcd, 0x97, 0x9d, 0x55, 0x88, 0xf1, 0x4a, 0x39, 0x1b, 0x37, 0x13,
+	0x40, 0xcd, 0x7d, 0xaf, 0x71, 0x3a, 0x79, 0xa3, 0x07, 0x9d, 0xbc, 0xd1, 0x9f, 0x4e, 0xde, 0x78,
+	0x47, 0x3a, 0xd8, 0x65, 0xf0, 0x5c, 0x80, 0xd1, 0x96, 0x3e, 0x81, 0x16, 0x7b, 0xf8, 0xf3, 0x17,
+	0x7e, 0x71, 0xa9, 0x3f, 0x90, 0xb3, 0xfb, 0x7f, 0x37, 0x76, 0x97, 0x1c, 0x76, 0x73, 0x5d, 0xd9,
+	0xc9, 0xac, 0xea, 0x73, 0x8e, 0x39, 0xdc, 0x8f, 0x63, 0x0e, 0x1f, 0x93, 0x63, 0x0e, 0xff, 0x13,
+	0x1c, 0xcb, 0xb8, 0xc9, 0x31, 0xfb, 0xde, 0x2f, 0x6f, 0x52, 0xc2, 0xab, 0x37, 0x29, 0xe1, 0x8f,
+	0x37, 0x29, 0xe1, 0x87, 0xb7, 0xa9, 0x81, 0x57, 0x6f, 0x53, 0x03, 0xbf, 0xbd, 0x4d, 0x0d, 0x7c,
+	0x32, 0x5f, 0xd1, 0xe9, 0xfe, 0x61, 0xd1, 0x79, 0x81, 0x79, 0x46, 0x9a, 0x6e, 0xf8, 0xcf, 0x19,
+	0x6a, 0x17, 0x87, 0xd8, 0xcf, 0x97, 0xeb, 0x7f, 0x0f, 0x00, 0xc1, 0x69, 0x5b, 0x1a, 0x96, 0x12,
 	0x00, 0x00,
 }
 
@@ -1224,6 +1478,9 @@ const _ = grpc.SupportPackageIsVersion4
 type ServiceClient interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// SimulateWithTrace simulates executing a transaction like Simulate,
+	// additionally returning the ordered store operations it performed.
+	SimulateWithTrace(ctx context.Context, in *SimulateWithTraceRequest, opts ...grpc.CallOption) (*SimulateWithTraceResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1259,6 +1516,15 @@ func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts
 	return out, nil
 }
 
+func (c *serviceClient) SimulateWithTrace(ctx context.Context, in *SimulateWithTraceRequest, opts ...grpc.CallOption) (*SimulateWithTraceResponse, error) {
+	out := new(SimulateWithTraceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/SimulateWithTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
 	out := new(GetTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTx", in, out, opts...)
@@ -1335,6 +1601,9 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// SimulateWithTrace simulates executing a transaction like Simulate,
+	// additionally returning the ordered store operations it performed.
+	SimulateWithTrace(context.Context, *SimulateWithTraceRequest) (*SimulateWithTraceResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1360,6 +1629,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
+func (*UnimplementedServiceServer) SimulateWithTrace(ctx context.Context, req *SimulateWithTraceRequest) (*SimulateWithTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateWithTrace not implemented")
+}
 func (*UnimplementedServiceServer) GetTx(ctx context.Context, req *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
@@ -1407,6 +1679,24 @@ func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SimulateWithTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateWithTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateWithTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/SimulateWithTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateWithTrace(ctx, req.(*SimulateWithTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTxRequest)
 	if err := dec(in); err != nil {
@@ -1560,6 +1850,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Simulate",
 			Handler:    _Service_Simulate_Handler,
 		},
+		{
+			MethodName: "SimulateWithTrace",
+			Handler:    _Service_SimulateWithTrace_Handler,
+		},
 		{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
@@ -1890,7 +2184,7 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateWithTraceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1900,27 +2194,27 @@ func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateWithTraceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateWithTraceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *SimulateWithTraceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1930,19 +2224,40 @@ func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateWithTraceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateWithTraceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxResponse != nil {
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Result != nil {
 		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1952,9 +2267,9 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Tx != nil {
+	if m.GasInfo != nil {
 		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1967,7 +2282,7 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+func (m *StoreOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1977,37 +2292,74 @@ func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StoreOperation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StoreOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if m.Gas != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintService(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DecodedKey) > 0 {
+		i -= len(m.DecodedKey)
+		copy(dAtA[i:], m.DecodedKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DecodedKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.StoreKey)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Height))
+	if len(m.OperationType) > 0 {
+		i -= len(m.OperationType)
+		copy(dAtA[i:], m.OperationType)
+		i = encodeVarintService(dAtA, i, uint64(len(m.OperationType)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2017,21 +2369,138 @@ func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
 			i -= size
 			i = encodeVarintService(dAtA, i, uint64(size))
@@ -2464,6 +2933,86 @@ func (m *SimulateResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateWithTraceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateWithTraceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperationType)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DecodedKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovService(uint64(m.Gas))
+	}
+	return n
+}
+
 func (m *GetTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3458,6 +4007,577 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SimulateWithTraceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateWithTraceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateWithTraceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateWithTraceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateWithTraceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateWithTraceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &StoreOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = append(m.OldValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldValue == nil {
+				m.OldValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = append(m.NewValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewValue == nil {
+				m.NewValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
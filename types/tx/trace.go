@@ -0,0 +1,24 @@
+package tx
+
+import (
+	corestore "cosmossdk.io/core/store"
+)
+
+// NewStoreOperations converts the store operations traced while simulating a tx
+// into their protobuf representation.
+func NewStoreOperations(ops []corestore.TraceOperation) []*StoreOperation {
+	operations := make([]*StoreOperation, len(ops))
+	for i, op := range ops {
+		operations[i] = &StoreOperation{
+			OperationType: string(op.Type),
+			StoreKey:      op.StoreKey,
+			Key:           op.Key,
+			Collection:    op.Collection,
+			DecodedKey:    op.DecodedKey,
+			OldValue:      op.OldValue,
+			NewValue:      op.NewValue,
+			Gas:           op.Gas,
+		}
+	}
+	return operations
+}
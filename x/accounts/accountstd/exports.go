@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 
+	coreaddress "cosmossdk.io/core/address"
 	"cosmossdk.io/x/accounts/internal/implementation"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -99,6 +100,12 @@ func ExecModuleUntyped(ctx context.Context, msg implementation.ProtoMsg) (implem
 	return implementation.ExecModuleUntyped(ctx, msg)
 }
 
+// SendCoins sends coins from the account to the provided address, using the same
+// bank message x/accounts uses to transfer funds.
+func SendCoins(ctx context.Context, addrCdc coreaddress.Codec, to []byte, coins sdk.Coins) error {
+	return implementation.SendCoins(ctx, addrCdc, to, coins)
+}
+
 // QueryModule can be used by an account to execute a module query.
 func QueryModule[Resp any, RespProto implementation.ProtoMsgG[Resp], Req any, ReqProto implementation.ProtoMsgG[Req]](ctx context.Context, req ReqProto) (RespProto, error) {
 	return implementation.QueryModule[Resp, RespProto, Req, ReqProto](ctx, req)
@@ -0,0 +1,394 @@
+package recovery
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+var SOCIAL_RECOVERY_ACCOUNT = "social-recovery-account"
+
+// the base account uses prefixes 0 and 1.
+var (
+	GuardiansPrefix  = collections.NewPrefix(2)
+	ConfigPrefix     = collections.NewPrefix(3)
+	RecoveriesPrefix = collections.NewPrefix(4)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = Account{}
+)
+
+// Account is a base account whose pubkey can be rotated by a set of guardians.
+// Once enough guardians approve a new pubkey, the recovery can be executed after
+// a delay, during which the owner can cancel it.
+type Account struct {
+	base.Account
+
+	Guardians collections.KeySet[[]byte]
+	Config    collections.Item[v1.Config]
+	// Recoveries maps the proposed pubkeys to their pending recovery.
+	Recoveries collections.Map[[]byte, v1.Recovery]
+
+	addrCodec     address.Codec
+	headerService header.Service
+	eventService  event.Service
+}
+
+// NewAccount returns a new social recovery account creator function.
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+		return name, Account{
+			Account:       baseAcc.(base.Account),
+			Guardians:     collections.NewKeySet(deps.SchemaBuilder, GuardiansPrefix, "guardians", collections.BytesKey),
+			Config:        collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Recoveries:    collections.NewMap(deps.SchemaBuilder, RecoveriesPrefix, "recoveries", collections.BytesKey, codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			addrCodec:     deps.AddressCodec,
+			headerService: deps.Environment.HeaderService,
+			eventService:  deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Init initializes the account with the owner pubkey, the guardians and the config.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.Config == nil {
+		return nil, errors.New("config must be specified")
+	}
+
+	if _, err := a.Account.Init(ctx, &basev1.MsgInit{PubKey: msg.PubKey}); err != nil {
+		return nil, err
+	}
+
+	if err := a.addGuardians(ctx, msg.Guardians); err != nil {
+		return nil, err
+	}
+
+	if err := a.validateConfig(ctx, *msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, a.Config.Set(ctx, *msg.Config)
+}
+
+// UpdateGuardians updates the guardians and the config of the account, it can only
+// be executed by the account itself. The pending recoveries are cancelled.
+func (a Account) UpdateGuardians(ctx context.Context, msg *v1.MsgUpdateGuardians) (*v1.MsgUpdateGuardiansResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("only the account itself can update the guardians")
+	}
+
+	for _, guardian := range msg.RemoveGuardians {
+		addrBz, err := a.addrCodec.StringToBytes(guardian)
+		if err != nil {
+			return nil, err
+		}
+		has, err := a.Guardians.Has(ctx, addrBz)
+		if err != nil {
+			return nil, err
+		}
+		if !has {
+			return nil, fmt.Errorf("guardian %s not found", guardian)
+		}
+		if err := a.Guardians.Remove(ctx, addrBz); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := a.addGuardians(ctx, msg.AddGuardians); err != nil {
+		return nil, err
+	}
+
+	if msg.Config != nil {
+		if err := a.Config.Set(ctx, *msg.Config); err != nil {
+			return nil, err
+		}
+	}
+
+	// get the config from state given that we might not have it in the message
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.validateConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateGuardiansResponse{}, a.Recoveries.Clear(ctx, nil)
+}
+
+// ApproveRecovery approves rotating the account pubkey, the sender must be a guardian.
+// Approvals are tracked per proposed pubkey and a guardian approves a single pubkey at
+// a time, so approving another pubkey withdraws the previous approval of the guardian.
+func (a Account) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dcrd_secp256k1.ParsePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery, err := a.Recoveries.Get(ctx, msg.NewPubKey)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		recovery = v1.Recovery{NewPubKey: msg.NewPubKey}
+	case err != nil:
+		return nil, err
+	case slices.Contains(recovery.Approvals, guardian):
+		return nil, errors.New("guardian has already approved the recovery")
+	}
+
+	if err := a.withdrawApproval(ctx, guardian, config); err != nil {
+		return nil, err
+	}
+
+	recovery.Approvals = append(recovery.Approvals, guardian)
+
+	// the delay starts once the threshold is reached.
+	if recovery.ExecutableAfter == 0 && uint64(len(recovery.Approvals)) >= config.Threshold {
+		recovery.ExecutableAfter = a.headerService.HeaderInfo(ctx).Time.Unix() + config.RecoveryDelay
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_approved",
+		event.NewAttribute("guardian", guardian),
+		event.NewAttribute("new_pub_key", hex.EncodeToString(msg.NewPubKey)),
+		event.NewAttribute("approvals", fmt.Sprint(len(recovery.Approvals))),
+		event.NewAttribute("executable_after", fmt.Sprint(recovery.ExecutableAfter)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgApproveRecoveryResponse{}, a.Recoveries.Set(ctx, msg.NewPubKey, recovery)
+}
+
+// withdrawApproval removes the approval of the guardian from the recovery it approved, if any.
+// A recovery left without approvals is removed, and a recovery falling below the threshold
+// must reach it again before its delay starts over.
+func (a Account) withdrawApproval(ctx context.Context, guardian string, config v1.Config) error {
+	var (
+		approved v1.Recovery
+		found    bool
+	)
+	err := a.Recoveries.Walk(ctx, nil, func(_ []byte, recovery v1.Recovery) (stop bool, err error) {
+		if slices.Contains(recovery.Approvals, guardian) {
+			approved, found = recovery, true
+		}
+		return found, nil
+	})
+	if err != nil || !found {
+		return err
+	}
+
+	approved.Approvals = slices.DeleteFunc(approved.Approvals, func(approval string) bool {
+		return approval == guardian
+	})
+	if len(approved.Approvals) == 0 {
+		return a.Recoveries.Remove(ctx, approved.NewPubKey)
+	}
+	if uint64(len(approved.Approvals)) < config.Threshold {
+		approved.ExecutableAfter = 0
+	}
+	return a.Recoveries.Set(ctx, approved.NewPubKey, approved)
+}
+
+// ExecuteRecovery rotates the account pubkey to the pubkey of a pending recovery, the sender
+// must be a guardian and the recovery delay must have passed. All the pending recoveries are
+// then removed.
+func (a Account) ExecuteRecovery(ctx context.Context, msg *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery, err := a.Recoveries.Get(ctx, msg.NewPubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errors.New("no pending recovery")
+		}
+		return nil, err
+	}
+
+	if recovery.ExecutableAfter == 0 {
+		return nil, errors.New("recovery has not reached the threshold")
+	}
+
+	if a.headerService.HeaderInfo(ctx).Time.Unix() < recovery.ExecutableAfter {
+		return nil, errors.New("recovery delay has not passed yet")
+	}
+
+	if err := a.PubKey.Set(ctx, secp256k1.PubKey{Key: recovery.NewPubKey}); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_executed",
+		event.NewAttribute("guardian", guardian),
+		event.NewAttribute("new_pub_key", hex.EncodeToString(recovery.NewPubKey)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteRecoveryResponse{}, a.Recoveries.Clear(ctx, nil)
+}
+
+// CancelRecovery cancels the pending recoveries, it can only be executed by the account itself.
+func (a Account) CancelRecovery(ctx context.Context, _ *v1.MsgCancelRecovery) (*v1.MsgCancelRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("only the account itself can cancel a recovery")
+	}
+
+	iter, err := a.Recoveries.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	pending := iter.Valid()
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	if !pending {
+		return nil, errors.New("no pending recovery")
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_cancelled"); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelRecoveryResponse{}, a.Recoveries.Clear(ctx, nil)
+}
+
+func (a Account) addGuardians(ctx context.Context, guardians []string) error {
+	for _, guardian := range guardians {
+		addrBz, err := a.addrCodec.StringToBytes(guardian)
+		if err != nil {
+			return err
+		}
+		has, err := a.Guardians.Has(ctx, addrBz)
+		if err != nil {
+			return err
+		}
+		if has {
+			return errors.New("duplicate guardian address found")
+		}
+		if err := a.Guardians.Set(ctx, addrBz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// senderGuardian returns the address of the sender if it is a guardian.
+func (a Account) senderGuardian(ctx context.Context) (string, error) {
+	sender := accountstd.Sender(ctx)
+	isGuardian, err := a.Guardians.Has(ctx, sender)
+	if err != nil {
+		return "", err
+	}
+	if !isGuardian {
+		return "", errors.New("sender is not a guardian")
+	}
+	return a.addrCodec.BytesToString(sender)
+}
+
+func (a Account) validateConfig(ctx context.Context, cfg v1.Config) error {
+	if cfg.Threshold == 0 || cfg.RecoveryDelay <= 0 {
+		return errors.New("threshold and recovery delay must be greater than zero")
+	}
+
+	guardians := uint64(0)
+	err := a.Guardians.Walk(ctx, nil, func(_ []byte) (stop bool, err error) {
+		guardians++
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if guardians < cfg.Threshold {
+		return errors.New("threshold must be less than or equal to the number of guardians")
+	}
+
+	return nil
+}
+
+// QueryConfig returns the guardians and the config of the account.
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	var guardians []string
+	err := a.Guardians.Walk(ctx, nil, func(addrBz []byte) (stop bool, err error) {
+		addr, err := a.addrCodec.BytesToString(addrBz)
+		if err != nil {
+			return true, err
+		}
+		guardians = append(guardians, addr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryConfigResponse{Guardians: guardians, Config: &config}, nil
+}
+
+// QueryRecovery returns the pending recoveries.
+func (a Account) QueryRecovery(ctx context.Context, _ *v1.QueryRecovery) (*v1.QueryRecoveryResponse, error) {
+	var recoveries []*v1.Recovery
+	err := a.Recoveries.Walk(ctx, nil, func(_ []byte, recovery v1.Recovery) (stop bool, err error) {
+		recoveries = append(recoveries, &recovery)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryRecoveryResponse{Recoveries: recoveries}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateGuardians)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecovery)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecovery)
+}
@@ -0,0 +1,301 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+const accAddr = "mock_recovery_account"
+
+func setupRecoveryAccount(t *testing.T) (context.Context, Account, *headerService) {
+	t.Helper()
+	ctx, ss := newMockContext(t)
+	hs := &headerService{time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	deps := makeMockDependencies(ss, hs)
+
+	createAccFn := NewAccount(SOCIAL_RECOVERY_ACCOUNT, signing.NewHandlerMap(directHandler{}))
+	_, acc, err := createAccFn(deps)
+	require.NoError(t, err)
+
+	return ctx, acc.(Account), hs
+}
+
+func TestInit(t *testing.T) {
+	ownerPk := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	testcases := []struct {
+		name     string
+		msg      *v1.MsgInit
+		isExpErr bool
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{
+				PubKey:    ownerPk,
+				Guardians: []string{"guardian1", "guardian2", "guardian3"},
+				Config:    &v1.Config{Threshold: 2, RecoveryDelay: 100},
+			},
+			false,
+		},
+		{
+			"invalid pubkey",
+			&v1.MsgInit{
+				PubKey:    []byte("invalid_pk"),
+				Guardians: []string{"guardian1"},
+				Config:    &v1.Config{Threshold: 1, RecoveryDelay: 100},
+			},
+			true,
+		},
+		{
+			"missing config",
+			&v1.MsgInit{
+				PubKey:    ownerPk,
+				Guardians: []string{"guardian1"},
+			},
+			true,
+		},
+		{
+			"duplicate guardians",
+			&v1.MsgInit{
+				PubKey:    ownerPk,
+				Guardians: []string{"guardian1", "guardian1"},
+				Config:    &v1.Config{Threshold: 1, RecoveryDelay: 100},
+			},
+			true,
+		},
+		{
+			"threshold greater than the number of guardians",
+			&v1.MsgInit{
+				PubKey:    ownerPk,
+				Guardians: []string{"guardian1"},
+				Config:    &v1.Config{Threshold: 2, RecoveryDelay: 100},
+			},
+			true,
+		},
+		{
+			"zero recovery delay",
+			&v1.MsgInit{
+				PubKey:    ownerPk,
+				Guardians: []string{"guardian1"},
+				Config:    &v1.Config{Threshold: 1},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, acc, _ := setupRecoveryAccount(t)
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.isExpErr {
+				require.NotNil(t, err, tc.name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, acc, hs := setupRecoveryAccount(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    secp256k1.GenPrivKey().PubKey().Bytes(),
+		Guardians: []string{"guardian1", "guardian2", "guardian3"},
+		Config:    &v1.Config{Threshold: 2, RecoveryDelay: 100},
+	})
+	require.NoError(t, err)
+
+	newPk := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	// only guardians can approve a recovery
+	ctx = accountstd.SetSender(ctx, []byte("sender"))
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: newPk})
+	require.Equal(t, errors.New("sender is not a guardian"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte("guardian1"))
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: newPk})
+	require.NoError(t, err)
+
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: newPk})
+	require.Equal(t, errors.New("guardian has already approved the recovery"), err)
+
+	// the threshold is not reached yet
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newPk})
+	require.Equal(t, errors.New("recovery has not reached the threshold"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte("guardian2"))
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: newPk})
+	require.NoError(t, err)
+
+	resp, err := acc.QueryRecovery(ctx, &v1.QueryRecovery{})
+	require.NoError(t, err)
+	require.Len(t, resp.Recoveries, 1)
+	require.Equal(t, []string{"guardian1", "guardian2"}, resp.Recoveries[0].Approvals)
+	require.Equal(t, hs.time.Unix()+100, resp.Recoveries[0].ExecutableAfter)
+
+	// the delay has not passed yet
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newPk})
+	require.Equal(t, errors.New("recovery delay has not passed yet"), err)
+
+	hs.time = hs.time.Add(100 * time.Second)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.Equal(t, errors.New("no pending recovery"), err)
+
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newPk})
+	require.NoError(t, err)
+
+	pk, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newPk, pk.Key)
+
+	resp, err = acc.QueryRecovery(ctx, &v1.QueryRecovery{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Recoveries)
+}
+
+func TestCompetingRecoveries(t *testing.T) {
+	ctx, acc, hs := setupRecoveryAccount(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    secp256k1.GenPrivKey().PubKey().Bytes(),
+		Guardians: []string{"guardian1", "guardian2", "guardian3"},
+		Config:    &v1.Config{Threshold: 2, RecoveryDelay: 100},
+	})
+	require.NoError(t, err)
+
+	attackerPk := secp256k1.GenPrivKey().PubKey().Bytes()
+	newPk := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	// a bad guardian opening a recovery to its own pubkey does not block the others
+	ctx = accountstd.SetSender(ctx, []byte("guardian1"))
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: attackerPk})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, []byte("guardian2"))
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: newPk})
+	require.NoError(t, err)
+
+	resp, err := acc.QueryRecovery(ctx, &v1.QueryRecovery{})
+	require.NoError(t, err)
+	require.Len(t, resp.Recoveries, 2)
+
+	// a guardian approves a single pubkey at a time, so a recovery losing an approval
+	// below the threshold is no longer executable.
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: attackerPk})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: newPk})
+	require.NoError(t, err)
+
+	resp, err = acc.QueryRecovery(ctx, &v1.QueryRecovery{})
+	require.NoError(t, err)
+	require.Len(t, resp.Recoveries, 2)
+	for _, recovery := range resp.Recoveries {
+		require.Zero(t, recovery.ExecutableAfter)
+	}
+
+	ctx = accountstd.SetSender(ctx, []byte("guardian3"))
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: newPk})
+	require.NoError(t, err)
+
+	hs.time = hs.time.Add(100 * time.Second)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: attackerPk})
+	require.Equal(t, errors.New("recovery has not reached the threshold"), err)
+
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newPk})
+	require.NoError(t, err)
+
+	pk, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newPk, pk.Key)
+
+	// executing a recovery removes the competing ones
+	resp, err = acc.QueryRecovery(ctx, &v1.QueryRecovery{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Recoveries)
+}
+
+func TestCancelRecovery(t *testing.T) {
+	ctx, acc, _ := setupRecoveryAccount(t)
+	ownerPk := secp256k1.GenPrivKey().PubKey().Bytes()
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    ownerPk,
+		Guardians: []string{"guardian1"},
+		Config:    &v1.Config{Threshold: 1, RecoveryDelay: 100},
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, []byte(accAddr))
+	_, err = acc.CancelRecovery(ctx, &v1.MsgCancelRecovery{})
+	require.Equal(t, errors.New("no pending recovery"), err)
+
+	newPk := secp256k1.GenPrivKey().PubKey().Bytes()
+	ctx = accountstd.SetSender(ctx, []byte("guardian1"))
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: newPk})
+	require.NoError(t, err)
+
+	// only the account itself can cancel a recovery
+	_, err = acc.CancelRecovery(ctx, &v1.MsgCancelRecovery{})
+	require.Equal(t, errors.New("only the account itself can cancel a recovery"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte(accAddr))
+	_, err = acc.CancelRecovery(ctx, &v1.MsgCancelRecovery{})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, []byte("guardian1"))
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newPk})
+	require.Equal(t, errors.New("no pending recovery"), err)
+
+	pk, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ownerPk, pk.Key)
+}
+
+func TestUpdateGuardians(t *testing.T) {
+	ctx, acc, _ := setupRecoveryAccount(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    secp256k1.GenPrivKey().PubKey().Bytes(),
+		Guardians: []string{"guardian1", "guardian2"},
+		Config:    &v1.Config{Threshold: 2, RecoveryDelay: 100},
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, []byte("guardian1"))
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+
+	// only the account itself can update the guardians
+	_, err = acc.UpdateGuardians(ctx, &v1.MsgUpdateGuardians{RemoveGuardians: []string{"guardian2"}})
+	require.Equal(t, errors.New("only the account itself can update the guardians"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte(accAddr))
+
+	_, err = acc.UpdateGuardians(ctx, &v1.MsgUpdateGuardians{RemoveGuardians: []string{"unknown"}})
+	require.Equal(t, errors.New("guardian unknown not found"), err)
+
+	_, err = acc.UpdateGuardians(ctx, &v1.MsgUpdateGuardians{
+		AddGuardians:    []string{"guardian3", "guardian4"},
+		RemoveGuardians: []string{"guardian2"},
+		Config:          &v1.Config{Threshold: 3, RecoveryDelay: 50},
+	})
+	require.NoError(t, err)
+
+	resp, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"guardian1", "guardian3", "guardian4"}, resp.Guardians)
+	require.Equal(t, uint64(3), resp.Config.Threshold)
+	require.Equal(t, int64(50), resp.Config.RecoveryDelay)
+
+	// the pending recovery was cancelled
+	recoveryResp, err := acc.QueryRecovery(ctx, &v1.QueryRecovery{})
+	require.NoError(t, err)
+	require.Empty(t, recoveryResp.Recoveries)
+}
@@ -0,0 +1,651 @@
+package sessionkey
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	distributionv1beta1 "cosmossdk.io/api/cosmos/distribution/v1beta1"
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+	govv1 "cosmossdk.io/api/cosmos/gov/v1"
+	protocolpoolv1 "cosmossdk.io/api/cosmos/protocolpool/v1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	vestingv1beta1 "cosmossdk.io/api/cosmos/vesting/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var SESSION_KEY_ACCOUNT = "session-key-account"
+
+var (
+	PubKeyPrefix      = collections.NewPrefix(0)
+	SequencePrefix    = collections.NewPrefix(1)
+	SessionKeysPrefix = collections.NewPrefix(2)
+)
+
+var (
+	msgMultiSendTypeURL             = msgTypeURL(&bankv1beta1.MsgMultiSend{})
+	msgAuthzExecTypeURL             = msgTypeURL(&authzv1beta1.MsgExec{})
+	msgCreatePeriodicVestingTypeURL = msgTypeURL(&vestingv1beta1.MsgCreatePeriodicVestingAccount{})
+	msgInitTypeURL                  = sdk.MsgTypeURL(&accountsv1.MsgInit{})
+	msgExecuteTypeURL               = sdk.MsgTypeURL(&accountsv1.MsgExecute{})
+)
+
+// msgTransferTypeURL is the type URL of the ibc-go fungible token transfer, which is not
+// part of the SDK and is therefore decoded from its wire format.
+const msgTransferTypeURL = "/ibc.applications.transfer.v1.MsgTransfer"
+
+// spendFuncs returns the spender and the coins spent by the messages moving funds out of
+// the spender account. They are metered against the session key spend limit when the
+// spender is the account.
+var spendFuncs = map[string]func(value []byte) (string, []*basev1beta1.Coin, error){
+	msgTypeURL(&bankv1beta1.MsgSend{}): spendFunc(func(msg *bankv1beta1.MsgSend) (string, []*basev1beta1.Coin) {
+		return msg.FromAddress, msg.Amount
+	}),
+	msgTypeURL(&bankv1beta1.MsgBurn{}): spendFunc(func(msg *bankv1beta1.MsgBurn) (string, []*basev1beta1.Coin) {
+		return msg.FromAddress, msg.Amount
+	}),
+	msgTypeURL(&stakingv1beta1.MsgDelegate{}): spendFunc(func(msg *stakingv1beta1.MsgDelegate) (string, []*basev1beta1.Coin) {
+		return msg.DelegatorAddress, []*basev1beta1.Coin{msg.Amount}
+	}),
+	msgTypeURL(&govv1.MsgSubmitProposal{}): spendFunc(func(msg *govv1.MsgSubmitProposal) (string, []*basev1beta1.Coin) {
+		return msg.Proposer, msg.InitialDeposit
+	}),
+	msgTypeURL(&govv1.MsgSubmitMultipleChoiceProposal{}): spendFunc(func(msg *govv1.MsgSubmitMultipleChoiceProposal) (string, []*basev1beta1.Coin) {
+		return msg.Proposer, msg.InitialDeposit
+	}),
+	msgTypeURL(&govv1.MsgDeposit{}): spendFunc(func(msg *govv1.MsgDeposit) (string, []*basev1beta1.Coin) {
+		return msg.Depositor, msg.Amount
+	}),
+	msgTypeURL(&distributionv1beta1.MsgFundCommunityPool{}): spendFunc(func(msg *distributionv1beta1.MsgFundCommunityPool) (string, []*basev1beta1.Coin) {
+		return msg.Depositor, msg.Amount
+	}),
+	msgTypeURL(&distributionv1beta1.MsgDepositValidatorRewardsPool{}): spendFunc(func(msg *distributionv1beta1.MsgDepositValidatorRewardsPool) (string, []*basev1beta1.Coin) {
+		return msg.Depositor, msg.Amount
+	}),
+	msgTypeURL(&protocolpoolv1.MsgFundCommunityPool{}): spendFunc(func(msg *protocolpoolv1.MsgFundCommunityPool) (string, []*basev1beta1.Coin) {
+		return msg.Depositor, msg.Amount
+	}),
+	msgTypeURL(&vestingv1beta1.MsgCreateVestingAccount{}): spendFunc(func(msg *vestingv1beta1.MsgCreateVestingAccount) (string, []*basev1beta1.Coin) {
+		return msg.FromAddress, msg.Amount
+	}),
+	msgTypeURL(&vestingv1beta1.MsgCreatePermanentLockedAccount{}): spendFunc(func(msg *vestingv1beta1.MsgCreatePermanentLockedAccount) (string, []*basev1beta1.Coin) {
+		return msg.FromAddress, msg.Amount
+	}),
+	msgCreatePeriodicVestingTypeURL: spendFunc(func(msg *vestingv1beta1.MsgCreatePeriodicVestingAccount) (string, []*basev1beta1.Coin) {
+		var coins []*basev1beta1.Coin
+		for _, period := range msg.VestingPeriods {
+			coins = append(coins, period.Amount...)
+		}
+		return msg.FromAddress, coins
+	}),
+	msgTransferTypeURL: decodeTransfer,
+}
+
+// unmeteredMsgs are the message types moving funds of the account in a way which cannot be
+// metered against a spend limit, such as granting another address to spend them, so session
+// keys cannot be allowed to sign them.
+var unmeteredMsgs = map[string]struct{}{
+	msgTypeURL(&authzv1beta1.MsgGrant{}):                     {},
+	msgTypeURL(&feegrantv1beta1.MsgGrantAllowance{}):         {},
+	msgTypeURL(&distributionv1beta1.MsgSetWithdrawAddress{}): {},
+	msgTypeURL(&stakingv1beta1.MsgCreateValidator{}):         {},
+}
+
+// NewAccount returns a new session key account creator function.
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			SessionKeys:     collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+			addrCodec:       deps.AddressCodec,
+			signingHandlers: handlerMap,
+			hs:              deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements an account owned by a single secp256k1 key, which can grant
+// scoped and expiring session keys to other secp256k1 keys.
+type Account struct {
+	PubKey   collections.Item[secp256k1.PubKey]
+	Sequence collections.Sequence
+	// SessionKeys maps the session key pubkey bytes to its scope.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+// Init initializes the account with the owner pubkey and the provided session keys.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if err := a.verifyAndSetPubKey(ctx, msg.PubKey); err != nil {
+		return nil, err
+	}
+
+	for _, sessionKey := range msg.SessionKeys {
+		if err := a.setSessionKey(ctx, sessionKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.MsgInitResponse{}, nil
+}
+
+// SwapPubKey replaces the owner pubkey, session keys are left untouched.
+func (a Account) SwapPubKey(ctx context.Context, msg *basev1.MsgSwapPubKey) (*basev1.MsgSwapPubKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &basev1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
+}
+
+// AddSessionKey adds a session key to the account, if a session key with the same
+// pubkey already exists then it is replaced.
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.setSessionKey(ctx, msg.SessionKey)
+}
+
+// RevokeSessionKey removes a session key from the account.
+func (a Account) RevokeSessionKey(ctx context.Context, msg *v1.MsgRevokeSessionKey) (*v1.MsgRevokeSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("session key not found")
+	}
+
+	return &v1.MsgRevokeSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey)
+}
+
+func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
+	_, err := dcrd_secp256k1.ParsePubKey(key)
+	if err != nil {
+		return err
+	}
+	return a.PubKey.Set(ctx, secp256k1.PubKey{Key: key})
+}
+
+func (a Account) setSessionKey(ctx context.Context, sessionKey v1.SessionKey) error {
+	if _, err := dcrd_secp256k1.ParsePubKey(sessionKey.PubKey); err != nil {
+		return fmt.Errorf("invalid session key pubkey: %w", err)
+	}
+
+	ownerPk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(ownerPk.Key, sessionKey.PubKey) {
+		return errors.New("session key cannot be the owner pubkey")
+	}
+
+	if len(sessionKey.AllowedMsgs) == 0 {
+		return errors.New("session key must allow at least one message type")
+	}
+	seen := make(map[string]struct{}, len(sessionKey.AllowedMsgs))
+	for _, typeURL := range sessionKey.AllowedMsgs {
+		if typeURL == "" {
+			return errors.New("empty allowed message type url")
+		}
+		if _, ok := seen[typeURL]; ok {
+			return fmt.Errorf("duplicate allowed message type url: %s", typeURL)
+		}
+		if _, ok := unmeteredMsgs[typeURL]; ok {
+			return fmt.Errorf("message type cannot be metered by a session key: %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	if err := sessionKey.SpendLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid spend limit: %w", err)
+	}
+
+	if !sessionKey.Expiry.After(a.hs.HeaderInfo(ctx).Time) {
+		return errors.New("session key expiry must be in the future")
+	}
+
+	return a.SessionKeys.Set(ctx, sessionKey.PubKey, sessionKey)
+}
+
+// Authenticate implements the authentication flow of an abstracted session key account.
+// The tx can be signed either by the owner key, which is unrestricted, or by one of the
+// session keys, in which case the tx must respect the session key scope.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+
+	pubKey, sessionKey, err := a.getSigningKey(ctx, signerInfo.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signerData, err := a.computeSignerData(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := a.getTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := signerInfo.Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := parseSignMode(signerInfo.ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signature := msg.Tx.Signatures[msg.SignerIndex]
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	// the owner key is not restricted.
+	if sessionKey == nil {
+		return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.checkSessionKeyScope(ctx, *sessionKey, msg)
+}
+
+// getSigningKey returns the key which signed the tx, if the signer is a session key then
+// the session key is returned too. When no pubkey is provided in the signer info, the owner
+// key is assumed.
+func (a Account) getSigningKey(ctx context.Context, pkAny *codectypes.Any) (secp256k1.PubKey, *v1.SessionKey, error) {
+	ownerPk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return secp256k1.PubKey{}, nil, err
+	}
+
+	if pkAny == nil {
+		return ownerPk, nil, nil
+	}
+
+	signerPk := new(secp256k1.PubKey)
+	if pkAny.TypeUrl != sdk.MsgTypeURL(signerPk) {
+		return secp256k1.PubKey{}, nil, fmt.Errorf("unsupported pubkey type: %s", pkAny.TypeUrl)
+	}
+	if err := signerPk.Unmarshal(pkAny.Value); err != nil {
+		return secp256k1.PubKey{}, nil, err
+	}
+
+	if bytes.Equal(signerPk.Key, ownerPk.Key) {
+		return ownerPk, nil, nil
+	}
+
+	sessionKey, err := a.SessionKeys.Get(ctx, signerPk.Key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return secp256k1.PubKey{}, nil, errors.New("signer is neither the owner nor a session key")
+		}
+		return secp256k1.PubKey{}, nil, err
+	}
+
+	return *signerPk, &sessionKey, nil
+}
+
+// checkSessionKeyScope verifies the tx is allowed by the session key scope, and deducts
+// the coins spent by the tx from the session key spend limit.
+func (a Account) checkSessionKeyScope(ctx context.Context, sessionKey v1.SessionKey, msg *aa_interface_v1.MsgAuthenticate) error {
+	if !a.hs.HeaderInfo(ctx).Time.Before(sessionKey.Expiry) {
+		return errors.New("session key expired")
+	}
+
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	allowed := make(map[string]struct{}, len(sessionKey.AllowedMsgs))
+	for _, typeURL := range sessionKey.AllowedMsgs {
+		allowed[typeURL] = struct{}{}
+	}
+
+	spent := sdk.NewCoins()
+	for _, anyMsg := range msg.Tx.Body.Messages {
+		coins, err := a.spentByMsg(addrStr, allowed, anyMsg.TypeUrl, anyMsg.Value)
+		if err != nil {
+			return err
+		}
+		spent = spent.Add(coins...)
+	}
+
+	// the first signer pays the fees, unless a payer or a granter is set.
+	fee := msg.Tx.AuthInfo.Fee
+	if fee != nil && fee.Granter == "" && (fee.Payer == addrStr || (fee.Payer == "" && msg.SignerIndex == 0)) {
+		spent = spent.Add(fee.Amount...)
+	}
+
+	if spent.IsZero() {
+		return nil
+	}
+
+	remaining, hasNeg := sessionKey.SpendLimit.SafeSub(spent...)
+	if hasNeg {
+		return fmt.Errorf("session key spend limit exceeded: spent %s, limit %s", spent, sessionKey.SpendLimit)
+	}
+	sessionKey.SpendLimit = remaining
+
+	return a.SessionKeys.Set(ctx, sessionKey.PubKey, sessionKey)
+}
+
+// spentByMsg checks the message type is allowed by the session key and returns the coins
+// the message spends on behalf of the account. The messages nested in an authz MsgExec are
+// checked and metered too, as the account can execute them on its own behalf.
+func (a Account) spentByMsg(addrStr string, allowed map[string]struct{}, typeURL string, value []byte) (sdk.Coins, error) {
+	if _, ok := allowed[typeURL]; !ok {
+		return nil, fmt.Errorf("message type not allowed by session key: %s", typeURL)
+	}
+
+	switch typeURL {
+	case msgAuthzExecTypeURL:
+		msgExec := new(authzv1beta1.MsgExec)
+		if err := proto.Unmarshal(value, msgExec); err != nil {
+			return nil, err
+		}
+		spent := sdk.NewCoins()
+		for _, nested := range msgExec.Msgs {
+			coins, err := a.spentByMsg(addrStr, allowed, nested.TypeUrl, nested.Value)
+			if err != nil {
+				return nil, err
+			}
+			spent = spent.Add(coins...)
+		}
+		return spent, nil
+	case msgMultiSendTypeURL:
+		msgMultiSend := new(bankv1beta1.MsgMultiSend)
+		if err := proto.Unmarshal(value, msgMultiSend); err != nil {
+			return nil, err
+		}
+		spent := sdk.NewCoins()
+		for _, input := range msgMultiSend.Inputs {
+			if input.Address != addrStr {
+				continue
+			}
+			coins, err := toSDKCoins(input.Coins)
+			if err != nil {
+				return nil, err
+			}
+			spent = spent.Add(coins...)
+		}
+		return spent, nil
+	case msgInitTypeURL:
+		msgInit := new(accountsv1.MsgInit)
+		if err := msgInit.Unmarshal(value); err != nil {
+			return nil, err
+		}
+		if msgInit.Sender != addrStr {
+			return nil, nil
+		}
+		return msgInit.Funds, nil
+	case msgExecuteTypeURL:
+		msgExecute := new(accountsv1.MsgExecute)
+		if err := msgExecute.Unmarshal(value); err != nil {
+			return nil, err
+		}
+		if msgExecute.Sender != addrStr {
+			return nil, nil
+		}
+		// a session key executing messages on the account itself would be able
+		// to manage its own scope.
+		if msgExecute.Target == addrStr {
+			return nil, errors.New("session key cannot execute messages on its own account")
+		}
+		return msgExecute.Funds, nil
+	}
+
+	spend, ok := spendFuncs[typeURL]
+	if !ok {
+		return nil, nil
+	}
+	spender, coins, err := spend(value)
+	if err != nil {
+		return nil, err
+	}
+	if spender != addrStr {
+		return nil, nil
+	}
+	return toSDKCoins(coins)
+}
+
+// spendFunc adapts a function returning the spender and the coins spent by a message
+// into a function decoding the message first.
+func spendFunc[T any, PT interface {
+	*T
+	proto.Message
+}](fn func(PT) (string, []*basev1beta1.Coin),
+) func([]byte) (string, []*basev1beta1.Coin, error) {
+	return func(value []byte) (string, []*basev1beta1.Coin, error) {
+		msg := PT(new(T))
+		if err := proto.Unmarshal(value, msg); err != nil {
+			return "", nil, err
+		}
+		spender, coins := fn(msg)
+		return spender, coins, nil
+	}
+}
+
+// decodeTransfer returns the sender and the tokens of an ibc-go MsgTransfer, these are
+// the token (3), sender (4) and tokens (9) fields.
+func decodeTransfer(value []byte) (string, []*basev1beta1.Coin, error) {
+	var (
+		sender string
+		coins  []*basev1beta1.Coin
+	)
+	for len(value) > 0 {
+		num, typ, n := protowire.ConsumeTag(value)
+		if n < 0 {
+			return "", nil, protowire.ParseError(n)
+		}
+		value = value[n:]
+
+		if typ != protowire.BytesType || (num != 3 && num != 4 && num != 9) {
+			n = protowire.ConsumeFieldValue(num, typ, value)
+			if n < 0 {
+				return "", nil, protowire.ParseError(n)
+			}
+			value = value[n:]
+			continue
+		}
+
+		field, n := protowire.ConsumeBytes(value)
+		if n < 0 {
+			return "", nil, protowire.ParseError(n)
+		}
+		value = value[n:]
+
+		if num == 4 {
+			sender = string(field)
+			continue
+		}
+		coin := new(basev1beta1.Coin)
+		if err := proto.Unmarshal(field, coin); err != nil {
+			return "", nil, err
+		}
+		coins = append(coins, coin)
+	}
+	return sender, coins, nil
+}
+
+func msgTypeURL(msg proto.Message) string {
+	return "/" + string(msg.ProtoReflect().Descriptor().FullName())
+}
+
+func toSDKCoins(coins []*basev1beta1.Coin) (sdk.Coins, error) {
+	res := sdk.NewCoins()
+	for _, coin := range coins {
+		if coin == nil {
+			continue
+		}
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid coin amount: %s", coin.Amount)
+		}
+		res = res.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return res, nil
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+// The sequence is shared between the owner and the session keys.
+func (a Account) computeSignerData(ctx context.Context, pk secp256k1.PubKey) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+	chainID := a.hs.HeaderInfo(ctx).ChainID
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(&pk)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := a.getNumber(ctx, addrStr)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      wantSequence,
+		PubKey: &anypb.Any{
+			TypeUrl: pkAny.TypeUrl,
+			Value:   pkAny.Value,
+		},
+	}, nil
+}
+
+func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error) {
+	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return 0, err
+	}
+
+	return accNum.Number, nil
+}
+
+func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:                       txBody,
+		AuthInfo:                   authInfo,
+		BodyBytes:                  msg.RawTx.BodyBytes,
+		AuthInfoBytes:              msg.RawTx.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: false, // NOTE: amino signing must be disabled.
+	}, nil
+}
+
+// QuerySequence returns the sequence of the account.
+func (a Account) QuerySequence(ctx context.Context, _ *basev1.QuerySequence) (*basev1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &basev1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+// QuerySessionKeys returns all the session keys of the account, including expired ones.
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	var sessionKeys []v1.SessionKey
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, sessionKey v1.SessionKey) (stop bool, err error) {
+		sessionKeys = append(sessionKeys, sessionKey)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RevokeSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+}
@@ -0,0 +1,496 @@
+package sessionkey
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const accAddr = "mock_session_key_account"
+
+func setupSessionKeyAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	handler := directHandler{}
+
+	createAccFn := NewAccount(SESSION_KEY_ACCOUNT, signing.NewHandlerMap(handler))
+	_, acc, err := createAccFn(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func newSessionKey(pk []byte) v1.SessionKey {
+	return v1.SessionKey{
+		PubKey:      pk,
+		AllowedMsgs: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Expiry:      blockTime.Add(time.Hour),
+	}
+}
+
+// signTx builds a tx containing the provided messages and fee, signed by privKey
+// with the provided sequence.
+func signTx(t *testing.T, privKey *secp256k1.PrivKey, seq uint64, fee *tx.Fee, msgs ...sdk.Msg) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	anyMsgs := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anyMsgs[i], err = codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+	}
+	return signAnyTx(t, privKey, seq, fee, anyMsgs...)
+}
+
+// signAnyTx is like signTx for messages which are already packed.
+func signAnyTx(t *testing.T, privKey *secp256k1.PrivKey, seq uint64, fee *tx.Fee, anyMsgs ...*codectypes.Any) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	pkAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
+
+	transaction := tx.Tx{
+		Body: &tx.TxBody{Messages: anyMsgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: pkAny,
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: seq,
+				},
+			},
+			Fee: fee,
+		},
+	}
+
+	bodyByte, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authByte, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	txDoc := tx.SignDoc{
+		BodyBytes:     bodyByte,
+		AuthInfoBytes: authByte,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}
+	signBytes, err := txDoc.Marshal()
+	require.NoError(t, err)
+
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sig}
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	}
+}
+
+// packAPIMsg packs a message using its api type, for the modules which are not
+// dependencies of x/accounts.
+func packAPIMsg(t *testing.T, msg protov2.Message) *codectypes.Any {
+	t.Helper()
+	bz, err := protov2.Marshal(msg)
+	require.NoError(t, err)
+	return &codectypes.Any{TypeUrl: msgTypeURL(msg), Value: bz}
+}
+
+func TestInit(t *testing.T) {
+	ownerPk := secp256k1.GenPrivKey().PubKey().Bytes()
+	sessionPk := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	testcases := []struct {
+		name     string
+		msg      *v1.MsgInit
+		isExpErr bool
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{
+				PubKey:      ownerPk,
+				SessionKeys: []v1.SessionKey{newSessionKey(sessionPk)},
+			},
+			false,
+		},
+		{
+			"invalid pubkey",
+			&v1.MsgInit{
+				PubKey: []byte("invalid_pk"),
+			},
+			true,
+		},
+		{
+			"invalid session key pubkey",
+			&v1.MsgInit{
+				PubKey:      ownerPk,
+				SessionKeys: []v1.SessionKey{newSessionKey([]byte("invalid_pk"))},
+			},
+			true,
+		},
+		{
+			"session key is the owner key",
+			&v1.MsgInit{
+				PubKey:      ownerPk,
+				SessionKeys: []v1.SessionKey{newSessionKey(ownerPk)},
+			},
+			true,
+		},
+		{
+			"session key without allowed messages",
+			&v1.MsgInit{
+				PubKey: ownerPk,
+				SessionKeys: []v1.SessionKey{func() v1.SessionKey {
+					sk := newSessionKey(sessionPk)
+					sk.AllowedMsgs = nil
+					return sk
+				}()},
+			},
+			true,
+		},
+		{
+			"session key allowed to grant authorizations",
+			&v1.MsgInit{
+				PubKey: ownerPk,
+				SessionKeys: []v1.SessionKey{func() v1.SessionKey {
+					sk := newSessionKey(sessionPk)
+					sk.AllowedMsgs = append(sk.AllowedMsgs, "/cosmos.authz.v1beta1.MsgGrant")
+					return sk
+				}()},
+			},
+			true,
+		},
+		{
+			"session key already expired",
+			&v1.MsgInit{
+				PubKey: ownerPk,
+				SessionKeys: []v1.SessionKey{func() v1.SessionKey {
+					sk := newSessionKey(sessionPk)
+					sk.Expiry = blockTime
+					return sk
+				}()},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupSessionKeyAccount(t, ss)
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.isExpErr {
+				require.NotNil(t, err, tc.name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAddRevokeSessionKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupSessionKeyAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+	})
+	require.NoError(t, err)
+
+	sessionKey := newSessionKey(secp256k1.GenPrivKey().PubKey().Bytes())
+
+	// only the account itself can add session keys
+	ctx = accountstd.SetSender(ctx, []byte("sender"))
+	_, err = acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.Equal(t, errors.New("unauthorized"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte(accAddr))
+	_, err = acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.NoError(t, err)
+
+	resp, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, resp.SessionKeys, 1)
+	require.Equal(t, sessionKey.PubKey, resp.SessionKeys[0].PubKey)
+
+	// only the account itself can revoke session keys
+	ctx = accountstd.SetSender(ctx, []byte("sender"))
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionKey.PubKey})
+	require.Equal(t, errors.New("unauthorized"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte(accAddr))
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionKey.PubKey})
+	require.NoError(t, err)
+
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionKey.PubKey})
+	require.Equal(t, errors.New("session key not found"), err)
+
+	resp, err = acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, resp.SessionKeys, 0)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupSessionKeyAccount(t, ss)
+
+	ownerKey := secp256k1.GenPrivKey()
+	sessionPrivKey := secp256k1.GenPrivKey()
+	sessionKey := newSessionKey(sessionPrivKey.PubKey().Bytes())
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:      ownerKey.PubKey().Bytes(),
+		SessionKeys: []v1.SessionKey{sessionKey},
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	send := func(amount int64) *banktypes.MsgSend {
+		return &banktypes.MsgSend{
+			FromAddress: accAddr,
+			ToAddress:   "recipient",
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+		}
+	}
+	fee := &tx.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+
+	// the owner is not restricted
+	_, err = acc.Authenticate(ctx, signTx(t, ownerKey, 0, fee, send(1000), &basev1.MsgSwapPubKey{}))
+	require.NoError(t, err)
+
+	// session key within its scope, spends 40 + 10 of fees
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 1, fee, send(40)))
+	require.NoError(t, err)
+
+	resp, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Equal(t, "50stake", resp.SessionKeys[0].SpendLimit.String())
+
+	// session key spending more than its limit
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 2, fee, send(41)))
+	require.ErrorContains(t, err, "spend limit exceeded")
+
+	// session key signing a message which is not allowed
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 3, nil, &basev1.MsgSwapPubKey{}))
+	require.ErrorContains(t, err, "message type not allowed")
+
+	// unknown key
+	_, err = acc.Authenticate(ctx, signTx(t, secp256k1.GenPrivKey(), 0, nil, send(1)))
+	require.Equal(t, errors.New("signer is neither the owner nor a session key"), err)
+
+	// invalid signature
+	msg := signTx(t, sessionPrivKey, 4, nil, send(1))
+	msg.RawTx.BodyBytes = []byte("invalid_msg")
+	_, err = acc.Authenticate(ctx, msg)
+	require.Error(t, err)
+
+	// expired session key
+	sessionKey.Expiry = blockTime
+	require.NoError(t, acc.SessionKeys.Set(ctx, sessionKey.PubKey, sessionKey))
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 5, nil, send(1)))
+	require.Equal(t, errors.New("session key expired"), err)
+
+	// only the accounts module can authenticate
+	ctx = accountstd.SetSender(ctx, []byte("sender"))
+	_, err = acc.Authenticate(ctx, signTx(t, ownerKey, 6, nil, send(1)))
+	require.Equal(t, errors.New("unauthorized: only accounts module is allowed to call this"), err)
+}
+
+func TestAuthenticateMeteredMessages(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupSessionKeyAccount(t, ss)
+
+	sessionPrivKey := secp256k1.GenPrivKey()
+	sessionKey := newSessionKey(sessionPrivKey.PubKey().Bytes())
+	sessionKey.AllowedMsgs = append(sessionKey.AllowedMsgs,
+		"/cosmos.authz.v1beta1.MsgExec",
+		"/cosmos.staking.v1beta1.MsgDelegate",
+		msgTransferTypeURL,
+	)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:      secp256k1.GenPrivKey().PubKey().Bytes(),
+		SessionKeys: []v1.SessionKey{sessionKey},
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	spendLimit := func() string {
+		resp, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+		require.NoError(t, err)
+		return resp.SessionKeys[0].SpendLimit.String()
+	}
+	stake := func(amount string) *basev1beta1.Coin {
+		return &basev1beta1.Coin{Denom: "stake", Amount: amount}
+	}
+	send := func(from, amount string) *anypb.Any {
+		bz, err := protov2.Marshal(&bankv1beta1.MsgSend{FromAddress: from, ToAddress: "recipient", Amount: []*basev1beta1.Coin{stake(amount)}})
+		require.NoError(t, err)
+		return &anypb.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: bz}
+	}
+	delegate := func(amount string) *codectypes.Any {
+		return packAPIMsg(t, &stakingv1beta1.MsgDelegate{DelegatorAddress: accAddr, ValidatorAddress: "validator", Amount: stake(amount)})
+	}
+
+	// the messages executed through authz on behalf of the account are metered, the ones
+	// executed on behalf of other granters are not.
+	_, err = acc.Authenticate(ctx, signAnyTx(t, sessionPrivKey, 0, nil, packAPIMsg(t, &authzv1beta1.MsgExec{
+		Grantee: accAddr,
+		Msgs:    []*anypb.Any{send(accAddr, "30"), send("granter", "1000")},
+	})))
+	require.NoError(t, err)
+	require.Equal(t, "70stake", spendLimit())
+
+	// nested messages must be allowed too
+	multiSend, err := protov2.Marshal(&bankv1beta1.MsgMultiSend{})
+	require.NoError(t, err)
+	_, err = acc.Authenticate(ctx, signAnyTx(t, sessionPrivKey, 1, nil, packAPIMsg(t, &authzv1beta1.MsgExec{
+		Grantee: accAddr,
+		Msgs:    []*anypb.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", Value: multiSend}},
+	})))
+	require.ErrorContains(t, err, "message type not allowed")
+
+	// delegations are metered
+	_, err = acc.Authenticate(ctx, signAnyTx(t, sessionPrivKey, 2, nil, delegate("71")))
+	require.ErrorContains(t, err, "spend limit exceeded")
+
+	_, err = acc.Authenticate(ctx, signAnyTx(t, sessionPrivKey, 3, nil, delegate("50")))
+	require.NoError(t, err)
+	require.Equal(t, "20stake", spendLimit())
+
+	// ibc transfers are metered, the token (3) and sender (4) fields are encoded by hand
+	token, err := protov2.Marshal(stake("21"))
+	require.NoError(t, err)
+	transfer := protowire.AppendTag(nil, 3, protowire.BytesType)
+	transfer = protowire.AppendBytes(transfer, token)
+	transfer = protowire.AppendTag(transfer, 4, protowire.BytesType)
+	transfer = protowire.AppendString(transfer, accAddr)
+	_, err = acc.Authenticate(ctx, signAnyTx(t, sessionPrivKey, 4, nil, &codectypes.Any{TypeUrl: msgTransferTypeURL, Value: transfer}))
+	require.ErrorContains(t, err, "spend limit exceeded")
+}
+
+func TestCheckSessionKeyScopeFees(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	testCases := []struct {
+		name        string
+		payer       string
+		granter     string
+		signerIndex uint32
+		noFee       bool
+		expLimit    string
+	}{
+		{
+			name:     "first signer without payer pays the fees",
+			expLimit: "50stake",
+		},
+		{
+			name:        "secondary signer without payer does not pay the fees",
+			signerIndex: 1,
+			expLimit:    "60stake",
+		},
+		{
+			name:        "secondary signer set as payer pays the fees",
+			payer:       accAddr,
+			signerIndex: 1,
+			expLimit:    "50stake",
+		},
+		{
+			name:     "first signer set as payer pays the fees",
+			payer:    accAddr,
+			expLimit: "50stake",
+		},
+		{
+			name:     "first signer does not pay the fees of another payer",
+			payer:    "payer",
+			expLimit: "60stake",
+		},
+		{
+			name:     "first signer does not pay the fees of a granter",
+			granter:  "granter",
+			expLimit: "60stake",
+		},
+		{
+			name:     "payer does not pay the fees of a granter",
+			payer:    accAddr,
+			granter:  "granter",
+			expLimit: "60stake",
+		},
+		{
+			name:     "no fee",
+			noFee:    true,
+			expLimit: "60stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupSessionKeyAccount(t, ss)
+
+			sessionKey := newSessionKey(secp256k1.GenPrivKey().PubKey().Bytes())
+			_, err := acc.Init(ctx, &v1.MsgInit{
+				PubKey:      secp256k1.GenPrivKey().PubKey().Bytes(),
+				SessionKeys: []v1.SessionKey{sessionKey},
+			})
+			require.NoError(t, err)
+
+			send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+				FromAddress: accAddr,
+				ToAddress:   "recipient",
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			})
+			require.NoError(t, err)
+
+			authInfo := &tx.AuthInfo{Fee: &tx.Fee{Amount: fee, Payer: tc.payer, Granter: tc.granter}}
+			if tc.noFee {
+				authInfo.Fee = nil
+			}
+			msg := &aa_interface_v1.MsgAuthenticate{
+				Tx: &tx.Tx{
+					Body:     &tx.TxBody{Messages: []*codectypes.Any{send}},
+					AuthInfo: authInfo,
+				},
+				SignerIndex: tc.signerIndex,
+			}
+
+			require.NoError(t, acc.checkSessionKeyScope(ctx, sessionKey, msg))
+
+			got, err := acc.SessionKeys.Get(ctx, sessionKey.PubKey)
+			require.NoError(t, err)
+			require.Equal(t, tc.expLimit, got.SpendLimit.String())
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/subscription/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var SUBSCRIPTION_ACCOUNT = "subscription-account"
+
+var (
+	OwnerPrefix          = collections.NewPrefix(0)
+	SubscriptionIDPrefix = collections.NewPrefix(1)
+	SubscriptionsPrefix  = collections.NewPrefix(2)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = Account{}
+)
+
+// Account holds funds of an owner and pays them out to payees through recurring
+// payments authorized by the owner. Payees pull the due amounts by claiming them.
+type Account struct {
+	Owner          collections.Item[[]byte]
+	SubscriptionID collections.Sequence
+	Subscriptions  collections.Map[uint64, v1.Subscription]
+
+	addrCodec     address.Codec
+	headerService header.Service
+	eventService  event.Service
+}
+
+// NewAccount returns a new subscription account creator function.
+func NewAccount(deps accountstd.Dependencies) (*Account, error) {
+	return &Account{
+		Owner:          collections.NewItem(deps.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+		SubscriptionID: collections.NewSequence(deps.SchemaBuilder, SubscriptionIDPrefix, "subscription_id"),
+		Subscriptions:  collections.NewMap(deps.SchemaBuilder, SubscriptionsPrefix, "subscriptions", collections.Uint64Key, codec.CollValue[v1.Subscription](deps.LegacyStateCodec)),
+		addrCodec:      deps.AddressCodec,
+		headerService:  deps.Environment.HeaderService,
+		eventService:   deps.Environment.EventService,
+	}, nil
+}
+
+// Init initializes the subscription account with its owner.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	owner, err := a.addrCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner address: %w", err)
+	}
+
+	return &v1.MsgInitResponse{}, a.Owner.Set(ctx, owner)
+}
+
+// AddSubscription authorizes a new recurring payment, the sender must be the owner.
+func (a Account) AddSubscription(ctx context.Context, msg *v1.MsgAddSubscription) (*v1.MsgAddSubscriptionResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	sub := msg.Subscription
+	if _, err := a.addrCodec.StringToBytes(sub.Payee); err != nil {
+		return nil, fmt.Errorf("invalid payee address: %w", err)
+	}
+	if !sub.Amount.IsValid() || sub.Amount.IsZero() {
+		return nil, fmt.Errorf("invalid amount: %s", sub.Amount)
+	}
+	if sub.Period <= 0 {
+		return nil, errors.New("period must be greater than zero")
+	}
+	if sub.EndTime.Sub(sub.StartTime) < sub.Period {
+		return nil, errors.New("end time must be at least one period after start time")
+	}
+	if !sub.EndTime.After(a.headerService.HeaderInfo(ctx).Time) {
+		return nil, errors.New("end time must be in the future")
+	}
+	if sub.ClaimedPeriods != 0 {
+		return nil, errors.New("claimed periods must be zero")
+	}
+
+	id, err := a.SubscriptionID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("subscription_added",
+		event.NewAttribute("subscription_id", fmt.Sprint(id)),
+		event.NewAttribute("payee", sub.Payee),
+		event.NewAttribute("amount", sub.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgAddSubscriptionResponse{SubscriptionId: id}, a.Subscriptions.Set(ctx, id, sub)
+}
+
+// CancelSubscription stops a recurring payment, the sender must be the owner.
+func (a Account) CancelSubscription(ctx context.Context, msg *v1.MsgCancelSubscription) (*v1.MsgCancelSubscriptionResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	has, err := a.Subscriptions.Has(ctx, msg.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("subscription %d not found", msg.SubscriptionId)
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("subscription_cancelled",
+		event.NewAttribute("subscription_id", fmt.Sprint(msg.SubscriptionId)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelSubscriptionResponse{}, a.Subscriptions.Remove(ctx, msg.SubscriptionId)
+}
+
+// Claim sends the due amount of a subscription to its payee, the sender must be the payee.
+// Once every period is paid the subscription is removed.
+func (a Account) Claim(ctx context.Context, msg *v1.MsgClaim) (*v1.MsgClaimResponse, error) {
+	sub, err := a.Subscriptions.Get(ctx, msg.SubscriptionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("subscription %d not found", msg.SubscriptionId)
+		}
+		return nil, err
+	}
+
+	payee, err := a.addrCodec.StringToBytes(sub.Payee)
+	if err != nil {
+		return nil, err
+	}
+	if !accountstd.HasSender(ctx, payee) {
+		return nil, errors.New("sender is not the payee of the subscription")
+	}
+
+	now := a.headerService.HeaderInfo(ctx).Time
+	duePeriods := elapsedPeriods(sub, now) - sub.ClaimedPeriods
+	if duePeriods == 0 {
+		return nil, errors.New("no amount due")
+	}
+
+	amount := dueAmount(sub, duePeriods)
+	if err := accountstd.SendCoins(ctx, a.addrCodec, payee, amount); err != nil {
+		return nil, err
+	}
+
+	sub.ClaimedPeriods += duePeriods
+	if err = a.eventService.EventManager(ctx).EmitKV("subscription_claimed",
+		event.NewAttribute("subscription_id", fmt.Sprint(msg.SubscriptionId)),
+		event.NewAttribute("payee", sub.Payee),
+		event.NewAttribute("amount", amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	if sub.ClaimedPeriods == elapsedPeriods(sub, sub.EndTime) {
+		return &v1.MsgClaimResponse{AmountClaimed: amount}, a.Subscriptions.Remove(ctx, msg.SubscriptionId)
+	}
+
+	return &v1.MsgClaimResponse{AmountClaimed: amount}, a.Subscriptions.Set(ctx, msg.SubscriptionId, sub)
+}
+
+// Withdraw sends funds out of the account, the sender must be the owner.
+func (a Account) Withdraw(ctx context.Context, msg *v1.MsgWithdraw) (*v1.MsgWithdrawResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	to, err := a.addrCodec.StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to address: %w", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, fmt.Errorf("invalid amount: %s", msg.Amount)
+	}
+
+	return &v1.MsgWithdrawResponse{}, accountstd.SendCoins(ctx, a.addrCodec, to, msg.Amount)
+}
+
+func (a Account) checkOwner(ctx context.Context) error {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !accountstd.HasSender(ctx, owner) {
+		return errors.New("sender is not the owner of the account")
+	}
+	return nil
+}
+
+// elapsedPeriods returns the number of periods of the subscription which ended at the
+// provided time, periods ending after the subscription end time are not counted.
+func elapsedPeriods(sub v1.Subscription, t time.Time) uint64 {
+	if t.After(sub.EndTime) {
+		t = sub.EndTime
+	}
+	if !t.After(sub.StartTime) {
+		return 0
+	}
+	return uint64(t.Sub(sub.StartTime) / sub.Period)
+}
+
+func dueAmount(sub v1.Subscription, periods uint64) sdk.Coins {
+	return sub.Amount.MulInt(math.NewIntFromUint64(periods))
+}
+
+// QueryOwner returns the owner of the account.
+func (a Account) QueryOwner(ctx context.Context, _ *v1.QueryOwner) (*v1.QueryOwnerResponse, error) {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerAddr, err := a.addrCodec.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryOwnerResponse{Owner: ownerAddr}, nil
+}
+
+// QuerySubscription returns a subscription and the amount its payee can claim.
+func (a Account) QuerySubscription(ctx context.Context, msg *v1.QuerySubscription) (*v1.QuerySubscriptionResponse, error) {
+	sub, err := a.Subscriptions.Get(ctx, msg.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	duePeriods := elapsedPeriods(sub, a.headerService.HeaderInfo(ctx).Time) - sub.ClaimedPeriods
+	return &v1.QuerySubscriptionResponse{
+		Subscription: &sub,
+		DueAmount:    dueAmount(sub, duePeriods),
+	}, nil
+}
+
+// QuerySubscriptions returns all the subscriptions of the account.
+func (a Account) QuerySubscriptions(ctx context.Context, _ *v1.QuerySubscriptions) (*v1.QuerySubscriptionsResponse, error) {
+	resp := &v1.QuerySubscriptionsResponse{}
+	err := a.Subscriptions.Walk(ctx, nil, func(id uint64, sub v1.Subscription) (stop bool, err error) {
+		resp.SubscriptionIds = append(resp.SubscriptionIds, id)
+		resp.Subscriptions = append(resp.Subscriptions, sub)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.AddSubscription)
+	accountstd.RegisterExecuteHandler(builder, a.CancelSubscription)
+	accountstd.RegisterExecuteHandler(builder, a.Claim)
+	accountstd.RegisterExecuteHandler(builder, a.Withdraw)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryOwner)
+	accountstd.RegisterQueryHandler(builder, a.QuerySubscription)
+	accountstd.RegisterQueryHandler(builder, a.QuerySubscriptions)
+}
@@ -0,0 +1,192 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/subscription/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func setupSubscriptionAccount(t *testing.T) (context.Context, *Account, *headerService, *[]*bankv1beta1.MsgSend) {
+	t.Helper()
+	sends := &[]*bankv1beta1.MsgSend{}
+	ctx, ss := newMockContext(t, sends)
+	hs := &headerService{time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	acc, err := NewAccount(makeMockDependencies(ss, hs))
+	require.NoError(t, err)
+
+	_, err = acc.Init(ctx, &v1.MsgInit{Owner: "owner"})
+	require.NoError(t, err)
+
+	return ctx, acc, hs, sends
+}
+
+func newSubscription(start time.Time) v1.Subscription {
+	return v1.Subscription{
+		Payee:     "payee",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		Period:    time.Hour,
+		StartTime: start,
+		EndTime:   start.Add(3 * time.Hour),
+	}
+}
+
+func TestAddSubscription(t *testing.T) {
+	ctx, acc, hs, _ := setupSubscriptionAccount(t)
+
+	testcases := []struct {
+		name     string
+		sender   string
+		malleate func(sub *v1.Subscription)
+		expErr   string
+	}{
+		{
+			"valid subscription",
+			"owner",
+			func(sub *v1.Subscription) {},
+			"",
+		},
+		{
+			"sender is not the owner",
+			"sender",
+			func(sub *v1.Subscription) {},
+			"sender is not the owner of the account",
+		},
+		{
+			"empty amount",
+			"owner",
+			func(sub *v1.Subscription) { sub.Amount = nil },
+			"invalid amount",
+		},
+		{
+			"zero period",
+			"owner",
+			func(sub *v1.Subscription) { sub.Period = 0 },
+			"period must be greater than zero",
+		},
+		{
+			"end time before the first period ends",
+			"owner",
+			func(sub *v1.Subscription) { sub.EndTime = sub.StartTime.Add(time.Minute) },
+			"end time must be at least one period after start time",
+		},
+		{
+			"end time in the past",
+			"owner",
+			func(sub *v1.Subscription) {
+				sub.StartTime = hs.time.Add(-3 * time.Hour)
+				sub.EndTime = hs.time
+			},
+			"end time must be in the future",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			sub := newSubscription(hs.time)
+			tc.malleate(&sub)
+			ctx := accountstd.SetSender(ctx, []byte(tc.sender))
+			_, err := acc.AddSubscription(ctx, &v1.MsgAddSubscription{Subscription: sub})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClaim(t *testing.T) {
+	ctx, acc, hs, sends := setupSubscriptionAccount(t)
+
+	ctx = accountstd.SetSender(ctx, []byte("owner"))
+	resp, err := acc.AddSubscription(ctx, &v1.MsgAddSubscription{Subscription: newSubscription(hs.time)})
+	require.NoError(t, err)
+	id := resp.SubscriptionId
+
+	// only the payee can claim
+	_, err = acc.Claim(ctx, &v1.MsgClaim{SubscriptionId: id})
+	require.Equal(t, errors.New("sender is not the payee of the subscription"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte("payee"))
+
+	// the first period has not ended yet
+	_, err = acc.Claim(ctx, &v1.MsgClaim{SubscriptionId: id})
+	require.Equal(t, errors.New("no amount due"), err)
+
+	// two periods ended
+	hs.time = hs.time.Add(2*time.Hour + time.Minute)
+	query, err := acc.QuerySubscription(ctx, &v1.QuerySubscription{SubscriptionId: id})
+	require.NoError(t, err)
+	require.Equal(t, "20stake", query.DueAmount.String())
+
+	claimResp, err := acc.Claim(ctx, &v1.MsgClaim{SubscriptionId: id})
+	require.NoError(t, err)
+	require.Equal(t, "20stake", claimResp.AmountClaimed.String())
+	require.Len(t, *sends, 1)
+	require.Equal(t, "mock_subscription_account", (*sends)[0].FromAddress)
+	require.Equal(t, "payee", (*sends)[0].ToAddress)
+	require.Equal(t, "20", (*sends)[0].Amount[0].Amount)
+
+	_, err = acc.Claim(ctx, &v1.MsgClaim{SubscriptionId: id})
+	require.Equal(t, errors.New("no amount due"), err)
+
+	// no more periods are due after the end time, the subscription is removed once fully paid
+	hs.time = hs.time.Add(10 * time.Hour)
+	claimResp, err = acc.Claim(ctx, &v1.MsgClaim{SubscriptionId: id})
+	require.NoError(t, err)
+	require.Equal(t, "10stake", claimResp.AmountClaimed.String())
+
+	subs, err := acc.QuerySubscriptions(ctx, &v1.QuerySubscriptions{})
+	require.NoError(t, err)
+	require.Len(t, subs.Subscriptions, 0)
+}
+
+func TestCancelSubscription(t *testing.T) {
+	ctx, acc, hs, _ := setupSubscriptionAccount(t)
+
+	ctx = accountstd.SetSender(ctx, []byte("owner"))
+	resp, err := acc.AddSubscription(ctx, &v1.MsgAddSubscription{Subscription: newSubscription(hs.time)})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, []byte("payee"))
+	_, err = acc.CancelSubscription(ctx, &v1.MsgCancelSubscription{SubscriptionId: resp.SubscriptionId})
+	require.Equal(t, errors.New("sender is not the owner of the account"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte("owner"))
+	_, err = acc.CancelSubscription(ctx, &v1.MsgCancelSubscription{SubscriptionId: resp.SubscriptionId})
+	require.NoError(t, err)
+
+	hs.time = hs.time.Add(time.Hour)
+	ctx = accountstd.SetSender(ctx, []byte("payee"))
+	_, err = acc.Claim(ctx, &v1.MsgClaim{SubscriptionId: resp.SubscriptionId})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestWithdraw(t *testing.T) {
+	ctx, acc, _, sends := setupSubscriptionAccount(t)
+
+	msg := &v1.MsgWithdraw{
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+	}
+
+	ctx = accountstd.SetSender(ctx, []byte("payee"))
+	_, err := acc.Withdraw(ctx, msg)
+	require.Equal(t, errors.New("sender is not the owner of the account"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte("owner"))
+	_, err = acc.Withdraw(ctx, msg)
+	require.NoError(t, err)
+	require.Len(t, *sends, 1)
+	require.Equal(t, "receiver", (*sends)[0].ToAddress)
+}
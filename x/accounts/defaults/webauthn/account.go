@@ -0,0 +1,373 @@
+package webauthn
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var WEBAUTHN_ACCOUNT = "webauthn-account"
+
+var (
+	PubKeyPrefix   = collections.NewPrefix(0)
+	SequencePrefix = collections.NewPrefix(1)
+	ConfigPrefix   = collections.NewPrefix(2)
+)
+
+const (
+	// clientDataTypeGet is the client data type of WebAuthn assertions.
+	clientDataTypeGet = "webauthn.get"
+
+	// authenticator data flags, see https://www.w3.org/TR/webauthn-2/#flags.
+	flagUserPresent  byte = 1 << 0
+	flagUserVerified byte = 1 << 2
+
+	// minAuthenticatorDataLen is the length of the rp id hash, the flags and the sign counter.
+	minAuthenticatorDataLen = 37
+)
+
+// NewAccount returns a new webauthn account creator function.
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256r1.PubKey](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			Config:          collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			addrCodec:       deps.AddressCodec,
+			signingHandlers: handlerMap,
+			hs:              deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements an account authenticated by a passkey, it verifies WebAuthn
+// assertions made by a secp256r1 (P-256) key over the tx sign bytes.
+type Account struct {
+	PubKey   collections.Item[secp256r1.PubKey]
+	Sequence collections.Sequence
+	Config   collections.Item[v1.Config]
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.Config == nil {
+		return nil, errors.New("config must be specified")
+	}
+	if err := a.setConfig(ctx, *msg.Config); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitResponse{}, a.verifyAndSetPubKey(ctx, msg.PubKey)
+}
+
+func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if msg.Config != nil {
+		if err := a.setConfig(ctx, *msg.Config); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
+}
+
+func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
+	pk, err := parsePubKey(key)
+	if err != nil {
+		return err
+	}
+	return a.PubKey.Set(ctx, *pk)
+}
+
+func (a Account) setConfig(ctx context.Context, cfg v1.Config) error {
+	if cfg.RpId == "" {
+		return errors.New("rp id must be specified")
+	}
+	return a.Config.Set(ctx, cfg)
+}
+
+// parsePubKey parses a compressed secp256r1 pubkey.
+func parsePubKey(key []byte) (*secp256r1.PubKey, error) {
+	pk := new(secp256r1.PubKey)
+	// the proto encoding of the pubkey is the compressed key in field 1.
+	err := pk.Unmarshal(protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), key))
+	if err != nil {
+		return nil, err
+	}
+	if pk.Key == nil {
+		return nil, errors.New("empty pubkey")
+	}
+	return pk, nil
+}
+
+// Authenticate implements the authentication flow of an abstracted webauthn account.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	pubKey, signerData, err := a.computeSignerData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := a.getTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signature := new(v1.WebAuthnSignature)
+	if err := signature.Unmarshal(msg.Tx.Signatures[msg.SignerIndex]); err != nil {
+		return nil, fmt.Errorf("unable to parse webauthn signature: %w", err)
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyAssertion(pubKey, cfg, signBytes, signature); err != nil {
+		return nil, fmt.Errorf("signature verification failed: %w", err)
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// clientData is the subset of the WebAuthn client data verified by the account.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// ecdsaSignature is the ASN.1 encoding of an ECDSA signature.
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// verifyAssertion verifies that the WebAuthn assertion was made by the pubkey over the
+// sign bytes, following https://www.w3.org/TR/webauthn-2/#sctn-verifying-assertion.
+// The sign counter is not checked, as synced passkeys do not maintain one.
+func verifyAssertion(pubKey secp256r1.PubKey, cfg v1.Config, signBytes []byte, sig *v1.WebAuthnSignature) error {
+	authData := sig.AuthenticatorData
+	if len(authData) < minAuthenticatorDataLen {
+		return errors.New("authenticator data too short")
+	}
+
+	rpIDHash := sha256.Sum256([]byte(cfg.RpId))
+	if !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return errors.New("rp id hash mismatch")
+	}
+
+	flags := authData[32]
+	if flags&flagUserPresent == 0 {
+		return errors.New("user not present")
+	}
+	if cfg.RequireUserVerification && flags&flagUserVerified == 0 {
+		return errors.New("user not verified")
+	}
+
+	var cd clientData
+	if err := json.Unmarshal(sig.ClientDataJson, &cd); err != nil {
+		return fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != clientDataTypeGet {
+		return fmt.Errorf("unexpected client data type: %s", cd.Type)
+	}
+	if cfg.Origin != "" && cd.Origin != cfg.Origin {
+		return fmt.Errorf("unexpected origin: %s", cd.Origin)
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(cd.Challenge)
+	if err != nil {
+		return fmt.Errorf("invalid challenge: %w", err)
+	}
+	wantChallenge := sha256.Sum256(signBytes)
+	if !bytes.Equal(challenge, wantChallenge[:]) {
+		return errors.New("challenge mismatch")
+	}
+
+	clientDataHash := sha256.Sum256(sig.ClientDataJson)
+	signedData := make([]byte, 0, len(authData)+len(clientDataHash))
+	signedData = append(signedData, authData...)
+	signedData = append(signedData, clientDataHash[:]...)
+	digest := sha256.Sum256(signedData)
+
+	// a signature (r, s) is also valid as (r, n-s), only the low-S form is accepted so that
+	// signatures cannot be malleated. Authenticators do not normalize S, clients must.
+	var ecdsaSig ecdsaSignature
+	if rest, err := asn1.Unmarshal(sig.Signature, &ecdsaSig); err != nil || len(rest) != 0 {
+		return errors.New("invalid signature encoding")
+	}
+	halfOrder := new(big.Int).Rsh(pubKey.Key.Curve.Params().N, 1)
+	if ecdsaSig.S.Cmp(halfOrder) > 0 {
+		return errors.New("signature s is not in the lower half of the curve order")
+	}
+
+	if !ecdsa.VerifyASN1(&pubKey.Key.PublicKey, digest[:], sig.Signature) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context) (secp256r1.PubKey, signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return secp256r1.PubKey{}, signing.SignerData{}, err
+	}
+	chainID := a.hs.HeaderInfo(ctx).ChainID
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return secp256r1.PubKey{}, signing.SignerData{}, err
+	}
+
+	pk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return secp256r1.PubKey{}, signing.SignerData{}, err
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(&pk)
+	if err != nil {
+		return secp256r1.PubKey{}, signing.SignerData{}, err
+	}
+
+	accNum, err := a.getNumber(ctx, addrStr)
+	if err != nil {
+		return secp256r1.PubKey{}, signing.SignerData{}, err
+	}
+
+	return pk, signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      wantSequence,
+		PubKey: &anypb.Any{
+			TypeUrl: pkAny.TypeUrl,
+			Value:   pkAny.Value,
+		},
+	}, nil
+}
+
+func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error) {
+	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return 0, err
+	}
+
+	return accNum.Number, nil
+}
+
+func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:                       txBody,
+		AuthInfo:                   authInfo,
+		BodyBytes:                  msg.RawTx.BodyBytes,
+		AuthInfoBytes:              msg.RawTx.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: false, // NOTE: amino signing must be disabled.
+	}, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	pk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryConfigResponse{PubKey: pk.Bytes(), Config: &cfg}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+}
@@ -0,0 +1,285 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	testRpID   = "example.com"
+	testOrigin = "https://example.com"
+)
+
+func setupWebAuthnAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	handler := directHandler{}
+
+	createAccFn := NewAccount(WEBAUTHN_ACCOUNT, signing.NewHandlerMap(handler))
+	_, acc, err := createAccFn(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func genPasskey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return sk, elliptic.MarshalCompressed(elliptic.P256(), sk.X, sk.Y)
+}
+
+type assertion struct {
+	rpID      string
+	flags     byte
+	typ       string
+	origin    string
+	challenge []byte
+	// highS produces the high-S form of the signature instead of the low-S one.
+	highS bool
+}
+
+// signAssertion mimics an authenticator producing a WebAuthn assertion, with the
+// signature S normalized by the client.
+func signAssertion(t *testing.T, sk *ecdsa.PrivateKey, a assertion) []byte {
+	t.Helper()
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	authData := append(rpIDHash[:], a.flags, 0, 0, 0, 0)
+
+	clientDataJSON, err := json.Marshal(clientData{
+		Type:      a.typ,
+		Challenge: base64.RawURLEncoding.EncodeToString(a.challenge),
+		Origin:    a.origin,
+	})
+	require.NoError(t, err)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	r, s, err := ecdsa.Sign(rand.Reader, sk, digest[:])
+	require.NoError(t, err)
+	n := sk.Curve.Params().N
+	if lowS := s.Cmp(new(big.Int).Rsh(n, 1)) <= 0; lowS == a.highS {
+		s = new(big.Int).Sub(n, s)
+	}
+	sig, err := asn1.Marshal(ecdsaSignature{R: r, S: s})
+	require.NoError(t, err)
+
+	bz, err := (&v1.WebAuthnSignature{
+		AuthenticatorData: authData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         sig,
+	}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestInit(t *testing.T) {
+	_, pk := genPasskey(t)
+
+	testcases := []struct {
+		name     string
+		msg      *v1.MsgInit
+		isExpErr bool
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{PubKey: pk, Config: &v1.Config{RpId: testRpID}},
+			false,
+		},
+		{
+			"invalid pubkey",
+			&v1.MsgInit{PubKey: []byte("invalid_pk"), Config: &v1.Config{RpId: testRpID}},
+			true,
+		},
+		{
+			"missing config",
+			&v1.MsgInit{PubKey: pk},
+			true,
+		},
+		{
+			"missing rp id",
+			&v1.MsgInit{PubKey: pk, Config: &v1.Config{}},
+			true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupWebAuthnAccount(t, ss)
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.isExpErr {
+				require.NotNil(t, err, tc.name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSwapPubKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupWebAuthnAccount(t, ss)
+	_, pk := genPasskey(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: pk, Config: &v1.Config{RpId: testRpID}})
+	require.NoError(t, err)
+
+	_, newPk := genPasskey(t)
+
+	ctx = accountstd.SetSender(ctx, []byte("sender"))
+	_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: newPk})
+	require.Equal(t, errors.New("unauthorized"), err)
+
+	ctx = accountstd.SetSender(ctx, []byte("mock_webauthn_account"))
+	_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: newPk, Config: &v1.Config{RpId: "other.com"}})
+	require.NoError(t, err)
+
+	resp, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, newPk, resp.PubKey)
+	require.Equal(t, "other.com", resp.Config.RpId)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupWebAuthnAccount(t, ss)
+	sk, pk := genPasskey(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey: pk,
+		Config: &v1.Config{RpId: testRpID, Origin: testOrigin, RequireUserVerification: true},
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	transaction := tx.Tx{
+		Body: &tx.TxBody{},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	validAssertion := func(signBytes []byte) assertion {
+		return assertion{
+			rpID:      testRpID,
+			flags:     flagUserPresent | flagUserVerified,
+			typ:       clientDataTypeGet,
+			origin:    testOrigin,
+			challenge: signBytes,
+		}
+	}
+
+	testcases := []struct {
+		name     string
+		malleate func(a *assertion)
+		expErr   string
+	}{
+		{
+			"valid assertion",
+			func(a *assertion) {},
+			"",
+		},
+		{
+			"wrong rp id",
+			func(a *assertion) { a.rpID = "evil.com" },
+			"rp id hash mismatch",
+		},
+		{
+			"user not verified",
+			func(a *assertion) { a.flags = flagUserPresent },
+			"user not verified",
+		},
+		{
+			"wrong client data type",
+			func(a *assertion) { a.typ = "webauthn.create" },
+			"unexpected client data type",
+		},
+		{
+			"wrong origin",
+			func(a *assertion) { a.origin = "https://evil.com" },
+			"unexpected origin",
+		},
+		{
+			"challenge is not the sign bytes hash",
+			func(a *assertion) { a.challenge = []byte("other_tx") },
+			"challenge mismatch",
+		},
+		{
+			"high-S signature",
+			func(a *assertion) { a.highS = true },
+			"signature s is not in the lower half of the curve order",
+		},
+	}
+
+	for i, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the sequence is increased by every authentication attempt.
+			transaction.AuthInfo.SignerInfos[0].Sequence = uint64(i)
+			bodyByte, err := transaction.Body.Marshal()
+			require.NoError(t, err)
+			authByte, err := transaction.AuthInfo.Marshal()
+			require.NoError(t, err)
+
+			signBytes, err := (&tx.SignDoc{
+				BodyBytes:     bodyByte,
+				AuthInfoBytes: authByte,
+				ChainId:       "test",
+				AccountNumber: 1,
+			}).Marshal()
+			require.NoError(t, err)
+
+			a := validAssertion(sha256Sum(signBytes))
+			tc.malleate(&a)
+			transaction.Signatures = [][]byte{signAssertion(t, sk, a)}
+
+			_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{
+				RawTx: &tx.TxRaw{
+					BodyBytes:     bodyByte,
+					AuthInfoBytes: authByte,
+					Signatures:    transaction.Signatures,
+				},
+				Tx:          &transaction,
+				SignerIndex: 0,
+			})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func sha256Sum(bz []byte) []byte {
+	h := sha256.Sum256(bz)
+	return h[:]
+}
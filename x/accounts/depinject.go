@@ -13,6 +13,10 @@ import (
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	"cosmossdk.io/x/accounts/defaults/lockup"
 	"cosmossdk.io/x/accounts/defaults/multisig"
+	"cosmossdk.io/x/accounts/defaults/recovery"
+	"cosmossdk.io/x/accounts/defaults/sessionkey"
+	"cosmossdk.io/x/accounts/defaults/subscription"
+	"cosmossdk.io/x/accounts/defaults/webauthn"
 	"cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -65,6 +69,9 @@ func (s directHandler) GetSignBytes(_ context.Context, _ signing.SignerData, _ s
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	handler := directHandler{}
 	account := baseaccount.NewAccount("base", signing.NewHandlerMap(handler))
+	sessionKeyAccount := sessionkey.NewAccount(sessionkey.SESSION_KEY_ACCOUNT, signing.NewHandlerMap(handler))
+	recoveryAccount := recovery.NewAccount(recovery.SOCIAL_RECOVERY_ACCOUNT, signing.NewHandlerMap(handler))
+	webAuthnAccount := webauthn.NewAccount(webauthn.WEBAUTHN_ACCOUNT, signing.NewHandlerMap(handler))
 	accountskeeper, err := NewKeeper(
 		in.Cdc, in.Environment, in.AddressCodec, in.Registry, account,
 		accountstd.AddAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT, lockup.NewContinuousLockingAccount),
@@ -72,6 +79,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		accountstd.AddAccount(multisig.MULTISIG_ACCOUNT, multisig.NewAccount),
+		accountstd.AddAccount(subscription.SUBSCRIPTION_ACCOUNT, subscription.NewAccount),
+		sessionKeyAccount,
+		recoveryAccount,
+		webAuthnAccount,
 	)
 	if err != nil {
 		panic(err)
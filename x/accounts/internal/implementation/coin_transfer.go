@@ -0,0 +1,71 @@
+package implementation
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type gogoProtoPlusV2 interface {
+	proto.Message
+	ProtoMsg
+}
+
+// protoV2GogoWrapper is a wrapper of a protov2 message into a gogo message.
+// this is exceptionally allowed to enable accounts to be decoupled from
+// the SDK, since x/accounts can support only protov1 in its APIs.
+// But in order to keep it decoupled from the SDK we need to use the API module.
+// This is a hack to make an API module type work in x/accounts. Once the SDK
+// has protov2 support, we can migrate internal/implementation/encoding.go to
+// work with protov2.
+type protoV2GogoWrapper struct {
+	gogoProtoPlusV2
+}
+
+func (h protoV2GogoWrapper) XXX_MessageName() string {
+	return string(proto.MessageName(h.gogoProtoPlusV2))
+}
+
+// MakeCoinsTransferMsg creates a bank.MsgSend sending coins from one address to the other,
+// and the message used to parse its response. It uses the API module types so that
+// x/accounts is kept independent of bank.
+func MakeCoinsTransferMsg(addrCdc address.Codec, from, to []byte, coins sdk.Coins) (ProtoMsg, ProtoMsg, error) {
+	fromAddr, err := addrCdc.BytesToString(from)
+	if err != nil {
+		return nil, nil, err
+	}
+	toAddr, err := addrCdc.BytesToString(to)
+	if err != nil {
+		return nil, nil, err
+	}
+	v2Coins := make([]*v1beta1.Coin, len(coins))
+	for i, coin := range coins {
+		v2Coins[i] = &v1beta1.Coin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.String(),
+		}
+	}
+	return protoV2GogoWrapper{&bankv1beta1.MsgSend{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      v2Coins,
+	}}, new(bankv1beta1.MsgSendResponse), nil
+}
+
+// SendCoins sends coins from the account being invoked to the provided address.
+func SendCoins(ctx context.Context, addrCdc address.Codec, to []byte, coins sdk.Coins) error {
+	v := getCtx(ctx)
+
+	msg, msgResp, err := MakeCoinsTransferMsg(addrCdc, v.whoami, to, coins)
+	if err != nil {
+		return err
+	}
+
+	return v.moduleExec(v.parentContext, v.whoami, msg, msgResp)
+}
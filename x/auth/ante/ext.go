@@ -1,9 +1,12 @@
 package ante
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 type HasExtensionOptionsTx interface {
@@ -20,6 +23,13 @@ func rejectExtensionOption(*codectypes.Any) bool {
 	return false
 }
 
+// AcceptAccessListExtensionOption is an ExtensionOptionChecker accepting the
+// access list extension option, which declares the state accessed by a tx.
+// It can be combined with other checkers to accept further extension options.
+func AcceptAccessListExtensionOption(opt *codectypes.Any) bool {
+	return opt.TypeUrl == "/"+proto.MessageName(&txtypes.AccessList{})
+}
+
 // RejectExtensionOptionsDecorator is an AnteDecorator that rejects all extension
 // options which can optionally be included in protobuf transactions. Users that
 // need extension options should create a custom AnteHandler chain that handles
@@ -10,8 +10,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+const flagTrace = "trace"
+
 // GetSimulateCmd returns a command that simulates whether a transaction will be
 // successful.
 func GetSimulateCmd() *cobra.Command {
@@ -64,6 +67,11 @@ empty; they will be auto-populated by dummy data for simulation purpose.
 
 The --from flag is mandatory, as the signer account's correct sequence number is
 necessary for simulation.
+
+With the --trace flag, the ordered store operations performed by the transaction
+are printed as well: the store key, the raw key and its decoded form when
+available, the old and new values and the gas charged for each of them. The
+trace is printed even if the transaction fails, alongside the error.
 `),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -87,6 +95,22 @@ necessary for simulation.
 				return err
 			}
 
+			if trace, _ := cmd.Flags().GetBool(flagTrace); trace {
+				txBytes, err := txf.BuildSimTx(stdTx.GetMsgs()...)
+				if err != nil {
+					return err
+				}
+
+				traceRes, err := txtypes.NewServiceClient(clientCtx).SimulateWithTrace(
+					cmd.Context(), &txtypes.SimulateWithTraceRequest{TxBytes: txBytes},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(traceRes)
+			}
+
 			simRes, _, err := tx.CalculateGas(clientCtx, txf, stdTx.GetMsgs()...)
 			if err != nil {
 				return err
@@ -96,6 +120,7 @@ necessary for simulation.
 		},
 	}
 
+	cmd.Flags().Bool(flagTrace, false, "Print the store operations performed by the transaction")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/ante"
@@ -78,6 +80,12 @@ func newWrapperFromDecodedTx(
 		reflectMsgs[i] = msg.ProtoReflect()
 	}
 
+	// access list
+	accessList, err := decodeAccessList(decodedTx.Tx.Body.ExtensionOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	return &gogoTxWrapper{
 		DecodedTx:   decodedTx,
 		cdc:         cdc,
@@ -85,9 +93,32 @@ func newWrapperFromDecodedTx(
 		fees:        fees,
 		feePayer:    feePayer,
 		feeGranter:  feeGranter,
+		accessList:  accessList,
 	}, nil
 }
 
+// decodeAccessList decodes the access list declared among the provided extension options, if any.
+func decodeAccessList(extensionOptions []*anypb.Any) (*txtypes.AccessList, error) {
+	var accessList *txtypes.AccessList
+	accessListTypeURL := "/" + proto.MessageName(&txtypes.AccessList{})
+	for _, opt := range extensionOptions {
+		if opt.TypeUrl != accessListTypeURL {
+			continue
+		}
+		if accessList != nil {
+			return nil, errors.New("tx declares more than one access list")
+		}
+		accessList = new(txtypes.AccessList)
+		if err := proto.Unmarshal(opt.Value, accessList); err != nil {
+			return nil, fmt.Errorf("invalid access list: %w", err)
+		}
+		if err := accessList.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid access list: %w", err)
+		}
+	}
+	return accessList, nil
+}
+
 // gogoTxWrapper is a gogoTxWrapper around the tx.Tx proto.Message which retain the raw
 // body and auth_info bytes.
 type gogoTxWrapper struct {
@@ -99,13 +130,15 @@ type gogoTxWrapper struct {
 	fees        sdk.Coins
 	feePayer    []byte
 	feeGranter  []byte
+	accessList  *txtypes.AccessList
 }
 
 func (w *gogoTxWrapper) String() string { return w.Tx.String() }
 
 var (
-	_ authsigning.Tx             = &gogoTxWrapper{}
-	_ ante.HasExtensionOptionsTx = &gogoTxWrapper{}
+	_ authsigning.Tx               = &gogoTxWrapper{}
+	_ ante.HasExtensionOptionsTx   = &gogoTxWrapper{}
+	_ transaction.TxWithAccessList = &gogoTxWrapper{}
 )
 
 // ExtensionOptionsTxBuilder defines a TxBuilder that can also set extensions.
@@ -186,6 +219,14 @@ func (w *gogoTxWrapper) GetTimeoutTimeStamp() time.Time {
 // GetUnordered returns the transaction's unordered field (if set).
 func (w *gogoTxWrapper) GetUnordered() bool { return w.Tx.Body.Unordered }
 
+// GetAccessList returns the state accesses declared by the tx access list extension option, if any.
+func (w *gogoTxWrapper) GetAccessList() ([]transaction.StateAccess, bool) {
+	if w.accessList == nil {
+		return nil, false
+	}
+	return w.accessList.StateAccesses(), true
+}
+
 // GetSignaturesV2 returns the signatures of the Tx.
 func (w *gogoTxWrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.Tx.AuthInfo.SignerInfos
@@ -0,0 +1,56 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestDecodeAccessList(t *testing.T) {
+	accessListAny := func(t *testing.T, accessList *txtypes.AccessList) *anypb.Any {
+		t.Helper()
+		bz, err := proto.Marshal(accessList)
+		require.NoError(t, err)
+		return &anypb.Any{TypeUrl: "/" + proto.MessageName(accessList), Value: bz}
+	}
+	validAccessList := &txtypes.AccessList{Entries: []*txtypes.AccessListEntry{
+		{StoreKey: "bank", Prefixes: [][]byte{[]byte("balances")}},
+		{StoreKey: "auth", ReadOnly: true},
+	}}
+	otherOption := &anypb.Any{TypeUrl: "/cosmos.tx.v1beta1.Other", Value: []byte{0x1}}
+
+	t.Run("no access list", func(t *testing.T) {
+		accessList, err := decodeAccessList([]*anypb.Any{otherOption})
+		require.NoError(t, err)
+		require.Nil(t, accessList)
+	})
+
+	t.Run("valid access list", func(t *testing.T) {
+		accessList, err := decodeAccessList([]*anypb.Any{otherOption, accessListAny(t, validAccessList)})
+		require.NoError(t, err)
+		accesses := accessList.StateAccesses()
+		require.Len(t, accesses, 2)
+		require.Equal(t, []byte("bank"), accesses[0].Actor)
+		require.Equal(t, [][]byte{[]byte("balances")}, accesses[0].Prefixes)
+		require.False(t, accesses[0].ReadOnly)
+		require.Equal(t, []byte("auth"), accesses[1].Actor)
+		require.Empty(t, accesses[1].Prefixes)
+		require.True(t, accesses[1].ReadOnly)
+	})
+
+	t.Run("duplicate access list", func(t *testing.T) {
+		_, err := decodeAccessList([]*anypb.Any{accessListAny(t, validAccessList), accessListAny(t, validAccessList)})
+		require.ErrorContains(t, err, "more than one access list")
+	})
+
+	t.Run("empty store key", func(t *testing.T) {
+		_, err := decodeAccessList([]*anypb.Any{accessListAny(t, &txtypes.AccessList{
+			Entries: []*txtypes.AccessListEntry{{Prefixes: [][]byte{[]byte("balances")}}},
+		})})
+		require.ErrorContains(t, err, "empty store key")
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/x/auth/migrations/legacytx"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,20 +25,44 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateWithTraceFn is the signature of the Baseapp#SimulateWithTrace function.
+type baseAppSimulateWithTraceFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, []corestore.TraceOperation, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
+	simulateWithTrace baseAppSimulateWithTraceFn
 	interfaceRegistry codectypes.InterfaceRegistry
 }
 
+// TxServerOption configures the Tx service server.
+type TxServerOption func(*txServer)
+
+// WithSimulateWithTrace serves the SimulateWithTrace RPC method with the provided
+// function, usually Baseapp#SimulateWithTrace. Without it the method is unimplemented.
+func WithSimulateWithTrace(simulateWithTrace baseAppSimulateWithTraceFn) TxServerOption {
+	return func(s *txServer) {
+		s.simulateWithTrace = simulateWithTrace
+	}
+}
+
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
-	return txServer{
+func NewTxServer(
+	clientCtx client.Context,
+	simulate baseAppSimulateFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...TxServerOption,
+) txtypes.ServiceServer {
+	s := txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
 	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return s
 }
 
 var _ txtypes.ServiceServer = txServer{}
@@ -106,6 +131,30 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// SimulateWithTrace implements the ServiceServer.SimulateWithTrace RPC method.
+// A failing tx is not an error of the method: the error is returned in the
+// response alongside the store operations performed until the failure.
+func (s txServer) SimulateWithTrace(ctx context.Context, req *txtypes.SimulateWithTraceRequest) (*txtypes.SimulateWithTraceResponse, error) {
+	if s.simulateWithTrace == nil {
+		return nil, status.Error(codes.Unimplemented, "simulation with trace is not enabled")
+	}
+	if req == nil || req.TxBytes == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	gasInfo, result, ops, err := s.simulateWithTrace(req.TxBytes)
+	res := &txtypes.SimulateWithTraceResponse{
+		GasInfo:    &gasInfo,
+		Result:     result,
+		Operations: txtypes.NewStoreOperations(ops),
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -314,10 +363,11 @@ func RegisterTxService(
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...TxServerOption,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, interfaceRegistry, opts...),
 	)
 }
 
@@ -0,0 +1,80 @@
+package encryptedtx
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	encryptedtxv1 "cosmossdk.io/api/cosmos/encryptedtx/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: encryptedtxv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current encryptedtx parameters",
+				},
+				{
+					RpcMethod: "ThresholdKey",
+					Use:       "threshold-key",
+					Short:     "Query the threshold key encrypted txs are encrypted with",
+				},
+				{
+					RpcMethod: "DKG",
+					Use:       "dkg",
+					Short:     "Query the last distributed key generation and its dealings",
+				},
+				{
+					RpcMethod: "EncryptedTxs",
+					Use:       "encrypted-txs",
+					Short:     "Query the encrypted txs waiting to be decrypted",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              encryptedtxv1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SubmitEncryptedTx",
+					Skip:      true, // skipped because the tx is encrypted by the custom submit command
+				},
+				{
+					RpcMethod:      "StartDKG",
+					Use:            "start-dkg-proposal [threshold] [validators]...",
+					Short:          "Submit a proposal to start a distributed key generation among the given validators",
+					Long:           "Submit a proposal to start a distributed key generation among the given validators, any threshold of which can decrypt the encrypted txs once it completes. The key holders of any threshold should hold more than 2/3 of the voting power.",
+					Example:        fmt.Sprintf(`%s tx encryptedtx start-dkg-proposal 3 cosmosvaloper1... cosmosvaloper1... cosmosvaloper1... cosmosvaloper1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "threshold"}, {ProtoField: "validators", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod: "RegisterDKGKey",
+					Skip:      true, // skipped because the key is generated by the custom register-dkg-key command
+				},
+				{
+					RpcMethod: "SubmitDealing",
+					Skip:      true, // skipped because the dealing is generated by the custom deal command
+				},
+				{
+					RpcMethod: "SubmitComplaint",
+					Skip:      true, // skipped because the complaints are generated by the custom complain command
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update encryptedtx module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update encryptedtx module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query encryptedtx params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx encryptedtx update-params-proposal '{ "max_ciphertext_size": 4096, "max_txs_per_block": 50, "dkg_phase_blocks": 100 }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
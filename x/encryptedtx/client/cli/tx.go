@@ -0,0 +1,257 @@
+package cli
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	authclient "cosmossdk.io/x/auth/client"
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/encryptedtx transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Encrypted tx transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSubmitEncryptedTxCmd(),
+		NewRegisterDKGKeyCmd(),
+		NewDealCmd(),
+		NewComplainCmd(),
+	)
+
+	return txCmd
+}
+
+// NewSubmitEncryptedTxCmd returns a CLI command handler for creating a
+// MsgSubmitEncryptedTx transaction.
+func NewSubmitEncryptedTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit [signed-tx-file]",
+		Short: "Encrypt a signed tx with the threshold key and submit it.",
+		Long: `Encrypt a signed tx with the threshold key of the chain and submit it.
+The signed tx is only decrypted by the validators once the block ordering it is
+committed, it is then executed in the block after the one it was submitted in. The tx is
+bound to the --from address, which pays the fees of the submission.`,
+		Example: fmt.Sprintf("%s tx encryptedtx submit signed_tx.json --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).ThresholdKey(cmd.Context(), &types.QueryThresholdKeyRequest{})
+			if err != nil {
+				return err
+			}
+			if res.ThresholdKey == nil {
+				return types.ErrDisabled
+			}
+
+			sender, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitEncryptedTx(rand.Reader, res.ThresholdKey, sender, txBytes)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterDKGKeyCmd returns a CLI command handler for creating a
+// MsgRegisterDKGKey transaction.
+func NewRegisterDKGKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-dkg-key [dkg-key-file]",
+		Short: "Register the DKG key of a validator in the current distributed key generation.",
+		Long: `Register the DKG key of the --from validator in the current distributed key
+generation, the key its shares are encrypted to. The key is read from the given
+file, which is created if it does not exist. The node of the validator must be
+configured with the file to derive its key share once the generation completes.`,
+		Example: fmt.Sprintf("%s tx encryptedtx register-dkg-key ~/.simapp/config/dkg_key.json --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := types.LoadDKGKey(args[0])
+			if errors.Is(err, os.ErrNotExist) {
+				if key, err = threshold.GenerateDKGKey(rand.Reader); err != nil {
+					return err
+				}
+				err = types.SaveDKGKey(args[0], key)
+			}
+			if err != nil {
+				return err
+			}
+
+			encryptionKey, err := key.PublicKey()
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := clientCtx.ValidatorAddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterDKGKey{ValidatorAddress: valAddr, EncryptionKey: encryptionKey}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDealCmd returns a CLI command handler for creating a MsgSubmitDealing
+// transaction.
+func NewDealCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deal",
+		Short: "Deal shares to the participants of the current distributed key generation.",
+		Long: `Deal a random secret to the registered participants of the current distributed
+key generation on behalf of the --from validator. The secret is discarded once
+its shares are encrypted to the participants.`,
+		Example: fmt.Sprintf("%s tx encryptedtx deal --from mykey", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).DKG(cmd.Context(), &types.QueryDKGRequest{})
+			if err != nil {
+				return err
+			}
+			if res.Dkg == nil {
+				return errors.New("no distributed key generation started")
+			}
+
+			dealing, err := threshold.NewDealing(rand.Reader, int(res.Dkg.Threshold), res.Dkg.EncryptionKeys())
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := clientCtx.ValidatorAddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), types.NewMsgSubmitDealing(valAddr, dealing))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewComplainCmd returns a CLI command handler for creating MsgSubmitComplaint
+// transactions.
+func NewComplainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "complain [dkg-key-file]",
+		Short: "Complain about the dealers of invalid shares in the current distributed key generation.",
+		Long: `Decrypt the shares dealt to the --from validator in the current distributed key
+generation with its DKG key and submit a complaint against the dealer of every
+invalid share, revealing the key the share was encrypted with.`,
+		Example: fmt.Sprintf("%s tx encryptedtx complain ~/.simapp/config/dkg_key.json --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := types.LoadDKGKey(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).DKG(cmd.Context(), &types.QueryDKGRequest{})
+			if err != nil {
+				return err
+			}
+			if res.Dkg == nil {
+				return errors.New("no distributed key generation started")
+			}
+
+			valAddr, err := clientCtx.ValidatorAddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			participant, ok := res.Dkg.Participant(valAddr)
+			if !ok {
+				return types.ErrNotParticipant
+			}
+
+			var msgs []sdk.Msg
+			for _, dealing := range res.Dealings {
+				if _, err := dealing.Threshold().Share(key, participant.Index); !errors.Is(err, threshold.ErrInvalidDealing) {
+					continue
+				}
+
+				sharedKey, err := dealing.Threshold().SharedKey(key)
+				if err != nil {
+					return err
+				}
+
+				msgs = append(msgs, &types.MsgSubmitComplaint{
+					ValidatorAddress: valAddr,
+					Dealer:           dealing.Dealer,
+					SharedKey:        sharedKey,
+				})
+			}
+			if len(msgs) == 0 {
+				return errors.New("all the shares dealt to the validator are valid")
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
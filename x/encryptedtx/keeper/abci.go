@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// BeginBlocker removes the encrypted txs decrypted by the proposal of the
+// current block. Encrypted txs ordered by block N are decrypted with the vote
+// extensions of block N+1 and included in block N+2, they are removed whether
+// or not enough decryption shares were published.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	ranger := new(collections.Range[collections.Pair[int64, uint64]]).EndExclusive(collections.Join(height-1, uint64(0)))
+	return k.EncryptedTxs.Clear(ctx, ranger)
+}
+
+// EndBlocker completes the distributed key generation at the end of its
+// complaint phase.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	dkg, err := k.GetDKG(ctx)
+	if err != nil {
+		return err
+	}
+	if dkg == nil || dkg.ComplaintEndHeight != k.HeaderService.HeaderInfo(ctx).Height {
+		return nil
+	}
+
+	return k.completeDKG(ctx, *dkg)
+}
+
+// completeDKG replaces the threshold key with the key generated by the dealings
+// of the qualified dealers, i.e. the dealers which were not disqualified by a
+// complaint. The threshold key is kept if fewer than threshold participants
+// registered or dealt.
+func (k Keeper) completeDKG(ctx context.Context, dkg types.DKG) error {
+	var (
+		qualified []threshold.Dealing
+		indexes   []uint32
+	)
+	for _, p := range dkg.Registered() {
+		indexes = append(indexes, p.Index)
+
+		key := collections.Join(dkg.Id, p.Index)
+		dealing, err := k.Dealings.Get(ctx, key)
+		if errors.IsOf(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if p.Disqualified {
+			if err := k.Dealings.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+		qualified = append(qualified, dealing.Threshold())
+	}
+
+	if len(indexes) < int(dkg.Threshold) {
+		return k.failDKG(ctx, dkg, fmt.Sprintf("%d participants registered, expected at least %d", len(indexes), dkg.Threshold))
+	}
+	if len(qualified) < int(dkg.Threshold) {
+		return k.failDKG(ctx, dkg, fmt.Sprintf("%d qualified dealers, expected at least %d", len(qualified), dkg.Threshold))
+	}
+
+	publicKey, verificationKeys, err := threshold.AggregateDealings(qualified, int(dkg.Threshold), indexes)
+	if err != nil {
+		return k.failDKG(ctx, dkg, err.Error())
+	}
+
+	key := types.ThresholdKey{
+		DkgId:     dkg.Id,
+		PublicKey: publicKey,
+		Threshold: dkg.Threshold,
+	}
+	for _, p := range dkg.Registered() {
+		key.KeyHolders = append(key.KeyHolders, types.KeyHolder{
+			ValidatorAddress: p.ConsensusAddress,
+			Index:            p.Index,
+			VerificationKey:  verificationKeys[p.Index],
+			EncryptionKey:    p.EncryptionKey,
+		})
+	}
+
+	// the dealings of the replaced key are not needed anymore to derive key
+	// shares
+	old, err := k.GetThresholdKey(ctx)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if err := k.Dealings.Clear(ctx, collections.NewPrefixedPairRange[uint64, uint32](old.DkgId)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ThresholdKey.Set(ctx, key); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDKGCompleted,
+		event.NewAttribute(types.AttributeKeyID, strconv.FormatUint(dkg.Id, 10)),
+		event.NewAttribute(types.AttributeKeyPublicKey, fmt.Sprintf("%X", publicKey)),
+	)
+}
+
+// failDKG removes the dealings of a failed distributed key generation.
+func (k Keeper) failDKG(ctx context.Context, dkg types.DKG, reason string) error {
+	if err := k.Dealings.Clear(ctx, collections.NewPrefixedPairRange[uint64, uint32](dkg.Id)); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDKGFailed,
+		event.NewAttribute(types.AttributeKeyID, strconv.FormatUint(dkg.Id, 10)),
+		event.NewAttribute(types.AttributeKeyReason, reason),
+	)
+}
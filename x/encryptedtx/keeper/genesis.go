@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/encryptedtx/types"
+)
+
+// InitGenesis new encryptedtx genesis
+func (keeper Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := keeper.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, tx := range data.EncryptedTxs {
+		if err := keeper.EncryptedTxs.Set(ctx, collections.Join(tx.Height, tx.Id), tx); err != nil {
+			return err
+		}
+	}
+
+	if data.ThresholdKey != nil {
+		if err := keeper.ThresholdKey.Set(ctx, *data.ThresholdKey); err != nil {
+			return err
+		}
+	}
+
+	if data.Dkg != nil {
+		if err := keeper.DKG.Set(ctx, *data.Dkg); err != nil {
+			return err
+		}
+	}
+
+	for _, dealing := range data.Dealings {
+		if err := keeper.Dealings.Set(ctx, collections.Join(dealing.DkgId, dealing.Dealer), dealing); err != nil {
+			return err
+		}
+	}
+
+	return keeper.NextID.Set(ctx, data.NextId)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := keeper.EncryptedTxs.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	nextID, err := keeper.NextID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholdKey, err := keeper.GetThresholdKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dkg, err := keeper.GetDKG(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealingsIter, err := keeper.Dealings.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	dealings, err := dealingsIter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, txs, nextID, thresholdKey, dkg, dealings), nil
+}
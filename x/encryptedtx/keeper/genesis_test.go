@@ -0,0 +1,49 @@
+//go:build bls12381
+
+package keeper_test
+
+import (
+	"cosmossdk.io/x/encryptedtx/types"
+)
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.submit(s.address("alice"), []byte("tx0"))
+	s.submit(s.address("bob"), []byte("tx1"))
+
+	exported, err := s.encryptedTxKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(types.ValidateGenesis(*exported))
+	s.Require().Len(exported.EncryptedTxs, 2)
+	s.Require().Equal(uint64(2), exported.NextId)
+	s.Require().Equal(s.thresholdKey, exported.ThresholdKey)
+	s.Require().Equal(s.thresholdKey.DkgId, exported.Dkg.Id)
+	s.Require().Len(exported.Dealings, len(s.vals))
+
+	s.SetupTest()
+	s.Require().NoError(s.encryptedTxKeeper.InitGenesis(s.ctx, exported))
+	imported, err := s.encryptedTxKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported, imported)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	s.submit(s.address("alice"), []byte("tx0"))
+
+	params, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(3), params.Params.MaxTxsPerBlock)
+
+	key, err := s.queryServer.ThresholdKey(s.ctx, &types.QueryThresholdKeyRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.thresholdKey, key.ThresholdKey)
+
+	dkg, err := s.queryServer.DKG(s.ctx, &types.QueryDKGRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.thresholdKey.DkgId, dkg.Dkg.Id)
+	s.Require().Len(dkg.Dealings, len(s.vals))
+
+	txs, err := s.queryServer.EncryptedTxs(s.ctx, &types.QueryEncryptedTxsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(txs.EncryptedTxs, 1)
+	s.Require().Equal(s.address("alice"), txs.EncryptedTxs[0].Sender)
+}
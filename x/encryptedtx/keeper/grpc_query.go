@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the encryptedtx module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// EncryptedTxs returns the encrypted txs waiting to be decrypted.
+func (q queryServer) EncryptedTxs(ctx context.Context, req *types.QueryEncryptedTxsRequest) (*types.QueryEncryptedTxsResponse, error) {
+	txs, pageRes, err := query.CollectionPaginate(ctx, q.k.EncryptedTxs, req.Pagination, func(_ collections.Pair[int64, uint64], tx types.EncryptedTx) (types.EncryptedTx, error) {
+		return tx, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEncryptedTxsResponse{EncryptedTxs: txs, Pagination: pageRes}, nil
+}
+
+// ThresholdKey returns the threshold key encrypted txs are encrypted with.
+func (q queryServer) ThresholdKey(ctx context.Context, _ *types.QueryThresholdKeyRequest) (*types.QueryThresholdKeyResponse, error) {
+	key, err := q.k.GetThresholdKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryThresholdKeyResponse{ThresholdKey: key}, nil
+}
+
+// DKG returns the last distributed key generation and its dealings.
+func (q queryServer) DKG(ctx context.Context, _ *types.QueryDKGRequest) (*types.QueryDKGResponse, error) {
+	dkg, err := q.k.GetDKG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dkg == nil {
+		return &types.QueryDKGResponse{}, nil
+	}
+
+	dealings, err := q.k.GetDealings(ctx, dkg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDKGResponse{Dkg: dkg, Dealings: dealings}, nil
+}
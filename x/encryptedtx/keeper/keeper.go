@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+// Keeper of the encryptedtx store
+type Keeper struct {
+	appmodule.Environment
+
+	cdc           codec.BinaryCodec
+	stakingKeeper types.StakingKeeper
+	// the address capable of executing MsgUpdateParams and MsgStartDKG messages.
+	// Typically, this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// EncryptedTxs are the encrypted txs waiting to be decrypted, indexed by the
+	// height of the block which ordered them and by id.
+	EncryptedTxs collections.Map[collections.Pair[int64, uint64], types.EncryptedTx]
+	// NextID is the id of the next encrypted tx.
+	NextID collections.Sequence
+	// ThresholdKey is the key encrypted txs are encrypted with, it is set by
+	// the last successful distributed key generation.
+	ThresholdKey collections.Item[types.ThresholdKey]
+	// DKG is the last distributed key generation.
+	DKG collections.Item[types.DKG]
+	// Dealings are the dealings of the last distributed key generation and of
+	// the one which generated the threshold key, indexed by the id of the
+	// distributed key generation and by dealer.
+	Dealings collections.Map[collections.Pair[uint64, uint32], types.Dealing]
+}
+
+// NewKeeper creates a new encryptedtx Keeper instance. It panics if the app is
+// not built with the bls12381 build tag, which threshold encryption requires.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	stakingKeeper types.StakingKeeper,
+	authority string,
+) Keeper {
+	if !threshold.Available() {
+		panic(errors.New("x/encryptedtx requires threshold encryption, build the app with the bls12381 build tag"))
+	}
+
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		Environment:   env,
+		cdc:           cdc,
+		stakingKeeper: stakingKeeper,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		EncryptedTxs: collections.NewMap(
+			sb, types.EncryptedTxsKey, "encrypted_txs",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+			codec.CollValue[types.EncryptedTx](cdc),
+		),
+		NextID:       collections.NewSequence(sb, types.NextIDKey, "next_id"),
+		ThresholdKey: collections.NewItem(sb, types.ThresholdKeyKey, "threshold_key", codec.CollValue[types.ThresholdKey](cdc)),
+		DKG:          collections.NewItem(sb, types.DKGKey, "dkg", codec.CollValue[types.DKG](cdc)),
+		Dealings: collections.NewMap(
+			sb, types.DealingsKey, "dealings",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key),
+			codec.CollValue[types.Dealing](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/encryptedtx module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetEncryptedTxs returns the encrypted txs ordered by the block at the given
+// height, by id.
+func (k Keeper) GetEncryptedTxs(ctx context.Context, height int64) ([]types.EncryptedTx, error) {
+	iter, err := k.EncryptedTxs.Iterate(ctx, collections.NewPrefixedPairRange[int64, uint64](height))
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// GetThresholdKey returns the threshold key, or nil if no distributed key
+// generation completed yet.
+func (k Keeper) GetThresholdKey(ctx context.Context) (*types.ThresholdKey, error) {
+	key, err := k.ThresholdKey.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// GetDKG returns the last distributed key generation, or nil if none started.
+func (k Keeper) GetDKG(ctx context.Context) (*types.DKG, error) {
+	dkg, err := k.DKG.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &dkg, nil
+}
+
+// GetDealings returns the dealings of the distributed key generation with the
+// given id, by dealer.
+func (k Keeper) GetDealings(ctx context.Context, dkgID uint64) ([]types.Dealing, error) {
+	iter, err := k.Dealings.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint32](dkgID))
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// ActiveThresholdKey returns the threshold key of the encrypted txs ordered by
+// the block at the given height, or nil if the block cannot order encrypted
+// txs. They are disabled while there is no threshold key, and in the block
+// setting a new threshold key and the one before it, as their decryption
+// shares or their decryption would use the next one.
+func (k Keeper) ActiveThresholdKey(ctx context.Context, height int64) (*types.ThresholdKey, error) {
+	key, err := k.GetThresholdKey(ctx)
+	if err != nil || key == nil {
+		return nil, err
+	}
+
+	dkg, err := k.GetDKG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dkg != nil && (dkg.ComplaintEndHeight == height || dkg.ComplaintEndHeight == height+1) {
+		return nil, nil
+	}
+
+	return key, nil
+}
+
+// keyHolders returns the key holders of the threshold key indexed by the bytes
+// of their consensus address.
+func (k Keeper) keyHolders(key *types.ThresholdKey) (map[string]types.KeyHolder, error) {
+	holders := make(map[string]types.KeyHolder, len(key.KeyHolders))
+	for _, holder := range key.KeyHolders {
+		addr, err := k.stakingKeeper.ConsensusAddressCodec().StringToBytes(holder.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		holders[string(addr)] = holder
+	}
+
+	return holders, nil
+}
@@ -0,0 +1,263 @@
+//go:build bls12381
+
+package keeper_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	cmtprotocrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	cmtsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/encryptedtx"
+	"cosmossdk.io/x/encryptedtx/keeper"
+	encryptedtxtestutil "cosmossdk.io/x/encryptedtx/testutil"
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const (
+	govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	chainID          = "chain-id"
+)
+
+type testValidator struct {
+	valAddr  string
+	consAddr sdk.ConsAddress
+	privKey  cmtsecp256k1.PrivKey
+	dkgKey   threshold.DKGKey
+}
+
+// stakingValidator is the staking validator of a testValidator, only its
+// consensus address is used by the module.
+type stakingValidator struct {
+	sdk.ValidatorI
+	consAddr sdk.ConsAddress
+}
+
+func (v stakingValidator) GetConsAddr() ([]byte, error) {
+	return v.consAddr, nil
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	encryptedTxKeeper keeper.Keeper
+	ctx               sdk.Context
+	msgServer         types.MsgServer
+	queryServer       types.QueryServer
+	valStore          *mock.MockValidatorStore
+	addressCodec      address.Codec
+	txConfig          client.TxConfig
+
+	// vals are the validators of the chain, they all took part in the
+	// distributed key generation of the threshold key, 3 of which are required
+	// to decrypt.
+	vals         [4]testValidator
+	thresholdKey *types.ThresholdKey
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, encryptedtx.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Height: 10, ChainID: chainID}).WithBlockHeight(10)
+	s.addressCodec = addresscodec.NewBech32Codec("cosmos")
+	s.txConfig = encCfg.TxConfig
+	validatorAddressCodec := addresscodec.NewBech32Codec("cosmosvaloper")
+	consensusAddressCodec := addresscodec.NewBech32Codec("cosmosvalcons")
+
+	ctrl := gomock.NewController(s.T())
+	s.valStore = mock.NewMockValidatorStore(ctrl)
+	stakingKeeper := encryptedtxtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(validatorAddressCodec).AnyTimes()
+	stakingKeeper.EXPECT().ConsensusAddressCodec().Return(consensusAddressCodec).AnyTimes()
+
+	for i := range s.vals {
+		privKey := cmtsecp256k1.GenPrivKey()
+		valAddr := sdk.ValAddress(privKey.PubKey().Address())
+		s.vals[i] = testValidator{consAddr: sdk.ConsAddress(privKey.PubKey().Address()), privKey: privKey}
+
+		var err error
+		s.vals[i].valAddr, err = validatorAddressCodec.BytesToString(valAddr)
+		s.Require().NoError(err)
+		s.vals[i].dkgKey, err = threshold.GenerateDKGKey(rand.Reader)
+		s.Require().NoError(err)
+
+		stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(stakingValidator{consAddr: s.vals[i].consAddr}, nil).AnyTimes()
+
+		pk, err := cryptocodec.FromCmtProtoPublicKey(cmtprotocrypto.PublicKey{
+			Sum: &cmtprotocrypto.PublicKey_Secp256K1{Secp256K1: privKey.PubKey().Bytes()},
+		})
+		s.Require().NoError(err)
+		s.valStore.EXPECT().GetPubKeyByConsAddr(gomock.Any(), s.vals[i].consAddr).Return(pk, nil).AnyTimes()
+	}
+
+	s.encryptedTxKeeper = keeper.NewKeeper(encCfg.Codec, env, stakingKeeper, govModuleNameStr)
+
+	params := types.DefaultParams()
+	params.MaxTxsPerBlock = 3
+	params.DkgPhaseBlocks = 2
+	s.Require().NoError(s.encryptedTxKeeper.InitGenesis(s.ctx, types.NewGenesisState(params, nil, 0, nil, nil, nil)))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.encryptedTxKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.encryptedTxKeeper)
+
+	s.thresholdKey = s.runDKG(3)
+}
+
+// runDKG runs a distributed key generation among all the validators, which
+// are honest, from the current block and returns the threshold key it
+// generates. The context is moved to the first block the key can be used in.
+func (s *KeeperTestSuite) runDKG(thresholdValue uint32) *types.ThresholdKey {
+	s.T().Helper()
+
+	validators := make([]string, len(s.vals))
+	for i, val := range s.vals {
+		validators[i] = val.valAddr
+	}
+	res, err := s.msgServer.StartDKG(s.ctx, &types.MsgStartDKG{Authority: govModuleNameStr, Threshold: thresholdValue, Validators: validators})
+	s.Require().NoError(err)
+
+	for _, val := range s.vals {
+		encryptionKey, err := val.dkgKey.PublicKey()
+		s.Require().NoError(err)
+		_, err = s.msgServer.RegisterDKGKey(s.ctx, &types.MsgRegisterDKGKey{ValidatorAddress: val.valAddr, EncryptionKey: encryptionKey})
+		s.Require().NoError(err)
+	}
+
+	dkg, err := s.encryptedTxKeeper.GetDKG(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(res.Id, dkg.Id)
+
+	s.setHeight(dkg.RegistrationEndHeight + 1)
+	for _, val := range s.vals {
+		_, err := s.msgServer.SubmitDealing(s.ctx, s.deal(dkg, val.valAddr))
+		s.Require().NoError(err)
+	}
+
+	s.setHeight(dkg.ComplaintEndHeight)
+	s.Require().NoError(s.encryptedTxKeeper.EndBlocker(s.ctx))
+	key, err := s.encryptedTxKeeper.GetThresholdKey(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NotNil(key)
+	s.Require().Equal(dkg.Id, key.DkgId)
+
+	s.setHeight(dkg.ComplaintEndHeight + 1)
+	return key
+}
+
+// deal returns the message submitting a valid dealing of validatorAddress.
+func (s *KeeperTestSuite) deal(dkg *types.DKG, validatorAddress string) *types.MsgSubmitDealing {
+	s.T().Helper()
+	dealing, err := threshold.NewDealing(rand.Reader, int(dkg.Threshold), dkg.EncryptionKeys())
+	s.Require().NoError(err)
+	return types.NewMsgSubmitDealing(validatorAddress, dealing)
+}
+
+// setHeight moves the context to the block at height.
+func (s *KeeperTestSuite) setHeight(height int64) {
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: height, ChainID: chainID}).WithBlockHeight(height)
+}
+
+// address returns the bech32 address of an account.
+func (s *KeeperTestSuite) address(name string) string {
+	s.T().Helper()
+	addr, err := s.addressCodec.BytesToString(sdk.AccAddress(name))
+	s.Require().NoError(err)
+	return addr
+}
+
+// submit submits txBytes encrypted by sender and returns the tx ordering it.
+func (s *KeeperTestSuite) submit(sender string, txBytes []byte) (uint64, []byte) {
+	s.T().Helper()
+	msg, err := types.NewMsgSubmitEncryptedTx(rand.Reader, s.thresholdKey, sender, txBytes)
+	s.Require().NoError(err)
+
+	res, err := s.msgServer.SubmitEncryptedTx(s.ctx, msg)
+	s.Require().NoError(err)
+	return res.Id, s.encodeTx(msg)
+}
+
+// encodeTx returns an unsigned tx holding msgs.
+func (s *KeeperTestSuite) encodeTx(msgs ...sdk.Msg) []byte {
+	s.T().Helper()
+	builder := s.txConfig.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(msgs...))
+	bz, err := s.txConfig.TxEncoder()(builder.GetTx())
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *KeeperTestSuite) TestBeginBlocker() {
+	height := s.ctx.HeaderInfo().Height
+	s.submit(s.address("alice"), []byte("tx1"))
+	txs, err := s.encryptedTxKeeper.GetEncryptedTxs(s.ctx, height)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+
+	// the encrypted txs of block N are decrypted by the proposal of block N+2,
+	// they are removed once it is executed
+	s.setHeight(height + 1)
+	s.Require().NoError(s.encryptedTxKeeper.BeginBlocker(s.ctx))
+	txs, err = s.encryptedTxKeeper.GetEncryptedTxs(s.ctx, height)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+
+	s.submit(s.address("alice"), []byte("tx2"))
+	s.setHeight(height + 2)
+	s.Require().NoError(s.encryptedTxKeeper.BeginBlocker(s.ctx))
+	txs, err = s.encryptedTxKeeper.GetEncryptedTxs(s.ctx, height)
+	s.Require().NoError(err)
+	s.Require().Empty(txs)
+	txs, err = s.encryptedTxKeeper.GetEncryptedTxs(s.ctx, height+1)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+}
+
+func (s *KeeperTestSuite) TestActiveThresholdKey() {
+	dkg, err := s.encryptedTxKeeper.GetDKG(s.ctx)
+	s.Require().NoError(err)
+
+	key, err := s.encryptedTxKeeper.ActiveThresholdKey(s.ctx, dkg.ComplaintEndHeight+1)
+	s.Require().NoError(err)
+	s.Require().Equal(s.thresholdKey, key)
+
+	// encrypted txs are disabled in the block setting the key, as their shares
+	// would be computed with it, and in the block before, as they would be
+	// decrypted with it
+	key, err = s.encryptedTxKeeper.ActiveThresholdKey(s.ctx, dkg.ComplaintEndHeight)
+	s.Require().NoError(err)
+	s.Require().Nil(key)
+	key, err = s.encryptedTxKeeper.ActiveThresholdKey(s.ctx, dkg.ComplaintEndHeight-1)
+	s.Require().NoError(err)
+	s.Require().Nil(key)
+
+	// and without a threshold key
+	s.Require().NoError(s.encryptedTxKeeper.ThresholdKey.Remove(s.ctx))
+	key, err = s.encryptedTxKeeper.ActiveThresholdKey(s.ctx, dkg.ComplaintEndHeight+1)
+	s.Require().NoError(err)
+	s.Require().Nil(key)
+}
@@ -0,0 +1,333 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/encryptedtx MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// SubmitEncryptedTx stores an encrypted tx to be decrypted and included two
+// blocks later. The ciphertext must be bound to the sender so that it cannot be
+// resubmitted by someone else.
+func (ms msgServer) SubmitEncryptedTx(ctx context.Context, msg *types.MsgSubmitEncryptedTx) (*types.MsgSubmitEncryptedTxResponse, error) {
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := ms.HeaderService.HeaderInfo(ctx).Height
+	key, err := ms.ActiveThresholdKey(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, types.ErrDisabled
+	}
+
+	if len(msg.Ciphertext) > int(params.MaxCiphertextSize) {
+		return nil, errors.Wrapf(types.ErrInvalidCiphertext, "ciphertext size %d exceeds maximum %d", len(msg.Ciphertext), params.MaxCiphertextSize)
+	}
+
+	if err := threshold.VerifyCiphertext(msg.Ciphertext, []byte(msg.Sender)); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidCiphertext, err.Error())
+	}
+
+	count := uint32(0)
+	err = ms.EncryptedTxs.Walk(ctx, collections.NewPrefixedPairRange[int64, uint64](height), func(collections.Pair[int64, uint64], types.EncryptedTx) (bool, error) {
+		count++
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count >= params.MaxTxsPerBlock {
+		return nil, errors.Wrapf(types.ErrBlockFull, "max %d encrypted txs per block", params.MaxTxsPerBlock)
+	}
+
+	id, err := ms.NextID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedTx := types.EncryptedTx{
+		Id:         id,
+		Height:     height,
+		Sender:     msg.Sender,
+		Ciphertext: msg.Ciphertext,
+	}
+	if err := ms.EncryptedTxs.Set(ctx, collections.Join(height, id), encryptedTx); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSubmitEncryptedTx,
+		event.NewAttribute(types.AttributeKeyID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, msg.Sender),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitEncryptedTxResponse{Id: id}, nil
+}
+
+// StartDKG starts a distributed key generation among the given validators. Its
+// phases each last DkgPhaseBlocks blocks, the threshold key is replaced at the
+// end of the last phase if enough participants dealt.
+func (ms msgServer) StartDKG(ctx context.Context, msg *types.MsgStartDKG) (*types.MsgStartDKGResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if msg.Threshold == 0 || int(msg.Threshold) > len(msg.Validators) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "threshold must be in [1, %d], got %d", len(msg.Validators), msg.Threshold)
+	}
+
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := ms.HeaderService.HeaderInfo(ctx).Height
+	last, err := ms.GetDKG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := uint64(1)
+	if last != nil {
+		if last.InProgress(height) {
+			return nil, errors.Wrapf(types.ErrDKGPhase, "distributed key generation %d is in progress", last.Id)
+		}
+		id = last.Id + 1
+	}
+
+	participants := make([]types.DKGParticipant, len(msg.Validators))
+	seen := make(map[string]struct{}, len(msg.Validators))
+	for i, validatorAddress := range msg.Validators {
+		if _, ok := seen[validatorAddress]; ok {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate validator %s", validatorAddress)
+		}
+		seen[validatorAddress] = struct{}{}
+
+		consAddr, err := ms.consensusAddress(ctx, validatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		participants[i] = types.DKGParticipant{
+			ValidatorAddress: validatorAddress,
+			ConsensusAddress: consAddr,
+			Index:            uint32(i + 1),
+		}
+	}
+
+	phase := int64(params.DkgPhaseBlocks)
+	dkg := types.DKG{
+		Id:                    id,
+		Threshold:             msg.Threshold,
+		RegistrationEndHeight: height + phase,
+		DealingEndHeight:      height + 2*phase,
+		ComplaintEndHeight:    height + 3*phase,
+		Participants:          participants,
+	}
+	if err := ms.DKG.Set(ctx, dkg); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeStartDKG,
+		event.NewAttribute(types.AttributeKeyID, strconv.FormatUint(id, 10)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStartDKGResponse{Id: id}, nil
+}
+
+// RegisterDKGKey registers the encryption key of a participant during the
+// registration phase. A participant can register again to replace its key.
+func (ms msgServer) RegisterDKGKey(ctx context.Context, msg *types.MsgRegisterDKGKey) (*types.MsgRegisterDKGKeyResponse, error) {
+	dkg, err := ms.GetDKG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dkg == nil || ms.HeaderService.HeaderInfo(ctx).Height > dkg.RegistrationEndHeight {
+		return nil, errors.Wrap(types.ErrDKGPhase, "registration is closed")
+	}
+
+	participant, ok := dkg.Participant(msg.ValidatorAddress)
+	if !ok {
+		return nil, errors.Wrap(types.ErrNotParticipant, msg.ValidatorAddress)
+	}
+
+	if err := threshold.VerifyDKGPublicKey(msg.EncryptionKey); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid encryption key: %s", err)
+	}
+
+	dkg.Participants[participant.Index-1].EncryptionKey = msg.EncryptionKey
+	if err := ms.DKG.Set(ctx, *dkg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterDKGKeyResponse{}, nil
+}
+
+// SubmitDealing stores the dealing of a registered participant during the
+// dealing phase, once it is verified to hold a share for every registered
+// participant.
+func (ms msgServer) SubmitDealing(ctx context.Context, msg *types.MsgSubmitDealing) (*types.MsgSubmitDealingResponse, error) {
+	dkg, err := ms.GetDKG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	height := ms.HeaderService.HeaderInfo(ctx).Height
+	if dkg == nil || height <= dkg.RegistrationEndHeight || height > dkg.DealingEndHeight {
+		return nil, errors.Wrap(types.ErrDKGPhase, "dealing is closed")
+	}
+
+	participant, ok := dkg.Participant(msg.ValidatorAddress)
+	if !ok || len(participant.EncryptionKey) == 0 {
+		return nil, errors.Wrap(types.ErrNotParticipant, msg.ValidatorAddress)
+	}
+
+	key := collections.Join(dkg.Id, participant.Index)
+	if has, err := ms.Dealings.Has(ctx, key); err != nil {
+		return nil, err
+	} else if has {
+		return nil, errors.Wrapf(types.ErrInvalidDealing, "%s already dealt", msg.ValidatorAddress)
+	}
+
+	registered := dkg.Registered()
+	indexes := make([]uint32, len(registered))
+	for i, p := range registered {
+		indexes[i] = p.Index
+	}
+	if len(msg.EncryptedShares) != len(indexes) {
+		return nil, errors.Wrapf(types.ErrInvalidDealing, "expected %d shares, got %d", len(indexes), len(msg.EncryptedShares))
+	}
+
+	dealing := types.Dealing{
+		DkgId:           dkg.Id,
+		Dealer:          participant.Index,
+		Commitments:     msg.Commitments,
+		EphemeralG1:     msg.EphemeralG1,
+		EphemeralG2:     msg.EphemeralG2,
+		EncryptedShares: msg.EncryptedShares,
+	}
+	if err := dealing.Threshold().Verify(int(dkg.Threshold), indexes); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDealing, err.Error())
+	}
+
+	if err := ms.Dealings.Set(ctx, key, dealing); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitDealingResponse{}, nil
+}
+
+// SubmitComplaint disqualifies the dealer of an invalid share during the
+// complaint phase. The complaint reveals the key the share of the complaining
+// participant was encrypted with, so that anyone can verify the share.
+func (ms msgServer) SubmitComplaint(ctx context.Context, msg *types.MsgSubmitComplaint) (*types.MsgSubmitComplaintResponse, error) {
+	dkg, err := ms.GetDKG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	height := ms.HeaderService.HeaderInfo(ctx).Height
+	if dkg == nil || height <= dkg.DealingEndHeight || height > dkg.ComplaintEndHeight {
+		return nil, errors.Wrap(types.ErrDKGPhase, "complaints are closed")
+	}
+
+	participant, ok := dkg.Participant(msg.ValidatorAddress)
+	if !ok || len(participant.EncryptionKey) == 0 {
+		return nil, errors.Wrap(types.ErrNotParticipant, msg.ValidatorAddress)
+	}
+
+	dealing, err := ms.Dealings.Get(ctx, collections.Join(dkg.Id, msg.Dealer))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, errors.Wrapf(types.ErrInvalidComplaint, "dealer %d did not deal", msg.Dealer)
+	} else if err != nil {
+		return nil, err
+	}
+	if dkg.Participants[msg.Dealer-1].Disqualified {
+		return nil, errors.Wrapf(types.ErrInvalidComplaint, "dealer %d is already disqualified", msg.Dealer)
+	}
+
+	if err := dealing.Threshold().VerifyComplaint(participant.Index, participant.EncryptionKey, msg.SharedKey); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidComplaint, err.Error())
+	}
+
+	dkg.Participants[msg.Dealer-1].Disqualified = true
+	if err := ms.DKG.Set(ctx, *dkg); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDisqualifyDealer,
+		event.NewAttribute(types.AttributeKeyID, strconv.FormatUint(dkg.Id, 10)),
+		event.NewAttribute(types.AttributeKeyDealer, dkg.Participants[msg.Dealer-1].ValidatorAddress),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitComplaintResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// consensusAddress returns the consensus address of the validator with the
+// given operator address.
+func (k Keeper) consensusAddress(ctx context.Context, validatorAddress string) (string, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validatorAddress)
+	if err != nil {
+		return "", errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s: %s", validatorAddress, err)
+	}
+
+	validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return "", fmt.Errorf("validator %s: %w", validatorAddress, err)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return "", err
+	}
+
+	return k.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+}
@@ -0,0 +1,275 @@
+//go:build bls12381
+
+package keeper_test
+
+import (
+	"crypto/rand"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+func (s *KeeperTestSuite) TestSubmitEncryptedTx() {
+	alice, bob := s.address("alice"), s.address("bob")
+	id, _ := s.submit(alice, []byte("tx0"))
+	s.Require().Equal(uint64(0), id)
+	id, _ = s.submit(bob, []byte("tx1"))
+	s.Require().Equal(uint64(1), id)
+
+	height := s.ctx.HeaderInfo().Height
+	txs, err := s.encryptedTxKeeper.GetEncryptedTxs(s.ctx, height)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 2)
+	s.Require().Equal(alice, txs[0].Sender)
+	s.Require().Equal(height, txs[1].Height)
+
+	// a ciphertext copied by another sender is rejected
+	_, err = s.msgServer.SubmitEncryptedTx(s.ctx, &types.MsgSubmitEncryptedTx{Sender: bob, Ciphertext: txs[0].Ciphertext})
+	s.Require().ErrorIs(err, types.ErrInvalidCiphertext)
+
+	// ciphertexts larger than the max ciphertext size are rejected
+	msg, err := types.NewMsgSubmitEncryptedTx(rand.Reader, s.thresholdKey, alice, make([]byte, types.DefaultMaxCiphertextSize))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitEncryptedTx(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidCiphertext)
+
+	// a block orders at most max txs per block encrypted txs
+	s.submit(alice, []byte("tx2"))
+	msg, err = types.NewMsgSubmitEncryptedTx(rand.Reader, s.thresholdKey, alice, []byte("tx3"))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitEncryptedTx(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrBlockFull)
+
+	// encrypted txs are disabled without a threshold key
+	s.Require().NoError(s.encryptedTxKeeper.ThresholdKey.Remove(s.ctx))
+	s.setHeight(height + 1)
+	_, err = s.msgServer.SubmitEncryptedTx(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrDisabled)
+}
+
+func (s *KeeperTestSuite) TestDKG() {
+	validators := []string{s.vals[0].valAddr, s.vals[1].valAddr, s.vals[2].valAddr}
+	start := func(msg *types.MsgStartDKG) error {
+		_, err := s.msgServer.StartDKG(s.ctx, msg)
+		return err
+	}
+	s.Require().ErrorIs(start(&types.MsgStartDKG{Authority: s.address("alice"), Threshold: 2, Validators: validators}), types.ErrInvalidSigner)
+	s.Require().Error(start(&types.MsgStartDKG{Authority: govModuleNameStr, Threshold: 0, Validators: validators}))
+	s.Require().Error(start(&types.MsgStartDKG{Authority: govModuleNameStr, Threshold: 4, Validators: validators}))
+	s.Require().Error(start(&types.MsgStartDKG{Authority: govModuleNameStr, Threshold: 2, Validators: append(validators, validators[0])}))
+
+	res, err := s.msgServer.StartDKG(s.ctx, &types.MsgStartDKG{Authority: govModuleNameStr, Threshold: 2, Validators: validators})
+	s.Require().NoError(err)
+	s.Require().Equal(s.thresholdKey.DkgId+1, res.Id)
+	s.Require().ErrorIs(start(&types.MsgStartDKG{Authority: govModuleNameStr, Threshold: 2, Validators: validators}), types.ErrDKGPhase)
+
+	dkg, err := s.encryptedTxKeeper.GetDKG(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.HeaderInfo().Height+2, dkg.RegistrationEndHeight)
+	s.Require().Equal(s.ctx.HeaderInfo().Height+6, dkg.ComplaintEndHeight)
+
+	// registration phase
+	register := func(val testValidator) error {
+		encryptionKey, err := val.dkgKey.PublicKey()
+		s.Require().NoError(err)
+		_, err = s.msgServer.RegisterDKGKey(s.ctx, &types.MsgRegisterDKGKey{ValidatorAddress: val.valAddr, EncryptionKey: encryptionKey})
+		return err
+	}
+	s.Require().ErrorIs(register(s.vals[3]), types.ErrNotParticipant)
+	_, err = s.msgServer.RegisterDKGKey(s.ctx, &types.MsgRegisterDKGKey{ValidatorAddress: s.vals[0].valAddr, EncryptionKey: []byte("invalid")})
+	s.Require().Error(err)
+	for _, val := range s.vals[:3] {
+		s.Require().NoError(register(val))
+	}
+	dkg, err = s.encryptedTxKeeper.GetDKG(s.ctx)
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitDealing(s.ctx, s.deal(dkg, s.vals[0].valAddr))
+	s.Require().ErrorIs(err, types.ErrDKGPhase)
+
+	// dealing phase: the second validator deals an invalid share to the third
+	// one, which does not deal
+	s.setHeight(dkg.RegistrationEndHeight + 1)
+	s.Require().ErrorIs(register(s.vals[0]), types.ErrDKGPhase)
+
+	_, err = s.msgServer.SubmitDealing(s.ctx, s.deal(dkg, s.vals[0].valAddr))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitDealing(s.ctx, s.deal(dkg, s.vals[0].valAddr))
+	s.Require().ErrorIs(err, types.ErrInvalidDealing)
+
+	msg := s.deal(dkg, s.vals[1].valAddr)
+	msg.EncryptedShares = msg.EncryptedShares[1:]
+	_, err = s.msgServer.SubmitDealing(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidDealing)
+
+	dealing, err := threshold.NewDealing(rand.Reader, 2, dkg.EncryptionKeys())
+	s.Require().NoError(err)
+	dealing.Shares[3] = dealing.Shares[2]
+	_, err = s.msgServer.SubmitDealing(s.ctx, types.NewMsgSubmitDealing(s.vals[1].valAddr, dealing))
+	s.Require().NoError(err)
+
+	// complaint phase
+	complain := func(val testValidator, dealer uint32) error {
+		stored, err := s.encryptedTxKeeper.Dealings.Get(s.ctx, collections.Join(dkg.Id, dealer))
+		if err != nil {
+			stored = types.NewDealing(dkg.Id, dealer, dealing)
+		}
+		sharedKey, err := stored.Threshold().SharedKey(val.dkgKey)
+		s.Require().NoError(err)
+		_, err = s.msgServer.SubmitComplaint(s.ctx, &types.MsgSubmitComplaint{ValidatorAddress: val.valAddr, Dealer: dealer, SharedKey: sharedKey})
+		return err
+	}
+	s.Require().ErrorIs(complain(s.vals[2], 2), types.ErrDKGPhase)
+
+	s.setHeight(dkg.DealingEndHeight + 1)
+	s.Require().ErrorIs(complain(s.vals[2], 1), types.ErrInvalidComplaint)
+	s.Require().ErrorIs(complain(s.vals[2], 3), types.ErrInvalidComplaint)
+	s.Require().ErrorIs(complain(s.vals[3], 2), types.ErrNotParticipant)
+	s.Require().NoError(complain(s.vals[2], 2))
+	s.Require().ErrorIs(complain(s.vals[2], 2), types.ErrInvalidComplaint)
+
+	dkg, err = s.encryptedTxKeeper.GetDKG(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(dkg.Participants[1].Disqualified)
+
+	// a single dealer is qualified, below the threshold: the threshold key is
+	// kept and the dealings are removed
+	s.setHeight(dkg.ComplaintEndHeight)
+	s.Require().NoError(s.encryptedTxKeeper.EndBlocker(s.ctx))
+	key, err := s.encryptedTxKeeper.GetThresholdKey(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(s.thresholdKey, key)
+	dealings, err := s.encryptedTxKeeper.GetDealings(s.ctx, dkg.Id)
+	s.Require().NoError(err)
+	s.Require().Empty(dealings)
+	dealings, err = s.encryptedTxKeeper.GetDealings(s.ctx, s.thresholdKey.DkgId)
+	s.Require().NoError(err)
+	s.Require().Len(dealings, len(s.vals))
+}
+
+func (s *KeeperTestSuite) TestDKG_Disqualified() {
+	validators := make([]string, len(s.vals))
+	for i, val := range s.vals {
+		validators[i] = val.valAddr
+	}
+	_, err := s.msgServer.StartDKG(s.ctx, &types.MsgStartDKG{Authority: govModuleNameStr, Threshold: 2, Validators: validators})
+	s.Require().NoError(err)
+
+	// the last validator does not register
+	for _, val := range s.vals[:3] {
+		encryptionKey, err := val.dkgKey.PublicKey()
+		s.Require().NoError(err)
+		_, err = s.msgServer.RegisterDKGKey(s.ctx, &types.MsgRegisterDKGKey{ValidatorAddress: val.valAddr, EncryptionKey: encryptionKey})
+		s.Require().NoError(err)
+	}
+	dkg, err := s.encryptedTxKeeper.GetDKG(s.ctx)
+	s.Require().NoError(err)
+
+	// the first validator deals an invalid share to the second one
+	s.setHeight(dkg.RegistrationEndHeight + 1)
+	dealing, err := threshold.NewDealing(rand.Reader, 2, dkg.EncryptionKeys())
+	s.Require().NoError(err)
+	dealing.Shares[2] = dealing.Shares[1]
+	_, err = s.msgServer.SubmitDealing(s.ctx, types.NewMsgSubmitDealing(s.vals[0].valAddr, dealing))
+	s.Require().NoError(err)
+	for _, val := range s.vals[1:3] {
+		_, err := s.msgServer.SubmitDealing(s.ctx, s.deal(dkg, val.valAddr))
+		s.Require().NoError(err)
+	}
+	_, err = s.msgServer.SubmitDealing(s.ctx, s.deal(dkg, s.vals[3].valAddr))
+	s.Require().ErrorIs(err, types.ErrNotParticipant)
+
+	s.setHeight(dkg.DealingEndHeight + 1)
+	sharedKey, err := dealing.SharedKey(s.vals[1].dkgKey)
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitComplaint(s.ctx, &types.MsgSubmitComplaint{ValidatorAddress: s.vals[1].valAddr, Dealer: 1, SharedKey: sharedKey})
+	s.Require().NoError(err)
+
+	// the key is generated by the dealings of the qualified dealers, and held
+	// by the registered participants
+	s.setHeight(dkg.ComplaintEndHeight)
+	s.Require().NoError(s.encryptedTxKeeper.EndBlocker(s.ctx))
+	key, err := s.encryptedTxKeeper.GetThresholdKey(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(dkg.Id, key.DkgId)
+	s.Require().NoError(key.Validate())
+	s.Require().Len(key.KeyHolders, 3)
+	s.Require().Equal(uint32(2), key.Threshold)
+
+	dealings, err := s.encryptedTxKeeper.GetDealings(s.ctx, dkg.Id)
+	s.Require().NoError(err)
+	s.Require().Len(dealings, 2)
+	dealings, err = s.encryptedTxKeeper.GetDealings(s.ctx, s.thresholdKey.DkgId)
+	s.Require().NoError(err)
+	s.Require().Empty(dealings)
+
+	thresholdDealings := make([]threshold.Dealing, len(dealings))
+	for i, dealing := range dealings {
+		thresholdDealings[i] = dealing.Threshold()
+	}
+	for i, val := range s.vals[:3] {
+		keyShare, err := threshold.NewKeyShare(val.dkgKey, key.KeyHolders[i].Index, thresholdDealings)
+		s.Require().NoError(err)
+		vk, err := keyShare.VerificationKey()
+		s.Require().NoError(err)
+		s.Require().Equal(key.KeyHolders[i].VerificationKey, vk)
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	invalidParams := types.DefaultParams()
+	invalidParams.MaxCiphertextSize = threshold.CiphertextOverhead
+
+	validParams := types.DefaultParams()
+	validParams.DkgPhaseBlocks = 10
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority (not an address)",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &types.MsgUpdateParams{
+				Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.encryptedTxKeeper.GetAuthority(),
+				Params:    invalidParams,
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.encryptedTxKeeper.GetAuthority(),
+				Params:    validParams,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
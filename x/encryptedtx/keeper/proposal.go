@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalHandler defines ABCI PrepareProposal and ProcessProposal handlers
+// including the decrypted txs at the top of the block. The encrypted txs ordered
+// by block N are decrypted with the decryption shares published in the vote
+// extensions of block N+1, block N+2 starts with the extended commit info of
+// block N+1, followed by the decrypted txs in submission order. The remaining
+// txs are selected and verified by the wrapped handlers.
+type ProposalHandler struct {
+	keeper          Keeper
+	valStore        baseapp.ValidatorStore
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a ProposalHandler wrapping the given handlers,
+// e.g. the handlers of baseapp.DefaultProposalHandler.
+func NewProposalHandler(
+	k Keeper,
+	valStore baseapp.ValidatorStore,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		keeper:          k,
+		valStore:        valStore,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler injecting the
+// extended commit info of the previous block and the txs it decrypts when
+// encrypted txs are due.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+		encryptedTxs, err := h.keeper.GetEncryptedTxs(ctx, req.Height-2)
+		if err != nil {
+			return nil, err
+		}
+		if len(encryptedTxs) == 0 {
+			return h.prepareProposal(ctx, req)
+		}
+
+		if err := h.verifyExtendedCommit(ctx, req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+
+		extCommit, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		decryptedTxs, err := h.keeper.DecryptTxs(ctx, encryptedTxs, req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		injectedTxs := append([][]byte{extCommit}, decryptedTxs...)
+		injectedTxsSize := cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(injectedTxs))
+		if injectedTxsSize > req.MaxTxBytes {
+			return nil, fmt.Errorf("decrypted txs size %d exceeds max tx bytes %d", injectedTxsSize, req.MaxTxBytes)
+		}
+
+		innerReq := *req
+		innerReq.MaxTxBytes -= injectedTxsSize
+		resp, err := h.prepareProposal(ctx, &innerReq)
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.PrepareProposalResponse{Txs: append(injectedTxs, resp.Txs...)}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler rejecting the
+// proposal when encrypted txs are due and it does not start with a valid
+// extended commit info of the previous block followed by the txs it decrypts.
+// The remaining txs are processed by the wrapped handler.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		encryptedTxs, err := h.keeper.GetEncryptedTxs(ctx, req.Height-2)
+		if err != nil {
+			return nil, err
+		}
+		if len(encryptedTxs) == 0 {
+			return h.processProposal(ctx, req)
+		}
+
+		reject := &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}
+		if len(req.Txs) == 0 {
+			return reject, nil
+		}
+
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			ctx.Logger().Error("failed to decode extended commit info", "err", err)
+			return reject, nil
+		}
+		if err := h.verifyExtendedCommit(ctx, extCommit); err != nil {
+			ctx.Logger().Error("invalid extended commit info", "err", err)
+			return reject, nil
+		}
+
+		decryptedTxs, err := h.keeper.DecryptTxs(ctx, encryptedTxs, extCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		txs := req.Txs[1:]
+		if len(txs) < len(decryptedTxs) {
+			return reject, nil
+		}
+		for i, tx := range decryptedTxs {
+			if !bytes.Equal(tx, txs[i]) {
+				return reject, nil
+			}
+		}
+
+		innerReq := *req
+		innerReq.Txs = txs[len(decryptedTxs):]
+		return h.processProposal(ctx, &innerReq)
+	}
+}
+
+// verifyExtendedCommit verifies the vote extensions of extCommit and that its
+// votes match the last commit, so that the proposer cannot omit the decryption
+// shares of some validators.
+func (h *ProposalHandler) verifyExtendedCommit(ctx sdk.Context, extCommit abci.ExtendedCommitInfo) error {
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, extCommit); err != nil {
+		return err
+	}
+
+	lastCommit := ctx.CometInfo().LastCommit
+	for i, vote := range extCommit.Votes {
+		if int32(vote.BlockIdFlag) != int32(lastCommit.Votes[i].BlockIDFlag) {
+			return fmt.Errorf("extended commit vote %X block id flag does not match last commit", vote.Validator.Address)
+		}
+	}
+
+	return nil
+}
+
+// DecryptTxs decrypts encryptedTxs with the decryption shares of the key holders
+// found in the vote extensions of extCommit, which must have been verified. The
+// encrypted txs which cannot be decrypted, e.g. when not enough key holders
+// published their share, are skipped. Invalid shares are ignored so that the
+// result only depends on extCommit.
+func (k Keeper) DecryptTxs(ctx sdk.Context, encryptedTxs []types.EncryptedTx, extCommit abci.ExtendedCommitInfo) ([][]byte, error) {
+	key, err := k.GetThresholdKey(ctx)
+	if err != nil || key == nil {
+		return nil, err
+	}
+
+	holders, err := k.keyHolders(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// decryption shares are matched to the encrypted txs by the ephemeral key
+	// of their ciphertext
+	thresholdValue := int(key.Threshold)
+	shares := make(map[string][]threshold.DecryptionShare, len(encryptedTxs))
+	for _, tx := range encryptedTxs {
+		ephemeral, err := threshold.Ephemeral(tx.Ciphertext)
+		if err != nil {
+			return nil, err
+		}
+		shares[string(ephemeral)] = nil
+	}
+
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		holder, ok := holders[string(vote.Validator.Address)]
+		if !ok {
+			continue
+		}
+
+		var voteExt types.VoteExtension
+		if err := voteExt.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+
+		seen := make(map[string]struct{}, len(voteExt.Shares))
+		for _, bz := range voteExt.Shares {
+			share, err := threshold.ParseDecryptionShare(bz)
+			if err != nil {
+				continue
+			}
+
+			ephemeral := string(share.Ephemeral)
+			txShares, ok := shares[ephemeral]
+			if !ok || len(txShares) == thresholdValue {
+				continue
+			}
+			if _, ok := seen[ephemeral]; ok {
+				continue
+			}
+			if verifyDecryptionShare(share, holder) != nil {
+				continue
+			}
+
+			seen[ephemeral] = struct{}{}
+			shares[ephemeral] = append(txShares, share)
+		}
+	}
+
+	var txs [][]byte
+	for _, tx := range encryptedTxs {
+		plaintext, err := threshold.Decrypt(tx.Ciphertext, []byte(tx.Sender), shares[string(tx.Ciphertext[:threshold.G1PointSize])], thresholdValue)
+		if err != nil {
+			ctx.Logger().Debug("failed to decrypt encrypted tx", "id", tx.Id, "err", err)
+			continue
+		}
+
+		txs = append(txs, plaintext)
+	}
+
+	return txs, nil
+}
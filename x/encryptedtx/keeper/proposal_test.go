@@ -0,0 +1,275 @@
+//go:build bls12381
+
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	protoio "github.com/cosmos/gogoproto/io"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/encryptedtx/keeper"
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// extendVotes returns the vote extensions of the validators for the block at
+// height, validators without DKG key do not extend their vote.
+func (s *KeeperTestSuite) extendVotes(height int64, withDKGKey ...bool) [][]byte {
+	s.T().Helper()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: height, ChainID: chainID})
+
+	voteExts := make([][]byte, len(s.vals))
+	for i, val := range s.vals {
+		dkgKey := &val.dkgKey
+		if !withDKGKey[i] {
+			dkgKey = nil
+		}
+
+		h := keeper.NewVoteExtensionHandler(s.encryptedTxKeeper, dkgKey)
+		res, err := h.ExtendVoteHandler()(ctx, &abci.ExtendVoteRequest{Height: height})
+		s.Require().NoError(err)
+		voteExts[i] = res.VoteExtension
+
+		verifyRes, err := h.VerifyVoteExtensionHandler()(ctx, &abci.VerifyVoteExtensionRequest{
+			ValidatorAddress: val.consAddr,
+			Height:           height,
+			VoteExtension:    res.VoteExtension,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT, verifyRes.Status)
+	}
+
+	return voteExts
+}
+
+// extendedCommit returns the extended commit info of the block at height
+// holding the signed vote extensions of all the validators, and the context of
+// the next block.
+func (s *KeeperTestSuite) extendedCommit(height int64, voteExts [][]byte) (sdk.Context, abci.ExtendedCommitInfo) {
+	s.T().Helper()
+
+	extCommit := abci.ExtendedCommitInfo{}
+	for i, val := range s.vals {
+		var buf bytes.Buffer
+		err := protoio.NewDelimitedWriter(&buf).WriteMsg(&cmtproto.CanonicalVoteExtension{
+			Extension: voteExts[i],
+			Height:    height,
+			ChainId:   chainID,
+		})
+		s.Require().NoError(err)
+
+		sig, err := val.privKey.Sign(buf.Bytes())
+		s.Require().NoError(err)
+
+		extCommit.Votes = append(extCommit.Votes, abci.ExtendedVoteInfo{
+			Validator:          abci.Validator{Address: val.consAddr, Power: 25},
+			VoteExtension:      voteExts[i],
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+	}
+	sort.Slice(extCommit.Votes, func(i, j int) bool {
+		return bytes.Compare(extCommit.Votes[i].Validator.Address, extCommit.Votes[j].Validator.Address) < 0
+	})
+
+	return s.nextBlockContext(height+1, extCommit), extCommit
+}
+
+// nextBlockContext returns the context of the proposal of the block at height,
+// whose last commit is extCommit.
+func (s *KeeperTestSuite) nextBlockContext(height int64, extCommit abci.ExtendedCommitInfo) sdk.Context {
+	lastCommit := comet.CommitInfo{Round: extCommit.Round}
+	for _, vote := range extCommit.Votes {
+		lastCommit.Votes = append(lastCommit.Votes, comet.VoteInfo{
+			Validator:   comet.Validator{Address: vote.Validator.Address, Power: vote.Validator.Power},
+			BlockIDFlag: comet.BlockIDFlag(vote.BlockIdFlag),
+		})
+	}
+
+	return s.ctx.
+		WithHeaderInfo(header.Info{Height: height, ChainID: chainID}).
+		WithCometInfo(comet.Info{LastCommit: lastCommit}).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Feature: &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 2}},
+		})
+}
+
+func (s *KeeperTestSuite) TestEncryptedTxsFlow() {
+	height := s.ctx.HeaderInfo().Height
+	s.submit(s.address("alice"), []byte("alice tx"))
+	s.submit(s.address("bob"), []byte("bob tx"))
+
+	// the validators publish the decryption shares of the encrypted txs ordered
+	// by the block in the vote extensions of the next one, one of them lost its
+	// DKG key
+	voteExts := s.extendVotes(height+1, true, true, false, true)
+	ctx, extCommit := s.extendedCommit(height+1, voteExts)
+
+	h := keeper.NewProposalHandler(s.encryptedTxKeeper, s.valStore, baseapp.NoOpPrepareProposal(), baseapp.NoOpProcessProposal())
+	prepareRes, err := h.PrepareProposalHandler()(ctx, &abci.PrepareProposalRequest{
+		Height:          height + 2,
+		MaxTxBytes:      1 << 20,
+		Txs:             [][]byte{[]byte("mempool tx")},
+		LocalLastCommit: extCommit,
+	})
+	s.Require().NoError(err)
+
+	// the block after the next one starts with the extended commit info then
+	// the decrypted txs
+	s.Require().Len(prepareRes.Txs, 4)
+	s.Require().Equal([]byte("alice tx"), prepareRes.Txs[1])
+	s.Require().Equal([]byte("bob tx"), prepareRes.Txs[2])
+	s.Require().Equal([]byte("mempool tx"), prepareRes.Txs[3])
+
+	process := func(ctx sdk.Context, txs [][]byte) abci.ProcessProposalStatus {
+		res, err := h.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Height: height + 2, Txs: txs})
+		s.Require().NoError(err)
+		return res.Status
+	}
+	s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_ACCEPT, process(ctx, prepareRes.Txs))
+
+	// the decrypted txs cannot be omitted nor reordered
+	s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_REJECT, process(ctx, prepareRes.Txs[1:]))
+	s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_REJECT, process(ctx, [][]byte{prepareRes.Txs[0], prepareRes.Txs[2], prepareRes.Txs[1]}))
+	s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_REJECT, process(ctx, [][]byte{prepareRes.Txs[0], prepareRes.Txs[1]}))
+
+	// the decryption shares of a validator cannot be omitted
+	extCommit.Votes[0].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+	bz, err := extCommit.Marshal()
+	s.Require().NoError(err)
+	s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_REJECT, process(ctx, append([][]byte{bz}, prepareRes.Txs[1:]...)))
+
+	// nothing is injected once the encrypted txs are removed
+	s.Require().NoError(s.encryptedTxKeeper.BeginBlocker(ctx))
+	prepareRes, err = h.PrepareProposalHandler()(ctx, &abci.PrepareProposalRequest{
+		Height:     height + 2,
+		MaxTxBytes: 1 << 20,
+		Txs:        [][]byte{[]byte("mempool tx")},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{[]byte("mempool tx")}, prepareRes.Txs)
+}
+
+func (s *KeeperTestSuite) TestEncryptedTxsFlow_NotEnoughShares() {
+	height := s.ctx.HeaderInfo().Height
+	s.submit(s.address("alice"), []byte("alice tx"))
+
+	// only 2 key holders publish their shares, below the threshold of 3
+	voteExts := s.extendVotes(height+1, true, false, false, true)
+	ctx, extCommit := s.extendedCommit(height+1, voteExts)
+
+	h := keeper.NewProposalHandler(s.encryptedTxKeeper, s.valStore, baseapp.NoOpPrepareProposal(), baseapp.NoOpProcessProposal())
+	prepareRes, err := h.PrepareProposalHandler()(ctx, &abci.PrepareProposalRequest{
+		Height:          height + 2,
+		MaxTxBytes:      1 << 20,
+		LocalLastCommit: extCommit,
+	})
+	s.Require().NoError(err)
+
+	// the encrypted tx is dropped, only the extended commit info is injected
+	s.Require().Len(prepareRes.Txs, 1)
+	res, err := h.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Height: height + 2, Txs: prepareRes.Txs})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_ACCEPT, res.Status)
+}
+
+func (s *KeeperTestSuite) TestExtendVote() {
+	height := s.ctx.HeaderInfo().Height
+	alice := s.address("alice")
+	_, aliceTx := s.submit(alice, []byte("alice tx"))
+
+	h := keeper.NewVoteExtensionHandler(s.encryptedTxKeeper, &s.vals[0].dkgKey)
+	shares := func(height int64, txs ...[]byte) int {
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: height, ChainID: chainID})
+		res, err := h.ExtendVoteHandler()(ctx, &abci.ExtendVoteRequest{Height: height, Txs: txs})
+		s.Require().NoError(err)
+
+		var voteExt types.VoteExtension
+		s.Require().NoError(voteExt.Unmarshal(res.VoteExtension))
+		return len(voteExt.Shares)
+	}
+
+	// shares are published for the encrypted txs stored by the previous block,
+	// whatever the txs of the block
+	s.Require().Equal(1, shares(height+1, aliceTx))
+	s.Require().Equal(1, shares(height+1))
+	s.Require().Equal(0, shares(height+2, aliceTx))
+
+	// no share is published for a submission the msg server rejects, even when
+	// it is included in the block
+	msg, err := types.NewMsgSubmitEncryptedTx(rand.Reader, s.thresholdKey, alice, []byte("tx"))
+	s.Require().NoError(err)
+	msg.Sender = s.address("bob")
+	_, err = s.msgServer.SubmitEncryptedTx(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidCiphertext)
+	s.Require().Equal(1, shares(height+1, aliceTx, s.encodeTx(msg)))
+
+	// nor beyond the max number of encrypted txs per block
+	s.submit(alice, []byte("tx1"))
+	_, tx2 := s.submit(alice, []byte("tx2"))
+	msg, err = types.NewMsgSubmitEncryptedTx(rand.Reader, s.thresholdKey, alice, []byte("tx3"))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitEncryptedTx(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrBlockFull)
+	s.Require().Equal(3, shares(height+1, aliceTx, tx2, s.encodeTx(msg)))
+}
+
+func (s *KeeperTestSuite) TestVerifyVoteExtension() {
+	height := s.ctx.HeaderInfo().Height
+	s.submit(s.address("alice"), []byte("alice tx"))
+	voteExts := s.extendVotes(height+1, true, true, true, true)
+
+	verifyAt := func(height int64, validator sdk.ConsAddress, voteExt []byte) abci.VerifyVoteExtensionStatus {
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: height, ChainID: chainID})
+		h := keeper.NewVoteExtensionHandler(s.encryptedTxKeeper, nil)
+		res, err := h.VerifyVoteExtensionHandler()(ctx, &abci.VerifyVoteExtensionRequest{
+			ValidatorAddress: validator,
+			Height:           height,
+			VoteExtension:    voteExt,
+		})
+		s.Require().NoError(err)
+		return res.Status
+	}
+	verify := func(validator sdk.ConsAddress, voteExt []byte) abci.VerifyVoteExtensionStatus {
+		return verifyAt(height+1, validator, voteExt)
+	}
+
+	// the shares of a validator cannot be published by another one
+	s.Require().Equal(abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify(s.vals[1].consAddr, voteExts[0]))
+	s.Require().Equal(abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify(sdk.ConsAddress("unknown"), voteExts[0]))
+	s.Require().Equal(abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify(s.vals[0].consAddr, []byte("invalid")))
+
+	// shares not computed with the key share of the validator are rejected
+	var voteExt, other types.VoteExtension
+	s.Require().NoError(voteExt.Unmarshal(voteExts[0]))
+	s.Require().NoError(other.Unmarshal(voteExts[1]))
+	share, err := threshold.ParseDecryptionShare(voteExt.Shares[0])
+	s.Require().NoError(err)
+	otherShare, err := threshold.ParseDecryptionShare(other.Shares[0])
+	s.Require().NoError(err)
+	share.Point = otherShare.Point
+	bz, err := (&types.VoteExtension{Shares: [][]byte{share.Bytes()}}).Marshal()
+	s.Require().NoError(err)
+	s.Require().Equal(abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify(s.vals[0].consAddr, bz))
+
+	// at most one share per encrypted tx stored by the previous block is
+	// accepted
+	bz, err = (&types.VoteExtension{Shares: [][]byte{voteExt.Shares[0], voteExt.Shares[0]}}).Marshal()
+	s.Require().NoError(err)
+	s.Require().Equal(abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify(s.vals[0].consAddr, bz))
+	s.Require().Equal(abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT, verify(s.vals[0].consAddr, voteExts[0]))
+	s.Require().Equal(abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verifyAt(height+2, s.vals[0].consAddr, voteExts[0]))
+
+	// empty vote extensions are accepted
+	s.Require().Equal(abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT, verify(s.vals[0].consAddr, nil))
+}
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	"cosmossdk.io/x/encryptedtx/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteExtensionHandler defines the ABCI ExtendVote and VerifyVoteExtension
+// handlers publishing the decryption shares of the key holders. When extending
+// its vote for block N+1, a key holder computes the decryption shares of the
+// encrypted txs stored by block N, so that block N+2 can decrypt and include
+// them. Shares are only published for the encrypted txs committed to state,
+// i.e. whose submission was executed successfully.
+type VoteExtensionHandler struct {
+	keeper Keeper
+	dkgKey *threshold.DKGKey
+
+	mu       sync.Mutex
+	keyShare *threshold.KeyShare
+	// keyShareDKG is the id of the distributed key generation keyShare was
+	// derived from.
+	keyShareDKG uint64
+}
+
+// NewVoteExtensionHandler returns a VoteExtensionHandler computing decryption
+// shares with the key share the validator running the node derives from
+// dkgKey, the DKG key it registered. It must be nil if the validator does not
+// take part in distributed key generations.
+func NewVoteExtensionHandler(k Keeper, dkgKey *threshold.DKGKey) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		keeper: k,
+		dkgKey: dkgKey,
+	}
+}
+
+// ExtendVoteHandler returns an ExtendVote handler extending the vote with the
+// decryption shares of the encrypted txs stored by the previous block. The
+// extension is empty if the node holds no key share of the threshold key.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+		empty := &abci.ExtendVoteResponse{VoteExtension: []byte{}}
+		if h.dkgKey == nil {
+			return empty, nil
+		}
+
+		encryptedTxs, err := h.keeper.GetEncryptedTxs(ctx, req.Height-1)
+		if err != nil {
+			return nil, err
+		}
+		if len(encryptedTxs) == 0 {
+			return empty, nil
+		}
+
+		// encrypted txs are not stored by the blocks whose txs would be
+		// decrypted with another key, see ActiveThresholdKey
+		key, err := h.keeper.GetThresholdKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if key == nil {
+			return empty, nil
+		}
+
+		keyShare, err := h.deriveKeyShare(ctx, key)
+		if err != nil {
+			ctx.Logger().Error("failed to derive key share", "dkg", key.DkgId, "err", err)
+			return empty, nil
+		}
+		if keyShare == nil {
+			return empty, nil
+		}
+
+		var voteExt types.VoteExtension
+		for _, tx := range encryptedTxs {
+			share, err := threshold.NewDecryptionShare(*keyShare, tx.Ciphertext, []byte(tx.Sender))
+			if err != nil {
+				return nil, fmt.Errorf("failed to compute decryption share: %w", err)
+			}
+
+			voteExt.Shares = append(voteExt.Shares, share.Bytes())
+		}
+
+		bz, err := voteExt.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ExtendVoteResponse{VoteExtension: bz}, nil
+	}
+}
+
+// deriveKeyShare returns the key share of the validator for key, or nil if it
+// is not a key holder. The key share is derived from the dealings of the
+// distributed key generation of key once, and cached until the key changes.
+func (h *VoteExtensionHandler) deriveKeyShare(ctx sdk.Context, key *types.ThresholdKey) (*threshold.KeyShare, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.keyShare != nil && h.keyShareDKG == key.DkgId {
+		return h.keyShare, nil
+	}
+
+	encryptionKey, err := h.dkgKey.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var holder *types.KeyHolder
+	for i := range key.KeyHolders {
+		if bytes.Equal(key.KeyHolders[i].EncryptionKey, encryptionKey) {
+			holder = &key.KeyHolders[i]
+			break
+		}
+	}
+	if holder == nil {
+		return nil, nil
+	}
+
+	dealings, err := h.keeper.GetDealings(ctx, key.DkgId)
+	if err != nil {
+		return nil, err
+	}
+	thresholdDealings := make([]threshold.Dealing, len(dealings))
+	for i, dealing := range dealings {
+		thresholdDealings[i] = dealing.Threshold()
+	}
+
+	keyShare, err := threshold.NewKeyShare(*h.dkgKey, holder.Index, thresholdDealings)
+	if err != nil {
+		return nil, err
+	}
+
+	vk, err := keyShare.VerificationKey()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(vk, holder.VerificationKey) {
+		return nil, errors.New("key share does not match the verification key of the key holder")
+	}
+
+	h.keyShare, h.keyShareDKG = &keyShare, key.DkgId
+	return h.keyShare, nil
+}
+
+// VerifyVoteExtensionHandler returns a VerifyVoteExtension handler rejecting
+// vote extensions holding decryption shares which were not computed with the
+// key share of the validator or for an encrypted tx stored by the previous
+// block. Empty vote extensions are accepted.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+		accept := &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}
+		reject := &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}
+		if len(req.VoteExtension) == 0 {
+			return accept, nil
+		}
+
+		if err := h.verifyVoteExtension(ctx, req); err != nil {
+			ctx.Logger().Error("invalid vote extension", "validator", fmt.Sprintf("%X", req.ValidatorAddress), "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		return accept, nil
+	}
+}
+
+func (h *VoteExtensionHandler) verifyVoteExtension(ctx sdk.Context, req *abci.VerifyVoteExtensionRequest) error {
+	var voteExt types.VoteExtension
+	if err := voteExt.Unmarshal(req.VoteExtension); err != nil {
+		return err
+	}
+
+	key, err := h.keeper.GetThresholdKey(ctx)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return errors.New("encrypted txs are disabled")
+	}
+
+	// shares are only accepted for the encrypted txs stored by the previous
+	// block, matched by the ephemeral key of their ciphertext
+	encryptedTxs, err := h.keeper.GetEncryptedTxs(ctx, req.Height-1)
+	if err != nil {
+		return err
+	}
+	if len(voteExt.Shares) > len(encryptedTxs) {
+		return fmt.Errorf("expected at most %d shares, got %d", len(encryptedTxs), len(voteExt.Shares))
+	}
+	ephemerals := make(map[string]struct{}, len(encryptedTxs))
+	for _, tx := range encryptedTxs {
+		ephemeral, err := threshold.Ephemeral(tx.Ciphertext)
+		if err != nil {
+			return err
+		}
+		ephemerals[string(ephemeral)] = struct{}{}
+	}
+
+	holders, err := h.keeper.keyHolders(key)
+	if err != nil {
+		return err
+	}
+	holder, ok := holders[string(req.ValidatorAddress)]
+	if !ok {
+		return errors.New("validator is not a key holder")
+	}
+
+	for _, bz := range voteExt.Shares {
+		share, err := threshold.ParseDecryptionShare(bz)
+		if err != nil {
+			return err
+		}
+		if _, ok := ephemerals[string(share.Ephemeral)]; !ok {
+			return fmt.Errorf("%w: no encrypted tx was stored for the share", threshold.ErrInvalidDecryptionShare)
+		}
+		if err := verifyDecryptionShare(share, holder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyDecryptionShare verifies that share was computed with the key share of
+// holder.
+func verifyDecryptionShare(share threshold.DecryptionShare, holder types.KeyHolder) error {
+	if share.Index != holder.Index {
+		return fmt.Errorf("%w: expected index %d, got %d", threshold.ErrInvalidDecryptionShare, holder.Index, share.Index)
+	}
+
+	return share.Verify(holder.VerificationKey)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	corelegacy "cosmossdk.io/core/legacy"
+	"cosmossdk.io/core/registry"
+	coretransaction "cosmossdk.io/core/transaction"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/encryptedtx/Params")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitEncryptedTx{}, "cosmos-sdk/x/encryptedtx/MsgSubmitEncryptedTx")
+	legacy.RegisterAminoMsg(cdc, &MsgStartDKG{}, "cosmos-sdk/x/encryptedtx/MsgStartDKG")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterDKGKey{}, "cosmos-sdk/x/encryptedtx/MsgRegisterDKGKey")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitDealing{}, "cosmos-sdk/x/encryptedtx/MsgSubmitDealing")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitComplaint{}, "cosmos-sdk/x/encryptedtx/MsgSubmitComplaint")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/encryptedtx/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations(
+		(*coretransaction.Msg)(nil),
+		&MsgSubmitEncryptedTx{},
+		&MsgStartDKG{},
+		&MsgRegisterDKGKey{},
+		&MsgSubmitDealing{},
+		&MsgSubmitComplaint{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
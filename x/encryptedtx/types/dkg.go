@@ -0,0 +1,148 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+// InProgress returns whether the distributed key generation is still running
+// at the given height.
+func (d DKG) InProgress(height int64) bool {
+	return height <= d.ComplaintEndHeight
+}
+
+// Participant returns the participant with the given operator address.
+func (d DKG) Participant(validatorAddress string) (DKGParticipant, bool) {
+	for _, p := range d.Participants {
+		if p.ValidatorAddress == validatorAddress {
+			return p, true
+		}
+	}
+
+	return DKGParticipant{}, false
+}
+
+// Registered returns the participants which registered their encryption key,
+// by index.
+func (d DKG) Registered() []DKGParticipant {
+	var registered []DKGParticipant
+	for _, p := range d.Participants {
+		if len(p.EncryptionKey) > 0 {
+			registered = append(registered, p)
+		}
+	}
+
+	slices.SortFunc(registered, func(a, b DKGParticipant) int { return int(a.Index) - int(b.Index) })
+	return registered
+}
+
+// EncryptionKeys returns the encryption keys of the registered participants,
+// by index.
+func (d DKG) EncryptionKeys() map[uint32][]byte {
+	keys := make(map[uint32][]byte, len(d.Participants))
+	for _, p := range d.Registered() {
+		keys[p.Index] = p.EncryptionKey
+	}
+
+	return keys
+}
+
+// Validate checks that the participants of the distributed key generation are
+// unique and that its phases are ordered.
+func (d DKG) Validate() error {
+	if d.Threshold == 0 || int(d.Threshold) > len(d.Participants) {
+		return fmt.Errorf("threshold must be in [1, %d], got %d", len(d.Participants), d.Threshold)
+	}
+	if d.RegistrationEndHeight >= d.DealingEndHeight || d.DealingEndHeight >= d.ComplaintEndHeight {
+		return errors.New("phases must end in order")
+	}
+
+	addresses := make(map[string]struct{}, len(d.Participants))
+	for i, p := range d.Participants {
+		if p.ValidatorAddress == "" || p.ConsensusAddress == "" {
+			return errors.New("participant addresses cannot be empty")
+		}
+		if _, ok := addresses[p.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate participant %s", p.ValidatorAddress)
+		}
+		addresses[p.ValidatorAddress] = struct{}{}
+
+		if p.Index != uint32(i+1) {
+			return fmt.Errorf("participant %s must have index %d, got %d", p.ValidatorAddress, i+1, p.Index)
+		}
+	}
+
+	return nil
+}
+
+// NewDealing returns the dealing of dealer in the distributed key generation
+// with the given id.
+func NewDealing(dkgID uint64, dealer uint32, d threshold.Dealing) Dealing {
+	dealing := Dealing{
+		DkgId:       dkgID,
+		Dealer:      dealer,
+		Commitments: d.Commitments,
+		EphemeralG1: d.EphemeralG1,
+		EphemeralG2: d.EphemeralG2,
+	}
+	for index, share := range d.Shares {
+		dealing.EncryptedShares = append(dealing.EncryptedShares, EncryptedShare{Index: index, Share: share})
+	}
+	slices.SortFunc(dealing.EncryptedShares, func(a, b EncryptedShare) int { return int(a.Index) - int(b.Index) })
+
+	return dealing
+}
+
+// Threshold returns the dealing as a threshold.Dealing.
+func (d Dealing) Threshold() threshold.Dealing {
+	shares := make(map[uint32][]byte, len(d.EncryptedShares))
+	for _, share := range d.EncryptedShares {
+		shares[share.Index] = share.Share
+	}
+
+	return threshold.Dealing{
+		Commitments: d.Commitments,
+		EphemeralG1: d.EphemeralG1,
+		EphemeralG2: d.EphemeralG2,
+		Shares:      shares,
+	}
+}
+
+// dkgKeyFile is the JSON encoding of the DKG key file of a validator.
+type dkgKeyFile struct {
+	Secret []byte `json:"secret"`
+}
+
+// LoadDKGKey reads the DKG key of a validator from a file written with
+// SaveDKGKey.
+func LoadDKGKey(path string) (threshold.DKGKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return threshold.DKGKey{}, err
+	}
+
+	var file dkgKeyFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return threshold.DKGKey{}, err
+	}
+
+	return threshold.DKGKey{PrivKey: bls12_381.PrivKey{Key: file.Secret}}, nil
+}
+
+// SaveDKGKey writes the DKG key of a validator to a file only readable by its
+// owner. The key is needed to derive the key share of the validator as long as
+// the threshold key it took part in generating is in use.
+func SaveDKGKey(path string, key threshold.DKGKey) error {
+	bz, err := json.MarshalIndent(dkgKeyFile{Secret: key.PrivKey.Key}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
@@ -0,0 +1,2888 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/encryptedtx/v1/encryptedtx.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/encryptedtx module.
+type Params struct {
+	// max_ciphertext_size is the maximum size, in bytes, of an encrypted tx.
+	MaxCiphertextSize uint32 `protobuf:"varint,1,opt,name=max_ciphertext_size,json=maxCiphertextSize,proto3" json:"max_ciphertext_size,omitempty"`
+	// max_txs_per_block is the maximum number of encrypted txs a block can order.
+	MaxTxsPerBlock uint32 `protobuf:"varint,2,opt,name=max_txs_per_block,json=maxTxsPerBlock,proto3" json:"max_txs_per_block,omitempty"`
+	// dkg_phase_blocks is the number of blocks each phase of a distributed key
+	// generation lasts.
+	DkgPhaseBlocks uint32 `protobuf:"varint,3,opt,name=dkg_phase_blocks,json=dkgPhaseBlocks,proto3" json:"dkg_phase_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05180bd0bf522b9f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxCiphertextSize() uint32 {
+	if m != nil {
+		return m.MaxCiphertextSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTxsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxTxsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetDkgPhaseBlocks() uint32 {
+	if m != nil {
+		return m.DkgPhaseBlocks
+	}
+	return 0
+}
+
+// ThresholdKey defines a threshold encryption key, shared among validators.
+type ThresholdKey struct {
+	// dkg_id is the id of the distributed key generation which generated the key.
+	DkgId uint64 `protobuf:"varint,1,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// public_key is the compressed BLS12-381 G2 public key encrypted txs are
+	// encrypted with.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// threshold is the number of decryption shares required to decrypt a tx.
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// key_holders are the validators holding a share of the decryption key.
+	KeyHolders []KeyHolder `protobuf:"bytes,4,rep,name=key_holders,json=keyHolders,proto3" json:"key_holders"`
+}
+
+func (m *ThresholdKey) Reset()         { *m = ThresholdKey{} }
+func (m *ThresholdKey) String() string { return proto.CompactTextString(m) }
+func (*ThresholdKey) ProtoMessage()    {}
+func (*ThresholdKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05180bd0bf522b9f, []int{1}
+}
+func (m *ThresholdKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdKey.Merge(m, src)
+}
+func (m *ThresholdKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdKey proto.InternalMessageInfo
+
+func (m *ThresholdKey) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
+	}
+	return 0
+}
+
+func (m *ThresholdKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ThresholdKey) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ThresholdKey) GetKeyHolders() []KeyHolder {
+	if m != nil {
+		return m.KeyHolders
+	}
+	return nil
+}
+
+// KeyHolder defines a validator holding a share of the decryption key.
+type KeyHolder struct {
+	// validator_address is the consensus address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// index is the index of the key share of the validator.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// verification_key is the public key matching the key share of the
+	// validator, decryption shares are verified against it.
+	VerificationKey []byte `protobuf:"bytes,3,opt,name=verification_key,json=verificationKey,proto3" json:"verification_key,omitempty"`
+	// encryption_key is the key the validator received its key share with during
+	// the distributed key generation.
+	EncryptionKey []byte `protobuf:"bytes,4,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+}
+
+func (m *KeyHolder) Reset()         { *m = KeyHolder{} }
+func (m *KeyHolder) String() string { return proto.CompactTextString(m) }
+func (*KeyHolder) ProtoMessage()    {}
+func (*KeyHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05180bd0bf522b9f, []int{2}
+}
+func (m *KeyHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyHolder.Merge(m, src)
+}
+func (m *KeyHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyHolder proto.InternalMessageInfo
+
+func (m *KeyHolder) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *KeyHolder) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *KeyHolder) GetVerificationKey() []byte {
+	if m != nil {
+		return m.VerificationKey
+	}
+	return nil
+}
+
+func (m *KeyHolder) GetEncryptionKey() []byte {
+	if m != nil {
+		return m.EncryptionKey
+	}
+	return nil
+}
+
+// DKG defines a distributed key generation of a threshold key. Participants
+// register their encryption key until registration_end_height, deal their
+// shares until dealing_end_height and complain about invalid shares until
+// complaint_end_height, at the end of which the threshold key is set.
+type DKG struct {
+	// id is the unique identifier of the distributed key generation.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// threshold is the number of decryption shares required to decrypt a tx.
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// registration_end_height is the last height at which participants can
+	// register their encryption key.
+	RegistrationEndHeight int64 `protobuf:"varint,3,opt,name=registration_end_height,json=registrationEndHeight,proto3" json:"registration_end_height,omitempty"`
+	// dealing_end_height is the last height at which participants can deal.
+	DealingEndHeight int64 `protobuf:"varint,4,opt,name=dealing_end_height,json=dealingEndHeight,proto3" json:"dealing_end_height,omitempty"`
+	// complaint_end_height is the last height at which participants can
+	// complain, the threshold key is set at the end of this block.
+	ComplaintEndHeight int64 `protobuf:"varint,5,opt,name=complaint_end_height,json=complaintEndHeight,proto3" json:"complaint_end_height,omitempty"`
+	// participants are the validators taking part in the distributed key
+	// generation.
+	Participants []DKGParticipant `protobuf:"bytes,6,rep,name=participants,proto3" json:"participants"`
+}
+
+func (m *DKG) Reset()         { *m = DKG{} }
+func (m *DKG) String() string { return proto.CompactTextString(m) }
+func (*DKG) ProtoMessage()    {}
+func (*DKG) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05180bd0bf522b9f, []int{3}
+}
+func (m *DKG) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKG.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DKG) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKG.Merge(m, src)
+}
+func (m *DKG) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKG) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKG.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKG proto.InternalMessageInfo
+
+func (m *DKG) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DKG) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *DKG) GetRegistrationEndHeight() int64 {
+	if m != nil {
+		return m.RegistrationEndHeight
+	}
+	return 0
+}
+
+func (m *DKG) GetDealingEndHeight() int64 {
+	if m != nil {
+		return m.DealingEndHeight
+	}
+	return 0
+}
+
+func (m *DKG) GetComplaintEndHeight() int64 {
+	if m != nil {
+		return m.ComplaintEndHeight
+	}
+	return 0
+}
+
+func (m *DKG) GetParticipants() []DKGParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+// DKGParticipant defines a validator taking part in a distributed key
+// generation.
+type DKGParticipant struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// consensus_address is the consensus address of the validator.
+	ConsensusAddress string `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// index is the index of the key share of the validator.
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// encryption_key is the compressed BLS12-381 G1 key the shares of the
+	// validator are encrypted to, it is empty until the validator registers.
+	EncryptionKey []byte `protobuf:"bytes,4,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// disqualified is set when a complaint against the dealing of the validator
+	// was upheld.
+	Disqualified bool `protobuf:"varint,5,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+}
+
+func (m *DKGParticipant) Reset()         { *m = DKGParticipant{} }
+func (m *DKGParticipant) String() string { return proto.CompactTextString(m) }
+func (*DKGParticipant) ProtoMessage()    {}
+func (*DKGParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05180bd0bf522b9f, []int{4}
+}
+func (m *DKGParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DKGParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGParticipant.Merge(m, src)
+}
+func (m *DKGParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKGParticipant proto.InternalMessageInfo
+
+func (m *DKGParticipant) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DKGParticipant) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *DKGParticipant) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DKGParticipant) GetEncryptionKey() []byte {
+	if m != nil {
+		return m.EncryptionKey
+	}
+	return nil
+}
+
+func (m *DKGParticipant) GetDisqualified() bool {
+	if m != nil {
+		return m.Disqualified
+	}
+	return false
+}
+
+// Dealing defines the contribution of a participant to a distributed key
+// generation.
+type Dealing struct {
+	// dkg_id is the id of the distributed key generation.
+	DkgId uint64 `protobuf:"varint,1,opt,name=dkg_id,json=dkgId,proto3" json:"dkg_id,omitempty"`
+	// dealer is the index of the participant which dealt.
+	Dealer uint32 `protobuf:"varint,2,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// commitments are the commitments to the coefficients of the polynomial of
+	// the dealer, one per coefficient.
+	Commitments [][]byte `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// ephemeral_g1 is the ephemeral key, in G1, the shares are encrypted with.
+	EphemeralG1 []byte `protobuf:"bytes,4,opt,name=ephemeral_g1,json=ephemeralG1,proto3" json:"ephemeral_g1,omitempty"`
+	// ephemeral_g2 is the same ephemeral key, in G2.
+	EphemeralG2 []byte `protobuf:"bytes,5,opt,name=ephemeral_g2,json=ephemeralG2,proto3" json:"ephemeral_g2,omitempty"`
+	// encrypted_shares are the encrypted shares of the registered participants.
+	EncryptedShares []EncryptedShare `protobuf:"bytes,6,rep,name=encrypted_shares,json=encryptedShares,proto3" json:"encrypted_shares"`
+}
+
+func (m *Dealing) Reset()         { *m = Dealing{} }
+func (m *Dealing) String() string { return proto.CompactTextString(m) }
+func (*Dealing) ProtoMessage()    {}
+func (*Dealing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05180bd0bf522b9f, []int{5}
+}
+func (m *Dealing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Dealing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Dealing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Dealing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dealing.Merge(m, src)
+}
+func (m *Dealing) XXX_Size() int {
+	return m.Size()
+}
+func (m *Dealing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dealing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dealing proto.InternalMessageInfo
+
+func (m *Dealing) GetDkgId() uint64 {
+	if m != nil {
+		return m.DkgId
+	}
+	return 0
+}
+
+func (m *Dealing) GetDealer() uint32 {
+	if m != nil {
+		return m.Dealer
+	}
+	return 0
+}
+
+func (m *Dealing) GetCommitments() [][]byte {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *Dealing) GetEphemeralG1() []byte {
+	if m != nil {
+		return m.EphemeralG1
+	}
+	return nil
+}
+
+func (m *Dealing) GetEphemeralG2() []byte {
+	if m != nil {
+		return m.EphemeralG2
+	}
+	return nil
+}
+
+func (m *Dealing) GetEncryptedShares() []EncryptedShare {
+	if m != nil {
+		return m.EncryptedShares
+	}
+	return nil
+}
+
+// EncryptedShare defines the share of a participant of a distributed key
+// generation, encrypted to its encryption key.
+type EncryptedShare struct {
+	// index is the index of the participant.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// share is the encrypted share.
+	Share []byte `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (m *EncryptedShare) Reset()         { *m = EncryptedShare{} }
+func (m *EncryptedShare) String() string { return proto.CompactTextString(m) }
+func (*EncryptedShare) ProtoMessage()    {}
+func (*EncryptedShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05180bd0bf522b9f, []int{6}
+}
+func (m *EncryptedShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptedShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedShare.Merge(m, src)
+}
+func (m *EncryptedShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedShare proto.InternalMessageInfo
+
+func (m *EncryptedShare) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *EncryptedShare) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+// EncryptedTx defines an encrypted tx ordered in a block, it is decrypted and
+// executed at the beginning of the block after the next one.
+type EncryptedTx struct {
+	// id is the unique identifier of the encrypted tx.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height is the height of the block which ordered the encrypted tx.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// sender is the address which submitted the encrypted tx.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ciphertext is the tx bytes encrypted with the threshold key and the sender
+	// as label.
+	Ciphertext []byte `protobuf:"bytes,4,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+}
+
+func (m *EncryptedTx) Reset()         { *m = EncryptedTx{} }
+func (m *EncryptedTx) String() string { return proto.CompactTextString(m) }
+func (*EncryptedTx) ProtoMessage()    {}
+func (*EncryptedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05180bd0bf522b9f, []int{7}
+}
+func (m *EncryptedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedTx.Merge(m, src)
+}
+func (m *EncryptedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedTx proto.InternalMessageInfo
+
+func (m *EncryptedTx) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EncryptedTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EncryptedTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EncryptedTx) GetCiphertext() []byte {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return nil
+}
+
+// VoteExtension defines the vote extension of a validator, holding its
+// decryption shares of the encrypted txs ordered by the voted block.
+type VoteExtension struct {
+	// shares are the encoded decryption shares of the validator, each one
+	// identifies its ciphertext by its ephemeral key.
+	Shares [][]byte `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *VoteExtension) Reset()         { *m = VoteExtension{} }
+func (m *VoteExtension) String() string { return proto.CompactTextString(m) }
+func (*VoteExtension) ProtoMessage()    {}
+func (*VoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05180bd0bf522b9f, []int{8}
+}
+func (m *VoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteExtension.Merge(m, src)
+}
+func (m *VoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteExtension proto.InternalMessageInfo
+
+func (m *VoteExtension) GetShares() [][]byte {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.encryptedtx.v1.Params")
+	proto.RegisterType((*ThresholdKey)(nil), "cosmos.encryptedtx.v1.ThresholdKey")
+	proto.RegisterType((*KeyHolder)(nil), "cosmos.encryptedtx.v1.KeyHolder")
+	proto.RegisterType((*DKG)(nil), "cosmos.encryptedtx.v1.DKG")
+	proto.RegisterType((*DKGParticipant)(nil), "cosmos.encryptedtx.v1.DKGParticipant")
+	proto.RegisterType((*Dealing)(nil), "cosmos.encryptedtx.v1.Dealing")
+	proto.RegisterType((*EncryptedShare)(nil), "cosmos.encryptedtx.v1.EncryptedShare")
+	proto.RegisterType((*EncryptedTx)(nil), "cosmos.encryptedtx.v1.EncryptedTx")
+	proto.RegisterType((*VoteExtension)(nil), "cosmos.encryptedtx.v1.VoteExtension")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/encryptedtx/v1/encryptedtx.proto", fileDescriptor_05180bd0bf522b9f)
+}
+
+var fileDescriptor_05180bd0bf522b9f = []byte{
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x35, 0x25, 0x5b, 0xad, 0x46, 0xb2, 0x22, 0x6f, 0xe5, 0x54, 0x0d, 0x1a, 0x45, 0x21, 0x1a,
+	0xc4, 0x09, 0x6a, 0x29, 0x76, 0x81, 0x1e, 0x82, 0x5e, 0xaa, 0xd8, 0x70, 0x0a, 0x15, 0x81, 0x21,
+	0x1b, 0x39, 0xb4, 0x07, 0x62, 0xcd, 0x9d, 0x50, 0x0b, 0x8a, 0x1f, 0xdd, 0x5d, 0x1b, 0x54, 0xfe,
+	0x40, 0x81, 0x9e, 0xda, 0x9f, 0xd0, 0x5b, 0x8f, 0x06, 0x9a, 0x7f, 0xd0, 0x4b, 0x8e, 0x41, 0x4e,
+	0x3d, 0x15, 0x85, 0x7d, 0xc8, 0xaf, 0x28, 0x50, 0x70, 0xb9, 0xa2, 0x28, 0xb5, 0x46, 0x7c, 0x11,
+	0x38, 0x6f, 0xde, 0x70, 0x87, 0xef, 0x3d, 0x52, 0x70, 0xdf, 0x8d, 0x64, 0x10, 0xc9, 0x3e, 0x86,
+	0xae, 0x98, 0xc6, 0x0a, 0x99, 0x4a, 0xfa, 0x67, 0x3b, 0xc5, 0xb2, 0x17, 0x8b, 0x48, 0x45, 0x64,
+	0x33, 0x23, 0xf6, 0x8a, 0x9d, 0xb3, 0x9d, 0x5b, 0x2d, 0x2f, 0xf2, 0x22, 0xcd, 0xe8, 0xa7, 0x57,
+	0x19, 0xf9, 0xd6, 0x27, 0x19, 0xd9, 0xc9, 0x1a, 0x66, 0x32, 0x6b, 0x6d, 0xd0, 0x80, 0x87, 0x51,
+	0x5f, 0xff, 0x66, 0x90, 0xfd, 0xbb, 0x05, 0x95, 0x43, 0x2a, 0x68, 0x20, 0x49, 0x0f, 0x3e, 0x0a,
+	0x68, 0xe2, 0xb8, 0x3c, 0x1e, 0xa3, 0x50, 0x98, 0x28, 0x47, 0xf2, 0x97, 0xd8, 0xb6, 0xba, 0xd6,
+	0xd6, 0xfa, 0x68, 0x23, 0xa0, 0xc9, 0x93, 0xbc, 0x73, 0xc4, 0x5f, 0x22, 0x79, 0x00, 0x29, 0xe8,
+	0xa8, 0x44, 0x3a, 0x31, 0x0a, 0xe7, 0x64, 0x12, 0xb9, 0x7e, 0xbb, 0xa4, 0xd9, 0x8d, 0x80, 0x26,
+	0xc7, 0x89, 0x3c, 0x44, 0x31, 0x48, 0x51, 0xb2, 0x05, 0x4d, 0xe6, 0x7b, 0x4e, 0x3c, 0xa6, 0x12,
+	0x33, 0xa2, 0x6c, 0x97, 0x33, 0x26, 0xf3, 0xbd, 0xc3, 0x14, 0xd6, 0x44, 0xf9, 0xf8, 0xb3, 0x9f,
+	0xde, 0x9d, 0x3f, 0xbc, 0x93, 0x6d, 0xbd, 0x2d, 0x99, 0xdf, 0x4f, 0x16, 0xe4, 0xc9, 0x56, 0xb5,
+	0xcf, 0x2d, 0xa8, 0x1f, 0x8f, 0x05, 0xca, 0x71, 0x34, 0x61, 0x43, 0x9c, 0x92, 0x4d, 0xa8, 0xa4,
+	0x07, 0x70, 0xa6, 0xd7, 0x5d, 0x1d, 0xad, 0x31, 0xdf, 0xfb, 0x86, 0x91, 0xdb, 0x00, 0xf1, 0xe9,
+	0xc9, 0x84, 0xbb, 0x8e, 0x8f, 0x53, 0xbd, 0x5b, 0x7d, 0x54, 0xcd, 0x90, 0x74, 0xea, 0x53, 0xa8,
+	0xaa, 0xd9, 0x5d, 0xcc, 0x3e, 0x73, 0x80, 0x7c, 0x0b, 0x35, 0x1f, 0xa7, 0x4e, 0x7a, 0x8d, 0x42,
+	0xb6, 0x57, 0xbb, 0xe5, 0xad, 0xda, 0x6e, 0xb7, 0xf7, 0xbf, 0x5e, 0xf4, 0x86, 0x38, 0x7d, 0xaa,
+	0x89, 0x83, 0xea, 0xeb, 0xbf, 0xee, 0xac, 0xfc, 0xf6, 0xee, 0xfc, 0xa1, 0x35, 0x02, 0x7f, 0x86,
+	0x4a, 0xfb, 0x0f, 0x0b, 0xaa, 0x39, 0x89, 0x3c, 0x83, 0x8d, 0x33, 0x3a, 0xe1, 0x8c, 0xaa, 0x48,
+	0x38, 0x94, 0x31, 0x81, 0x52, 0xea, 0xd5, 0xab, 0x83, 0xbb, 0x6f, 0x5f, 0x6d, 0xdf, 0x36, 0x87,
+	0x3c, 0x89, 0x42, 0x89, 0xa1, 0x3c, 0x95, 0x5f, 0x67, 0x94, 0x23, 0x25, 0x78, 0xe8, 0x8d, 0x9a,
+	0xf9, 0xac, 0xc1, 0x49, 0x0b, 0xd6, 0x78, 0xc8, 0x30, 0x31, 0xfa, 0x67, 0x05, 0x79, 0x00, 0xcd,
+	0x33, 0x14, 0xfc, 0x05, 0x77, 0xa9, 0xe2, 0x51, 0xa8, 0x45, 0x28, 0x6b, 0x11, 0x6e, 0x14, 0xf1,
+	0x54, 0x8a, 0x7b, 0xd0, 0x30, 0x4f, 0x34, 0x23, 0xae, 0x6a, 0xe2, 0xfa, 0x1c, 0x1d, 0xe2, 0xd4,
+	0xfe, 0xb5, 0x04, 0xe5, 0xbd, 0xe1, 0x01, 0x69, 0x40, 0x29, 0xd7, 0xba, 0xc4, 0xd9, 0xa2, 0x92,
+	0xa5, 0x65, 0x25, 0xbf, 0x84, 0x8f, 0x05, 0x7a, 0x5c, 0x2a, 0x91, 0xed, 0x81, 0x21, 0x73, 0xc6,
+	0xc8, 0xbd, 0xb1, 0xd2, 0xeb, 0x94, 0x47, 0x9b, 0xc5, 0xf6, 0x7e, 0xc8, 0x9e, 0xea, 0x26, 0xf9,
+	0x1c, 0x08, 0x43, 0x3a, 0xe1, 0xa1, 0x57, 0x1c, 0x59, 0xd5, 0x23, 0x4d, 0xd3, 0x99, 0xb3, 0x1f,
+	0x41, 0xcb, 0x8d, 0x82, 0x78, 0x42, 0x79, 0xa8, 0x8a, 0xfc, 0x35, 0xcd, 0x27, 0x79, 0x6f, 0x3e,
+	0x71, 0x0c, 0xf5, 0x98, 0x0a, 0xc5, 0x5d, 0x1e, 0xd3, 0x50, 0xc9, 0x76, 0x45, 0x5b, 0x7c, 0xef,
+	0x0a, 0x8b, 0xf7, 0x86, 0x07, 0x87, 0x73, 0x76, 0xd1, 0xe7, 0x85, 0xbb, 0xd8, 0xbf, 0x94, 0xa0,
+	0xb1, 0xc8, 0xbd, 0xae, 0xdd, 0xcf, 0x97, 0x6c, 0xbd, 0xd2, 0xee, 0x67, 0xb0, 0xe1, 0xce, 0xa2,
+	0x91, 0xdf, 0xaf, 0x74, 0xed, 0xf8, 0xb8, 0x4b, 0xf8, 0x3c, 0x3e, 0xe5, 0x62, 0x7c, 0xae, 0x97,
+	0x09, 0x62, 0x43, 0x9d, 0x71, 0xf9, 0xc3, 0x29, 0x9d, 0xf0, 0x17, 0x1c, 0x99, 0xd6, 0xfb, 0xc3,
+	0xd1, 0x02, 0x66, 0xff, 0x63, 0xc1, 0x07, 0x7b, 0x99, 0x61, 0x57, 0xbd, 0xab, 0x37, 0xa1, 0x92,
+	0x5a, 0x8a, 0xc2, 0xe4, 0xc7, 0x54, 0xa4, 0x0b, 0x35, 0x37, 0x0a, 0x02, 0xae, 0x02, 0x4c, 0x3d,
+	0x2a, 0x77, 0xcb, 0x5b, 0xf5, 0x51, 0x11, 0x22, 0x77, 0xa1, 0x8e, 0xf1, 0x18, 0x03, 0x14, 0x74,
+	0xe2, 0x78, 0x3b, 0x66, 0xcb, 0x5a, 0x8e, 0x1d, 0xec, 0x2c, 0x51, 0x76, 0xdb, 0x6b, 0xcb, 0x94,
+	0x5d, 0xf2, 0x3d, 0x34, 0x73, 0xc3, 0x1d, 0x39, 0xa6, 0x02, 0xdf, 0x17, 0x88, 0xfd, 0x59, 0x79,
+	0x94, 0xb2, 0x8b, 0x81, 0xb8, 0x81, 0x0b, 0x2d, 0x69, 0x7f, 0x05, 0x8d, 0x45, 0xf6, 0x5c, 0x72,
+	0xab, 0x28, 0x79, 0x0b, 0xd6, 0xf4, 0xd1, 0xe6, 0x5b, 0x95, 0x15, 0xf6, 0x8f, 0x16, 0xd4, 0xf2,
+	0xf1, 0xe3, 0xe4, 0x3f, 0x6f, 0xdf, 0x4d, 0xa8, 0x98, 0xac, 0x97, 0x74, 0xd6, 0x4d, 0x45, 0x1e,
+	0x41, 0x45, 0x62, 0xc8, 0x50, 0x68, 0x5f, 0xab, 0x83, 0xf6, 0xdb, 0x57, 0xdb, 0x2d, 0xf3, 0x2c,
+	0x8b, 0x91, 0x30, 0x3c, 0xd2, 0x01, 0x98, 0x7f, 0xff, 0x8d, 0x90, 0x05, 0xc4, 0xbe, 0x0f, 0xeb,
+	0xcf, 0x23, 0x85, 0xfb, 0x89, 0xc2, 0x50, 0xf2, 0x28, 0x4c, 0x8f, 0x36, 0x5a, 0x59, 0xda, 0x18,
+	0x53, 0x0d, 0x1e, 0xbf, 0xbe, 0xe8, 0x58, 0x6f, 0x2e, 0x3a, 0xd6, 0xdf, 0x17, 0x1d, 0xeb, 0xe7,
+	0xcb, 0xce, 0xca, 0x9b, 0xcb, 0xce, 0xca, 0x9f, 0x97, 0x9d, 0x95, 0xef, 0xba, 0xd9, 0x02, 0x92,
+	0xf9, 0x3d, 0x1e, 0x2d, 0x7d, 0xde, 0xd5, 0x34, 0x46, 0x79, 0x52, 0xd1, 0x7f, 0x4d, 0x5f, 0xfc,
+	0x3b, 0x00, 0x6a, 0x4f, 0x83, 0x80, 0x20, 0x07, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DkgPhaseBlocks != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.DkgPhaseBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTxsPerBlock != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.MaxTxsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxCiphertextSize != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.MaxCiphertextSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyHolders) > 0 {
+		for iNdEx := len(m.KeyHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncryptedtx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptionKey) > 0 {
+		i -= len(m.EncryptionKey)
+		copy(dAtA[i:], m.EncryptionKey)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.EncryptionKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VerificationKey) > 0 {
+		i -= len(m.VerificationKey)
+		copy(dAtA[i:], m.VerificationKey)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.VerificationKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncryptedtx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ComplaintEndHeight != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.ComplaintEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DealingEndHeight != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.DealingEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RegistrationEndHeight != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.RegistrationEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Disqualified {
+		i--
+		if m.Disqualified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EncryptionKey) > 0 {
+		i -= len(m.EncryptionKey)
+		copy(dAtA[i:], m.EncryptionKey)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.EncryptionKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Dealing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dealing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dealing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedShares) > 0 {
+		for iNdEx := len(m.EncryptedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEncryptedtx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EphemeralG2) > 0 {
+		i -= len(m.EphemeralG2)
+		copy(dAtA[i:], m.EphemeralG2)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.EphemeralG2)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EphemeralG1) > 0 {
+		i -= len(m.EphemeralG1)
+		copy(dAtA[i:], m.EphemeralG1)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.EphemeralG1)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Dealer != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.Dealer))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DkgId != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.DkgId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ciphertext) > 0 {
+		i -= len(m.Ciphertext)
+		copy(dAtA[i:], m.Ciphertext)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.Ciphertext)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEncryptedtx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintEncryptedtx(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEncryptedtx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEncryptedtx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxCiphertextSize != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.MaxCiphertextSize))
+	}
+	if m.MaxTxsPerBlock != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.MaxTxsPerBlock))
+	}
+	if m.DkgPhaseBlocks != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.DkgPhaseBlocks))
+	}
+	return n
+}
+
+func (m *ThresholdKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DkgId != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.DkgId))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.Threshold))
+	}
+	if len(m.KeyHolders) > 0 {
+		for _, e := range m.KeyHolders {
+			l = e.Size()
+			n += 1 + l + sovEncryptedtx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.Index))
+	}
+	l = len(m.VerificationKey)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	l = len(m.EncryptionKey)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	return n
+}
+
+func (m *DKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.Id))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.Threshold))
+	}
+	if m.RegistrationEndHeight != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.RegistrationEndHeight))
+	}
+	if m.DealingEndHeight != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.DealingEndHeight))
+	}
+	if m.ComplaintEndHeight != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.ComplaintEndHeight))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovEncryptedtx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DKGParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.Index))
+	}
+	l = len(m.EncryptionKey)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	if m.Disqualified {
+		n += 2
+	}
+	return n
+}
+
+func (m *Dealing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DkgId != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.DkgId))
+	}
+	if m.Dealer != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.Dealer))
+	}
+	if len(m.Commitments) > 0 {
+		for _, b := range m.Commitments {
+			l = len(b)
+			n += 1 + l + sovEncryptedtx(uint64(l))
+		}
+	}
+	l = len(m.EphemeralG1)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	l = len(m.EphemeralG2)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	if len(m.EncryptedShares) > 0 {
+		for _, e := range m.EncryptedShares {
+			l = e.Size()
+			n += 1 + l + sovEncryptedtx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EncryptedShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.Index))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEncryptedtx(uint64(m.Height))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	l = len(m.Ciphertext)
+	if l > 0 {
+		n += 1 + l + sovEncryptedtx(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, b := range m.Shares {
+			l = len(b)
+			n += 1 + l + sovEncryptedtx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEncryptedtx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEncryptedtx(x uint64) (n int) {
+	return sovEncryptedtx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCiphertextSize", wireType)
+			}
+			m.MaxCiphertextSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCiphertextSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerBlock", wireType)
+			}
+			m.MaxTxsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgPhaseBlocks", wireType)
+			}
+			m.DkgPhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgPhaseBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedtx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+			}
+			m.DkgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyHolders = append(m.KeyHolders, KeyHolder{})
+			if err := m.KeyHolders[len(m.KeyHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedtx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationKey = append(m.VerificationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationKey == nil {
+				m.VerificationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKey = append(m.EncryptionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptionKey == nil {
+				m.EncryptionKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedtx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKG) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKG: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKG: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationEndHeight", wireType)
+			}
+			m.RegistrationEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealingEndHeight", wireType)
+			}
+			m.DealingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplaintEndHeight", wireType)
+			}
+			m.ComplaintEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComplaintEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, DKGParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedtx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKey = append(m.EncryptionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptionKey == nil {
+				m.EncryptionKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disqualified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disqualified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedtx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dealing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dealing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dealing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgId", wireType)
+			}
+			m.DkgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			m.Dealer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dealer |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, make([]byte, postIndex-iNdEx))
+			copy(m.Commitments[len(m.Commitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralG1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralG1 = append(m.EphemeralG1[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralG1 == nil {
+				m.EphemeralG1 = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralG2", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralG2 = append(m.EphemeralG2[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralG2 == nil {
+				m.EphemeralG2 = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedShares = append(m.EncryptedShares, EncryptedShare{})
+			if err := m.EncryptedShares[len(m.EncryptedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedtx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedtx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphertext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ciphertext = append(m.Ciphertext[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ciphertext == nil {
+				m.Ciphertext = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedtx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, make([]byte, postIndex-iNdEx))
+			copy(m.Shares[len(m.Shares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedtx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedtx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEncryptedtx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEncryptedtx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncryptedtx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEncryptedtx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEncryptedtx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEncryptedtx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEncryptedtx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEncryptedtx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEncryptedtx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidSigner     = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrDisabled          = errors.Register(ModuleName, 2, "encrypted txs are disabled")
+	ErrInvalidCiphertext = errors.Register(ModuleName, 3, "invalid ciphertext")
+	ErrBlockFull         = errors.Register(ModuleName, 4, "block encrypted txs limit reached")
+	ErrDKGPhase          = errors.Register(ModuleName, 5, "distributed key generation is not in the expected phase")
+	ErrNotParticipant    = errors.Register(ModuleName, 6, "validator does not take part in the distributed key generation")
+	ErrInvalidDealing    = errors.Register(ModuleName, 7, "invalid dealing")
+	ErrInvalidComplaint  = errors.Register(ModuleName, 8, "invalid complaint")
+)
@@ -0,0 +1,16 @@
+package types
+
+// Encryptedtx module event types
+const (
+	EventTypeSubmitEncryptedTx = "submit_encrypted_tx"
+	EventTypeStartDKG          = "start_dkg"
+	EventTypeDisqualifyDealer  = "disqualify_dealer"
+	EventTypeDKGCompleted      = "dkg_completed"
+	EventTypeDKGFailed         = "dkg_failed"
+
+	AttributeKeyID        = "id"
+	AttributeKeySender    = "sender"
+	AttributeKeyDealer    = "dealer"
+	AttributeKeyPublicKey = "public_key"
+	AttributeKeyReason    = "reason"
+)
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingKeeper defines the expected staking keeper, used to resolve the
+// consensus address of the participants of a distributed key generation.
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+	ConsensusAddressCodec() address.Codec
+	Validator(context.Context, sdk.ValAddress) (sdk.ValidatorI, error) // get a particular validator by operator address
+}
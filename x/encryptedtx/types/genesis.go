@@ -0,0 +1,82 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, encryptedTxs []EncryptedTx, nextID uint64, thresholdKey *ThresholdKey, dkg *DKG, dealings []Dealing) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		EncryptedTxs: encryptedTxs,
+		NextId:       nextID,
+		ThresholdKey: thresholdKey,
+		Dkg:          dkg,
+		Dealings:     dealings,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[uint64]struct{}, len(data.EncryptedTxs))
+	for _, tx := range data.EncryptedTxs {
+		if _, ok := ids[tx.Id]; ok {
+			return fmt.Errorf("duplicate encrypted tx %d", tx.Id)
+		}
+		ids[tx.Id] = struct{}{}
+
+		if tx.Id >= data.NextId {
+			return fmt.Errorf("encrypted tx id %d must be lower than next id %d", tx.Id, data.NextId)
+		}
+		if tx.Sender == "" {
+			return errors.New("encrypted tx sender cannot be empty")
+		}
+		if err := threshold.VerifyCiphertext(tx.Ciphertext, []byte(tx.Sender)); err != nil {
+			return fmt.Errorf("encrypted tx %d: %w", tx.Id, err)
+		}
+	}
+
+	dkgIDs := make(map[uint64]struct{}, 2)
+	if data.ThresholdKey != nil {
+		if err := data.ThresholdKey.Validate(); err != nil {
+			return fmt.Errorf("invalid threshold key: %w", err)
+		}
+		dkgIDs[data.ThresholdKey.DkgId] = struct{}{}
+	}
+	if data.Dkg != nil {
+		if err := data.Dkg.Validate(); err != nil {
+			return fmt.Errorf("invalid dkg: %w", err)
+		}
+		dkgIDs[data.Dkg.Id] = struct{}{}
+	}
+
+	dealings := make(map[[2]uint64]struct{}, len(data.Dealings))
+	for _, dealing := range data.Dealings {
+		if _, ok := dkgIDs[dealing.DkgId]; !ok {
+			return fmt.Errorf("dealing %d of unknown dkg %d", dealing.Dealer, dealing.DkgId)
+		}
+
+		key := [2]uint64{dealing.DkgId, uint64(dealing.Dealer)}
+		if _, ok := dealings[key]; ok {
+			return fmt.Errorf("duplicate dealing %d of dkg %d", dealing.Dealer, dealing.DkgId)
+		}
+		dealings[key] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/encryptedtx/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the encryptedtx module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// encrypted_txs are the encrypted txs pending decryption.
+	EncryptedTxs []EncryptedTx `protobuf:"bytes,2,rep,name=encrypted_txs,json=encryptedTxs,proto3" json:"encrypted_txs"`
+	// next_id is the id of the next encrypted tx.
+	NextId uint64 `protobuf:"varint,3,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// threshold_key is the key encrypted txs are encrypted with, if any.
+	ThresholdKey *ThresholdKey `protobuf:"bytes,4,opt,name=threshold_key,json=thresholdKey,proto3" json:"threshold_key,omitempty"`
+	// dkg is the last distributed key generation, if any.
+	Dkg *DKG `protobuf:"bytes,5,opt,name=dkg,proto3" json:"dkg,omitempty"`
+	// dealings are the dealings of the last distributed key generation and of
+	// the one which generated the threshold key.
+	Dealings []Dealing `protobuf:"bytes,6,rep,name=dealings,proto3" json:"dealings"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_583a4ace0bba6e8f, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetEncryptedTxs() []EncryptedTx {
+	if m != nil {
+		return m.EncryptedTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextId() uint64 {
+	if m != nil {
+		return m.NextId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetThresholdKey() *ThresholdKey {
+	if m != nil {
+		return m.ThresholdKey
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDkg() *DKG {
+	if m != nil {
+		return m.Dkg
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDealings() []Dealing {
+	if m != nil {
+		return m.Dealings
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "cosmos.encryptedtx.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/encryptedtx/v1/genesis.proto", fileDescriptor_583a4ace0bba6e8f)
+}
+
+var fileDescriptor_583a4ace0bba6e8f = []byte{
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
+	0x14, 0x85, 0x3b, 0xc0, 0xdf, 0x5f, 0x07, 0x58, 0x38, 0xd1, 0xd8, 0x90, 0x38, 0x36, 0xb0, 0x90,
+	0x18, 0xd3, 0x06, 0xdc, 0xb9, 0x32, 0x44, 0x82, 0x86, 0x8d, 0xa9, 0xac, 0xdc, 0x90, 0xca, 0x4c,
+	0x4a, 0x03, 0x74, 0x9a, 0xce, 0x84, 0xb4, 0x6f, 0xe1, 0x63, 0xb8, 0xf4, 0x31, 0x58, 0xb2, 0x74,
+	0x65, 0x0c, 0x2c, 0x7c, 0x09, 0x17, 0xa6, 0x33, 0x82, 0x35, 0xa1, 0x9b, 0xc9, 0x9c, 0x9b, 0xef,
+	0x9c, 0x7b, 0x6f, 0x2e, 0x6c, 0x8c, 0x18, 0x9f, 0x31, 0x6e, 0xd3, 0x60, 0x14, 0x25, 0xa1, 0xa0,
+	0x44, 0xc4, 0xf6, 0xbc, 0x65, 0x7b, 0x34, 0xa0, 0xdc, 0xe7, 0x56, 0x18, 0x31, 0xc1, 0xd0, 0x91,
+	0x82, 0xac, 0x0c, 0x64, 0xcd, 0x5b, 0xb5, 0x43, 0x8f, 0x79, 0x4c, 0x12, 0x76, 0xfa, 0x53, 0x70,
+	0xed, 0x6c, 0x77, 0x62, 0xd6, 0xab, 0xc0, 0x03, 0x77, 0xe6, 0x07, 0xcc, 0x96, 0xaf, 0x2a, 0xd5,
+	0xbf, 0x0a, 0xb0, 0xd2, 0x53, 0xad, 0x1f, 0x84, 0x2b, 0x28, 0xba, 0x86, 0x7a, 0xe8, 0x46, 0xee,
+	0x8c, 0x1b, 0xc0, 0x04, 0xcd, 0x72, 0xfb, 0xc4, 0xda, 0x39, 0x8a, 0x75, 0x2f, 0xa1, 0xce, 0xfe,
+	0xe2, 0xfd, 0x54, 0x7b, 0xf9, 0x7c, 0x3d, 0x07, 0xce, 0x8f, 0x0f, 0x39, 0xb0, 0xba, 0x65, 0x87,
+	0x22, 0xe6, 0x46, 0xc1, 0x2c, 0x36, 0xcb, 0xed, 0x7a, 0x4e, 0x50, 0x77, 0x23, 0x07, 0x71, 0x36,
+	0xad, 0x42, 0x7f, 0xeb, 0x1c, 0x1d, 0xc3, 0xff, 0x01, 0x8d, 0xc5, 0xd0, 0x27, 0x46, 0xd1, 0x04,
+	0xcd, 0x92, 0xa3, 0xa7, 0xf2, 0x8e, 0xa0, 0x5b, 0x58, 0x15, 0xe3, 0x88, 0xf2, 0x31, 0x9b, 0x92,
+	0xe1, 0x84, 0x26, 0x46, 0x49, 0x4e, 0xdd, 0xc8, 0x69, 0x36, 0xd8, 0xb0, 0x7d, 0x9a, 0x38, 0x15,
+	0x91, 0x51, 0xe8, 0x02, 0x16, 0xc9, 0xc4, 0x33, 0xfe, 0x49, 0x7f, 0x2d, 0xc7, 0x7f, 0xd3, 0xef,
+	0x39, 0x29, 0x86, 0xba, 0x70, 0x8f, 0x50, 0x77, 0xea, 0x07, 0x1e, 0x37, 0x74, 0xb9, 0x1f, 0xce,
+	0xb3, 0x28, 0x2c, 0xbb, 0xdb, 0xd6, 0xda, 0xb9, 0x5a, 0xac, 0x30, 0x58, 0xae, 0x30, 0xf8, 0x58,
+	0x61, 0xf0, 0xbc, 0xc6, 0xda, 0x72, 0x8d, 0xb5, 0xb7, 0x35, 0xd6, 0x1e, 0x4d, 0x95, 0xc6, 0xc9,
+	0xc4, 0xf2, 0x99, 0x1d, 0xff, 0x39, 0xae, 0x48, 0x42, 0xca, 0x9f, 0x74, 0x79, 0xc1, 0xcb, 0xef,
+	0x01, 0x00, 0x12, 0x50, 0x2b, 0x2c, 0x51, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dealings) > 0 {
+		for iNdEx := len(m.Dealings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dealings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Dkg != nil {
+		{
+			size, err := m.Dkg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ThresholdKey != nil {
+		{
+			size, err := m.ThresholdKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EncryptedTxs) > 0 {
+		for iNdEx := len(m.EncryptedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EncryptedTxs) > 0 {
+		for _, e := range m.EncryptedTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextId))
+	}
+	if m.ThresholdKey != nil {
+		l = m.ThresholdKey.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Dkg != nil {
+		l = m.Dkg.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Dealings) > 0 {
+		for _, e := range m.Dealings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedTxs = append(m.EncryptedTxs, EncryptedTx{})
+			if err := m.EncryptedTxs[len(m.EncryptedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
+			}
+			m.NextId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ThresholdKey == nil {
+				m.ThresholdKey = &ThresholdKey{}
+			}
+			if err := m.ThresholdKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dkg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dkg == nil {
+				m.Dkg = &DKG{}
+			}
+			if err := m.Dkg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealings = append(m.Dealings, Dealing{})
+			if err := m.Dealings[len(m.Dealings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,119 @@
+//go:build bls12381
+
+package types
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+// newThresholdKey returns a threshold key generated by 3 honest participants,
+// 2 of which are required to decrypt, and its dealings.
+func newThresholdKey(t *testing.T) (*ThresholdKey, []Dealing) {
+	t.Helper()
+
+	encryptionKeys := make(map[uint32][]byte)
+	indexes := []uint32{1, 2, 3}
+	for _, index := range indexes {
+		key, err := threshold.GenerateDKGKey(rand.Reader)
+		require.NoError(t, err)
+		encryptionKeys[index], err = key.PublicKey()
+		require.NoError(t, err)
+	}
+
+	var (
+		thresholdDealings []threshold.Dealing
+		dealings          []Dealing
+	)
+	for _, index := range indexes {
+		dealing, err := threshold.NewDealing(rand.Reader, 2, encryptionKeys)
+		require.NoError(t, err)
+		thresholdDealings = append(thresholdDealings, dealing)
+		dealings = append(dealings, NewDealing(1, index, dealing))
+	}
+
+	publicKey, verificationKeys, err := threshold.AggregateDealings(thresholdDealings, 2, indexes)
+	require.NoError(t, err)
+
+	key := &ThresholdKey{DkgId: 1, PublicKey: publicKey, Threshold: 2}
+	for _, index := range indexes {
+		key.KeyHolders = append(key.KeyHolders, KeyHolder{
+			ValidatorAddress: string([]byte{'a' + byte(index)}),
+			Index:            index,
+			VerificationKey:  verificationKeys[index],
+			EncryptionKey:    encryptionKeys[index],
+		})
+	}
+
+	return key, dealings
+}
+
+func TestThresholdKey_Validate(t *testing.T) {
+	testCases := map[string]func(key *ThresholdKey){
+		"empty validator address": func(key *ThresholdKey) {
+			key.KeyHolders[0].ValidatorAddress = ""
+		},
+		"duplicate validator address": func(key *ThresholdKey) {
+			key.KeyHolders[1].ValidatorAddress = key.KeyHolders[0].ValidatorAddress
+		},
+		"duplicate index": func(key *ThresholdKey) {
+			key.KeyHolders[1].Index = key.KeyHolders[0].Index
+		},
+		"swapped verification keys": func(key *ThresholdKey) {
+			key.KeyHolders[0].VerificationKey, key.KeyHolders[1].VerificationKey = key.KeyHolders[1].VerificationKey, key.KeyHolders[0].VerificationKey
+		},
+		"invalid public key": func(key *ThresholdKey) {
+			key.PublicKey = key.KeyHolders[0].VerificationKey
+		},
+		"zero threshold": func(key *ThresholdKey) {
+			key.Threshold = 0
+		},
+	}
+
+	for name, malleate := range testCases {
+		t.Run(name, func(t *testing.T) {
+			key, _ := newThresholdKey(t)
+			require.NoError(t, key.Validate())
+
+			malleate(key)
+			require.Error(t, key.Validate())
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState()))
+
+	key, dealings := newThresholdKey(t)
+	msg, err := NewMsgSubmitEncryptedTx(rand.Reader, key, "cosmos1alice", []byte("tx"))
+	require.NoError(t, err)
+
+	encryptedTx := EncryptedTx{Id: 0, Height: 1, Sender: msg.Sender, Ciphertext: msg.Ciphertext}
+	genesis := NewGenesisState(DefaultParams(), []EncryptedTx{encryptedTx}, 1, key, nil, dealings)
+	require.NoError(t, ValidateGenesis(*genesis))
+
+	genesis.NextId = 0
+	require.Error(t, ValidateGenesis(*genesis))
+
+	genesis = NewGenesisState(DefaultParams(), []EncryptedTx{encryptedTx, encryptedTx}, 1, key, nil, dealings)
+	require.Error(t, ValidateGenesis(*genesis))
+
+	encryptedTx.Sender = "cosmos1bob"
+	genesis = NewGenesisState(DefaultParams(), []EncryptedTx{encryptedTx}, 1, key, nil, dealings)
+	require.Error(t, ValidateGenesis(*genesis))
+
+	// dealings must belong to a known distributed key generation
+	genesis = NewGenesisState(DefaultParams(), nil, 0, nil, nil, dealings)
+	require.Error(t, ValidateGenesis(*genesis))
+
+	genesis = NewGenesisState(DefaultParams(), nil, 0, key, nil, append(dealings, dealings[0]))
+	require.Error(t, ValidateGenesis(*genesis))
+
+	key.Threshold = 4
+	genesis = NewGenesisState(DefaultParams(), nil, 0, key, nil, dealings)
+	require.Error(t, ValidateGenesis(*genesis))
+}
@@ -0,0 +1,26 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// ParamsKey is the key to use for the keeper store.
+	ParamsKey       = collections.NewPrefix(0)
+	EncryptedTxsKey = collections.NewPrefix(1)
+	NextIDKey       = collections.NewPrefix(2)
+	ThresholdKeyKey = collections.NewPrefix(3)
+	DKGKey          = collections.NewPrefix(4)
+	DealingsKey     = collections.NewPrefix(5)
+)
+
+const (
+	// module name
+	ModuleName = "encryptedtx"
+
+	// StoreKey is the default store key for encryptedtx
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
+	GovModuleName = "gov"
+)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"errors"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+// NewMsgSubmitEncryptedTx returns a message submitting txBytes, a signed tx,
+// encrypted with the threshold key.
+func NewMsgSubmitEncryptedTx(rand io.Reader, key *ThresholdKey, sender string, txBytes []byte) (*MsgSubmitEncryptedTx, error) {
+	if key == nil {
+		return nil, errors.New("threshold key cannot be nil")
+	}
+
+	ciphertext, err := threshold.Encrypt(rand, key.PublicKey, []byte(sender), txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitEncryptedTx{Sender: sender, Ciphertext: ciphertext}, nil
+}
+
+// NewMsgSubmitDealing returns a message submitting the dealing of a
+// participant of the distributed key generation.
+func NewMsgSubmitDealing(validatorAddress string, dealing threshold.Dealing) *MsgSubmitDealing {
+	d := NewDealing(0, 0, dealing)
+	return &MsgSubmitDealing{
+		ValidatorAddress: validatorAddress,
+		Commitments:      d.Commitments,
+		EphemeralG1:      d.EphemeralG1,
+		EphemeralG2:      d.EphemeralG2,
+		EncryptedShares:  d.EncryptedShares,
+	}
+}
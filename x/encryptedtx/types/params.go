@@ -0,0 +1,71 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+const (
+	// DefaultMaxCiphertextSize is the default maximum size of an encrypted tx.
+	DefaultMaxCiphertextSize = 4 * 1024
+	// DefaultMaxTxsPerBlock is the default maximum number of encrypted txs a
+	// block can order.
+	DefaultMaxTxsPerBlock = 50
+	// DefaultDKGPhaseBlocks is the default number of blocks each phase of a
+	// distributed key generation lasts.
+	DefaultDKGPhaseBlocks = 100
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(maxCiphertextSize, maxTxsPerBlock, dkgPhaseBlocks uint32) Params {
+	return Params{
+		MaxCiphertextSize: maxCiphertextSize,
+		MaxTxsPerBlock:    maxTxsPerBlock,
+		DkgPhaseBlocks:    dkgPhaseBlocks,
+	}
+}
+
+// DefaultParams returns default x/encryptedtx module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxCiphertextSize, DefaultMaxTxsPerBlock, DefaultDKGPhaseBlocks)
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.MaxCiphertextSize <= threshold.CiphertextOverhead {
+		return fmt.Errorf("max ciphertext size must be greater than %d", threshold.CiphertextOverhead)
+	}
+	if p.MaxTxsPerBlock == 0 {
+		return errors.New("max txs per block must be positive")
+	}
+	if p.DkgPhaseBlocks == 0 {
+		return errors.New("dkg phase blocks must be positive")
+	}
+
+	return nil
+}
+
+// Validate checks that the verification keys of the key holders match the
+// public key and the threshold.
+func (k ThresholdKey) Validate() error {
+	addresses := make(map[string]struct{}, len(k.KeyHolders))
+	verificationKeys := make(map[uint32][]byte, len(k.KeyHolders))
+	for _, holder := range k.KeyHolders {
+		if holder.ValidatorAddress == "" {
+			return errors.New("key holder validator address cannot be empty")
+		}
+		if _, ok := addresses[holder.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate key holder %s", holder.ValidatorAddress)
+		}
+		addresses[holder.ValidatorAddress] = struct{}{}
+
+		if _, ok := verificationKeys[holder.Index]; ok {
+			return fmt.Errorf("duplicate key share index %d", holder.Index)
+		}
+		verificationKeys[holder.Index] = holder.VerificationKey
+	}
+
+	return threshold.VerifyVerificationKeys(k.PublicKey, int(k.Threshold), verificationKeys)
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/threshold"
+)
+
+func TestValidate(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params2 := NewParams(params.MaxCiphertextSize, params.MaxTxsPerBlock, params.DkgPhaseBlocks)
+	require.NoError(t, params2.Validate())
+	require.Equal(t, params, params2)
+
+	params.MaxCiphertextSize = threshold.CiphertextOverhead
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxTxsPerBlock = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.DkgPhaseBlocks = 0
+	require.Error(t, params.Validate())
+}
+
+func TestDKG_Validate(t *testing.T) {
+	newDKG := func() DKG {
+		return DKG{
+			Id:                    1,
+			Threshold:             2,
+			RegistrationEndHeight: 10,
+			DealingEndHeight:      20,
+			ComplaintEndHeight:    30,
+			Participants: []DKGParticipant{
+				{ValidatorAddress: "cosmosvaloper1a", ConsensusAddress: "cosmosvalcons1a", Index: 1},
+				{ValidatorAddress: "cosmosvaloper1b", ConsensusAddress: "cosmosvalcons1b", Index: 2, EncryptionKey: []byte{1}},
+				{ValidatorAddress: "cosmosvaloper1c", ConsensusAddress: "cosmosvalcons1c", Index: 3, EncryptionKey: []byte{2}},
+			},
+		}
+	}
+	dkg := newDKG()
+	require.NoError(t, dkg.Validate())
+	require.True(t, dkg.InProgress(30))
+	require.False(t, dkg.InProgress(31))
+	require.Len(t, dkg.Registered(), 2)
+	require.Equal(t, map[uint32][]byte{2: {1}, 3: {2}}, dkg.EncryptionKeys())
+
+	p, ok := dkg.Participant("cosmosvaloper1b")
+	require.True(t, ok)
+	require.Equal(t, uint32(2), p.Index)
+	_, ok = dkg.Participant("cosmosvaloper1d")
+	require.False(t, ok)
+
+	testCases := map[string]func(dkg *DKG){
+		"zero threshold": func(dkg *DKG) {
+			dkg.Threshold = 0
+		},
+		"threshold above participants": func(dkg *DKG) {
+			dkg.Threshold = 4
+		},
+		"unordered phases": func(dkg *DKG) {
+			dkg.DealingEndHeight = dkg.ComplaintEndHeight
+		},
+		"empty consensus address": func(dkg *DKG) {
+			dkg.Participants[0].ConsensusAddress = ""
+		},
+		"duplicate participant": func(dkg *DKG) {
+			dkg.Participants[1].ValidatorAddress = dkg.Participants[0].ValidatorAddress
+		},
+		"invalid index": func(dkg *DKG) {
+			dkg.Participants[2].Index = 4
+		},
+	}
+
+	for name, malleate := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dkg := newDKG()
+			malleate(&dkg)
+			require.Error(t, dkg.Validate())
+		})
+	}
+}
+
+func TestDKGKeyFile(t *testing.T) {
+	key := threshold.DKGKey{PrivKey: bls12_381.PrivKey{Key: []byte{1, 2, 3}}}
+
+	path := filepath.Join(t.TempDir(), "dkg_key.json")
+	require.NoError(t, SaveDKGKey(path, key))
+
+	loaded, err := LoadDKGKey(path)
+	require.NoError(t, err)
+	require.Equal(t, key, loaded)
+}
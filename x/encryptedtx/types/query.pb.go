@@ -0,0 +1,1763 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/encryptedtx/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8b695738de8dae, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8b695738de8dae, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryThresholdKeyRequest is the request type for the Query/ThresholdKey RPC method.
+type QueryThresholdKeyRequest struct {
+}
+
+func (m *QueryThresholdKeyRequest) Reset()         { *m = QueryThresholdKeyRequest{} }
+func (m *QueryThresholdKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryThresholdKeyRequest) ProtoMessage()    {}
+func (*QueryThresholdKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8b695738de8dae, []int{2}
+}
+func (m *QueryThresholdKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryThresholdKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryThresholdKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryThresholdKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryThresholdKeyRequest.Merge(m, src)
+}
+func (m *QueryThresholdKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryThresholdKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryThresholdKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryThresholdKeyRequest proto.InternalMessageInfo
+
+// QueryThresholdKeyResponse is the response type for the Query/ThresholdKey RPC method.
+type QueryThresholdKeyResponse struct {
+	// threshold_key is the key encrypted txs are encrypted with, it is null while
+	// no distributed key generation completed.
+	ThresholdKey *ThresholdKey `protobuf:"bytes,1,opt,name=threshold_key,json=thresholdKey,proto3" json:"threshold_key,omitempty"`
+}
+
+func (m *QueryThresholdKeyResponse) Reset()         { *m = QueryThresholdKeyResponse{} }
+func (m *QueryThresholdKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryThresholdKeyResponse) ProtoMessage()    {}
+func (*QueryThresholdKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8b695738de8dae, []int{3}
+}
+func (m *QueryThresholdKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryThresholdKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryThresholdKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryThresholdKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryThresholdKeyResponse.Merge(m, src)
+}
+func (m *QueryThresholdKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryThresholdKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryThresholdKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryThresholdKeyResponse proto.InternalMessageInfo
+
+func (m *QueryThresholdKeyResponse) GetThresholdKey() *ThresholdKey {
+	if m != nil {
+		return m.ThresholdKey
+	}
+	return nil
+}
+
+// QueryDKGRequest is the request type for the Query/DKG RPC method.
+type QueryDKGRequest struct {
+}
+
+func (m *QueryDKGRequest) Reset()         { *m = QueryDKGRequest{} }
+func (m *QueryDKGRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDKGRequest) ProtoMessage()    {}
+func (*QueryDKGRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8b695738de8dae, []int{4}
+}
+func (m *QueryDKGRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDKGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDKGRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDKGRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDKGRequest.Merge(m, src)
+}
+func (m *QueryDKGRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDKGRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDKGRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDKGRequest proto.InternalMessageInfo
+
+// QueryDKGResponse is the response type for the Query/DKG RPC method.
+type QueryDKGResponse struct {
+	// dkg is the last distributed key generation, it is null if none started.
+	Dkg *DKG `protobuf:"bytes,1,opt,name=dkg,proto3" json:"dkg,omitempty"`
+	// dealings are the dealings of the distributed key generation.
+	Dealings []Dealing `protobuf:"bytes,2,rep,name=dealings,proto3" json:"dealings"`
+}
+
+func (m *QueryDKGResponse) Reset()         { *m = QueryDKGResponse{} }
+func (m *QueryDKGResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDKGResponse) ProtoMessage()    {}
+func (*QueryDKGResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8b695738de8dae, []int{5}
+}
+func (m *QueryDKGResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDKGResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDKGResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDKGResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDKGResponse.Merge(m, src)
+}
+func (m *QueryDKGResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDKGResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDKGResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDKGResponse proto.InternalMessageInfo
+
+func (m *QueryDKGResponse) GetDkg() *DKG {
+	if m != nil {
+		return m.Dkg
+	}
+	return nil
+}
+
+func (m *QueryDKGResponse) GetDealings() []Dealing {
+	if m != nil {
+		return m.Dealings
+	}
+	return nil
+}
+
+// QueryEncryptedTxsRequest is the request type for the Query/EncryptedTxs RPC method.
+type QueryEncryptedTxsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEncryptedTxsRequest) Reset()         { *m = QueryEncryptedTxsRequest{} }
+func (m *QueryEncryptedTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxsRequest) ProtoMessage()    {}
+func (*QueryEncryptedTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8b695738de8dae, []int{6}
+}
+func (m *QueryEncryptedTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEncryptedTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxsRequest.Merge(m, src)
+}
+func (m *QueryEncryptedTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEncryptedTxsRequest proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEncryptedTxsResponse is the response type for the Query/EncryptedTxs RPC method.
+type QueryEncryptedTxsResponse struct {
+	// encrypted_txs are the encrypted txs pending decryption.
+	EncryptedTxs []EncryptedTx `protobuf:"bytes,1,rep,name=encrypted_txs,json=encryptedTxs,proto3" json:"encrypted_txs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEncryptedTxsResponse) Reset()         { *m = QueryEncryptedTxsResponse{} }
+func (m *QueryEncryptedTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxsResponse) ProtoMessage()    {}
+func (*QueryEncryptedTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8b695738de8dae, []int{7}
+}
+func (m *QueryEncryptedTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEncryptedTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxsResponse.Merge(m, src)
+}
+func (m *QueryEncryptedTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEncryptedTxsResponse proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxsResponse) GetEncryptedTxs() []EncryptedTx {
+	if m != nil {
+		return m.EncryptedTxs
+	}
+	return nil
+}
+
+func (m *QueryEncryptedTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.encryptedtx.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.encryptedtx.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryThresholdKeyRequest)(nil), "cosmos.encryptedtx.v1.QueryThresholdKeyRequest")
+	proto.RegisterType((*QueryThresholdKeyResponse)(nil), "cosmos.encryptedtx.v1.QueryThresholdKeyResponse")
+	proto.RegisterType((*QueryDKGRequest)(nil), "cosmos.encryptedtx.v1.QueryDKGRequest")
+	proto.RegisterType((*QueryDKGResponse)(nil), "cosmos.encryptedtx.v1.QueryDKGResponse")
+	proto.RegisterType((*QueryEncryptedTxsRequest)(nil), "cosmos.encryptedtx.v1.QueryEncryptedTxsRequest")
+	proto.RegisterType((*QueryEncryptedTxsResponse)(nil), "cosmos.encryptedtx.v1.QueryEncryptedTxsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/encryptedtx/v1/query.proto", fileDescriptor_8b8b695738de8dae) }
+
+var fileDescriptor_8b8b695738de8dae = []byte{
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x89, 0x06, 0x9d, 0xa6, 0x68, 0xc7, 0x0a, 0xe9, 0xda, 0x6e, 0xe3, 0x2a, 0x49,
+	0x0d, 0xb2, 0xd3, 0xc4, 0x9b, 0x27, 0x29, 0xad, 0x11, 0x72, 0xa9, 0xa1, 0x20, 0x78, 0x29, 0x93,
+	0x66, 0xd8, 0x2e, 0x49, 0x76, 0xb6, 0x99, 0x69, 0x48, 0x4e, 0x82, 0x17, 0x05, 0x2f, 0x82, 0x5f,
+	0xc1, 0x83, 0x47, 0x8f, 0x7e, 0x84, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0x24, 0x05, 0xbf, 0x86, 0xcc,
+	0xce, 0x6c, 0x33, 0x8b, 0xbb, 0x69, 0x7a, 0x09, 0xc9, 0xec, 0xff, 0xff, 0xfe, 0xbf, 0x79, 0xfb,
+	0x5e, 0xc0, 0xc3, 0x23, 0xca, 0xfa, 0x94, 0x21, 0xe2, 0x1f, 0x0d, 0xc6, 0x01, 0x27, 0x1d, 0x3e,
+	0x42, 0xc3, 0x1a, 0x3a, 0x39, 0x25, 0x83, 0xb1, 0x13, 0x0c, 0x28, 0xa7, 0xf0, 0xbe, 0x94, 0x38,
+	0x9a, 0xc4, 0x19, 0xd6, 0xcc, 0x55, 0x97, 0xba, 0x34, 0x54, 0x20, 0xf1, 0x4d, 0x8a, 0xcd, 0x75,
+	0x97, 0x52, 0xb7, 0x47, 0x10, 0x0e, 0x3c, 0x84, 0x7d, 0x9f, 0x72, 0xcc, 0x3d, 0xea, 0x33, 0xf5,
+	0xb4, 0xaa, 0xd2, 0xda, 0x98, 0x11, 0x99, 0x81, 0x86, 0xb5, 0x36, 0xe1, 0xb8, 0x86, 0x02, 0xec,
+	0x7a, 0x7e, 0x28, 0x56, 0xda, 0x4a, 0x32, 0x99, 0x4e, 0x21, 0x85, 0x0f, 0x94, 0x30, 0xaa, 0xa7,
+	0xc3, 0x9b, 0x2b, 0xb8, 0xef, 0xf9, 0x14, 0x85, 0x9f, 0xf2, 0xc8, 0x5e, 0x05, 0xf0, 0xb5, 0x50,
+	0xec, 0xe3, 0x01, 0xee, 0xb3, 0x16, 0x39, 0x39, 0x25, 0x8c, 0xdb, 0x6f, 0xc0, 0xbd, 0xd8, 0x29,
+	0x0b, 0xa8, 0xcf, 0x08, 0x7c, 0x01, 0xf2, 0x41, 0x78, 0x52, 0x34, 0x4a, 0xc6, 0xd6, 0x52, 0x7d,
+	0xc3, 0x49, 0xec, 0x86, 0x23, 0x6d, 0x3b, 0xb7, 0xcf, 0x7e, 0x6f, 0x66, 0xbe, 0xfd, 0xfd, 0x5e,
+	0x35, 0x5a, 0xca, 0x67, 0x9b, 0xa0, 0x18, 0x16, 0x3e, 0x38, 0x1e, 0x10, 0x76, 0x4c, 0x7b, 0x9d,
+	0x26, 0x19, 0x47, 0xa1, 0x04, 0xac, 0x25, 0x3c, 0x53, 0xd1, 0xaf, 0xc0, 0x32, 0x8f, 0xce, 0x0f,
+	0xbb, 0x64, 0xac, 0x08, 0x1e, 0xa5, 0x10, 0xc4, 0x6a, 0x14, 0xb8, 0xf6, 0xcb, 0x5e, 0x01, 0x77,
+	0xc2, 0x98, 0xdd, 0x66, 0x23, 0x4a, 0xfe, 0x60, 0x80, 0xbb, 0xb3, 0x33, 0x95, 0xf8, 0x14, 0xe4,
+	0x3a, 0x5d, 0x57, 0xe5, 0x98, 0x29, 0x39, 0xc2, 0x20, 0x64, 0x70, 0x0f, 0xdc, 0xea, 0x10, 0xdc,
+	0xf3, 0x7c, 0x97, 0x15, 0xb3, 0xa5, 0xdc, 0xd6, 0x52, 0xdd, 0x4a, 0xb3, 0x48, 0x99, 0xde, 0x9d,
+	0x4b, 0xab, 0xdd, 0x56, 0xfd, 0xd9, 0x8b, 0x3c, 0x07, 0xa3, 0xe8, 0xa5, 0xc0, 0x97, 0x00, 0xcc,
+	0xe6, 0x42, 0x71, 0x95, 0xa3, 0x10, 0x31, 0x44, 0x8e, 0x7c, 0xd7, 0x6a, 0x88, 0x9c, 0x7d, 0xec,
+	0x12, 0xe5, 0x6d, 0x69, 0x4e, 0xfb, 0x87, 0x01, 0xd6, 0x12, 0x42, 0xd4, 0xb5, 0x5b, 0x60, 0xf9,
+	0x12, 0xf8, 0x90, 0x8f, 0xc4, 0xab, 0x16, 0xb7, 0xb1, 0x53, 0x6e, 0xa3, 0xd5, 0xd0, 0x6f, 0x54,
+	0x20, 0x5a, 0x6d, 0xd8, 0x88, 0x91, 0x67, 0x43, 0xf2, 0xca, 0x95, 0xe4, 0x12, 0x48, 0x47, 0xaf,
+	0x5f, 0xdc, 0x00, 0x37, 0x43, 0x74, 0xf8, 0xc9, 0x00, 0x79, 0x39, 0x66, 0xf0, 0x49, 0x0a, 0xda,
+	0xff, 0x73, 0x6d, 0x56, 0x17, 0x91, 0xca, 0x5c, 0xbb, 0xfa, 0x51, 0xdc, 0xe4, 0xfd, 0xcf, 0x8b,
+	0x2f, 0xd9, 0x4d, 0xb8, 0x81, 0x92, 0x17, 0x50, 0x8e, 0x35, 0xfc, 0x6a, 0x80, 0x82, 0x3e, 0x72,
+	0x10, 0xcd, 0x0b, 0x4a, 0x18, 0x7e, 0x73, 0x7b, 0x71, 0x83, 0xe2, 0xab, 0xcd, 0xf8, 0xca, 0xf0,
+	0x71, 0x0a, 0x5f, 0x6c, 0x67, 0xe0, 0x3b, 0x90, 0xdb, 0x6d, 0x36, 0x60, 0x79, 0x5e, 0xd6, 0x6c,
+	0x2d, 0xcc, 0xca, 0x95, 0x3a, 0x85, 0x52, 0x99, 0xa1, 0xac, 0x43, 0x33, 0x05, 0x45, 0x6c, 0x89,
+	0xe8, 0x93, 0x3e, 0x75, 0xf3, 0xfb, 0x94, 0xb0, 0x04, 0xe6, 0xf6, 0xe2, 0x86, 0xeb, 0xf4, 0x29,
+	0x36, 0xf2, 0x3b, 0xcf, 0xcf, 0x26, 0x96, 0x71, 0x3e, 0xb1, 0x8c, 0x3f, 0x13, 0xcb, 0xf8, 0x3c,
+	0xb5, 0x32, 0xe7, 0x53, 0x2b, 0xf3, 0x6b, 0x6a, 0x65, 0xde, 0x96, 0xa4, 0x9d, 0x75, 0xba, 0x8e,
+	0x47, 0xd1, 0x28, 0x56, 0x86, 0x8f, 0x03, 0xc2, 0xda, 0xf9, 0xf0, 0x7f, 0xf5, 0xd9, 0xbf, 0x01,
+	0x00, 0x14, 0xd3, 0xe3, 0x72, 0x4c, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the encryptedtx parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ThresholdKey returns the key encrypted txs are encrypted with.
+	ThresholdKey(ctx context.Context, in *QueryThresholdKeyRequest, opts ...grpc.CallOption) (*QueryThresholdKeyResponse, error)
+	// DKG returns the last distributed key generation and its dealings.
+	DKG(ctx context.Context, in *QueryDKGRequest, opts ...grpc.CallOption) (*QueryDKGResponse, error)
+	// EncryptedTxs returns the encrypted txs pending decryption.
+	EncryptedTxs(ctx context.Context, in *QueryEncryptedTxsRequest, opts ...grpc.CallOption) (*QueryEncryptedTxsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ThresholdKey(ctx context.Context, in *QueryThresholdKeyRequest, opts ...grpc.CallOption) (*QueryThresholdKeyResponse, error) {
+	out := new(QueryThresholdKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Query/ThresholdKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DKG(ctx context.Context, in *QueryDKGRequest, opts ...grpc.CallOption) (*QueryDKGResponse, error) {
+	out := new(QueryDKGResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Query/DKG", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptedTxs(ctx context.Context, in *QueryEncryptedTxsRequest, opts ...grpc.CallOption) (*QueryEncryptedTxsResponse, error) {
+	out := new(QueryEncryptedTxsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Query/EncryptedTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the encryptedtx parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ThresholdKey returns the key encrypted txs are encrypted with.
+	ThresholdKey(context.Context, *QueryThresholdKeyRequest) (*QueryThresholdKeyResponse, error)
+	// DKG returns the last distributed key generation and its dealings.
+	DKG(context.Context, *QueryDKGRequest) (*QueryDKGResponse, error)
+	// EncryptedTxs returns the encrypted txs pending decryption.
+	EncryptedTxs(context.Context, *QueryEncryptedTxsRequest) (*QueryEncryptedTxsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ThresholdKey(ctx context.Context, req *QueryThresholdKeyRequest) (*QueryThresholdKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThresholdKey not implemented")
+}
+func (*UnimplementedQueryServer) DKG(ctx context.Context, req *QueryDKGRequest) (*QueryDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKG not implemented")
+}
+func (*UnimplementedQueryServer) EncryptedTxs(ctx context.Context, req *QueryEncryptedTxsRequest) (*QueryEncryptedTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxs not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ThresholdKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryThresholdKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ThresholdKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Query/ThresholdKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ThresholdKey(ctx, req.(*QueryThresholdKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDKGRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DKG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Query/DKG",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DKG(ctx, req.(*QueryDKGRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Query/EncryptedTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxs(ctx, req.(*QueryEncryptedTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.encryptedtx.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ThresholdKey",
+			Handler:    _Query_ThresholdKey_Handler,
+		},
+		{
+			MethodName: "DKG",
+			Handler:    _Query_DKG_Handler,
+		},
+		{
+			MethodName: "EncryptedTxs",
+			Handler:    _Query_EncryptedTxs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/encryptedtx/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThresholdKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThresholdKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThresholdKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThresholdKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThresholdKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThresholdKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdKey != nil {
+		{
+			size, err := m.ThresholdKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDKGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDKGResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dealings) > 0 {
+		for iNdEx := len(m.Dealings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dealings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Dkg != nil {
+		{
+			size, err := m.Dkg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EncryptedTxs) > 0 {
+		for iNdEx := len(m.EncryptedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryThresholdKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryThresholdKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdKey != nil {
+		l = m.ThresholdKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDKGRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDKGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dkg != nil {
+		l = m.Dkg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Dealings) > 0 {
+		for _, e := range m.Dealings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEncryptedTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEncryptedTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EncryptedTxs) > 0 {
+		for _, e := range m.EncryptedTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThresholdKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThresholdKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThresholdKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThresholdKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThresholdKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThresholdKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ThresholdKey == nil {
+				m.ThresholdKey = &ThresholdKey{}
+			}
+			if err := m.ThresholdKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDKGResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDKGResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dkg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dkg == nil {
+				m.Dkg = &DKG{}
+			}
+			if err := m.Dkg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealings = append(m.Dealings, Dealing{})
+			if err := m.Dealings[len(m.Dealings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptedTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptedTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedTxs = append(m.EncryptedTxs, EncryptedTx{})
+			if err := m.EncryptedTxs[len(m.EncryptedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2889 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/encryptedtx/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSubmitEncryptedTx is the Msg/SubmitEncryptedTx request type.
+type MsgSubmitEncryptedTx struct {
+	// sender is the address submitting the encrypted tx, it is the label of the
+	// ciphertext.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ciphertext is the signed tx bytes encrypted with the threshold key.
+	Ciphertext []byte `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+}
+
+func (m *MsgSubmitEncryptedTx) Reset()         { *m = MsgSubmitEncryptedTx{} }
+func (m *MsgSubmitEncryptedTx) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEncryptedTx) ProtoMessage()    {}
+func (*MsgSubmitEncryptedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{0}
+}
+func (m *MsgSubmitEncryptedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEncryptedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEncryptedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitEncryptedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEncryptedTx.Merge(m, src)
+}
+func (m *MsgSubmitEncryptedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEncryptedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEncryptedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEncryptedTx proto.InternalMessageInfo
+
+func (m *MsgSubmitEncryptedTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSubmitEncryptedTx) GetCiphertext() []byte {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return nil
+}
+
+// MsgSubmitEncryptedTxResponse defines the response structure for executing a
+// MsgSubmitEncryptedTx message.
+type MsgSubmitEncryptedTxResponse struct {
+	// id is the identifier of the encrypted tx.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgSubmitEncryptedTxResponse) Reset()         { *m = MsgSubmitEncryptedTxResponse{} }
+func (m *MsgSubmitEncryptedTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEncryptedTxResponse) ProtoMessage()    {}
+func (*MsgSubmitEncryptedTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{1}
+}
+func (m *MsgSubmitEncryptedTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEncryptedTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEncryptedTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitEncryptedTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEncryptedTxResponse.Merge(m, src)
+}
+func (m *MsgSubmitEncryptedTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEncryptedTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEncryptedTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEncryptedTxResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitEncryptedTxResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgStartDKG is the Msg/StartDKG request type.
+type MsgStartDKG struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// threshold is the number of decryption shares required to decrypt a tx.
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// validators are the operator addresses of the validators taking part in the
+	// distributed key generation.
+	Validators []string `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *MsgStartDKG) Reset()         { *m = MsgStartDKG{} }
+func (m *MsgStartDKG) String() string { return proto.CompactTextString(m) }
+func (*MsgStartDKG) ProtoMessage()    {}
+func (*MsgStartDKG) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{2}
+}
+func (m *MsgStartDKG) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartDKG) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartDKG.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStartDKG) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartDKG.Merge(m, src)
+}
+func (m *MsgStartDKG) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartDKG) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartDKG.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStartDKG proto.InternalMessageInfo
+
+func (m *MsgStartDKG) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgStartDKG) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MsgStartDKG) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// MsgStartDKGResponse defines the response structure for executing a
+// MsgStartDKG message.
+type MsgStartDKGResponse struct {
+	// id is the identifier of the distributed key generation.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgStartDKGResponse) Reset()         { *m = MsgStartDKGResponse{} }
+func (m *MsgStartDKGResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStartDKGResponse) ProtoMessage()    {}
+func (*MsgStartDKGResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{3}
+}
+func (m *MsgStartDKGResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartDKGResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartDKGResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStartDKGResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartDKGResponse.Merge(m, src)
+}
+func (m *MsgStartDKGResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartDKGResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartDKGResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStartDKGResponse proto.InternalMessageInfo
+
+func (m *MsgStartDKGResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgRegisterDKGKey is the Msg/RegisterDKGKey request type.
+type MsgRegisterDKGKey struct {
+	// validator_address is the operator address of the participant.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// encryption_key is the compressed BLS12-381 G1 key the shares of the
+	// participant are encrypted to.
+	EncryptionKey []byte `protobuf:"bytes,2,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+}
+
+func (m *MsgRegisterDKGKey) Reset()         { *m = MsgRegisterDKGKey{} }
+func (m *MsgRegisterDKGKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDKGKey) ProtoMessage()    {}
+func (*MsgRegisterDKGKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{4}
+}
+func (m *MsgRegisterDKGKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDKGKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDKGKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDKGKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDKGKey.Merge(m, src)
+}
+func (m *MsgRegisterDKGKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDKGKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDKGKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDKGKey proto.InternalMessageInfo
+
+func (m *MsgRegisterDKGKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterDKGKey) GetEncryptionKey() []byte {
+	if m != nil {
+		return m.EncryptionKey
+	}
+	return nil
+}
+
+// MsgRegisterDKGKeyResponse defines the response structure for executing a
+// MsgRegisterDKGKey message.
+type MsgRegisterDKGKeyResponse struct {
+}
+
+func (m *MsgRegisterDKGKeyResponse) Reset()         { *m = MsgRegisterDKGKeyResponse{} }
+func (m *MsgRegisterDKGKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDKGKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterDKGKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{5}
+}
+func (m *MsgRegisterDKGKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDKGKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDKGKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDKGKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDKGKeyResponse.Merge(m, src)
+}
+func (m *MsgRegisterDKGKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDKGKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDKGKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDKGKeyResponse proto.InternalMessageInfo
+
+// MsgSubmitDealing is the Msg/SubmitDealing request type.
+type MsgSubmitDealing struct {
+	// validator_address is the operator address of the dealer.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// commitments are the commitments to the coefficients of the polynomial of
+	// the dealer, one per coefficient.
+	Commitments [][]byte `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// ephemeral_g1 is the ephemeral key, in G1, the shares are encrypted with.
+	EphemeralG1 []byte `protobuf:"bytes,3,opt,name=ephemeral_g1,json=ephemeralG1,proto3" json:"ephemeral_g1,omitempty"`
+	// ephemeral_g2 is the same ephemeral key, in G2.
+	EphemeralG2 []byte `protobuf:"bytes,4,opt,name=ephemeral_g2,json=ephemeralG2,proto3" json:"ephemeral_g2,omitempty"`
+	// encrypted_shares are the encrypted shares of the registered participants.
+	EncryptedShares []EncryptedShare `protobuf:"bytes,5,rep,name=encrypted_shares,json=encryptedShares,proto3" json:"encrypted_shares"`
+}
+
+func (m *MsgSubmitDealing) Reset()         { *m = MsgSubmitDealing{} }
+func (m *MsgSubmitDealing) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDealing) ProtoMessage()    {}
+func (*MsgSubmitDealing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{6}
+}
+func (m *MsgSubmitDealing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitDealing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDealing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitDealing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDealing.Merge(m, src)
+}
+func (m *MsgSubmitDealing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDealing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDealing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitDealing proto.InternalMessageInfo
+
+func (m *MsgSubmitDealing) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitDealing) GetCommitments() [][]byte {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *MsgSubmitDealing) GetEphemeralG1() []byte {
+	if m != nil {
+		return m.EphemeralG1
+	}
+	return nil
+}
+
+func (m *MsgSubmitDealing) GetEphemeralG2() []byte {
+	if m != nil {
+		return m.EphemeralG2
+	}
+	return nil
+}
+
+func (m *MsgSubmitDealing) GetEncryptedShares() []EncryptedShare {
+	if m != nil {
+		return m.EncryptedShares
+	}
+	return nil
+}
+
+// MsgSubmitDealingResponse defines the response structure for executing a
+// MsgSubmitDealing message.
+type MsgSubmitDealingResponse struct {
+}
+
+func (m *MsgSubmitDealingResponse) Reset()         { *m = MsgSubmitDealingResponse{} }
+func (m *MsgSubmitDealingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDealingResponse) ProtoMessage()    {}
+func (*MsgSubmitDealingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{7}
+}
+func (m *MsgSubmitDealingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitDealingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDealingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitDealingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDealingResponse.Merge(m, src)
+}
+func (m *MsgSubmitDealingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDealingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDealingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitDealingResponse proto.InternalMessageInfo
+
+// MsgSubmitComplaint is the Msg/SubmitComplaint request type.
+type MsgSubmitComplaint struct {
+	// validator_address is the operator address of the participant complaining.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// dealer is the index of the dealer the complaint is about.
+	Dealer uint32 `protobuf:"varint,2,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// shared_key is the key the share of the participant was encrypted with,
+	// revealing the share.
+	SharedKey []byte `protobuf:"bytes,3,opt,name=shared_key,json=sharedKey,proto3" json:"shared_key,omitempty"`
+}
+
+func (m *MsgSubmitComplaint) Reset()         { *m = MsgSubmitComplaint{} }
+func (m *MsgSubmitComplaint) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitComplaint) ProtoMessage()    {}
+func (*MsgSubmitComplaint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{8}
+}
+func (m *MsgSubmitComplaint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitComplaint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitComplaint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitComplaint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitComplaint.Merge(m, src)
+}
+func (m *MsgSubmitComplaint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitComplaint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitComplaint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitComplaint proto.InternalMessageInfo
+
+func (m *MsgSubmitComplaint) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitComplaint) GetDealer() uint32 {
+	if m != nil {
+		return m.Dealer
+	}
+	return 0
+}
+
+func (m *MsgSubmitComplaint) GetSharedKey() []byte {
+	if m != nil {
+		return m.SharedKey
+	}
+	return nil
+}
+
+// MsgSubmitComplaintResponse defines the response structure for executing a
+// MsgSubmitComplaint message.
+type MsgSubmitComplaintResponse struct {
+}
+
+func (m *MsgSubmitComplaintResponse) Reset()         { *m = MsgSubmitComplaintResponse{} }
+func (m *MsgSubmitComplaintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitComplaintResponse) ProtoMessage()    {}
+func (*MsgSubmitComplaintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{9}
+}
+func (m *MsgSubmitComplaintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitComplaintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitComplaintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitComplaintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitComplaintResponse.Merge(m, src)
+}
+func (m *MsgSubmitComplaintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitComplaintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitComplaintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitComplaintResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/encryptedtx parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{10}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26294904258bc3ee, []int{11}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSubmitEncryptedTx)(nil), "cosmos.encryptedtx.v1.MsgSubmitEncryptedTx")
+	proto.RegisterType((*MsgSubmitEncryptedTxResponse)(nil), "cosmos.encryptedtx.v1.MsgSubmitEncryptedTxResponse")
+	proto.RegisterType((*MsgStartDKG)(nil), "cosmos.encryptedtx.v1.MsgStartDKG")
+	proto.RegisterType((*MsgStartDKGResponse)(nil), "cosmos.encryptedtx.v1.MsgStartDKGResponse")
+	proto.RegisterType((*MsgRegisterDKGKey)(nil), "cosmos.encryptedtx.v1.MsgRegisterDKGKey")
+	proto.RegisterType((*MsgRegisterDKGKeyResponse)(nil), "cosmos.encryptedtx.v1.MsgRegisterDKGKeyResponse")
+	proto.RegisterType((*MsgSubmitDealing)(nil), "cosmos.encryptedtx.v1.MsgSubmitDealing")
+	proto.RegisterType((*MsgSubmitDealingResponse)(nil), "cosmos.encryptedtx.v1.MsgSubmitDealingResponse")
+	proto.RegisterType((*MsgSubmitComplaint)(nil), "cosmos.encryptedtx.v1.MsgSubmitComplaint")
+	proto.RegisterType((*MsgSubmitComplaintResponse)(nil), "cosmos.encryptedtx.v1.MsgSubmitComplaintResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.encryptedtx.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.encryptedtx.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/encryptedtx/v1/tx.proto", fileDescriptor_26294904258bc3ee) }
+
+var fileDescriptor_26294904258bc3ee = []byte{
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x93, 0x6d, 0x45, 0x5e, 0xd2, 0x3f, 0x31, 0x85, 0x75, 0x4d, 0x6b, 0xb2, 0x16, 0x65,
+	0xb3, 0x59, 0xc5, 0xd9, 0x64, 0x25, 0x90, 0x82, 0x90, 0xd8, 0x90, 0xa5, 0x87, 0x28, 0x08, 0xb9,
+	0xc0, 0x61, 0x39, 0x44, 0xde, 0x78, 0x70, 0xac, 0xc6, 0x1e, 0xcb, 0x33, 0x8d, 0x92, 0x1b, 0xe2,
+	0xc8, 0x09, 0x3e, 0x05, 0x1c, 0x7b, 0xd8, 0x0f, 0xd1, 0xe3, 0xb2, 0x27, 0x4e, 0x80, 0x9a, 0x43,
+	0x4f, 0x7c, 0x07, 0x64, 0x7b, 0x32, 0x71, 0x9c, 0xbf, 0xa0, 0x5e, 0x2a, 0xcf, 0xef, 0xfd, 0xde,
+	0x9f, 0xdf, 0x9b, 0x37, 0xaf, 0x01, 0xa5, 0x8b, 0x89, 0x83, 0x49, 0x05, 0xb9, 0x5d, 0x7f, 0xe4,
+	0x51, 0x64, 0xd2, 0x61, 0x65, 0x50, 0xad, 0xd0, 0xa1, 0xe6, 0xf9, 0x98, 0x62, 0xf1, 0x9d, 0xc8,
+	0xae, 0xc5, 0xec, 0xda, 0xa0, 0x2a, 0xdf, 0x67, 0x6e, 0x0e, 0xb1, 0x02, 0xba, 0x43, 0xac, 0x88,
+	0x2f, 0xe7, 0x0d, 0xc7, 0x76, 0x71, 0x25, 0xfc, 0xcb, 0xa0, 0x87, 0x8b, 0x53, 0xc4, 0x23, 0x46,
+	0xc4, 0x43, 0x0b, 0x5b, 0x38, 0xfc, 0xac, 0x04, 0x5f, 0x0c, 0x3d, 0x8a, 0xdc, 0x3b, 0x91, 0x81,
+	0x95, 0x13, 0x1e, 0xd4, 0x5f, 0x05, 0x38, 0x6c, 0x13, 0xeb, 0xfc, 0xf2, 0xa5, 0x63, 0xd3, 0xe7,
+	0x93, 0x78, 0x5f, 0x0f, 0xc5, 0x27, 0xb0, 0x43, 0x90, 0x6b, 0x22, 0x5f, 0x12, 0x0a, 0x42, 0x31,
+	0xd3, 0x90, 0xde, 0xbc, 0x2a, 0x1f, 0x32, 0xd7, 0x67, 0xa6, 0xe9, 0x23, 0x42, 0xce, 0xa9, 0x6f,
+	0xbb, 0x96, 0xce, 0x78, 0xa2, 0x02, 0xd0, 0xb5, 0xbd, 0x1e, 0xf2, 0x29, 0x1a, 0x52, 0x29, 0x55,
+	0x10, 0x8a, 0x39, 0x3d, 0x86, 0xd4, 0x3f, 0xfd, 0xf1, 0xf6, 0xaa, 0xc4, 0xc8, 0x3f, 0xdd, 0x5e,
+	0x95, 0xca, 0x51, 0xb4, 0x32, 0x31, 0x2f, 0x2a, 0xc3, 0x19, 0x69, 0x8b, 0x0a, 0x52, 0x35, 0x38,
+	0x5e, 0x84, 0xeb, 0x88, 0x78, 0xd8, 0x25, 0x48, 0xdc, 0x83, 0x94, 0x6d, 0x86, 0xc5, 0xde, 0xd3,
+	0x53, 0xb6, 0xa9, 0xfe, 0x25, 0x40, 0x36, 0x70, 0xa0, 0x86, 0x4f, 0x9b, 0xad, 0x33, 0xf1, 0x23,
+	0xc8, 0x18, 0x97, 0xb4, 0x87, 0x7d, 0x9b, 0x8e, 0xd6, 0x6a, 0x9a, 0x52, 0xc5, 0x63, 0xc8, 0xd0,
+	0x9e, 0x8f, 0x48, 0x0f, 0xf7, 0xcd, 0x50, 0xd5, 0xae, 0x3e, 0x05, 0xc4, 0x67, 0x00, 0x03, 0xa3,
+	0x6f, 0x9b, 0x06, 0xc5, 0x3e, 0x91, 0xd2, 0x85, 0x74, 0x31, 0xd3, 0x78, 0xf0, 0xe6, 0x55, 0xf9,
+	0x84, 0x85, 0xfd, 0x76, 0x62, 0x9c, 0x8d, 0x1f, 0x73, 0xaa, 0x7f, 0x1c, 0xf4, 0x65, 0x9a, 0x30,
+	0x68, 0xcd, 0x07, 0x2b, 0x5b, 0xc3, 0x14, 0xa9, 0xa7, 0xf0, 0x76, 0xec, 0xb8, 0xb4, 0x11, 0xbf,
+	0x0b, 0x90, 0x6f, 0x13, 0x4b, 0x47, 0x96, 0x4d, 0x28, 0xf2, 0x9b, 0xad, 0xb3, 0x16, 0x1a, 0x89,
+	0x5f, 0x42, 0x9e, 0xd7, 0xd0, 0x31, 0xa2, 0xe2, 0x58, 0x5b, 0x36, 0xa8, 0xff, 0x60, 0x90, 0xc0,
+	0xc5, 0x53, 0xd8, 0x63, 0x75, 0xda, 0xd8, 0xed, 0x5c, 0xa0, 0x11, 0x9b, 0x80, 0xdd, 0x29, 0xda,
+	0x42, 0xa3, 0xfa, 0xf3, 0x40, 0xec, 0x7c, 0xe6, 0x40, 0x74, 0x69, 0x95, 0xe8, 0xd9, 0xea, 0xd5,
+	0xf7, 0xe0, 0x68, 0x0e, 0x9c, 0x34, 0x40, 0xfd, 0x27, 0x05, 0x07, 0x7c, 0x54, 0x9a, 0xc8, 0xe8,
+	0xdb, 0xae, 0x75, 0xe7, 0x7a, 0x0b, 0x90, 0xed, 0x62, 0xc7, 0xb1, 0xa9, 0x83, 0x5c, 0x4a, 0xa4,
+	0x54, 0x21, 0x5d, 0xcc, 0xe9, 0x71, 0x48, 0x7c, 0x00, 0x39, 0xe4, 0xf5, 0x90, 0x83, 0x7c, 0xa3,
+	0xdf, 0xb1, 0xaa, 0x52, 0x3a, 0xec, 0x47, 0x96, 0x63, 0x67, 0xd5, 0x04, 0xa5, 0x26, 0xdd, 0x4b,
+	0x52, 0x6a, 0xe2, 0x77, 0x70, 0xc0, 0x5b, 0xd1, 0x21, 0x3d, 0xc3, 0x47, 0x44, 0xda, 0x2e, 0xa4,
+	0x8b, 0xd9, 0xda, 0xa9, 0xb6, 0x70, 0xb1, 0x68, 0xfc, 0x71, 0x9c, 0x07, 0xec, 0x46, 0xe6, 0xfa,
+	0xcf, 0xf7, 0xb7, 0x7e, 0xbb, 0xbd, 0x2a, 0x09, 0xfa, 0x3e, 0x9a, 0x31, 0x91, 0x7a, 0x73, 0xf9,
+	0x6d, 0x3c, 0x5a, 0xff, 0x3a, 0x59, 0x6b, 0x55, 0x19, 0xa4, 0x24, 0xc6, 0xef, 0x62, 0x2c, 0x80,
+	0xc8, 0x8d, 0x9f, 0x63, 0xc7, 0xeb, 0x1b, 0xb6, 0x4b, 0xef, 0xfc, 0x36, 0xde, 0x85, 0x1d, 0x13,
+	0x19, 0x7d, 0xe4, 0xb3, 0x17, 0xca, 0x4e, 0xe2, 0x09, 0x40, 0xd8, 0x33, 0x33, 0x9c, 0xc8, 0xe8,
+	0x06, 0x32, 0x11, 0x12, 0x4c, 0xe3, 0x17, 0xcb, 0xf5, 0x3f, 0x5e, 0xaf, 0x9f, 0xcb, 0x51, 0x8f,
+	0x41, 0x9e, 0x47, 0x79, 0x0f, 0xae, 0x05, 0xd8, 0x6f, 0x13, 0xeb, 0x1b, 0xcf, 0x34, 0x28, 0xfa,
+	0xca, 0xf0, 0x0d, 0x87, 0xfc, 0xef, 0x6d, 0xf4, 0x19, 0xec, 0x78, 0x61, 0x84, 0x50, 0x68, 0xb6,
+	0x76, 0xb2, 0x64, 0x08, 0xa2, 0x34, 0xf1, 0xcb, 0x67, 0x7e, 0xf5, 0x4f, 0xe6, 0xd7, 0x4d, 0x71,
+	0x95, 0xd6, 0x78, 0xd9, 0xea, 0x11, 0xdc, 0x4f, 0x40, 0x13, 0x95, 0xb5, 0x5f, 0xb6, 0x21, 0xdd,
+	0x26, 0x96, 0x78, 0x09, 0xf9, 0xf9, 0xff, 0x26, 0x8f, 0x97, 0x94, 0xb9, 0x68, 0xa3, 0xcb, 0x4f,
+	0xff, 0x03, 0x99, 0x6f, 0xbd, 0x17, 0xf0, 0x16, 0x5f, 0xf5, 0xea, 0x8a, 0x00, 0x8c, 0x23, 0x97,
+	0xd6, 0x73, 0x78, 0xec, 0x3e, 0xec, 0x25, 0xb6, 0x67, 0x71, 0xb9, 0xf7, 0x2c, 0x53, 0x7e, 0xb2,
+	0x29, 0x93, 0x67, 0xb3, 0x61, 0x77, 0x76, 0x75, 0x3d, 0x5c, 0xd7, 0x0f, 0x46, 0x94, 0x2b, 0x1b,
+	0x12, 0x79, 0x2a, 0x0c, 0xfb, 0xc9, 0x97, 0xf9, 0x68, 0x5d, 0x0c, 0x4e, 0x95, 0xab, 0x1b, 0x53,
+	0x79, 0xc2, 0xef, 0x21, 0x37, 0xf3, 0x0c, 0x3e, 0x5c, 0x1e, 0x22, 0xce, 0x93, 0xb5, 0xcd, 0x78,
+	0x93, 0x3c, 0xf2, 0xf6, 0x0f, 0xc1, 0xcc, 0x37, 0xea, 0xd7, 0x37, 0x8a, 0xf0, 0xfa, 0x46, 0x11,
+	0xfe, 0xbe, 0x51, 0x84, 0x9f, 0xc7, 0xca, 0xd6, 0xeb, 0xb1, 0xb2, 0xf5, 0xc7, 0x58, 0xd9, 0x7a,
+	0x51, 0x88, 0xe2, 0x11, 0xf3, 0x42, 0xb3, 0x71, 0x62, 0xe8, 0xe9, 0xc8, 0x43, 0xe4, 0xe5, 0x4e,
+	0xf8, 0x03, 0xe9, 0xe9, 0xbf, 0x03, 0x00, 0x32, 0x2c, 0xbc, 0xdd, 0xdf, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SubmitEncryptedTx orders an encrypted tx, it is decrypted and executed at
+	// the beginning of the block after the next one.
+	SubmitEncryptedTx(ctx context.Context, in *MsgSubmitEncryptedTx, opts ...grpc.CallOption) (*MsgSubmitEncryptedTxResponse, error)
+	// StartDKG defines a governance operation starting a distributed key
+	// generation of a new threshold key among the given validators.
+	StartDKG(ctx context.Context, in *MsgStartDKG, opts ...grpc.CallOption) (*MsgStartDKGResponse, error)
+	// RegisterDKGKey registers the key a participant of the distributed key
+	// generation receives its shares with.
+	RegisterDKGKey(ctx context.Context, in *MsgRegisterDKGKey, opts ...grpc.CallOption) (*MsgRegisterDKGKeyResponse, error)
+	// SubmitDealing submits the dealing of a participant of the distributed key
+	// generation.
+	SubmitDealing(ctx context.Context, in *MsgSubmitDealing, opts ...grpc.CallOption) (*MsgSubmitDealingResponse, error)
+	// SubmitComplaint submits the complaint of a participant of the distributed
+	// key generation which received an invalid share, disqualifying its dealer.
+	SubmitComplaint(ctx context.Context, in *MsgSubmitComplaint, opts ...grpc.CallOption) (*MsgSubmitComplaintResponse, error)
+	// UpdateParams defines a governance operation for updating the x/encryptedtx module
+	// parameters. The authority is defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitEncryptedTx(ctx context.Context, in *MsgSubmitEncryptedTx, opts ...grpc.CallOption) (*MsgSubmitEncryptedTxResponse, error) {
+	out := new(MsgSubmitEncryptedTxResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Msg/SubmitEncryptedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StartDKG(ctx context.Context, in *MsgStartDKG, opts ...grpc.CallOption) (*MsgStartDKGResponse, error) {
+	out := new(MsgStartDKGResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Msg/StartDKG", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterDKGKey(ctx context.Context, in *MsgRegisterDKGKey, opts ...grpc.CallOption) (*MsgRegisterDKGKeyResponse, error) {
+	out := new(MsgRegisterDKGKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Msg/RegisterDKGKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitDealing(ctx context.Context, in *MsgSubmitDealing, opts ...grpc.CallOption) (*MsgSubmitDealingResponse, error) {
+	out := new(MsgSubmitDealingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Msg/SubmitDealing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitComplaint(ctx context.Context, in *MsgSubmitComplaint, opts ...grpc.CallOption) (*MsgSubmitComplaintResponse, error) {
+	out := new(MsgSubmitComplaintResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Msg/SubmitComplaint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.encryptedtx.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SubmitEncryptedTx orders an encrypted tx, it is decrypted and executed at
+	// the beginning of the block after the next one.
+	SubmitEncryptedTx(context.Context, *MsgSubmitEncryptedTx) (*MsgSubmitEncryptedTxResponse, error)
+	// StartDKG defines a governance operation starting a distributed key
+	// generation of a new threshold key among the given validators.
+	StartDKG(context.Context, *MsgStartDKG) (*MsgStartDKGResponse, error)
+	// RegisterDKGKey registers the key a participant of the distributed key
+	// generation receives its shares with.
+	RegisterDKGKey(context.Context, *MsgRegisterDKGKey) (*MsgRegisterDKGKeyResponse, error)
+	// SubmitDealing submits the dealing of a participant of the distributed key
+	// generation.
+	SubmitDealing(context.Context, *MsgSubmitDealing) (*MsgSubmitDealingResponse, error)
+	// SubmitComplaint submits the complaint of a participant of the distributed
+	// key generation which received an invalid share, disqualifying its dealer.
+	SubmitComplaint(context.Context, *MsgSubmitComplaint) (*MsgSubmitComplaintResponse, error)
+	// UpdateParams defines a governance operation for updating the x/encryptedtx module
+	// parameters. The authority is defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SubmitEncryptedTx(ctx context.Context, req *MsgSubmitEncryptedTx) (*MsgSubmitEncryptedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEncryptedTx not implemented")
+}
+func (*UnimplementedMsgServer) StartDKG(ctx context.Context, req *MsgStartDKG) (*MsgStartDKGResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDKG not implemented")
+}
+func (*UnimplementedMsgServer) RegisterDKGKey(ctx context.Context, req *MsgRegisterDKGKey) (*MsgRegisterDKGKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDKGKey not implemented")
+}
+func (*UnimplementedMsgServer) SubmitDealing(ctx context.Context, req *MsgSubmitDealing) (*MsgSubmitDealingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDealing not implemented")
+}
+func (*UnimplementedMsgServer) SubmitComplaint(ctx context.Context, req *MsgSubmitComplaint) (*MsgSubmitComplaintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitComplaint not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitEncryptedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEncryptedTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEncryptedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Msg/SubmitEncryptedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEncryptedTx(ctx, req.(*MsgSubmitEncryptedTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StartDKG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartDKG)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StartDKG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Msg/StartDKG",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartDKG(ctx, req.(*MsgStartDKG))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterDKGKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDKGKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDKGKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Msg/RegisterDKGKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDKGKey(ctx, req.(*MsgRegisterDKGKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDealing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDealing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDealing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Msg/SubmitDealing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDealing(ctx, req.(*MsgSubmitDealing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitComplaint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitComplaint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitComplaint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Msg/SubmitComplaint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitComplaint(ctx, req.(*MsgSubmitComplaint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.encryptedtx.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.encryptedtx.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitEncryptedTx",
+			Handler:    _Msg_SubmitEncryptedTx_Handler,
+		},
+		{
+			MethodName: "StartDKG",
+			Handler:    _Msg_StartDKG_Handler,
+		},
+		{
+			MethodName: "RegisterDKGKey",
+			Handler:    _Msg_RegisterDKGKey_Handler,
+		},
+		{
+			MethodName: "SubmitDealing",
+			Handler:    _Msg_SubmitDealing_Handler,
+		},
+		{
+			MethodName: "SubmitComplaint",
+			Handler:    _Msg_SubmitComplaint_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/encryptedtx/v1/tx.proto",
+}
+
+func (m *MsgSubmitEncryptedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEncryptedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEncryptedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ciphertext) > 0 {
+		i -= len(m.Ciphertext)
+		copy(dAtA[i:], m.Ciphertext)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ciphertext)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEncryptedTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEncryptedTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEncryptedTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartDKG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartDKG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartDKG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartDKGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartDKGResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartDKGResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDKGKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDKGKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDKGKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptionKey) > 0 {
+		i -= len(m.EncryptionKey)
+		copy(dAtA[i:], m.EncryptionKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptionKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDKGKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDKGKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDKGKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDealing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDealing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDealing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedShares) > 0 {
+		for iNdEx := len(m.EncryptedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EphemeralG2) > 0 {
+		i -= len(m.EphemeralG2)
+		copy(dAtA[i:], m.EphemeralG2)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EphemeralG2)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EphemeralG1) > 0 {
+		i -= len(m.EphemeralG1)
+		copy(dAtA[i:], m.EphemeralG1)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EphemeralG1)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDealingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDealingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDealingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitComplaint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitComplaint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitComplaint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SharedKey) > 0 {
+		i -= len(m.SharedKey)
+		copy(dAtA[i:], m.SharedKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SharedKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Dealer != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Dealer))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitComplaintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitComplaintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitComplaintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitEncryptedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ciphertext)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEncryptedTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgStartDKG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStartDKGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRegisterDKGKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EncryptionKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterDKGKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitDealing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, b := range m.Commitments {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.EphemeralG1)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EphemeralG2)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EncryptedShares) > 0 {
+		for _, e := range m.EncryptedShares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitDealingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitComplaint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Dealer != 0 {
+		n += 1 + sovTx(uint64(m.Dealer))
+	}
+	l = len(m.SharedKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitComplaintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitEncryptedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEncryptedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEncryptedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphertext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ciphertext = append(m.Ciphertext[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ciphertext == nil {
+				m.Ciphertext = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEncryptedTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEncryptedTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEncryptedTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartDKG) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartDKG: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartDKG: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartDKGResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartDKGResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartDKGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDKGKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDKGKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDKGKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKey = append(m.EncryptionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptionKey == nil {
+				m.EncryptionKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDKGKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDKGKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDKGKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDealing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDealing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDealing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, make([]byte, postIndex-iNdEx))
+			copy(m.Commitments[len(m.Commitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralG1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralG1 = append(m.EphemeralG1[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralG1 == nil {
+				m.EphemeralG1 = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralG2", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralG2 = append(m.EphemeralG2[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralG2 == nil {
+				m.EphemeralG2 = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedShares = append(m.EncryptedShares, EncryptedShare{})
+			if err := m.EncryptedShares[len(m.EncryptedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDealingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDealingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDealingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitComplaint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitComplaint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitComplaint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			m.Dealer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dealer |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharedKey = append(m.SharedKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SharedKey == nil {
+				m.SharedKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitComplaintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitComplaintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitComplaintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
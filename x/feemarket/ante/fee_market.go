@@ -0,0 +1,65 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeMarketKeeper defines the expected feemarket keeper.
+type FeeMarketKeeper interface {
+	CheckTxFee(ctx context.Context, tx sdk.Tx) error
+	RefundUnusedGas(ctx context.Context, tx sdk.Tx, gasUsed uint64) error
+}
+
+// FeeMarketDecorator is an AnteDecorator that rejects transactions paying less
+// than the base fee for their gas limit, and records the gas of the
+// transactions executed in the block so that the base fee can be adjusted.
+// It does not deduct fees and must be used along with the x/auth DeductFeeDecorator.
+// CONTRACT: Tx must implement the FeeTx interface.
+type FeeMarketDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+}
+
+func NewFeeMarketDecorator(fk FeeMarketKeeper) FeeMarketDecorator {
+	return FeeMarketDecorator{
+		feeMarketKeeper: fk,
+	}
+}
+
+// AnteHandle implements an AnteHandler decorator for the FeeMarketDecorator.
+func (fmd FeeMarketDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := fmd.ValidateTx(ctx, tx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateTx implements an TxValidator for FeeMarketDecorator
+func (fmd FeeMarketDecorator) ValidateTx(ctx context.Context, tx sdk.Tx) error {
+	return fmd.feeMarketKeeper.CheckTxFee(ctx, tx)
+}
+
+// FeeMarketPostDecorator is a PostDecorator that replaces the gas limit of the
+// executed transactions, recorded by the FeeMarketDecorator, with the gas they used.
+// The state of failed transactions is discarded, they are therefore accounted by
+// their gas limit.
+type FeeMarketPostDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+}
+
+func NewFeeMarketPostDecorator(fk FeeMarketKeeper) FeeMarketPostDecorator {
+	return FeeMarketPostDecorator{
+		feeMarketKeeper: fk,
+	}
+}
+
+// PostHandle implements a PostHandler decorator for the FeeMarketPostDecorator.
+func (fmd FeeMarketPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if err := fmd.feeMarketKeeper.RefundUnusedGas(ctx, tx, ctx.GasMeter().GasConsumedToLimit()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker applies the fee policy to the base fee paid by the transactions of
+// the block and computes the base fee of the next block from the gas the block
+// consumed.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockGasUsed, err := k.getBlockGasUsed(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.BlockGasUsed.Remove(ctx); err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	burned := sdk.NewCoins()
+	if params.FeePolicy == types.FeePolicy_FEE_POLICY_BURN {
+		burned, err = k.burnBaseFee(ctx, params.FeeDenom, state.BaseFee, blockGasUsed)
+		if err != nil {
+			return err
+		}
+	}
+
+	state.BaseFee = params.NextBaseFee(state.BaseFee, blockGasUsed)
+	if err := k.State.Set(ctx, state); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBaseFee,
+		event.NewAttribute(types.AttributeKeyBaseFee, state.BaseFee.String()),
+		event.NewAttribute(types.AttributeKeyBlockGasUsed, strconv.FormatUint(blockGasUsed, 10)),
+		event.NewAttribute(types.AttributeKeyBurned, burned.String()),
+	)
+}
+
+// burnBaseFee burns the base fee paid for the gas consumed by the block from the
+// fee collector.
+func (k Keeper) burnBaseFee(ctx context.Context, denom string, baseFee math.LegacyDec, blockGasUsed uint64) (sdk.Coins, error) {
+	amount := baseFee.MulInt(math.NewIntFromUint64(blockGasUsed)).TruncateInt()
+
+	// never burn more than collected, e.g. when fees are not deducted in the
+	// same transaction validation path as the base fee is checked.
+	feeCollector := k.accountKeeper.GetModuleAddress(k.feeCollectorName)
+	amount = math.MinInt(amount, k.bankKeeper.GetBalance(ctx, feeCollector, denom).Amount)
+	if !amount.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), coins); err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
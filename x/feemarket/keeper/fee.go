@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CheckTxFee ensures that the tx pays at least the base fee for each unit of gas
+// it requests. When the tx is executed in a block, its gas limit is added to the
+// gas consumed by the block, from which the next base fee is computed. The gas
+// limit is an upper bound, it is replaced by the gas used by the tx once executed
+// when the app calls RefundUnusedGas or PostTxExec.
+func (k Keeper) CheckTxFee(ctx context.Context, tx sdk.Tx) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.Enabled {
+		return nil
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must implement the FeeTx interface")
+	}
+
+	// gentxs are executed at genesis and do not pay fees.
+	if k.HeaderService.HeaderInfo(ctx).Height == 0 {
+		return nil
+	}
+
+	execMode := k.TransactionService.ExecMode(ctx)
+	if execMode == transaction.ExecModeSimulate {
+		return nil
+	}
+
+	baseFee, err := k.GetBaseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	gas := feeTx.GetGas()
+	required := types.RequiredFee(baseFee, gas)
+	if paid := feeTx.GetFee().AmountOf(params.FeeDenom); paid.LT(required) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeTx.GetFee(), sdk.NewCoin(params.FeeDenom, required))
+	}
+
+	if execMode != transaction.ExecModeFinalize {
+		return nil
+	}
+
+	blockGasUsed, err := k.getBlockGasUsed(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.BlockGasUsed.Set(ctx, blockGasUsed+gas)
+}
+
+// RefundUnusedGas removes the gas the executed tx did not use from the gas consumed by
+// the block, so that the block is accounted by the gas its txs used rather than by
+// their gas limits.
+func (k Keeper) RefundUnusedGas(ctx context.Context, tx sdk.Tx, gasUsed uint64) error {
+	if k.TransactionService.ExecMode(ctx) != transaction.ExecModeFinalize || k.HeaderService.HeaderInfo(ctx).Height == 0 {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.Enabled {
+		return nil
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must implement the FeeTx interface")
+	}
+	if gasUsed >= feeTx.GetGas() {
+		return nil
+	}
+
+	blockGasUsed, err := k.getBlockGasUsed(ctx)
+	if err != nil {
+		return err
+	}
+
+	unused := feeTx.GetGas() - gasUsed
+	if unused > blockGasUsed {
+		unused = blockGasUsed
+	}
+	return k.BlockGasUsed.Set(ctx, blockGasUsed-unused)
+}
+
+// PostTxExec replaces the gas limit of the executed tx with the gas it used, read from the gas
+// meter of the context. It replaces the FeeMarketPostDecorator for server/v2 and must be set with
+// runtime.AppBuilderWithPostTxExec. The state of failed txs is discarded, they are therefore
+// accounted by their gas limit.
+func (k Keeper) PostTxExec(ctx context.Context, tx transaction.Tx, success bool) error {
+	if !success {
+		return nil
+	}
+
+	sdkTx, ok := tx.(sdk.Tx)
+	if !ok {
+		return fmt.Errorf("invalid tx type %T, expected sdk.Tx", tx)
+	}
+
+	meter := k.GasService.GasMeter(ctx)
+	return k.RefundUnusedGas(ctx, sdkTx, meter.Limit()-meter.Remaining())
+}
+
+func (k Keeper) getBlockGasUsed(ctx context.Context) (uint64, error) {
+	blockGasUsed, err := k.BlockGasUsed.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	return blockGasUsed, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/feemarket/types"
+)
+
+// InitGenesis new feemarket genesis
+func (keeper Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := keeper.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	return keeper.State.Set(ctx, data.State)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := keeper.State.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, state), nil
+}
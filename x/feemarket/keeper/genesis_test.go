@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestGenesis() {
+	genesis := types.NewGenesisState(types.DefaultParams(), types.State{BaseFee: math.LegacyMustNewDecFromStr("0.5")})
+	s.Require().NoError(s.feeMarketKeeper.InitGenesis(s.ctx, genesis))
+
+	exported, err := s.feeMarketKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(genesis.Params, exported.Params)
+	s.Require().True(genesis.State.BaseFee.Equal(exported.State.BaseFee))
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	params, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1000), params.Params.TargetBlockGas)
+
+	baseFee, err := s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().True(math.LegacyNewDec(8).Equal(baseFee.BaseFee))
+	s.Require().Equal(params.Params.FeeDenom, baseFee.Denom)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	appmodule.Environment
+
+	cdc              codec.BinaryCodec
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	State  collections.Item[types.State]
+	// BlockGasUsed is the gas consumed by the transactions of the current block,
+	// it is kept in the memory store as it is reset at the end of every block.
+	BlockGasUsed collections.Item[uint64]
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	memSb := collections.NewSchemaBuilderFromAccessor(env.MemStoreService.OpenMemoryStore)
+	k := Keeper{
+		Environment:      env,
+		cdc:              cdc,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		State:            collections.NewItem(sb, types.StateKey, "state", codec.CollValue[types.State](cdc)),
+		BlockGasUsed:     collections.NewItem(memSb, types.BlockGasUsedKey, "block_gas_used", collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	if _, err := memSb.Build(); err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetBaseFee returns the current base fee per unit of gas.
+func (k Keeper) GetBaseFee(ctx context.Context) (math.LegacyDec, error) {
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return state.BaseFee, nil
+}
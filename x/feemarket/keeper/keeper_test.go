@@ -0,0 +1,292 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/gas"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/ante"
+	"cosmossdk.io/x/feemarket/keeper"
+	feemarkettestutil "cosmossdk.io/x/feemarket/testutil"
+	"cosmossdk.io/x/feemarket/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+var (
+	feeCollectorAddr = sdk.AccAddress("fee_collector_______")
+	feeMarketAddr    = sdk.AccAddress("feemarket___________")
+)
+
+// feeTx is a minimal sdk.FeeTx, only the fee related methods are implemented.
+type feeTx struct {
+	sdk.Tx
+	gas uint64
+	fee sdk.Coins
+}
+
+func (tx feeTx) GetGas() uint64     { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins  { return tx.fee }
+func (tx feeTx) FeePayer() []byte   { return nil }
+func (tx feeTx) FeeGranter() []byte { return nil }
+
+// consumedGasService returns the gas meter of the server/v2 post tx exec handler,
+// which has consumed the gas used by the tx.
+type consumedGasService struct {
+	gas.Service
+	consumed uint64
+}
+
+func (s consumedGasService) GasMeter(context.Context) gas.Meter {
+	return consumedGasMeter{consumed: s.consumed}
+}
+
+type consumedGasMeter struct {
+	gas.Meter
+	consumed uint64
+}
+
+func (m consumedGasMeter) Limit() gas.Gas     { return gas.NoGasLimit }
+func (m consumedGasMeter) Remaining() gas.Gas { return gas.NoGasLimit - m.consumed }
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	feeMarketKeeper keeper.Keeper
+	ctx             sdk.Context
+	msgServer       types.MsgServer
+	queryServer     types.QueryServer
+	accountKeeper   *feemarkettestutil.MockAccountKeeper
+	bankKeeper      *feemarkettestutil.MockBankKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, feemarket.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithMemStoreService(runtime.NewMemStoreService(memKey)))
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: key},
+		nil,
+		map[string]*storetypes.MemoryStoreKey{types.MemStoreKey: memKey},
+	)
+	s.ctx = ctx.WithHeaderInfo(header.Info{Height: 1}).WithExecMode(sdk.ExecModeFinalize)
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = feemarkettestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = feemarkettestutil.NewMockBankKeeper(ctrl)
+
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(feeMarketAddr).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollectorAddr).AnyTimes()
+
+	s.feeMarketKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		s.accountKeeper,
+		s.bankKeeper,
+		authtypes.FeeCollectorName,
+		govModuleNameStr,
+	)
+
+	params := types.DefaultParams()
+	params.MinBaseFee = math.LegacyNewDec(4)
+	params.TargetBlockGas = 1000
+	s.NoError(s.feeMarketKeeper.InitGenesis(s.ctx, types.NewGenesisState(params, types.State{BaseFee: math.LegacyNewDec(8)})))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.feeMarketKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.feeMarketKeeper)
+}
+
+func (s *KeeperTestSuite) requireBaseFee(expected math.LegacyDec) {
+	s.T().Helper()
+	baseFee, err := s.feeMarketKeeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(expected.Equal(baseFee), "expected base fee %s, got %s", expected, baseFee)
+}
+
+func (s *KeeperTestSuite) TestCheckTxFee() {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	// the base fee is 8 per unit of gas
+	err := s.feeMarketKeeper.CheckTxFee(s.ctx, feeTx{gas: 100, fee: stake(799)})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	err = s.feeMarketKeeper.CheckTxFee(s.ctx, feeTx{gas: 100, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 800))})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	s.Require().NoError(s.feeMarketKeeper.CheckTxFee(s.ctx, feeTx{gas: 100, fee: stake(800)}))
+	s.Require().NoError(s.feeMarketKeeper.CheckTxFee(s.ctx, feeTx{gas: 300, fee: stake(5000)}))
+
+	blockGasUsed, err := s.feeMarketKeeper.BlockGasUsed.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(400), blockGasUsed)
+
+	// the gas of txs not executed in a block is not recorded
+	s.Require().NoError(s.feeMarketKeeper.CheckTxFee(s.ctx.WithExecMode(sdk.ExecModeCheck), feeTx{gas: 100, fee: stake(800)}))
+	blockGasUsed, err = s.feeMarketKeeper.BlockGasUsed.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(400), blockGasUsed)
+
+	// simulations, gentxs and disabled fee markets do not require fees
+	s.Require().NoError(s.feeMarketKeeper.CheckTxFee(s.ctx.WithExecMode(sdk.ExecModeSimulate), feeTx{gas: 100}))
+	s.Require().NoError(s.feeMarketKeeper.CheckTxFee(s.ctx.WithHeaderInfo(header.Info{Height: 0}), feeTx{gas: 100}))
+
+	params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.Enabled = false
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.CheckTxFee(s.ctx, feeTx{gas: 100}))
+}
+
+func (s *KeeperTestSuite) TestRefundUnusedGas() {
+	stake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))
+
+	s.Require().NoError(s.feeMarketKeeper.CheckTxFee(s.ctx, feeTx{gas: 300, fee: stake}))
+	s.Require().NoError(s.feeMarketKeeper.CheckTxFee(s.ctx, feeTx{gas: 200, fee: stake}))
+
+	// the block is accounted by the gas used by its txs
+	s.Require().NoError(s.feeMarketKeeper.RefundUnusedGas(s.ctx, feeTx{gas: 300, fee: stake}, 120))
+	s.Require().NoError(s.feeMarketKeeper.RefundUnusedGas(s.ctx, feeTx{gas: 200, fee: stake}, 200))
+
+	blockGasUsed, err := s.feeMarketKeeper.BlockGasUsed.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(320), blockGasUsed)
+
+	// the gas of txs not executed in a block is not refunded
+	s.Require().NoError(s.feeMarketKeeper.RefundUnusedGas(s.ctx.WithExecMode(sdk.ExecModeCheck), feeTx{gas: 300, fee: stake}, 0))
+	blockGasUsed, err = s.feeMarketKeeper.BlockGasUsed.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(320), blockGasUsed)
+
+	// the block gas is never refunded below zero
+	s.Require().NoError(s.feeMarketKeeper.RefundUnusedGas(s.ctx, feeTx{gas: 1000, fee: stake}, 0))
+	blockGasUsed, err = s.feeMarketKeeper.BlockGasUsed.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(blockGasUsed)
+}
+
+func (s *KeeperTestSuite) TestPostTxExec() {
+	stake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 24000))
+	tx := feeTx{gas: 3000, fee: stake}
+
+	// the ante handler chain accounts the tx by the gas consumed by its context
+	v1Ctx, _ := s.ctx.CacheContext()
+	s.Require().NoError(s.feeMarketKeeper.CheckTxFee(v1Ctx, tx))
+	gasMeter := storetypes.NewGasMeter(tx.gas)
+	gasMeter.ConsumeGas(1200, "tx")
+	_, err := ante.NewFeeMarketPostDecorator(s.feeMarketKeeper).PostHandle(v1Ctx.WithGasMeter(gasMeter), tx, false, true,
+		func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil })
+	s.Require().NoError(err)
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(v1Ctx))
+	v1BaseFee, err := s.feeMarketKeeper.GetBaseFee(v1Ctx)
+	s.Require().NoError(err)
+
+	// server/v2 accounts it by the gas consumed by the context of the post tx exec handler
+	v2Keeper := s.feeMarketKeeper
+	v2Keeper.GasService = consumedGasService{consumed: 1200}
+	v2Ctx, _ := s.ctx.CacheContext()
+	s.Require().NoError(v2Keeper.CheckTxFee(v2Ctx, tx))
+	s.Require().NoError(v2Keeper.PostTxExec(v2Ctx, tx, true))
+	s.Require().NoError(v2Keeper.EndBlocker(v2Ctx))
+	v2BaseFee, err := v2Keeper.GetBaseFee(v2Ctx)
+	s.Require().NoError(err)
+
+	// the block consumed 1.2 times the target gas rather than 3 times
+	s.Require().True(math.LegacyMustNewDecFromStr("8.2").Equal(v1BaseFee), v1BaseFee.String())
+	s.Require().True(v1BaseFee.Equal(v2BaseFee), "v1 base fee %s, v2 base fee %s", v1BaseFee, v2BaseFee)
+
+	// failed txs are accounted by their gas limit
+	s.Require().NoError(v2Keeper.CheckTxFee(s.ctx, tx))
+	s.Require().NoError(v2Keeper.PostTxExec(s.ctx, tx, false))
+	blockGasUsed, err := v2Keeper.BlockGasUsed.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(tx.gas, blockGasUsed)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	// a block without txs decreases the base fee by 1/8th
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+	s.requireBaseFee(math.LegacyNewDec(7))
+
+	// a block consuming twice the target gas increases it by 1/8th
+	s.Require().NoError(s.feeMarketKeeper.BlockGasUsed.Set(s.ctx, 2000))
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+	s.requireBaseFee(math.LegacyMustNewDecFromStr("7.875"))
+
+	// the block gas is reset at the end of every block
+	has, err := s.feeMarketKeeper.BlockGasUsed.Has(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// the base fee never goes below the min base fee
+	for i := 0; i < 10; i++ {
+		s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+	}
+	s.requireBaseFee(math.LegacyNewDec(4))
+
+	// the base fee is not updated when the fee market is disabled
+	params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.Enabled = false
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.BlockGasUsed.Set(s.ctx, 2000))
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+	s.requireBaseFee(math.LegacyNewDec(4))
+}
+
+func (s *KeeperTestSuite) TestEndBlockerBurn() {
+	params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.FeePolicy = types.FeePolicy_FEE_POLICY_BURN
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+
+	// the base fee paid for the block gas is burned, tips are left to the fee
+	// collector
+	burned := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 8000))
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, feeCollectorAddr, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, authtypes.FeeCollectorName, types.ModuleName, burned).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, []byte(feeMarketAddr), burned).Return(nil)
+
+	s.Require().NoError(s.feeMarketKeeper.BlockGasUsed.Set(s.ctx, 1000))
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+	s.requireBaseFee(math.LegacyNewDec(8))
+
+	// no more than the collected fees are burned
+	burned = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, feeCollectorAddr, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, authtypes.FeeCollectorName, types.ModuleName, burned).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, []byte(feeMarketAddr), burned).Return(nil)
+
+	s.Require().NoError(s.feeMarketKeeper.BlockGasUsed.Set(s.ctx, 1000))
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+
+	// nothing is burned when the fee collector is empty
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, feeCollectorAddr, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+
+	s.Require().NoError(s.feeMarketKeeper.BlockGasUsed.Set(s.ctx, 1000))
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	invalidParams := types.DefaultParams()
+	invalidParams.TargetBlockGas = 0
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority (not an address)",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &types.MsgUpdateParams{
+				Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    invalidParams,
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    types.DefaultParams(),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParamsRaisesBaseFee() {
+	params := types.DefaultParams()
+	params.MinBaseFee = sdkmath.LegacyNewDec(10)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: s.feeMarketKeeper.GetAuthority(),
+		Params:    params,
+	})
+	s.Require().NoError(err)
+	s.requireBaseFee(sdkmath.LegacyNewDec(10))
+
+	// lowering the min base fee leaves the base fee unchanged
+	params.MinBaseFee = sdkmath.LegacyNewDec(1)
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: s.feeMarketKeeper.GetAuthority(),
+		Params:    params,
+	})
+	s.Require().NoError(err)
+	s.requireBaseFee(sdkmath.LegacyNewDec(10))
+}
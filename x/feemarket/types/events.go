@@ -0,0 +1,10 @@
+package types
+
+// Feemarket module event types
+const (
+	EventTypeBaseFee = "base_fee"
+
+	AttributeKeyBaseFee      = "base_fee"
+	AttributeKeyBlockGasUsed = "block_gas_used"
+	AttributeKeyBurned       = "burned"
+)
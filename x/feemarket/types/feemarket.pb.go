@@ -0,0 +1,743 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1/feemarket.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeePolicy defines what happens to the base fee paid by the transactions of a block.
+type FeePolicy int32
+
+const (
+	// FEE_POLICY_DISTRIBUTE_UNSPECIFIED leaves the base fee in the fee collector,
+	// it is distributed along with the rest of the fees.
+	FeePolicy_FEE_POLICY_DISTRIBUTE_UNSPECIFIED FeePolicy = 0
+	// FEE_POLICY_BURN burns the base fee at the end of the block, only the fees
+	// paid above the base fee are distributed.
+	FeePolicy_FEE_POLICY_BURN FeePolicy = 1
+)
+
+var FeePolicy_name = map[int32]string{
+	0: "FEE_POLICY_DISTRIBUTE_UNSPECIFIED",
+	1: "FEE_POLICY_BURN",
+}
+
+var FeePolicy_value = map[string]int32{
+	"FEE_POLICY_DISTRIBUTE_UNSPECIFIED": 0,
+	"FEE_POLICY_BURN":                   1,
+}
+
+func (x FeePolicy) String() string {
+	return proto.EnumName(FeePolicy_name, int32(x))
+}
+
+func (FeePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_481036a621b23787, []int{0}
+}
+
+// Params defines the parameters for the x/feemarket module.
+type Params struct {
+	// enabled defines whether the base fee is enforced and adjusted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// fee_denom is the denom in which the base fee must be paid.
+	FeeDenom string `protobuf:"bytes,2,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// min_base_fee is the floor of the base fee per unit of gas.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// target_block_gas is the amount of gas a block is expected to consume. The
+	// base fee increases after blocks consuming more gas and decreases after
+	// blocks consuming less.
+	TargetBlockGas uint64 `protobuf:"varint,4,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee between two
+	// blocks to 1/base_fee_change_denominator.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,5,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// fee_policy defines whether the base fee is burned or distributed.
+	FeePolicy FeePolicy `protobuf:"varint,6,opt,name=fee_policy,json=feePolicy,proto3,enum=cosmos.feemarket.v1.FeePolicy" json:"fee_policy,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_481036a621b23787, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetFeePolicy() FeePolicy {
+	if m != nil {
+		return m.FeePolicy
+	}
+	return FeePolicy_FEE_POLICY_DISTRIBUTE_UNSPECIFIED
+}
+
+// State defines the fee market state.
+type State struct {
+	// base_fee is the minimum price per unit of gas a transaction must pay to be
+	// included in the current block.
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+}
+
+func (m *State) Reset()         { *m = State{} }
+func (m *State) String() string { return proto.CompactTextString(m) }
+func (*State) ProtoMessage()    {}
+func (*State) Descriptor() ([]byte, []int) {
+	return fileDescriptor_481036a621b23787, []int{1}
+}
+func (m *State) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_State.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *State) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_State.Merge(m, src)
+}
+func (m *State) XXX_Size() int {
+	return m.Size()
+}
+func (m *State) XXX_DiscardUnknown() {
+	xxx_messageInfo_State.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_State proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmos.feemarket.v1.FeePolicy", FeePolicy_name, FeePolicy_value)
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1.Params")
+	proto.RegisterType((*State)(nil), "cosmos.feemarket.v1.State")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1/feemarket.proto", fileDescriptor_481036a621b23787)
+}
+
+var fileDescriptor_481036a621b23787 = []byte{
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xc1, 0x6a, 0xdb, 0x4a,
+	0x14, 0xd5, 0xe4, 0x25, 0x8e, 0x3d, 0x3c, 0x52, 0x77, 0xd2, 0x85, 0x1a, 0x53, 0xc5, 0x75, 0x29,
+	0x08, 0x43, 0x24, 0xd2, 0x42, 0x29, 0x85, 0x6c, 0x64, 0xc9, 0x41, 0x10, 0x52, 0x57, 0x8e, 0xa1,
+	0xcd, 0x66, 0x18, 0xc9, 0x57, 0x8a, 0xb0, 0xa5, 0x31, 0x1a, 0x11, 0xea, 0x5f, 0xe8, 0xaa, 0x9f,
+	0xd1, 0x65, 0x16, 0xfd, 0x88, 0x2c, 0x43, 0x57, 0xa5, 0x8b, 0x50, 0x6c, 0x4a, 0x7e, 0xa3, 0x48,
+	0x63, 0xc7, 0x2e, 0x74, 0xd7, 0x8d, 0xb8, 0xf7, 0x9c, 0x73, 0x35, 0xe7, 0x9e, 0x19, 0xfc, 0x2c,
+	0xe0, 0x22, 0xe1, 0xc2, 0x0c, 0x01, 0x12, 0x96, 0x8d, 0x20, 0x37, 0x2f, 0x0f, 0x57, 0x8d, 0x31,
+	0xc9, 0x78, 0xce, 0xc9, 0xae, 0x14, 0x19, 0x2b, 0xfc, 0xf2, 0x70, 0xef, 0x51, 0xc4, 0x23, 0x5e,
+	0xf2, 0x66, 0x51, 0x49, 0xe9, 0xde, 0x63, 0x29, 0xa5, 0x92, 0x58, 0xcc, 0x49, 0xea, 0x21, 0x4b,
+	0xe2, 0x94, 0x9b, 0xe5, 0x57, 0x42, 0xad, 0x5f, 0x1b, 0xb8, 0xd2, 0x63, 0x19, 0x4b, 0x04, 0x51,
+	0xf1, 0x36, 0xa4, 0xcc, 0x1f, 0xc3, 0x50, 0x45, 0x4d, 0xa4, 0x57, 0xbd, 0x65, 0x4b, 0x1a, 0xb8,
+	0x16, 0x02, 0xd0, 0x21, 0xa4, 0x3c, 0x51, 0x37, 0x9a, 0x48, 0xaf, 0x79, 0xd5, 0x10, 0xc0, 0x2e,
+	0x7a, 0xf2, 0x1e, 0xff, 0x9f, 0xc4, 0x29, 0xf5, 0x99, 0x00, 0x1a, 0x02, 0xa8, 0xff, 0x15, 0xbc,
+	0xf5, 0xea, 0xfa, 0x76, 0x5f, 0xf9, 0x71, 0xbb, 0xdf, 0x90, 0x06, 0xc4, 0x70, 0x64, 0xc4, 0xdc,
+	0x4c, 0x58, 0x7e, 0x61, 0x9c, 0x40, 0xc4, 0x82, 0xa9, 0x0d, 0xc1, 0xb7, 0xaf, 0x07, 0x78, 0xe1,
+	0xcf, 0x86, 0xe0, 0xcb, 0xdd, 0x55, 0x1b, 0x79, 0x38, 0x89, 0x53, 0x8b, 0x09, 0xe8, 0x02, 0x10,
+	0x1d, 0xd7, 0x73, 0x96, 0x45, 0x90, 0x53, 0x7f, 0xcc, 0x83, 0x11, 0x8d, 0x98, 0x50, 0x37, 0x9b,
+	0x48, 0xdf, 0xf4, 0x76, 0x24, 0x6e, 0x15, 0xf0, 0x31, 0x13, 0xe4, 0x08, 0x37, 0x96, 0xe7, 0xd3,
+	0xe0, 0x82, 0xa5, 0xd1, 0xc2, 0x6c, 0x9c, 0xb2, 0x9c, 0x67, 0xea, 0x56, 0x39, 0xa4, 0xfa, 0xf2,
+	0xbf, 0x9d, 0x52, 0x60, 0xaf, 0x78, 0x72, 0x84, 0x71, 0x31, 0x39, 0xe1, 0xe3, 0x38, 0x98, 0xaa,
+	0x95, 0x26, 0xd2, 0x77, 0x5e, 0x68, 0xc6, 0x5f, 0x22, 0x37, 0xba, 0x00, 0xbd, 0x52, 0xe5, 0xd5,
+	0xc2, 0x65, 0xf9, 0xa6, 0xf5, 0xe9, 0xee, 0xaa, 0xfd, 0x44, 0xca, 0x0f, 0xc4, 0x70, 0x64, 0x7e,
+	0x5c, 0xbb, 0x4c, 0x19, 0x6e, 0xeb, 0x1c, 0x6f, 0xf5, 0x73, 0x96, 0x03, 0x79, 0x87, 0xab, 0xf7,
+	0x51, 0xa1, 0x7f, 0x8a, 0x6a, 0x7b, 0xb1, 0x4f, 0xfb, 0x18, 0xd7, 0xee, 0x7d, 0x91, 0xe7, 0xf8,
+	0x69, 0xd7, 0x71, 0x68, 0xef, 0xed, 0x89, 0xdb, 0xf9, 0x40, 0x6d, 0xb7, 0x7f, 0xe6, 0xb9, 0xd6,
+	0xe0, 0xcc, 0xa1, 0x83, 0xd3, 0x7e, 0xcf, 0xe9, 0xb8, 0x5d, 0xd7, 0xb1, 0xeb, 0x0a, 0xd9, 0xc5,
+	0x0f, 0xd6, 0x64, 0xd6, 0xc0, 0x3b, 0xad, 0x23, 0xeb, 0xf5, 0xf5, 0x4c, 0x43, 0x37, 0x33, 0x0d,
+	0xfd, 0x9c, 0x69, 0xe8, 0xf3, 0x5c, 0x53, 0x6e, 0xe6, 0x9a, 0xf2, 0x7d, 0xae, 0x29, 0xe7, 0xda,
+	0x1f, 0xde, 0xd6, 0xf7, 0xcb, 0xa7, 0x13, 0x10, 0x7e, 0xa5, 0x7c, 0x4d, 0x2f, 0x7f, 0x0f, 0x00,
+	0xa6, 0x53, 0x1e, 0xcb, 0xcd, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeePolicy != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeePolicy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.FeePolicy != 0 {
+		n += 1 + sovFeemarket(uint64(m.FeePolicy))
+	}
+	return n
+}
+
+func (m *State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePolicy", wireType)
+			}
+			m.FeePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePolicy |= FeePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, state State) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		State:  state,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return &GenesisState{
+		Params: params,
+		State:  State{BaseFee: params.MinBaseFee},
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateState(data.State, data.Params)
+}
+
+// ValidateState validates the fee market state against the module params.
+func ValidateState(state State, params Params) error {
+	if state.BaseFee.IsNil() {
+		return errors.New("base fee cannot be nil")
+	}
+	if state.BaseFee.LT(params.MinBaseFee) {
+		return fmt.Errorf("base fee %s cannot be lower than min base fee %s", state.BaseFee, params.MinBaseFee)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// ParamsKey is the key to use for the keeper store.
+	ParamsKey = collections.NewPrefix(0)
+	StateKey  = collections.NewPrefix(1)
+	// BlockGasUsedKey is the key of the block gas used in the memory store.
+	BlockGasUsedKey = collections.NewPrefix(2)
+)
+
+const (
+	// module name
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+
+	// MemStoreKey is the default memory store key for feemarket
+	MemStoreKey = "mem_feemarket"
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
+	GovModuleName = "gov"
+)
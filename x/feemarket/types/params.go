@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBaseFeeChangeDenominator bounds the base fee change between two blocks
+// to 1/8th, as in EIP-1559.
+const DefaultBaseFeeChangeDenominator = 8
+
+// NewParams returns Params instance with the given values.
+func NewParams(enabled bool, feeDenom string, minBaseFee math.LegacyDec, targetBlockGas, baseFeeChangeDenominator uint64, feePolicy FeePolicy) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		TargetBlockGas:           targetBlockGas,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		FeePolicy:                feePolicy,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters.
+// The base fee starts at zero, like the default minimum gas prices of a node, and
+// only increases once blocks exceed the target gas.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  true,
+		FeeDenom:                 sdk.DefaultBondDenom,
+		MinBaseFee:               math.LegacyZeroDec(),
+		TargetBlockGas:           15_000_000,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		FeePolicy:                FeePolicy_FEE_POLICY_DISTRIBUTE_UNSPECIFIED,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if p.TargetBlockGas == 0 {
+		return errors.New("target block gas must be positive")
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+	if _, ok := FeePolicy_name[int32(p.FeePolicy)]; !ok {
+		return fmt.Errorf("invalid fee policy: %d", p.FeePolicy)
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the next block given the base fee and the
+// gas consumed by the current block, following EIP-1559: the base fee moves
+// proportionally to the distance between the gas consumed and the target, by at
+// most 1/BaseFeeChangeDenominator, and never goes below MinBaseFee. A block above
+// the target always increases the base fee, by at least the smallest decimal.
+func (p Params) NextBaseFee(baseFee math.LegacyDec, blockGasUsed uint64) math.LegacyDec {
+	if blockGasUsed == p.TargetBlockGas {
+		return math.LegacyMaxDec(baseFee, p.MinBaseFee)
+	}
+
+	target := math.NewIntFromUint64(p.TargetBlockGas)
+	denominator := math.NewIntFromUint64(p.BaseFeeChangeDenominator)
+
+	if blockGasUsed > p.TargetBlockGas {
+		// a block can consume more gas than its limit when txs are accounted by
+		// their gas limit, the change is therefore capped to 1/denominator.
+		excess := math.LegacyMinDec(
+			math.LegacyNewDecFromInt(math.NewIntFromUint64(blockGasUsed-p.TargetBlockGas)),
+			math.LegacyNewDecFromInt(target),
+		)
+		delta := baseFee.Mul(excess).QuoInt(target).QuoInt(denominator)
+		// always increase the base fee, otherwise a zero base fee could never grow
+		if delta.IsZero() {
+			delta = math.LegacySmallestDec()
+		}
+		return math.LegacyMaxDec(baseFee.Add(delta), p.MinBaseFee)
+	}
+
+	delta := baseFee.MulInt(math.NewIntFromUint64(p.TargetBlockGas - blockGasUsed)).QuoInt(target).QuoInt(denominator)
+	return math.LegacyMaxDec(baseFee.Sub(delta), p.MinBaseFee)
+}
+
+// RequiredFee returns the minimum amount of fee denom a tx consuming gas must
+// pay, fee = ceil(baseFee * gas).
+func RequiredFee(baseFee math.LegacyDec, gas uint64) math.Int {
+	return baseFee.MulInt(math.NewIntFromUint64(gas)).Ceil().TruncateInt()
+}
+
+func validateFeeDenom(v string) error {
+	if strings.TrimSpace(v) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseFee(v math.LegacyDec) error {
+	if v.IsNil() {
+		return errors.New("min base fee cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestValidate(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params2 := NewParams(
+		params.Enabled,
+		params.FeeDenom,
+		params.MinBaseFee,
+		params.TargetBlockGas,
+		params.BaseFeeChangeDenominator,
+		params.FeePolicy,
+	)
+	require.NoError(t, params2.Validate())
+	require.Equal(t, params, params2)
+
+	params.FeeDenom = ""
+	require.Error(t, params.Validate())
+
+	params.FeeDenom = "asd/$%!@#"
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinBaseFee = math.LegacyDec{}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinBaseFee = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinBaseFee = math.LegacyZeroDec()
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.TargetBlockGas = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.BaseFeeChangeDenominator = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.FeePolicy = FeePolicy(42)
+	require.Error(t, params.Validate())
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := DefaultParams()
+	params.MinBaseFee = math.LegacyNewDec(4)
+	params.TargetBlockGas = 1000
+
+	tests := []struct {
+		name         string
+		baseFee      math.LegacyDec
+		blockGasUsed uint64
+		expected     math.LegacyDec
+	}{
+		{"at target", math.LegacyNewDec(8), 1000, math.LegacyNewDec(8)},
+		{"full block", math.LegacyNewDec(8), 2000, math.LegacyNewDec(9)},
+		{"over full block is capped", math.LegacyNewDec(8), 5000, math.LegacyNewDec(9)},
+		{"half above target", math.LegacyNewDec(8), 1500, math.LegacyMustNewDecFromStr("8.5")},
+		{"empty block", math.LegacyNewDec(8), 0, math.LegacyNewDec(7)},
+		{"half below target", math.LegacyNewDec(8), 500, math.LegacyMustNewDecFromStr("7.5")},
+		{"floored to min base fee", math.LegacyMustNewDecFromStr("4.1"), 0, math.LegacyNewDec(4)},
+		{"raised to min base fee", math.LegacyNewDec(2), 1000, math.LegacyNewDec(4)},
+		{"smallest increase", math.LegacyZeroDec(), 2000, math.LegacySmallestDec()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			nextBaseFee := params.NextBaseFee(tc.baseFee, tc.blockGasUsed)
+			require.True(t, tc.expected.Equal(nextBaseFee), "expected %s, got %s", tc.expected, nextBaseFee)
+		})
+	}
+}
+
+func TestRequiredFee(t *testing.T) {
+	require.Equal(t, math.NewInt(200), RequiredFee(math.LegacyNewDec(2), 100))
+	require.Equal(t, math.NewInt(1), RequiredFee(math.LegacyMustNewDecFromStr("0.001"), 100))
+	require.Equal(t, math.NewInt(2), RequiredFee(math.LegacyMustNewDecFromStr("0.001"), 1001))
+	require.Equal(t, math.ZeroInt(), RequiredFee(math.LegacyZeroDec(), 100))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState()))
+
+	genesis := DefaultGenesisState()
+	genesis.State.BaseFee = math.LegacyDec{}
+	require.Error(t, ValidateGenesis(*genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.MinBaseFee = math.LegacyNewDec(2)
+	genesis.State.BaseFee = math.LegacyOneDec()
+	require.Error(t, ValidateGenesis(*genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.TargetBlockGas = 0
+	require.Error(t, ValidateGenesis(*genesis))
+}
@@ -11,6 +11,10 @@ import (
 func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgSend{},
+		&MsgSaveClass{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgUpdate{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
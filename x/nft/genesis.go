@@ -2,6 +2,8 @@ package nft
 
 import (
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 )
 
 // ValidateGenesis checks that the given genesis state has no integrity issues
@@ -21,6 +23,30 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			}
 		}
 	}
+	for _, perms := range data.ClassPermissions {
+		if len(perms.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if _, err := ac.StringToBytes(perms.Issuer); err != nil {
+			return err
+		}
+		for _, minter := range perms.Minters {
+			if _, err := ac.StringToBytes(minter); err != nil {
+				return err
+			}
+		}
+	}
+	for _, royalty := range data.Royalties {
+		if len(royalty.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if _, err := ac.StringToBytes(royalty.Recipient); err != nil {
+			return err
+		}
+		if royalty.Rate.IsNil() || !royalty.Rate.IsPositive() || royalty.Rate.GT(math.LegacyOneDec()) {
+			return errors.Wrapf(ErrInvalidRoyalty, "rate of class %s must be greater than 0 and at most 1", royalty.ClassId)
+		}
+	}
 	return nil
 }
 
@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -28,6 +29,10 @@ type GenesisState struct {
 	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	// entry defines all nft owned by a person.
 	Entries []*Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// class_permissions defines the issuer and minters of the classes managed through the Msg service.
+	ClassPermissions []*ClassPermissions `protobuf:"bytes,3,rep,name=class_permissions,json=classPermissions,proto3" json:"class_permissions,omitempty"`
+	// royalties defines the royalty info of the classes which have one.
+	Royalties []*RoyaltyInfo `protobuf:"bytes,4,rep,name=royalties,proto3" json:"royalties,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +82,20 @@ func (m *GenesisState) GetEntries() []*Entry {
 	return nil
 }
 
+func (m *GenesisState) GetClassPermissions() []*ClassPermissions {
+	if m != nil {
+		return m.ClassPermissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoyalties() []*RoyaltyInfo {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
 // Entry Defines all nft owned by a person
 type Entry struct {
 	// owner is the owner address of the following nft
@@ -140,22 +159,27 @@ func init() {
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/genesis.proto", fileDescriptor_0095f7548e354a72) }
 
 var fileDescriptor_0095f7548e354a72 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0xcb, 0x4b, 0x2b, 0xd1, 0x83, 0xaa, 0x90, 0x92, 0xc1, 0xa2, 0x0b, 0x24, 0x0f, 0xd6, 0xa1,
-	0x54, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x22, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x98, 0x8b, 0x3d,
-	0x39, 0x27, 0xb1, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x52, 0x0f,
-	0xd3, 0x4c, 0x3d, 0x67, 0x90, 0x92, 0x20, 0x98, 0x4a, 0x90, 0xa6, 0xd4, 0xbc, 0x92, 0xa2, 0xcc,
-	0xd4, 0x62, 0x09, 0x26, 0xdc, 0x9a, 0x5c, 0xf3, 0x4a, 0x8a, 0x2a, 0x83, 0x60, 0x2a, 0x95, 0xbc,
-	0xb8, 0x58, 0xc1, 0x22, 0x42, 0x22, 0x5c, 0xac, 0xf9, 0xe5, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x36, 0x17, 0x4b, 0x5e, 0x5a, 0x09, 0xcc, 0x40, 0x71,
-	0x6c, 0x06, 0xfa, 0xb9, 0x85, 0x04, 0x81, 0x15, 0x39, 0xe9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x34, 0x44, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x40,
-	0x3e, 0x4f, 0x62, 0x03, 0x7b, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xe8, 0x93, 0x50,
-	0x50, 0x01, 0x00, 0x00,
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4a, 0x33, 0x31,
+	0x14, 0x86, 0x9b, 0xfe, 0x7c, 0x1f, 0x8d, 0x5d, 0xd4, 0x20, 0x38, 0x2d, 0x12, 0x87, 0xe2, 0xa2,
+	0xa0, 0x66, 0xfa, 0x73, 0x07, 0x15, 0x15, 0x5d, 0xa8, 0x44, 0x57, 0x6e, 0xca, 0x74, 0xcc, 0xc8,
+	0x60, 0x9b, 0x94, 0x9c, 0x50, 0xed, 0x5d, 0x78, 0x25, 0xae, 0xbc, 0x08, 0x97, 0x5d, 0x8a, 0x2b,
+	0x69, 0x6f, 0x44, 0x26, 0x33, 0x43, 0x41, 0xa7, 0xcb, 0x43, 0x9e, 0xe7, 0x3d, 0xe7, 0xe4, 0x60,
+	0x37, 0x50, 0x30, 0x51, 0xe0, 0xc9, 0xd0, 0x78, 0xb3, 0xee, 0x48, 0x18, 0xbf, 0xeb, 0x3d, 0x0a,
+	0x29, 0x20, 0x02, 0x36, 0xd5, 0xca, 0x28, 0x42, 0x12, 0x82, 0xc9, 0xd0, 0xb0, 0x94, 0x68, 0xee,
+	0xe5, 0x58, 0xf1, 0xbb, 0x35, 0x9a, 0x8d, 0xe4, 0x75, 0x68, 0x2b, 0x2f, 0xd5, 0x6d, 0xd1, 0x7a,
+	0x2b, 0xe2, 0xda, 0x79, 0x12, 0x7f, 0x6b, 0x7c, 0x23, 0x48, 0x1f, 0xff, 0x0f, 0xc6, 0x3e, 0x80,
+	0x00, 0x07, 0xb9, 0xa5, 0xf6, 0x56, 0xaf, 0xc1, 0xfe, 0xf6, 0x63, 0x27, 0x31, 0xc2, 0x33, 0x32,
+	0x96, 0x84, 0x34, 0x3a, 0x12, 0xe0, 0x14, 0x37, 0x4b, 0xa7, 0xd2, 0xe8, 0x39, 0xcf, 0x48, 0x12,
+	0xe0, 0x6d, 0xeb, 0x0f, 0xa7, 0x42, 0x4f, 0x22, 0x80, 0x48, 0x49, 0x70, 0x4a, 0x56, 0x3f, 0xd8,
+	0xd8, 0xf3, 0x66, 0xcd, 0x0e, 0xea, 0x5f, 0xef, 0xc7, 0xb5, 0x97, 0x78, 0x4f, 0x77, 0xd6, 0x61,
+	0x3d, 0xd6, 0xe1, 0xf5, 0xe0, 0x17, 0x43, 0xae, 0x71, 0x55, 0xab, 0xb9, 0x3f, 0x36, 0xf1, 0x6c,
+	0x65, 0x1b, 0xbe, 0x9f, 0x17, 0xce, 0x2d, 0x34, 0xbf, 0x90, 0xa1, 0xca, 0xc9, 0x5d, 0x67, 0xb4,
+	0x2e, 0x71, 0xc5, 0xee, 0x41, 0x76, 0x70, 0x45, 0x3d, 0x4b, 0xa1, 0x1d, 0xe4, 0xa2, 0x76, 0x95,
+	0x27, 0x05, 0x39, 0xc4, 0x65, 0x19, 0x9a, 0xec, 0x1b, 0x76, 0xf3, 0x5a, 0x5d, 0x9d, 0xdd, 0x71,
+	0x0b, 0x0d, 0x8e, 0x3e, 0x96, 0x14, 0x2d, 0x96, 0x14, 0x7d, 0x2f, 0x29, 0x7a, 0x5d, 0xd1, 0xc2,
+	0x62, 0x45, 0x0b, 0x9f, 0x2b, 0x5a, 0xb8, 0x4f, 0x6f, 0x0c, 0x0f, 0x4f, 0x2c, 0x52, 0x9e, 0x9d,
+	0x65, 0xf4, 0xcf, 0x5e, 0xac, 0xff, 0x33, 0x00, 0x27, 0x75, 0xa3, 0x5c, 0x22, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +202,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClassPermissions) > 0 {
+		for iNdEx := len(m.ClassPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -282,6 +334,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClassPermissions) > 0 {
+		for _, e := range m.ClassPermissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -407,6 +471,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassPermissions = append(m.ClassPermissions, &ClassPermissions{})
+			if err := m.ClassPermissions[len(m.ClassPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, &RoyaltyInfo{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
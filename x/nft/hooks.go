@@ -0,0 +1,23 @@
+package nft
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferHook defines the interface for modules to observe or veto nft transfers.
+// Hooks are only called on transfers, not when nfts are minted or burned.
+type TransferHook interface {
+	// BeforeTransfer is called before a nft is transferred. Returning an error aborts the transfer.
+	BeforeTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+
+	// AfterTransfer is called after a nft has been transferred. Returning an error aborts the transfer.
+	AfterTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+}
+
+// TransferHookWrapper is a wrapper for modules to inject TransferHook using depinject.
+type TransferHookWrapper struct{ TransferHook }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (TransferHookWrapper) IsOnePerModuleType() {}
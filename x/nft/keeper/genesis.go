@@ -27,6 +27,16 @@ func (k Keeper) InitGenesis(ctx context.Context, data *nft.GenesisState) error {
 			}
 		}
 	}
+	for _, perms := range data.ClassPermissions {
+		if err := k.SetClassPermissions(ctx, *perms); err != nil {
+			return err
+		}
+	}
+	for _, royalty := range data.Royalties {
+		if err := k.SetRoyalty(ctx, *royalty); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -64,7 +74,9 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*nft.GenesisState, error) {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:          classes,
+		Entries:          entries,
+		ClassPermissions: k.GetAllClassPermissions(ctx),
+		Royalties:        k.GetRoyalties(ctx),
 	}, nil
 }
@@ -259,3 +259,42 @@ func (k Keeper) Classes(ctx context.Context, r *nft.QueryClassesRequest) (*nft.Q
 		Pagination: pageRes,
 	}, nil
 }
+
+// Royalty return the royalty info of the class and, if a sale price is given, the royalty owed on the sale
+func (k Keeper) Royalty(ctx context.Context, r *nft.QueryRoyaltyRequest) (*nft.QueryRoyaltyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	royalty, has := k.GetRoyalty(ctx, r.ClassId)
+	if !has {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no royalty for class: %s", r.ClassId)
+	}
+
+	res := &nft.QueryRoyaltyResponse{Royalty: &royalty}
+	if r.SalePrice != nil {
+		if err := r.SalePrice.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sale price: %s", err)
+		}
+
+		_, amount, _, err := k.RoyaltyAmount(ctx, r.ClassId, *r.SalePrice)
+		if err != nil {
+			return nil, err
+		}
+		res.RoyaltyAmount = &amount
+	}
+	return res, nil
+}
+
+// TransferHooks return the names of the modules whose hooks are called on nft transfers, in order
+func (k Keeper) TransferHooks(ctx context.Context, r *nft.QueryTransferHooksRequest) (*nft.QueryTransferHooksResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	return &nft.QueryTransferHooksResponse{Modules: k.GetTransferHooks()}, nil
+}
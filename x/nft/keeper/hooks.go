@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// transferHooks are the hooks called on nft transfers, in the order they were set.
+type transferHooks struct {
+	names []string
+	hooks []nft.TransferHook
+}
+
+// SetTransferHook appends the hook of the given module to the hooks called on nft transfers.
+// It panics if a hook has already been set for the module.
+func (k Keeper) SetTransferHook(moduleName string, hook nft.TransferHook) {
+	if slices.Contains(k.hooks.names, moduleName) {
+		panic(fmt.Sprintf("cannot set nft transfer hook of module %s twice", moduleName))
+	}
+
+	k.hooks.names = append(k.hooks.names, moduleName)
+	k.hooks.hooks = append(k.hooks.hooks, hook)
+}
+
+// GetTransferHooks returns the names of the modules whose hooks are called on nft transfers, in order.
+func (k Keeper) GetTransferHooks() []string {
+	return slices.Clone(k.hooks.names)
+}
+
+func (h *transferHooks) beforeTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i, hook := range h.hooks {
+		if err := hook.BeforeTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return fmt.Errorf("nft transfer hook of module %s: %w", h.names[i], err)
+		}
+	}
+	return nil
+}
+
+func (h *transferHooks) afterTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i, hook := range h.hooks {
+		if err := hook.AfterTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return fmt.Errorf("nft transfer hook of module %s: %w", h.names[i], err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockTransferHook struct {
+	name   string
+	calls  *[]string
+	vetoed bool
+}
+
+func (h mockTransferHook) BeforeTransfer(_ context.Context, classID, nftID string, _, _ sdk.AccAddress) error {
+	*h.calls = append(*h.calls, h.name+" before "+classID+"/"+nftID)
+	if h.vetoed {
+		return errors.New("vetoed")
+	}
+	return nil
+}
+
+func (h mockTransferHook) AfterTransfer(_ context.Context, classID, nftID string, _, _ sdk.AccAddress) error {
+	*h.calls = append(*h.calls, h.name+" after "+classID+"/"+nftID)
+	return nil
+}
+
+func (s *TestSuite) TestTransferHooks() {
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+	err = s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	var calls []string
+	// hooks set on a copy of the keeper are shared with all copies
+	k := s.nftKeeper
+	k.SetTransferHook("a", mockTransferHook{name: "a", calls: &calls})
+	k.SetTransferHook("b", mockTransferHook{name: "b", calls: &calls})
+	s.Require().Panics(func() {
+		k.SetTransferHook("a", mockTransferHook{name: "a", calls: &calls})
+	})
+
+	res, err := s.queryClient.TransferHooks(s.ctx, &nft.QueryTransferHooksRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"a", "b"}, res.Modules)
+
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().Equal([]string{
+		"a before kitty/kitty1",
+		"b before kitty/kitty1",
+		"a after kitty/kitty1",
+		"b after kitty/kitty1",
+	}, calls)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	calls = nil
+	s.nftKeeper.SetTransferHook("c", mockTransferHook{name: "c", calls: &calls, vetoed: true})
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[2])
+	s.Require().ErrorContains(err, "nft transfer hook of module c: vetoed")
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+}
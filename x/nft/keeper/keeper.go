@@ -15,6 +15,10 @@ type Keeper struct {
 	cdc codec.BinaryCodec
 	bk  nft.BankKeeper
 	ac  address.Codec
+
+	// hooks is shared by all copies of the keeper so that transfer hooks can be set
+	// after the keeper has been provided to other modules.
+	hooks *transferHooks
 }
 
 // NewKeeper creates a new nft Keeper instance
@@ -31,5 +35,6 @@ func NewKeeper(env appmodule.Environment,
 		cdc:         cdc,
 		bk:          bk,
 		ac:          ak.AddressCodec(),
+		hooks:       &transferHooks{},
 	}
 }
@@ -16,6 +16,8 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassPermissionsKey  = []byte{0x06}
+	RoyaltyKey           = []byte{0x07}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -32,6 +34,22 @@ func classStoreKey(classID string) []byte {
 	return key
 }
 
+// classPermissionsStoreKey returns the byte representation of the nft class permissions key
+func classPermissionsStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassPermissionsKey)+len(classID))
+	copy(key, ClassPermissionsKey)
+	copy(key[len(ClassPermissionsKey):], classID)
+	return key
+}
+
+// royaltyStoreKey returns the byte representation of the nft class royalty key
+func royaltyStoreKey(classID string) []byte {
+	key := make([]byte, len(RoyaltyKey)+len(classID))
+	copy(key, RoyaltyKey)
+	copy(key[len(RoyaltyKey):], classID)
+	return key
+}
+
 // nftStoreKey returns the byte representation of the nft
 func nftStoreKey(classID string) []byte {
 	key := make([]byte, len(NFTKey)+len(classID)+len(Delimiter))
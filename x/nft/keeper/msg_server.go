@@ -10,10 +10,28 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
 
-// Send implements Send method of the types.MsgServer.
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) nft.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// Send implements the Msg/Send method on the Keeper for callers that used the Keeper as
+// the nft MsgServer, it delegates to the MsgServer returned by NewMsgServerImpl.
 func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+	return msgServer{Keeper: k}.Send(ctx, msg)
+}
+
+// Send implements Send method of the types.MsgServer.
+func (k msgServer) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	if len(msg.ClassId) == 0 {
 		return nil, nft.ErrEmptyClassID
 	}
@@ -52,3 +70,189 @@ func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespons
 
 	return &nft.MsgSendResponse{}, nil
 }
+
+// SaveClass implements SaveClass method of the types.MsgServer.
+// It creates the class with the signer as its issuer, or updates the class and its minters
+// if the class already exists and the signer is its issuer.
+func (k msgServer) SaveClass(ctx context.Context, msg *nft.MsgSaveClass) (*nft.MsgSaveClassResponse, error) {
+	if msg.Class == nil || len(msg.Class.Id) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	issuer, err := k.ac.StringToBytes(msg.Issuer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", msg.Issuer)
+	}
+
+	seen := make(map[string]bool, len(msg.Minters))
+	for _, minter := range msg.Minters {
+		if _, err := k.ac.StringToBytes(minter); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", minter)
+		}
+		if seen[minter] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate minter address (%s)", minter)
+		}
+		seen[minter] = true
+	}
+
+	if k.HasClass(ctx, msg.Class.Id) {
+		perms, found := k.GetClassPermissions(ctx, msg.Class.Id)
+		if !found {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "nft class %s has no issuer", msg.Class.Id)
+		}
+
+		ok, err := k.isIssuer(perms, issuer)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of nft class %s", msg.Issuer, msg.Class.Id)
+		}
+
+		if err := k.UpdateClass(ctx, *msg.Class); err != nil {
+			return nil, err
+		}
+	} else if err := k.Keeper.SaveClass(ctx, *msg.Class); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetClassPermissions(ctx, nft.ClassPermissions{
+		ClassId: msg.Class.Id,
+		Issuer:  msg.Issuer,
+		Minters: msg.Minters,
+	}); err != nil {
+		return nil, err
+	}
+
+	if msg.Royalty != nil {
+		royalty := *msg.Royalty
+		if len(royalty.ClassId) != 0 && royalty.ClassId != msg.Class.Id {
+			return nil, errorsmod.Wrapf(nft.ErrInvalidRoyalty, "royalty class id %s does not match class id %s", royalty.ClassId, msg.Class.Id)
+		}
+		royalty.ClassId = msg.Class.Id
+		if err := k.SetRoyalty(ctx, royalty); err != nil {
+			return nil, err
+		}
+	} else if err := k.DeleteRoyalty(ctx, msg.Class.Id); err != nil {
+		return nil, err
+	}
+
+	if err = k.EventService.EventManager(ctx).Emit(&nft.EventSaveClass{
+		ClassId: msg.Class.Id,
+		Issuer:  msg.Issuer,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgSaveClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(ctx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	if msg.Nft == nil || len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	minter, err := k.ac.StringToBytes(msg.Minter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", msg.Minter)
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	perms, found := k.GetClassPermissions(ctx, msg.Nft.ClassId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "nft class %s has no issuer", msg.Nft.ClassId)
+	}
+
+	ok, err := k.canMint(perms, minter)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nfts of class %s", msg.Minter, msg.Nft.ClassId)
+	}
+
+	// the keeper emits EventMint
+	if err := k.Keeper.Mint(ctx, *msg.Nft, receiver); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(ctx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	owner, err := k.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	if !bytes.Equal(k.GetOwner(ctx, msg.ClassId, msg.Id), owner) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Owner, msg.Id)
+	}
+
+	// the keeper emits EventBurn
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// Update implements Update method of the types.MsgServer.
+func (k msgServer) Update(ctx context.Context, msg *nft.MsgUpdate) (*nft.MsgUpdateResponse, error) {
+	if msg.Nft == nil || len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	issuer, err := k.ac.StringToBytes(msg.Issuer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", msg.Issuer)
+	}
+
+	perms, found := k.GetClassPermissions(ctx, msg.Nft.ClassId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "nft class %s has no issuer", msg.Nft.ClassId)
+	}
+
+	ok, err := k.isIssuer(perms, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of nft class %s", msg.Issuer, msg.Nft.ClassId)
+	}
+
+	if err := k.Keeper.Update(ctx, *msg.Nft); err != nil {
+		return nil, err
+	}
+
+	if err = k.EventService.EventManager(ctx).Emit(&nft.EventUpdate{
+		ClassId: msg.Nft.ClassId,
+		Id:      msg.Nft.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgUpdateResponse{}, nil
+}
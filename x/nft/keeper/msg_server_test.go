@@ -3,7 +3,10 @@ package keeper_test
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -121,7 +124,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
@@ -131,3 +134,226 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestSaveClassMsg() {
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Issuer:  s.encodedAddrs[0],
+		Class:   &ExpClass,
+		Minters: []string{s.encodedAddrs[1]},
+	})
+	s.Require().NoError(err)
+
+	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(ExpClass, actual)
+
+	perms, has := s.nftKeeper.GetClassPermissions(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(nft.ClassPermissions{
+		ClassId: testClassID,
+		Issuer:  s.encodedAddrs[0],
+		Minters: []string{s.encodedAddrs[1]},
+	}, perms)
+
+	updated := ExpClass
+	updated.Name = "Updated Kitty"
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgSaveClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "empty class id",
+			req: &nft.MsgSaveClass{
+				Issuer: s.encodedAddrs[0],
+				Class:  &nft.Class{},
+			},
+			expErr: true,
+			errMsg: "empty class id",
+		},
+		{
+			name: "invalid minter",
+			req: &nft.MsgSaveClass{
+				Issuer:  s.encodedAddrs[0],
+				Class:   &updated,
+				Minters: []string{"invalid"},
+			},
+			expErr: true,
+			errMsg: "Invalid minter address",
+		},
+		{
+			name: "duplicate minter",
+			req: &nft.MsgSaveClass{
+				Issuer:  s.encodedAddrs[0],
+				Class:   &updated,
+				Minters: []string{s.encodedAddrs[1], s.encodedAddrs[1]},
+			},
+			expErr: true,
+			errMsg: "duplicate minter address",
+		},
+		{
+			name: "not the issuer",
+			req: &nft.MsgSaveClass{
+				Issuer: s.encodedAddrs[1],
+				Class:  &updated,
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the issuer of nft class %s", s.encodedAddrs[1], testClassID),
+		},
+		{
+			name: "valid update",
+			req: &nft.MsgSaveClass{
+				Issuer:  s.encodedAddrs[0],
+				Class:   &updated,
+				Minters: []string{s.encodedAddrs[2]},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SaveClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	actual, has = s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(updated, actual)
+
+	perms, has = s.nftKeeper.GetClassPermissions(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal([]string{s.encodedAddrs[2]}, perms.Minters)
+}
+
+func (s *TestSuite) TestMintBurnUpdateMsgs() {
+	// classes created through the keeper have no issuer and can't be minted through the Msg service
+	err := s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "keeper-class"})
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[0],
+		Nft:      &nft.NFT{ClassId: "keeper-class", Id: testID},
+		Receiver: s.encodedAddrs[0],
+	})
+	s.Require().ErrorContains(err, "nft class keeper-class has no issuer")
+
+	_, err = s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Issuer:  s.encodedAddrs[0],
+		Class:   &ExpClass,
+		Minters: []string{s.encodedAddrs[1]},
+	})
+	s.Require().NoError(err)
+
+	// mint
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[2],
+		Nft:      &ExpNFT,
+		Receiver: s.encodedAddrs[2],
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not allowed to mint nfts of class %s", s.encodedAddrs[2], testClassID))
+
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[1],
+		Nft:      &ExpNFT,
+		Receiver: s.encodedAddrs[2],
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[0],
+		Nft:      &ExpNFT,
+		Receiver: s.encodedAddrs[0],
+	})
+	s.Require().ErrorIs(err, nft.ErrNFTExists)
+
+	// update
+	updated := ExpNFT
+	updated.Uri = "updated uri"
+	_, err = s.msgServer.Update(s.ctx, &nft.MsgUpdate{
+		Issuer: s.encodedAddrs[1],
+		Nft:    &updated,
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the issuer of nft class %s", s.encodedAddrs[1], testClassID))
+
+	_, err = s.msgServer.Update(s.ctx, &nft.MsgUpdate{
+		Issuer: s.encodedAddrs[0],
+		Nft:    &updated,
+	})
+	s.Require().NoError(err)
+	actual, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().EqualValues(updated, actual)
+
+	// burn
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{
+		Owner:   s.encodedAddrs[0],
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the owner of nft %s", s.encodedAddrs[0], testID))
+
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{
+		Owner:   s.encodedAddrs[2],
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().Equal(uint64(0), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestSaveClassRoyalty() {
+	royalty := &nft.RoyaltyInfo{
+		Recipient: s.encodedAddrs[1],
+		Rate:      math.LegacyNewDecWithPrec(25, 3),
+	}
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Issuer:  s.encodedAddrs[0],
+		Class:   &ExpClass,
+		Royalty: royalty,
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Royalty(s.ctx, &nft.QueryRoyaltyRequest{
+		ClassId:   testClassID,
+		SalePrice: &sdk.Coin{Denom: "stake", Amount: math.NewInt(1000)},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(testClassID, res.Royalty.ClassId)
+	s.Require().Equal(s.encodedAddrs[1], res.Royalty.Recipient)
+	s.Require().True(royalty.Rate.Equal(res.Royalty.Rate))
+	s.Require().Equal("25stake", res.RoyaltyAmount.String())
+
+	for _, rate := range []math.LegacyDec{math.LegacyZeroDec(), math.LegacyNewDec(2), math.LegacyNewDec(-1)} {
+		_, err = s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+			Issuer:  s.encodedAddrs[0],
+			Class:   &ExpClass,
+			Royalty: &nft.RoyaltyInfo{Recipient: s.encodedAddrs[1], Rate: rate},
+		})
+		s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+	}
+
+	_, err = s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Issuer:  s.encodedAddrs[0],
+		Class:   &ExpClass,
+		Royalty: &nft.RoyaltyInfo{ClassId: "other", Recipient: s.encodedAddrs[1], Rate: royalty.Rate},
+	})
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+
+	// saving the class without royalty removes it
+	_, err = s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Issuer: s.encodedAddrs[0],
+		Class:  &ExpClass,
+	})
+	s.Require().NoError(err)
+	_, has := s.nftKeeper.GetRoyalty(s.ctx, testClassID)
+	s.Require().False(has)
+}
@@ -137,9 +137,13 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	receiver sdk.AccAddress,
 ) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	if err := k.hooks.beforeTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+		return err
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
-	return nil
+	return k.hooks.afterTransfer(ctx, classID, nftID, owner, receiver)
 }
 
 // GetNFT returns the nft information of the specified classID and nftID
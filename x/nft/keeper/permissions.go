@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetClassPermissions defines a method for setting the issuer and minters of an exist nft class
+func (k Keeper) SetClassPermissions(ctx context.Context, perms nft.ClassPermissions) error {
+	if !k.HasClass(ctx, perms.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, perms.ClassId)
+	}
+	bz, err := k.cdc.Marshal(&perms)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassPermissions failed")
+	}
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(classPermissionsStoreKey(perms.ClassId), bz)
+}
+
+// GetClassPermissions defines a method for returning the issuer and minters of the specified class.
+// Classes which were not created through the Msg service have no permissions.
+func (k Keeper) GetClassPermissions(ctx context.Context, classID string) (nft.ClassPermissions, bool) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	var perms nft.ClassPermissions
+
+	bz, err := store.Get(classPermissionsStoreKey(classID))
+	if err != nil {
+		return perms, false
+	}
+
+	if len(bz) == 0 {
+		return perms, false
+	}
+	k.cdc.MustUnmarshal(bz, &perms)
+	return perms, true
+}
+
+// GetAllClassPermissions defines a method for returning the permissions of all classes
+func (k Keeper) GetAllClassPermissions(ctx context.Context) (perms []*nft.ClassPermissions) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassPermissionsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var p nft.ClassPermissions
+		k.cdc.MustUnmarshal(iterator.Value(), &p)
+		perms = append(perms, &p)
+	}
+	return
+}
+
+// isIssuer determines whether the account is the issuer of the class
+func (k Keeper) isIssuer(perms nft.ClassPermissions, account sdk.AccAddress) (bool, error) {
+	issuer, err := k.ac.StringToBytes(perms.Issuer)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(issuer, account), nil
+}
+
+// canMint determines whether the account is the issuer or one of the minters of the class
+func (k Keeper) canMint(perms nft.ClassPermissions, account sdk.AccAddress) (bool, error) {
+	if ok, err := k.isIssuer(perms, account); err != nil || ok {
+		return ok, err
+	}
+	for _, m := range perms.Minters {
+		minter, err := k.ac.StringToBytes(m)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(minter, account) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
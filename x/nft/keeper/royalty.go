@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetRoyalty defines a method for setting the royalty info of an exist nft class
+func (k Keeper) SetRoyalty(ctx context.Context, royalty nft.RoyaltyInfo) error {
+	if !k.HasClass(ctx, royalty.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, royalty.ClassId)
+	}
+	if err := k.validateRoyalty(royalty); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&royalty)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.RoyaltyInfo failed")
+	}
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(royaltyStoreKey(royalty.ClassId), bz)
+}
+
+// DeleteRoyalty defines a method for removing the royalty info of the specified class
+func (k Keeper) DeleteRoyalty(ctx context.Context, classID string) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Delete(royaltyStoreKey(classID))
+}
+
+// GetRoyalty defines a method for returning the royalty info of the specified class
+func (k Keeper) GetRoyalty(ctx context.Context, classID string) (nft.RoyaltyInfo, bool) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	var royalty nft.RoyaltyInfo
+
+	bz, err := store.Get(royaltyStoreKey(classID))
+	if err != nil {
+		return royalty, false
+	}
+
+	if len(bz) == 0 {
+		return royalty, false
+	}
+	k.cdc.MustUnmarshal(bz, &royalty)
+	return royalty, true
+}
+
+// GetRoyalties defines a method for returning the royalty info of all classes which have one
+func (k Keeper) GetRoyalties(ctx context.Context) (royalties []*nft.RoyaltyInfo) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), RoyaltyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var royalty nft.RoyaltyInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &royalty)
+		royalties = append(royalties, &royalty)
+	}
+	return
+}
+
+// RoyaltyAmount returns the royalty recipient and the royalty owed on a sale of a nft of the
+// specified class at the given price, rounded down. It returns false if the class has no royalty.
+func (k Keeper) RoyaltyAmount(ctx context.Context, classID string, salePrice sdk.Coin) (sdk.AccAddress, sdk.Coin, bool, error) {
+	royalty, has := k.GetRoyalty(ctx, classID)
+	if !has {
+		return nil, sdk.Coin{}, false, nil
+	}
+
+	recipient, err := k.ac.StringToBytes(royalty.Recipient)
+	if err != nil {
+		return nil, sdk.Coin{}, false, err
+	}
+
+	amount := math.LegacyNewDecFromInt(salePrice.Amount).Mul(royalty.Rate).TruncateInt()
+	return recipient, sdk.NewCoin(salePrice.Denom, amount), true, nil
+}
+
+func (k Keeper) validateRoyalty(royalty nft.RoyaltyInfo) error {
+	if _, err := k.ac.StringToBytes(royalty.Recipient); err != nil {
+		return errors.Wrapf(nft.ErrInvalidRoyalty, "invalid recipient address (%s)", royalty.Recipient)
+	}
+	if royalty.Rate.IsNil() || !royalty.Rate.IsPositive() || royalty.Rate.GT(math.LegacyOneDec()) {
+		return errors.Wrapf(nft.ErrInvalidRoyalty, "rate must be greater than 0 and at most 1, got %s", royalty.Rate)
+	}
+	return nil
+}
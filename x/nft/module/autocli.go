@@ -79,6 +79,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "Royalty",
+					Use:       "royalty [class-id]",
+					Short:     "Query the royalty info of an NFT class and the royalty owed on a sale.",
+					Example:   fmt.Sprintf(`%s query %s royalty <class-id> --sale-price=1000stake`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "TransferHooks",
+					Use:       "transfer-hooks",
+					Short:     "Query the modules whose hooks are called on NFT transfers, in order.",
+					Example:   fmt.Sprintf(`%s query %s transfer-hooks`, version.AppName, nft.ModuleName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -95,6 +110,44 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					// Sender is the signer of the transaction and is automatically added as from flag by AutoCLI.
 				},
+				{
+					RpcMethod: "SaveClass",
+					Use:       "save-class [class] --from [issuer]",
+					Short:     "Create an NFT class, or update a class issued by the sender",
+					Long:      "Create an NFT class with the sender as its issuer, or update a class issued by the sender. The class is given as JSON and the accounts allowed to mint nfts of the class, other than the issuer, with the --minters flag.",
+					Example:   fmt.Sprintf(`%s tx %s save-class '{"id":"kitty","name":"Crypto Kitty","uri":"ipfs://..."}' --minters=<minter> --from <issuer>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class"},
+					},
+				},
+				{
+					RpcMethod: "Mint",
+					Use:       "mint [nft] [receiver] --from [minter]",
+					Short:     "Mint an NFT of a class the sender is the issuer or a minter of",
+					Example:   fmt.Sprintf(`%s tx %s mint '{"class_id":"kitty","id":"kitty1","uri":"ipfs://..."}' <receiver> --from <minter>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "nft"},
+						{ProtoField: "receiver"},
+					},
+				},
+				{
+					RpcMethod: "Burn",
+					Use:       "burn [class-id] [nft-id] --from [owner]",
+					Short:     "Burn an NFT owned by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "Update",
+					Use:       "update [nft] --from [issuer]",
+					Short:     "Update the metadata of an NFT of a class issued by the sender",
+					Example:   fmt.Sprintf(`%s tx %s update '{"class_id":"kitty","id":"kitty1","uri":"ipfs://..."}' --from <issuer>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "nft"},
+					},
+				},
 			},
 		},
 	}
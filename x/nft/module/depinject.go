@@ -1,6 +1,11 @@
 package module
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+
 	modulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -20,6 +25,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetTransferHooks),
 	)
 }
 
@@ -47,3 +53,42 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{NFTKeeper: k, Module: m}
 }
+
+// InvokeSetTransferHooks sets the nft transfer hooks provided by other modules
+// in the order configured in the module config.
+func InvokeSetTransferHooks(
+	config *modulev1.Module,
+	k keeper.Keeper,
+	transferHooks map[string]nft.TransferHookWrapper,
+) error {
+	// all arguments to invokers are optional
+	if config == nil {
+		return nil
+	}
+
+	if len(transferHooks) == 0 {
+		return nil
+	}
+
+	modNames := slices.Collect(maps.Keys(transferHooks))
+	order := config.TransferHooksOrder
+	if len(order) == 0 {
+		order = modNames
+		sort.Strings(order)
+	}
+
+	if len(order) != len(modNames) {
+		return fmt.Errorf("len(transfer_hooks_order: %v) != len(transfer hooks modules: %v)", order, modNames)
+	}
+
+	for _, modName := range order {
+		hook, ok := transferHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find nft transfer hook for module %s", modName)
+		}
+
+		k.SetTransferHook(modName, hook)
+	}
+
+	return nil
+}